@@ -0,0 +1,92 @@
+// Package diff computes line-level differences between texts.
+package diff
+
+import (
+	"strings"
+)
+
+// Operation describes how a line changed between two texts.
+type Operation string
+
+const (
+	// Equal marks a line present in both texts.
+	Equal Operation = "EQUAL"
+	// Insert marks a line only present in the new text.
+	Insert Operation = "INSERT"
+	// Delete marks a line only present in the old text.
+	Delete Operation = "DELETE"
+)
+
+// Line is a line of a diff.
+type Line struct {
+	Operation Operation
+	Text      string
+}
+
+// Lines returns the line-level diff turning the old text into the new one.
+// Lines are matched by their longest common subsequence, and deletions come before insertions.
+func Lines(oldText, newText string) []Line {
+	a, b := splitLines(oldText), splitLines(newText)
+
+	// Common prefixes and suffixes are kept out of the quadratic part.
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	lines := make([]Line, 0, len(a)+len(b))
+	for _, text := range a[:prefix] {
+		lines = append(lines, Line{Operation: Equal, Text: text})
+	}
+	lines = append(lines, diffMiddle(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for _, text := range a[len(a)-suffix:] {
+		lines = append(lines, Line{Operation: Equal, Text: text})
+	}
+	return lines
+}
+
+func diffMiddle(a, b []string) []Line {
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:].
+	lcs := make([][]int32, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int32, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	lines := make([]Line, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			lines = append(lines, Line{Operation: Equal, Text: a[i]})
+			i++
+			j++
+		case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+			lines = append(lines, Line{Operation: Delete, Text: a[i]})
+			i++
+		default:
+			lines = append(lines, Line{Operation: Insert, Text: b[j]})
+			j++
+		}
+	}
+	return lines
+}
+
+func splitLines(text string) []string {
+	if text == "" {
+		return []string{}
+	}
+	return strings.Split(text, "\n")
+}
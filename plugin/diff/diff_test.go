@@ -0,0 +1,79 @@
+package diff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		oldText  string
+		newText  string
+		expected []Line
+	}{
+		{
+			name:     "empty",
+			oldText:  "",
+			newText:  "",
+			expected: []Line{},
+		},
+		{
+			name:    "created",
+			oldText: "",
+			newText: "a\nb",
+			expected: []Line{
+				{Operation: Insert, Text: "a"},
+				{Operation: Insert, Text: "b"},
+			},
+		},
+		{
+			name:    "cleared",
+			oldText: "a",
+			newText: "",
+			expected: []Line{
+				{Operation: Delete, Text: "a"},
+			},
+		},
+		{
+			name:    "unchanged",
+			oldText: "a\nb",
+			newText: "a\nb",
+			expected: []Line{
+				{Operation: Equal, Text: "a"},
+				{Operation: Equal, Text: "b"},
+			},
+		},
+		{
+			name:    "replaced line",
+			oldText: "a\nb\nc",
+			newText: "a\nx\nc",
+			expected: []Line{
+				{Operation: Equal, Text: "a"},
+				{Operation: Delete, Text: "b"},
+				{Operation: Insert, Text: "x"},
+				{Operation: Equal, Text: "c"},
+			},
+		},
+		{
+			name:    "moved lines",
+			oldText: "a\nb\nc\nd",
+			newText: "b\nc\na\nd\ne",
+			expected: []Line{
+				{Operation: Delete, Text: "a"},
+				{Operation: Equal, Text: "b"},
+				{Operation: Equal, Text: "c"},
+				{Operation: Insert, Text: "a"},
+				{Operation: Equal, Text: "d"},
+				{Operation: Insert, Text: "e"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, Lines(test.oldText, test.newText))
+		})
+	}
+}
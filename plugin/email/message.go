@@ -3,6 +3,7 @@ package email
 import (
 	"errors"
 	"fmt"
+	"mime"
 	"strings"
 	"time"
 )
@@ -33,31 +34,32 @@ func (m *Message) Validate() error {
 }
 
 // Format creates an RFC 5322 formatted email message.
+// Line breaks are stripped from header values so that they cannot inject headers, and non-ASCII names and subjects are encoded.
 func (m *Message) Format(fromEmail, fromName string) string {
 	var sb strings.Builder
 
 	// From header
 	if fromName != "" {
-		sb.WriteString(fmt.Sprintf("From: %s <%s>\r\n", fromName, fromEmail))
+		sb.WriteString(fmt.Sprintf("From: %s <%s>\r\n", encodeHeader(fromName), sanitizeHeader(fromEmail)))
 	} else {
-		sb.WriteString(fmt.Sprintf("From: %s\r\n", fromEmail))
+		sb.WriteString(fmt.Sprintf("From: %s\r\n", sanitizeHeader(fromEmail)))
 	}
 
 	// To header
-	sb.WriteString(fmt.Sprintf("To: %s\r\n", strings.Join(m.To, ", ")))
+	sb.WriteString(fmt.Sprintf("To: %s\r\n", sanitizeHeader(strings.Join(m.To, ", "))))
 
 	// Cc header (optional)
 	if len(m.Cc) > 0 {
-		sb.WriteString(fmt.Sprintf("Cc: %s\r\n", strings.Join(m.Cc, ", ")))
+		sb.WriteString(fmt.Sprintf("Cc: %s\r\n", sanitizeHeader(strings.Join(m.Cc, ", "))))
 	}
 
 	// Reply-To header (optional)
 	if m.ReplyTo != "" {
-		sb.WriteString(fmt.Sprintf("Reply-To: %s\r\n", m.ReplyTo))
+		sb.WriteString(fmt.Sprintf("Reply-To: %s\r\n", sanitizeHeader(m.ReplyTo)))
 	}
 
 	// Subject header
-	sb.WriteString(fmt.Sprintf("Subject: %s\r\n", m.Subject))
+	sb.WriteString(fmt.Sprintf("Subject: %s\r\n", encodeHeader(m.Subject)))
 
 	// Date header (RFC 5322 format)
 	sb.WriteString(fmt.Sprintf("Date: %s\r\n", time.Now().Format(time.RFC1123Z)))
@@ -81,6 +83,16 @@ func (m *Message) Format(fromEmail, fromName string) string {
 	return sb.String()
 }
 
+// sanitizeHeader strips the line breaks from a header value, which would otherwise start new headers.
+func sanitizeHeader(value string) string {
+	return strings.NewReplacer("\r", "", "\n", "").Replace(value)
+}
+
+// encodeHeader sanitizes a header value and encodes it as an RFC 2047 encoded-word if it is not plain ASCII.
+func encodeHeader(value string) string {
+	return mime.QEncoding.Encode("utf-8", sanitizeHeader(value))
+}
+
 // GetAllRecipients returns all recipients (To, Cc, Bcc) as a single slice.
 func (m *Message) GetAllRecipients() []string {
 	var recipients []string
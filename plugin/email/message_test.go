@@ -146,6 +146,46 @@ func TestMessageFormatMultipleRecipients(t *testing.T) {
 	}
 }
 
+func TestMessageFormatHeaderInjection(t *testing.T) {
+	msg := Message{
+		To:      []string{"user@example.com"},
+		Subject: "x\r\nBcc: victim@example.com",
+		Body:    "Test Body",
+		ReplyTo: "reply@example.com\r\nCc: victim@example.com",
+	}
+
+	formatted := msg.Format("sender@example.com", "Sender\r\nBcc: victim@example.com")
+	headers, _, _ := strings.Cut(formatted, "\r\n\r\n")
+
+	// Line breaks in header values must not start new headers
+	for _, line := range strings.Split(headers, "\r\n") {
+		if strings.HasPrefix(line, "Bcc:") || strings.HasPrefix(line, "Cc:") {
+			t.Errorf("Injected header: %q", line)
+		}
+	}
+	if !strings.Contains(headers, "Subject: xBcc: victim@example.com") {
+		t.Error("Missing or incorrect Subject header")
+	}
+}
+
+func TestMessageFormatNonASCIISubject(t *testing.T) {
+	msg := Message{
+		To:      []string{"user@example.com"},
+		Subject: "Café ☕",
+		Body:    "Test Body",
+	}
+
+	formatted := msg.Format("sender@example.com", "Mémo")
+
+	// Non-ASCII header values are encoded as RFC 2047 encoded-words
+	if !strings.Contains(formatted, "Subject: =?utf-8?q?Caf=C3=A9_=E2=98=95?=") {
+		t.Error("Missing or incorrect encoded Subject header")
+	}
+	if !strings.Contains(formatted, "From: =?utf-8?q?M=C3=A9mo?= <sender@example.com>") {
+		t.Error("Missing or incorrect encoded From header")
+	}
+}
+
 func TestGetAllRecipients(t *testing.T) {
 	msg := Message{
 		To:  []string{"user1@example.com", "user2@example.com"},
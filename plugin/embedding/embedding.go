@@ -0,0 +1,109 @@
+package embedding
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store/cache"
+)
+
+// Provider generates embedding vectors for text.
+type Provider interface {
+	// Model identifies the model and dimension producing the vectors.
+	// Vectors of different models are not comparable.
+	Model() string
+	// Embed returns the embedding vector of the text.
+	Embed(ctx context.Context, text string) ([]float32, error)
+}
+
+// requestTimeout is the timeout of requests to remote providers.
+const requestTimeout = 60 * time.Second
+
+// NewProvider creates the provider configured by the instance embedding setting.
+// It returns nil if no provider is configured.
+func NewProvider(setting *storepb.InstanceEmbeddingSetting) (Provider, error) {
+	switch setting.GetProvider() {
+	case storepb.InstanceEmbeddingSetting_OPENAI:
+		return NewOpenAI(setting.Endpoint, setting.ApiKey, setting.Model, int(setting.Dimension)), nil
+	case storepb.InstanceEmbeddingSetting_HUGGINGFACE:
+		return NewHuggingFace(setting.Endpoint, setting.ApiKey, setting.Model), nil
+	case storepb.InstanceEmbeddingSetting_HASHING:
+		return NewHashing(int(setting.Dimension)), nil
+	case storepb.InstanceEmbeddingSetting_PROVIDER_UNSPECIFIED:
+		// Keep supporting instances configuring the HuggingFace token with the environment.
+		if token := os.Getenv("HF_TOKEN"); token != "" {
+			return NewHuggingFace("", token, ""), nil
+		}
+		return nil, nil
+	default:
+		return nil, errors.Errorf("unsupported embedding provider: %v", setting.GetProvider())
+	}
+}
+
+type cachedProvider struct {
+	Provider
+	cache cache.Interface
+}
+
+// NewCachedProvider wraps the provider so that embeddings are cached by the hash of the model and content.
+func NewCachedProvider(provider Provider, cache cache.Interface) Provider {
+	return &cachedProvider{
+		Provider: provider,
+		cache:    cache,
+	}
+}
+
+func (p *cachedProvider) Embed(ctx context.Context, text string) ([]float32, error) {
+	key := p.Model() + ":" + ContentHash(text)
+	if value, ok := p.cache.Get(ctx, key); ok {
+		if vector, ok := value.([]float32); ok {
+			return vector, nil
+		}
+	}
+	vector, err := p.Provider.Embed(ctx, text)
+	if err != nil {
+		return nil, err
+	}
+	p.cache.Set(ctx, key, vector)
+	return vector, nil
+}
+
+// ContentHash returns the hash identifying the content an embedding is generated from.
+func ContentHash(content string) string {
+	hash := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(hash[:])
+}
+
+// CosineSimilarity returns the cosine similarity of two vectors.
+// It returns 0 if the vectors have different dimensions or either of them is zero.
+func CosineSimilarity(a, b []float32) float64 {
+	if len(a) != len(b) || len(a) == 0 {
+		return 0
+	}
+	var dot, normA, normB float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		normA += float64(a[i]) * float64(a[i])
+		normB += float64(b[i]) * float64(b[i])
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
+
+// modelWithDimension identifies a model truncated to a dimension, or the model itself for its default dimension.
+func modelWithDimension(model string, dimension int) string {
+	if dimension <= 0 {
+		return model
+	}
+	return fmt.Sprintf("%s:%d", model, dimension)
+}
@@ -0,0 +1,110 @@
+package embedding
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store/cache"
+)
+
+func TestCosineSimilarity(t *testing.T) {
+	require.InDelta(t, 1, CosineSimilarity([]float32{1, 2, 3}, []float32{2, 4, 6}), 1e-9)
+	require.InDelta(t, 0, CosineSimilarity([]float32{1, 0}, []float32{0, 1}), 1e-9)
+	require.InDelta(t, -1, CosineSimilarity([]float32{1, 1}, []float32{-1, -1}), 1e-9)
+	require.Equal(t, float64(0), CosineSimilarity([]float32{1, 2}, []float32{1, 2, 3}))
+	require.Equal(t, float64(0), CosineSimilarity([]float32{0, 0}, []float32{1, 2}))
+}
+
+func TestNewProvider(t *testing.T) {
+	t.Setenv("HF_TOKEN", "")
+	provider, err := NewProvider(&storepb.InstanceEmbeddingSetting{})
+	require.NoError(t, err)
+	require.Nil(t, provider)
+
+	provider, err = NewProvider(&storepb.InstanceEmbeddingSetting{Provider: storepb.InstanceEmbeddingSetting_HASHING, Dimension: 64})
+	require.NoError(t, err)
+	require.Equal(t, "hashing:64", provider.Model())
+
+	provider, err = NewProvider(&storepb.InstanceEmbeddingSetting{Provider: storepb.InstanceEmbeddingSetting_HUGGINGFACE})
+	require.NoError(t, err)
+	require.Equal(t, defaultHuggingFaceModel, provider.Model())
+
+	t.Setenv("HF_TOKEN", "token")
+	provider, err = NewProvider(&storepb.InstanceEmbeddingSetting{})
+	require.NoError(t, err)
+	require.IsType(t, &HuggingFace{}, provider)
+}
+
+func TestHashing(t *testing.T) {
+	ctx := context.Background()
+	provider := NewHashing(128)
+
+	a, err := provider.Embed(ctx, "Planting tomatoes in the garden")
+	require.NoError(t, err)
+	require.Len(t, a, 128)
+	again, err := provider.Embed(ctx, "planting tomatoes, in the garden!")
+	require.NoError(t, err)
+	require.Equal(t, a, again)
+
+	b, err := provider.Embed(ctx, "garden tomatoes")
+	require.NoError(t, err)
+	c, err := provider.Embed(ctx, "quarterly budget review")
+	require.NoError(t, err)
+	require.Greater(t, CosineSimilarity(a, b), CosineSimilarity(a, c))
+}
+
+func TestOpenAI(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/embeddings", r.URL.Path)
+		require.Equal(t, "Bearer key", r.Header.Get("Authorization"))
+		request := &openAIEmbeddingRequest{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(request))
+		require.Equal(t, "nomic-embed-text", request.Model)
+		require.Equal(t, "hello", request.Input)
+		require.Equal(t, 3, request.Dimensions)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": [{"embedding": [0.1, 0.2, 0.3]}]}`))
+	}))
+	defer server.Close()
+
+	provider := NewOpenAI(server.URL+"/v1/", "key", "nomic-embed-text", 3)
+	require.Equal(t, "nomic-embed-text:3", provider.Model())
+	vector, err := provider.Embed(context.Background(), "hello")
+	require.NoError(t, err)
+	require.Equal(t, []float32{0.1, 0.2, 0.3}, vector)
+}
+
+type countingProvider struct {
+	Provider
+	calls int
+}
+
+func (p *countingProvider) Embed(ctx context.Context, text string) ([]float32, error) {
+	p.calls++
+	return p.Provider.Embed(ctx, text)
+}
+
+func TestCachedProvider(t *testing.T) {
+	ctx := context.Background()
+	c := cache.New(cache.DefaultConfig())
+	defer c.Close()
+
+	counting := &countingProvider{Provider: NewHashing(0)}
+	provider := NewCachedProvider(counting, c)
+	first, err := provider.Embed(ctx, "hello world")
+	require.NoError(t, err)
+	second, err := provider.Embed(ctx, "hello world")
+	require.NoError(t, err)
+	require.Equal(t, first, second)
+	require.Equal(t, 1, counting.calls)
+
+	_, err = provider.Embed(ctx, "another text")
+	require.NoError(t, err)
+	require.Equal(t, 2, counting.calls)
+}
@@ -0,0 +1,62 @@
+package embedding
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"strings"
+	"unicode"
+)
+
+const defaultHashingDimension = 256
+
+// Hashing is a deterministic local provider hashing the words of a text into a vector.
+// Texts sharing words are similar, which is enough for tests and instances without a model.
+type Hashing struct {
+	dimension int
+}
+
+// NewHashing creates a hashing provider, a non-positive dimension uses the default.
+func NewHashing(dimension int) *Hashing {
+	if dimension <= 0 {
+		dimension = defaultHashingDimension
+	}
+	return &Hashing{
+		dimension: dimension,
+	}
+}
+
+func (h *Hashing) Model() string {
+	return fmt.Sprintf("hashing:%d", h.dimension)
+}
+
+func (h *Hashing) Embed(_ context.Context, text string) ([]float32, error) {
+	vector := make([]float32, h.dimension)
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+	for _, word := range words {
+		hash := fnv.New64a()
+		hash.Write([]byte(word))
+		sum := hash.Sum64()
+		// Use the highest bit as the sign to reduce the bias of collisions.
+		sign := float32(1)
+		if sum>>63 == 1 {
+			sign = -1
+		}
+		vector[sum%uint64(h.dimension)] += sign
+	}
+
+	var norm float64
+	for _, v := range vector {
+		norm += float64(v) * float64(v)
+	}
+	if norm > 0 {
+		norm = math.Sqrt(norm)
+		for i := range vector {
+			vector[i] = float32(float64(vector[i]) / norm)
+		}
+	}
+	return vector, nil
+}
@@ -0,0 +1,95 @@
+package embedding
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultHuggingFaceEndpoint = "https://router.huggingface.co/hf-inference/models"
+	defaultHuggingFaceModel    = "sentence-transformers/all-MiniLM-L6-v2"
+)
+
+// HuggingFace generates embeddings with the HuggingFace inference API.
+type HuggingFace struct {
+	endpoint string
+	token    string
+	model    string
+	client   *http.Client
+}
+
+// NewHuggingFace creates a HuggingFace provider, empty values use the defaults.
+func NewHuggingFace(endpoint, token, model string) *HuggingFace {
+	if endpoint == "" {
+		endpoint = defaultHuggingFaceEndpoint
+	}
+	if model == "" {
+		model = defaultHuggingFaceModel
+	}
+	return &HuggingFace{
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		token:    token,
+		model:    model,
+		client: &http.Client{
+			Timeout: requestTimeout,
+		},
+	}
+}
+
+func (h *HuggingFace) Model() string {
+	return h.model
+}
+
+func (h *HuggingFace) Embed(ctx context.Context, text string) ([]float32, error) {
+	body, err := json.Marshal(map[string]any{
+		"inputs": text,
+		"options": map[string]any{
+			"wait_for_model": true,
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal request")
+	}
+	url := h.endpoint + "/" + h.model + "/pipeline/feature-extraction"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if h.token != "" {
+		req.Header.Set("Authorization", "Bearer "+h.token)
+	}
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call HuggingFace API")
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read response")
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("HuggingFace API returned status %d: %s", resp.StatusCode, data)
+	}
+
+	// The feature extraction pipeline returns a single vector, or a batch of one vector for some models.
+	var vector []float32
+	if err := json.Unmarshal(data, &vector); err == nil {
+		return vector, nil
+	}
+	var batch [][]float32
+	if err := json.Unmarshal(data, &batch); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal embedding")
+	}
+	if len(batch) != 1 {
+		return nil, errors.Errorf("unexpected embedding batch size %d", len(batch))
+	}
+	return batch[0], nil
+}
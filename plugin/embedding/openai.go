@@ -0,0 +1,103 @@
+package embedding
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultOpenAIEndpoint = "https://api.openai.com/v1"
+	defaultOpenAIModel    = "text-embedding-3-small"
+)
+
+// OpenAI generates embeddings with an OpenAI compatible /v1/embeddings endpoint,
+// which also covers local servers such as Ollama and llama.cpp.
+type OpenAI struct {
+	endpoint  string
+	apiKey    string
+	model     string
+	dimension int
+	client    *http.Client
+}
+
+// NewOpenAI creates an OpenAI compatible provider, empty values use the OpenAI defaults.
+func NewOpenAI(endpoint, apiKey, model string, dimension int) *OpenAI {
+	if endpoint == "" {
+		endpoint = defaultOpenAIEndpoint
+	}
+	if model == "" {
+		model = defaultOpenAIModel
+	}
+	return &OpenAI{
+		endpoint:  strings.TrimSuffix(endpoint, "/"),
+		apiKey:    apiKey,
+		model:     model,
+		dimension: dimension,
+		client: &http.Client{
+			Timeout: requestTimeout,
+		},
+	}
+}
+
+func (o *OpenAI) Model() string {
+	return modelWithDimension(o.model, o.dimension)
+}
+
+type openAIEmbeddingRequest struct {
+	Model      string `json:"model"`
+	Input      string `json:"input"`
+	Dimensions int    `json:"dimensions,omitempty"`
+}
+
+type openAIEmbeddingResponse struct {
+	Data []struct {
+		Embedding []float32 `json:"embedding"`
+	} `json:"data"`
+}
+
+func (o *OpenAI) Embed(ctx context.Context, text string) ([]float32, error) {
+	body, err := json.Marshal(&openAIEmbeddingRequest{
+		Model:      o.model,
+		Input:      text,
+		Dimensions: o.dimension,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal request")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, o.endpoint+"/embeddings", bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if o.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+o.apiKey)
+	}
+
+	resp, err := o.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to call embeddings API")
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read response")
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("embeddings API returned status %d: %s", resp.StatusCode, data)
+	}
+
+	response := &openAIEmbeddingResponse{}
+	if err := json.Unmarshal(data, response); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal response")
+	}
+	if len(response.Data) != 1 {
+		return nil, errors.Errorf("unexpected number of embeddings %d", len(response.Data))
+	}
+	return response.Data[0].Embedding, nil
+}
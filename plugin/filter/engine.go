@@ -102,6 +102,9 @@ var (
 	defaultAttachmentOnce sync.Once
 	defaultAttachmentInst *Engine
 	defaultAttachmentErr  error
+	defaultActivityOnce   sync.Once
+	defaultActivityInst   *Engine
+	defaultActivityErr    error
 )
 
 // DefaultEngine returns the process-wide memo filter engine.
@@ -120,6 +123,14 @@ func DefaultAttachmentEngine() (*Engine, error) {
 	return defaultAttachmentInst, defaultAttachmentErr
 }
 
+// DefaultActivityEngine returns the process-wide activity filter engine.
+func DefaultActivityEngine() (*Engine, error) {
+	defaultActivityOnce.Do(func() {
+		defaultActivityInst, defaultActivityErr = NewEngine(NewActivitySchema())
+	})
+	return defaultActivityInst, defaultActivityErr
+}
+
 func normalizeLegacyFilter(expr string) string {
 	expr = rewriteNumericLogicalOperand(expr, "&&")
 	expr = rewriteNumericLogicalOperand(expr, "||")
@@ -3,6 +3,9 @@ package filter
 import (
 	"context"
 	"fmt"
+	"slices"
+
+	"github.com/pkg/errors"
 )
 
 // AppendConditions compiles the provided filters and appends the resulting SQL fragments and args.
@@ -23,3 +26,38 @@ func AppendConditions(ctx context.Context, engine *Engine, filters []string, dia
 	}
 	return nil
 }
+
+// AppendSearchRank appends the relevance of the rows to the search() calls of the filters as a
+// descending sort key. It does nothing if the filters contain no search() call.
+func AppendSearchRank(ctx context.Context, engine *Engine, filters []string, dialect DialectName, orderBy *[]string, args *[]any) error {
+	terms := []string{}
+	for _, filterStr := range filters {
+		program, err := engine.Compile(ctx, filterStr)
+		if err != nil {
+			return err
+		}
+		for _, term := range program.SearchTerms() {
+			if !slices.Contains(terms, term) {
+				terms = append(terms, term)
+			}
+		}
+	}
+	if len(terms) == 0 {
+		return nil
+	}
+	field, ok := engine.schema.Field(engine.schema.SearchField)
+	if !ok {
+		return errors.New("search field is not configured")
+	}
+	renderer := newRenderer(engine.schema, RenderOptions{
+		Dialect:           dialect,
+		PlaceholderOffset: len(*args),
+	})
+	stmt, err := renderer.renderSearchRank(field, terms)
+	if err != nil {
+		return err
+	}
+	*orderBy = append(*orderBy, fmt.Sprintf("%s DESC", stmt.SQL))
+	*args = append(*args, stmt.Args...)
+	return nil
+}
@@ -81,6 +81,22 @@ type ContainsCondition struct {
 
 func (*ContainsCondition) isCondition() {}
 
+// SearchCondition models the search(<query>) call matching the full-text index of a field.
+type SearchCondition struct {
+	Field string
+	Terms []string
+}
+
+func (*SearchCondition) isCondition() {}
+
+// TagUnderCondition models the tag_under(<tag>) call matching a tag or any tag nested under it.
+type TagUnderCondition struct {
+	Field string
+	Tag   string
+}
+
+func (*TagUnderCondition) isCondition() {}
+
 // ConstantCondition captures a literal boolean outcome.
 type ConstantCondition struct {
 	Value bool
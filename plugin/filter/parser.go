@@ -1,6 +1,7 @@
 package filter
 
 import (
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -94,6 +95,10 @@ func buildCallCondition(call *exprv1.Expr_Call, schema Schema) (Condition, error
 		return buildInCondition(call, schema)
 	case "contains":
 		return buildContainsCondition(call, schema)
+	case "search":
+		return buildSearchCondition(call, schema)
+	case "tag_under":
+		return buildTagUnderCondition(call, schema)
 	default:
 		val, ok, err := evaluateBool(call)
 		if err != nil {
@@ -232,6 +237,58 @@ func buildContainsCondition(call *exprv1.Expr_Call, schema Schema) (Condition, e
 	}, nil
 }
 
+func buildSearchCondition(call *exprv1.Expr_Call, schema Schema) (Condition, error) {
+	if schema.SearchField == "" {
+		return nil, errors.New("search() is not supported")
+	}
+	if len(call.Args) != 1 {
+		return nil, errors.New("search expects exactly one argument")
+	}
+	value, err := getConstValue(call.Args[0])
+	if err != nil {
+		return nil, errors.Wrap(err, "search only supports literal arguments")
+	}
+	query, ok := value.(string)
+	if !ok {
+		return nil, errors.New("search argument must be a string")
+	}
+	terms := SearchTerms(query)
+	if len(terms) == 0 {
+		return nil, errors.New("search query must contain at least one word")
+	}
+	return &SearchCondition{
+		Field: schema.SearchField,
+		Terms: terms,
+	}, nil
+}
+
+func buildTagUnderCondition(call *exprv1.Expr_Call, schema Schema) (Condition, error) {
+	field, ok := schema.Field("tags")
+	if !ok || field.Kind != FieldKindJSONList {
+		return nil, errors.New("tag_under() is not supported")
+	}
+	if len(call.Args) != 1 {
+		return nil, errors.New("tag_under expects exactly one argument")
+	}
+	value, err := getConstValue(call.Args[0])
+	if err != nil {
+		return nil, errors.Wrap(err, "tag_under only supports literal arguments")
+	}
+	tag, ok := value.(string)
+	if !ok {
+		return nil, errors.New("tag_under argument must be a string")
+	}
+	// Tags are stored lowercase, and nesting is expressed by "/".
+	tag = strings.ToLower(strings.Trim(tag, "/"))
+	if tag == "" {
+		return nil, errors.New("tag_under argument must not be empty")
+	}
+	return &TagUnderCondition{
+		Field: field.Name,
+		Tag:   tag,
+	}, nil
+}
+
 func buildValueExpr(expr *exprv1.Expr, schema Schema) (ValueExpr, error) {
 	if identName, err := getIdentName(expr); err == nil {
 		if _, ok := schema.Field(identName); !ok {
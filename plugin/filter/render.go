@@ -1,6 +1,7 @@
 package filter
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -74,6 +75,10 @@ func (r *renderer) renderCondition(cond Condition) (renderResult, error) {
 		return r.renderElementInCondition(c)
 	case *ContainsCondition:
 		return r.renderContainsCondition(c)
+	case *SearchCondition:
+		return r.renderSearchCondition(c)
+	case *TagUnderCondition:
+		return r.renderTagUnderCondition(c)
 	case *ListComprehensionCondition:
 		return r.renderListComprehension(c)
 	case *ConstantCondition:
@@ -394,6 +399,9 @@ func (r *renderer) renderElementInCondition(cond *ElementInCondition) (renderRes
 	if err != nil {
 		return renderResult{}, err
 	}
+	if field.Type == FieldTypeInt {
+		return r.renderIntElementInCondition(field, lit)
+	}
 	str, ok := lit.(string)
 	if !ok {
 		return renderResult{}, errors.New("tags membership requires string literal")
@@ -414,6 +422,28 @@ func (r *renderer) renderElementInCondition(cond *ElementInCondition) (renderRes
 	}
 }
 
+// renderIntElementInCondition generates SQL for the CEL syntax `123 in field` on a JSON list of numbers.
+func (r *renderer) renderIntElementInCondition(field Field, lit any) (renderResult, error) {
+	value, ok := lit.(int64)
+	if !ok {
+		return renderResult{}, errors.Errorf("%s membership requires integer literal", field.Name)
+	}
+
+	switch r.dialect {
+	case DialectSQLite:
+		sql := fmt.Sprintf("EXISTS (SELECT 1 FROM json_each(%s) WHERE json_each.value = %s)", jsonArrayExpr(r.dialect, field), r.addArg(value))
+		return renderResult{sql: sql}, nil
+	case DialectMySQL:
+		sql := fmt.Sprintf("JSON_CONTAINS(%s, %s)", jsonArrayExpr(r.dialect, field), r.addArg(fmt.Sprintf("%d", value)))
+		return renderResult{sql: sql}, nil
+	case DialectPostgres:
+		sql := fmt.Sprintf("%s @> jsonb_build_array(%s::json)", jsonArrayExpr(r.dialect, field), r.addArg(fmt.Sprintf("%d", value)))
+		return renderResult{sql: sql}, nil
+	default:
+		return renderResult{}, errors.Errorf("unsupported dialect %s", r.dialect)
+	}
+}
+
 func (r *renderer) renderScalarInCondition(field Field, values []ValueExpr) (renderResult, error) {
 	placeholders := make([]string, 0, len(values))
 
@@ -463,6 +493,80 @@ func (r *renderer) renderContainsCondition(cond *ContainsCondition) (renderResul
 	}
 }
 
+func (r *renderer) renderSearchCondition(cond *SearchCondition) (renderResult, error) {
+	field, ok := r.schema.Field(cond.Field)
+	if !ok {
+		return renderResult{}, errors.Errorf("unknown field %q", cond.Field)
+	}
+	column := qualifyColumn(r.dialect, field.Column)
+	switch r.dialect {
+	case DialectSQLite:
+		// The FTS5 table shares its rowid with the indexed table.
+		sql := fmt.Sprintf("`%s`.`id` IN (SELECT `rowid` FROM `%s` WHERE `%s` MATCH %s)", field.Column.Table, searchTable(field), searchTable(field), r.addArg(sqliteSearchQuery(cond.Terms, "AND")))
+		return renderResult{sql: sql}, nil
+	case DialectMySQL:
+		sql := fmt.Sprintf("MATCH(%s) AGAINST (%s IN BOOLEAN MODE)", column, r.addArg(mysqlSearchQuery(cond.Terms)))
+		return renderResult{sql: sql}, nil
+	case DialectPostgres:
+		sql := fmt.Sprintf("to_tsvector('%s', %s) @@ to_tsquery('%s', %s)", postgresSearchConfig, column, postgresSearchConfig, r.addArg(postgresSearchQuery(cond.Terms, "&")))
+		return renderResult{sql: sql}, nil
+	default:
+		return renderResult{}, errors.Errorf("unsupported dialect %s", r.dialect)
+	}
+}
+
+// renderTagUnderCondition matches the elements of the tag list equal to the tag or nested under it.
+// Unlike tag in [...], the tags are compared element by element, so "work" does not match "workout".
+func (r *renderer) renderTagUnderCondition(cond *TagUnderCondition) (renderResult, error) {
+	field, ok := r.schema.Field(cond.Field)
+	if !ok {
+		return renderResult{}, errors.Errorf("unknown field %q", cond.Field)
+	}
+	column := qualifyColumn(r.dialect, field.Column)
+	// The backslash escapes the LIKE wildcards, which are valid in tags, e.g. "snake_case".
+	pattern := escapeLikePattern(cond.Tag) + "/%"
+	switch r.dialect {
+	case DialectSQLite:
+		sql := fmt.Sprintf("EXISTS (SELECT 1 FROM json_each(%s, '%s') WHERE json_each.value = %s OR json_each.value LIKE %s ESCAPE '\\')", column, jsonPath(field), r.addArg(cond.Tag), r.addArg(pattern))
+		return renderResult{sql: sql}, nil
+	case DialectMySQL:
+		tag, err := json.Marshal(cond.Tag)
+		if err != nil {
+			return renderResult{}, err
+		}
+		arrayExpr := jsonArrayExpr(r.dialect, field)
+		// COALESCE keeps memos without tags matching the negated condition.
+		sql := fmt.Sprintf("(COALESCE(JSON_CONTAINS(%s, %s), 0) = 1 OR JSON_SEARCH(%s, 'one', %s) IS NOT NULL)", arrayExpr, r.addArg(string(tag)), arrayExpr, r.addArg(pattern))
+		return renderResult{sql: sql}, nil
+	case DialectPostgres:
+		sql := fmt.Sprintf("EXISTS (SELECT 1 FROM jsonb_array_elements_text(%s) AS tag WHERE tag = %s OR tag LIKE %s)", jsonArrayExpr(r.dialect, field), r.addArg(cond.Tag), r.addArg(pattern))
+		return renderResult{sql: sql}, nil
+	default:
+		return renderResult{}, errors.Errorf("unsupported dialect %s", r.dialect)
+	}
+}
+
+// renderSearchRank renders the relevance of the field to any of the terms, higher being more relevant.
+func (r *renderer) renderSearchRank(field Field, terms []string) (Statement, error) {
+	column := qualifyColumn(r.dialect, field.Column)
+	var sql string
+	switch r.dialect {
+	case DialectSQLite:
+		// bm25() scores better matches lower, and rows not matching any term rank last.
+		sql = fmt.Sprintf("COALESCE((SELECT -bm25(`%s`) FROM `%s` WHERE `%s` MATCH %s AND `%s`.`rowid` = `%s`.`id`), 0)", searchTable(field), searchTable(field), searchTable(field), r.addArg(sqliteSearchQuery(terms, "OR")), searchTable(field), field.Column.Table)
+	case DialectMySQL:
+		sql = fmt.Sprintf("MATCH(%s) AGAINST (%s IN NATURAL LANGUAGE MODE)", column, r.addArg(strings.Join(terms, " ")))
+	case DialectPostgres:
+		sql = fmt.Sprintf("ts_rank(to_tsvector('%s', %s), to_tsquery('%s', %s))", postgresSearchConfig, column, postgresSearchConfig, r.addArg(postgresSearchQuery(terms, "|")))
+	default:
+		return Statement{}, errors.Errorf("unsupported dialect %s", r.dialect)
+	}
+	return Statement{
+		SQL:  sql,
+		Args: r.args,
+	}, nil
+}
+
 func (r *renderer) renderListComprehension(cond *ListComprehensionCondition) (renderResult, error) {
 	field, ok := r.schema.Field(cond.Field)
 	if !ok {
@@ -688,6 +792,11 @@ func qualifyColumn(d DialectName, col Column) string {
 	}
 }
 
+// escapeLikePattern escapes the LIKE wildcards of the value with backslashes.
+func escapeLikePattern(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
+}
+
 func jsonPath(field Field) string {
 	return "$." + strings.Join(field.JSONPath, ".")
 }
@@ -63,6 +63,8 @@ type Schema struct {
 	Name       string
 	Fields     map[string]Field
 	EnvOptions []cel.EnvOption
+	// SearchField is the full-text indexed field matched by search(), empty if unsupported.
+	SearchField string
 }
 
 // Field returns the field metadata if present.
@@ -97,6 +99,22 @@ var nowFunction = cel.Function("now",
 	),
 )
 
+// searchFunction declares search(query), which is rendered as a full-text index match.
+var searchFunction = cel.Function("search",
+	cel.Overload("search_string",
+		[]*cel.Type{cel.StringType},
+		cel.BoolType,
+	),
+)
+
+// tagUnderFunction declares tag_under(tag), which matches a tag and the tags nested under it.
+var tagUnderFunction = cel.Function("tag_under",
+	cel.Overload("tag_under_string",
+		[]*cel.Type{cel.StringType},
+		cel.BoolType,
+	),
+)
+
 // NewSchema constructs the memo filter schema and CEL environment.
 func NewSchema() Schema {
 	fields := map[string]Field{
@@ -180,6 +198,13 @@ func NewSchema() Schema {
 			Type:     FieldTypeString,
 			AliasFor: "tags",
 		},
+		"mentions": {
+			Name:     "mentions",
+			Kind:     FieldKindJSONList,
+			Type:     FieldTypeInt,
+			Column:   Column{Table: "memo", Name: "payload"},
+			JSONPath: []string{"mentions"},
+		},
 		"has_task_list": {
 			Name:     "has_task_list",
 			Kind:     FieldKindJSONBool,
@@ -224,6 +249,18 @@ func NewSchema() Schema {
 				CompareNeq: true,
 			},
 		},
+		"due_time": {
+			Name:   "due_time",
+			Kind:   FieldKindScalar,
+			Type:   FieldTypeTimestamp,
+			Column: Column{Table: "memo", Name: "payload"},
+			Expressions: map[DialectName]string{
+				// The earliest due time of the incomplete tasks, protojson encodes the int64 epoch as a string
+				DialectMySQL:    "CAST(JSON_UNQUOTE(JSON_EXTRACT(%s, '$.property.dueTs')) AS SIGNED)",
+				DialectPostgres: "(%s->'property'->>'dueTs')::BIGINT",
+				DialectSQLite:   "CAST(JSON_EXTRACT(%s, '$.property.dueTs') AS INTEGER)",
+			},
+		},
 	}
 
 	envOptions := []cel.EnvOption{
@@ -235,17 +272,22 @@ func NewSchema() Schema {
 		cel.Variable("tag", cel.StringType),
 		cel.Variable("tags", cel.ListType(cel.StringType)),
 		cel.Variable("visibility", cel.StringType),
+		cel.Variable("mentions", cel.ListType(cel.IntType)),
 		cel.Variable("has_task_list", cel.BoolType),
 		cel.Variable("has_link", cel.BoolType),
 		cel.Variable("has_code", cel.BoolType),
 		cel.Variable("has_incomplete_tasks", cel.BoolType),
+		cel.Variable("due_time", cel.IntType),
 		nowFunction,
+		searchFunction,
+		tagUnderFunction,
 	}
 
 	return Schema{
-		Name:       "memo",
-		Fields:     fields,
-		EnvOptions: envOptions,
+		Name:        "memo",
+		Fields:      fields,
+		EnvOptions:  envOptions,
+		SearchField: "content",
 	}
 }
 
@@ -308,6 +350,73 @@ func NewAttachmentSchema() Schema {
 	}
 }
 
+// NewActivitySchema constructs the activity filter schema and CEL environment.
+func NewActivitySchema() Schema {
+	fields := map[string]Field{
+		// type is reserved in CEL.
+		"activity_type": {
+			Name:        "activity_type",
+			Kind:        FieldKindScalar,
+			Type:        FieldTypeString,
+			Column:      Column{Table: "activity", Name: "type"},
+			Expressions: map[DialectName]string{},
+			AllowedComparisonOps: map[ComparisonOperator]bool{
+				CompareEq:  true,
+				CompareNeq: true,
+			},
+		},
+		"level": {
+			Name:        "level",
+			Kind:        FieldKindScalar,
+			Type:        FieldTypeString,
+			Column:      Column{Table: "activity", Name: "level"},
+			Expressions: map[DialectName]string{},
+			AllowedComparisonOps: map[ComparisonOperator]bool{
+				CompareEq:  true,
+				CompareNeq: true,
+			},
+		},
+		"creator_id": {
+			Name:        "creator_id",
+			Kind:        FieldKindScalar,
+			Type:        FieldTypeInt,
+			Column:      Column{Table: "activity", Name: "creator_id"},
+			Expressions: map[DialectName]string{},
+			AllowedComparisonOps: map[ComparisonOperator]bool{
+				CompareEq:  true,
+				CompareNeq: true,
+			},
+		},
+		"create_time": {
+			Name:   "create_time",
+			Kind:   FieldKindScalar,
+			Type:   FieldTypeTimestamp,
+			Column: Column{Table: "activity", Name: "created_ts"},
+			Expressions: map[DialectName]string{
+				// MySQL stores created_ts as TIMESTAMP, needs conversion to epoch
+				DialectMySQL: "UNIX_TIMESTAMP(%s)",
+				// PostgreSQL and SQLite store created_ts as BIGINT (epoch), no conversion needed
+				DialectPostgres: "%s",
+				DialectSQLite:   "%s",
+			},
+		},
+	}
+
+	envOptions := []cel.EnvOption{
+		cel.Variable("activity_type", cel.StringType),
+		cel.Variable("level", cel.StringType),
+		cel.Variable("creator_id", cel.IntType),
+		cel.Variable("create_time", cel.IntType),
+		nowFunction,
+	}
+
+	return Schema{
+		Name:       "activity",
+		Fields:     fields,
+		EnvOptions: envOptions,
+	}
+}
+
 // columnExpr returns the field expression for the given dialect, applying
 // any schema-specific overrides (e.g. UNIX timestamp conversions).
 func (f Field) columnExpr(d DialectName) string {
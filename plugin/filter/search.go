@@ -0,0 +1,83 @@
+package filter
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// postgresSearchConfig is the text search configuration of the Postgres full-text index.
+// The language agnostic "simple" configuration lowercases words without stemming them.
+const postgresSearchConfig = "simple"
+
+// IsSearchTermRune reports whether the rune is part of a search term.
+// Any other rune separates terms, matching the tokenizers of the full-text indexes.
+func IsSearchTermRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsNumber(r)
+}
+
+// SearchTerms splits a search query into its distinct lowercase terms.
+func SearchTerms(query string) []string {
+	terms := []string{}
+	for _, term := range strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !IsSearchTermRune(r)
+	}) {
+		if !slices.Contains(terms, term) {
+			terms = append(terms, term)
+		}
+	}
+	return terms
+}
+
+// SearchTerms returns the terms of the search() calls of the program, excluding negated ones.
+func (p *Program) SearchTerms() []string {
+	terms := []string{}
+	collectSearchTerms(p.condition, &terms)
+	return terms
+}
+
+func collectSearchTerms(cond Condition, terms *[]string) {
+	switch c := cond.(type) {
+	case *LogicalCondition:
+		collectSearchTerms(c.Left, terms)
+		collectSearchTerms(c.Right, terms)
+	case *SearchCondition:
+		for _, term := range c.Terms {
+			if !slices.Contains(*terms, term) {
+				*terms = append(*terms, term)
+			}
+		}
+	default:
+		// Negated and other conditions do not contribute to the relevance.
+	}
+}
+
+// searchTable returns the SQLite FTS5 table indexing the field.
+func searchTable(field Field) string {
+	return field.Column.Table + "_fts"
+}
+
+// sqliteSearchQuery builds an FTS5 query combining the quoted terms with the operator.
+func sqliteSearchQuery(terms []string, operator string) string {
+	quoted := make([]string, 0, len(terms))
+	for _, term := range terms {
+		quoted = append(quoted, fmt.Sprintf(`"%s"`, strings.ReplaceAll(term, `"`, `""`)))
+	}
+	return strings.Join(quoted, " "+operator+" ")
+}
+
+// mysqlSearchQuery builds a boolean mode query requiring all the terms.
+func mysqlSearchQuery(terms []string) string {
+	required := make([]string, 0, len(terms))
+	for _, term := range terms {
+		required = append(required, "+"+term)
+	}
+	return strings.Join(required, " ")
+}
+
+// postgresSearchQuery builds a tsquery combining the terms with the operator.
+// Terms only contain letters and numbers, so they need no quoting.
+func postgresSearchQuery(terms []string, operator string) string {
+	return strings.Join(terms, " "+operator+" ")
+}
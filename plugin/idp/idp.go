@@ -5,4 +5,6 @@ type IdentityProviderUserInfo struct {
 	DisplayName string
 	Email       string
 	AvatarURL   string
+	// Groups are the groups of the user, only set by providers with a configured groups claim.
+	Groups []string
 }
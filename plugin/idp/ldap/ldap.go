@@ -0,0 +1,176 @@
+// Package ldap is the plugin for LDAP Identity Provider.
+package ldap
+
+import (
+	"crypto/tls"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	goldap "github.com/go-ldap/ldap/v3"
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/idp"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// DefaultUserFilter finds the user by uid if the configuration has no user filter.
+const DefaultUserFilter = "(uid={username})"
+
+// usernamePlaceholder is replaced with the escaped username in the user filter.
+const usernamePlaceholder = "{username}"
+
+// dialTimeout limits connecting to the directory.
+const dialTimeout = 10 * time.Second
+
+// ErrInvalidCredentials is returned if no user has the username or the password is wrong.
+var ErrInvalidCredentials = errors.New("invalid username or password")
+
+// IdentityProvider represents an LDAP Identity Provider.
+type IdentityProvider struct {
+	config *storepb.LDAPConfig
+}
+
+// NewIdentityProvider initializes a new LDAP Identity Provider with the given configuration.
+func NewIdentityProvider(config *storepb.LDAPConfig) (*IdentityProvider, error) {
+	for v, field := range map[string]string{
+		config.ServerUrl:  "serverUrl",
+		config.SearchBase: "searchBase",
+	} {
+		if v == "" {
+			return nil, errors.Errorf(`the field "%s" is empty but required`, field)
+		}
+	}
+	if config.FieldMapping == nil || config.FieldMapping.Identifier == "" {
+		return nil, errors.New(`the field "fieldMapping.identifier" is empty but required`)
+	}
+	if config.UserFilter != "" && !strings.Contains(config.UserFilter, usernamePlaceholder) {
+		return nil, errors.Errorf(`the field "userFilter" must contain %s`, usernamePlaceholder)
+	}
+	if _, err := url.Parse(config.ServerUrl); err != nil {
+		return nil, errors.Wrap(err, `the field "serverUrl" is invalid`)
+	}
+
+	return &IdentityProvider{
+		config: config,
+	}, nil
+}
+
+// Authenticate verifies the password of the user in the directory and returns the user information of its entry.
+// It returns ErrInvalidCredentials if no user has the username or the password is wrong.
+func (p *IdentityProvider) Authenticate(username, password string) (*idp.IdentityProviderUserInfo, error) {
+	// An empty password would be an unauthenticated bind, which most directories accept.
+	if username == "" || password == "" {
+		return nil, ErrInvalidCredentials
+	}
+
+	conn, err := p.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if p.config.BindDn != "" {
+		if err := conn.Bind(p.config.BindDn, p.config.BindPassword); err != nil {
+			return nil, errors.Wrap(err, "failed to bind the service account")
+		}
+	}
+	entry, err := p.searchUser(conn, username)
+	if err != nil {
+		return nil, err
+	}
+	if err := conn.Bind(entry.DN, password); err != nil {
+		if goldap.IsErrorWithCode(err, goldap.LDAPResultInvalidCredentials) {
+			return nil, ErrInvalidCredentials
+		}
+		return nil, errors.Wrap(err, "failed to bind the user")
+	}
+
+	fieldMapping := p.config.FieldMapping
+	userInfo := &idp.IdentityProviderUserInfo{
+		Identifier: entry.GetAttributeValue(fieldMapping.Identifier),
+	}
+	if userInfo.Identifier == "" {
+		return nil, errors.Errorf("the attribute %q is not found in the entry or has empty value", fieldMapping.Identifier)
+	}
+
+	// Best effort to map optional fields
+	if fieldMapping.DisplayName != "" {
+		userInfo.DisplayName = entry.GetAttributeValue(fieldMapping.DisplayName)
+	}
+	if userInfo.DisplayName == "" {
+		userInfo.DisplayName = userInfo.Identifier
+	}
+	if fieldMapping.Email != "" {
+		userInfo.Email = entry.GetAttributeValue(fieldMapping.Email)
+	}
+	if fieldMapping.AvatarUrl != "" {
+		userInfo.AvatarURL = entry.GetAttributeValue(fieldMapping.AvatarUrl)
+	}
+	return userInfo, nil
+}
+
+func (p *IdentityProvider) dial() (*goldap.Conn, error) {
+	tlsConfig := &tls.Config{
+		// Opted in by the admin for directories with self-signed certificates.
+		InsecureSkipVerify: p.config.InsecureSkipVerify,
+	}
+	if serverURL, err := url.Parse(p.config.ServerUrl); err == nil {
+		tlsConfig.ServerName = serverURL.Hostname()
+	}
+	conn, err := goldap.DialURL(p.config.ServerUrl,
+		goldap.DialWithDialer(&net.Dialer{Timeout: dialTimeout}),
+		goldap.DialWithTLSConfig(tlsConfig),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to connect to the directory")
+	}
+	conn.SetTimeout(dialTimeout)
+	if p.config.StartTls {
+		if err := conn.StartTLS(tlsConfig); err != nil {
+			conn.Close()
+			return nil, errors.Wrap(err, "failed to start tls")
+		}
+	}
+	return conn, nil
+}
+
+// searchUser returns the single entry matching the user filter.
+func (p *IdentityProvider) searchUser(conn *goldap.Conn, username string) (*goldap.Entry, error) {
+	userFilter := p.config.UserFilter
+	if userFilter == "" {
+		userFilter = DefaultUserFilter
+	}
+	fieldMapping := p.config.FieldMapping
+	attributes := []string{}
+	for _, attribute := range []string{fieldMapping.Identifier, fieldMapping.DisplayName, fieldMapping.Email, fieldMapping.AvatarUrl} {
+		if attribute != "" {
+			attributes = append(attributes, attribute)
+		}
+	}
+
+	result, err := conn.Search(goldap.NewSearchRequest(
+		p.config.SearchBase,
+		goldap.ScopeWholeSubtree,
+		goldap.NeverDerefAliases,
+		// Two entries are enough to tell that the username is ambiguous.
+		2,
+		int(dialTimeout.Seconds()),
+		false,
+		strings.ReplaceAll(userFilter, usernamePlaceholder, goldap.EscapeFilter(username)),
+		attributes,
+		nil,
+	))
+	if err != nil && !goldap.IsErrorWithCode(err, goldap.LDAPResultSizeLimitExceeded) {
+		return nil, errors.Wrap(err, "failed to search the user")
+	}
+	switch len(result.Entries) {
+	case 0:
+		return nil, ErrInvalidCredentials
+	case 1:
+		return result.Entries[0], nil
+	default:
+		return nil, errors.Errorf("the username %q matches several entries", username)
+	}
+}
@@ -0,0 +1,160 @@
+package ldap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/plugin/idp"
+	"github.com/usememos/memos/plugin/idp/ldap/ldaptest"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestNewIdentityProvider(t *testing.T) {
+	fieldMapping := &storepb.FieldMapping{Identifier: "uid"}
+	tests := []struct {
+		name        string
+		config      *storepb.LDAPConfig
+		containsErr string
+	}{
+		{
+			name:        "no serverUrl",
+			config:      &storepb.LDAPConfig{SearchBase: "dc=example,dc=com", FieldMapping: fieldMapping},
+			containsErr: `the field "serverUrl" is empty but required`,
+		},
+		{
+			name:        "no searchBase",
+			config:      &storepb.LDAPConfig{ServerUrl: "ldap://localhost", FieldMapping: fieldMapping},
+			containsErr: `the field "searchBase" is empty but required`,
+		},
+		{
+			name:        "no field mapping identifier",
+			config:      &storepb.LDAPConfig{ServerUrl: "ldap://localhost", SearchBase: "dc=example,dc=com"},
+			containsErr: `the field "fieldMapping.identifier" is empty but required`,
+		},
+		{
+			name: "user filter without username",
+			config: &storepb.LDAPConfig{
+				ServerUrl:    "ldap://localhost",
+				SearchBase:   "dc=example,dc=com",
+				UserFilter:   "(objectClass=person)",
+				FieldMapping: fieldMapping,
+			},
+			containsErr: `the field "userFilter" must contain {username}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := NewIdentityProvider(test.config)
+			assert.ErrorContains(t, err, test.containsErr)
+		})
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	server, err := ldaptest.NewServer(
+		&ldaptest.Entry{
+			DN:       "cn=memos,ou=services,dc=example,dc=com",
+			Password: "service-password",
+		},
+		&ldaptest.Entry{
+			DN:       "uid=alice,ou=people,dc=example,dc=com",
+			Password: "alice-password",
+			Attributes: map[string][]string{
+				"objectClass": {"person"},
+				"uid":         {"alice"},
+				"cn":          {"Alice Liddell"},
+				"mail":        {"alice@example.com"},
+			},
+		},
+		&ldaptest.Entry{
+			DN:       "uid=bob,ou=people,dc=example,dc=com",
+			Password: "bob-password",
+			Attributes: map[string][]string{
+				"objectClass": {"person"},
+				"uid":         {"bob"},
+			},
+		},
+		// Two entries with the same mail make the mail ambiguous.
+		&ldaptest.Entry{
+			DN:         "uid=carol,ou=people,dc=example,dc=com",
+			Attributes: map[string][]string{"objectClass": {"person"}, "uid": {"carol"}, "mail": {"shared@example.com"}},
+		},
+		&ldaptest.Entry{
+			DN:         "uid=dave,ou=people,dc=example,dc=com",
+			Attributes: map[string][]string{"objectClass": {"person"}, "uid": {"dave"}, "mail": {"shared@example.com"}},
+		},
+	)
+	require.NoError(t, err)
+	defer server.Close()
+
+	newIdentityProvider := func(t *testing.T, userFilter string) *IdentityProvider {
+		identityProvider, err := NewIdentityProvider(&storepb.LDAPConfig{
+			ServerUrl:    server.URL,
+			BindDn:       "cn=memos,ou=services,dc=example,dc=com",
+			BindPassword: "service-password",
+			SearchBase:   "ou=people,dc=example,dc=com",
+			UserFilter:   userFilter,
+			FieldMapping: &storepb.FieldMapping{
+				Identifier:  "uid",
+				DisplayName: "cn",
+				Email:       "mail",
+			},
+		})
+		require.NoError(t, err)
+		return identityProvider
+	}
+
+	t.Run("valid password", func(t *testing.T) {
+		userInfo, err := newIdentityProvider(t, "").Authenticate("alice", "alice-password")
+		require.NoError(t, err)
+		assert.Equal(t, &idp.IdentityProviderUserInfo{
+			Identifier:  "alice",
+			DisplayName: "Alice Liddell",
+			Email:       "alice@example.com",
+		}, userInfo)
+
+		// The display name falls back to the identifier.
+		userInfo, err = newIdentityProvider(t, "").Authenticate("bob", "bob-password")
+		require.NoError(t, err)
+		assert.Equal(t, "bob", userInfo.DisplayName)
+	})
+
+	t.Run("custom user filter", func(t *testing.T) {
+		identityProvider := newIdentityProvider(t, "(&(objectClass=person)(|(uid={username})(mail={username})))")
+		userInfo, err := identityProvider.Authenticate("alice@example.com", "alice-password")
+		require.NoError(t, err)
+		assert.Equal(t, "alice", userInfo.Identifier)
+
+		_, err = identityProvider.Authenticate("shared@example.com", "password")
+		assert.ErrorContains(t, err, "matches several entries")
+	})
+
+	t.Run("invalid credentials", func(t *testing.T) {
+		identityProvider := newIdentityProvider(t, "")
+		_, err := identityProvider.Authenticate("alice", "wrong-password")
+		assert.ErrorIs(t, err, ErrInvalidCredentials)
+		_, err = identityProvider.Authenticate("alice", "")
+		assert.ErrorIs(t, err, ErrInvalidCredentials)
+		_, err = identityProvider.Authenticate("unknown", "alice-password")
+		assert.ErrorIs(t, err, ErrInvalidCredentials)
+		// The username is escaped in the filter.
+		_, err = identityProvider.Authenticate("*", "alice-password")
+		assert.ErrorIs(t, err, ErrInvalidCredentials)
+	})
+
+	t.Run("invalid service account", func(t *testing.T) {
+		identityProvider, err := NewIdentityProvider(&storepb.LDAPConfig{
+			ServerUrl:    server.URL,
+			BindDn:       "cn=memos,ou=services,dc=example,dc=com",
+			BindPassword: "wrong-password",
+			SearchBase:   "ou=people,dc=example,dc=com",
+			FieldMapping: &storepb.FieldMapping{Identifier: "uid"},
+		})
+		require.NoError(t, err)
+		_, err = identityProvider.Authenticate("alice", "alice-password")
+		assert.ErrorContains(t, err, "failed to bind the service account")
+		assert.NotErrorIs(t, err, ErrInvalidCredentials)
+	})
+}
@@ -0,0 +1,212 @@
+// Package ldaptest provides an in-process LDAP directory for tests.
+// It answers simple binds and searches with equality, presence, and, or and not filters.
+package ldaptest
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	goldap "github.com/go-ldap/ldap/v3"
+)
+
+// Entry is an entry of the directory.
+type Entry struct {
+	DN string
+	// Password is accepted by simple binds as the entry.
+	Password   string
+	Attributes map[string][]string
+}
+
+// Server is an LDAP directory listening on a local port.
+type Server struct {
+	// URL is the ldap:// URL of the directory.
+	URL string
+
+	listener net.Listener
+	entries  []*Entry
+	wg       sync.WaitGroup
+}
+
+// NewServer starts a directory with the entries.
+func NewServer(entries ...*Entry) (*Server, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	s := &Server{
+		URL:      "ldap://" + listener.Addr().String(),
+		listener: listener,
+		entries:  entries,
+	}
+	s.wg.Add(1)
+	go s.serve()
+	return s, nil
+}
+
+// Close stops the directory.
+func (s *Server) Close() {
+	s.listener.Close()
+	s.wg.Wait()
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			defer conn.Close()
+			s.handle(conn)
+		}()
+	}
+}
+
+func (s *Server) handle(conn net.Conn) {
+	for {
+		packet, err := ber.ReadPacket(conn)
+		if err != nil || len(packet.Children) < 2 {
+			return
+		}
+		messageID := packet.Children[0].Value
+		request := packet.Children[1]
+		switch request.Tag {
+		case goldap.ApplicationBindRequest:
+			s.write(conn, messageID, goldap.ApplicationBindResponse, s.bind(request), nil)
+		case goldap.ApplicationSearchRequest:
+			entries, resultCode := s.search(request)
+			for _, entry := range entries {
+				s.write(conn, messageID, goldap.ApplicationSearchResultEntry, 0, entry)
+			}
+			s.write(conn, messageID, goldap.ApplicationSearchResultDone, resultCode, nil)
+		case goldap.ApplicationUnbindRequest:
+			return
+		default:
+			s.write(conn, messageID, goldap.ApplicationExtendedResponse, goldap.LDAPResultUnwillingToPerform, nil)
+		}
+	}
+}
+
+func (s *Server) bind(request *ber.Packet) uint16 {
+	if len(request.Children) < 3 {
+		return goldap.LDAPResultProtocolError
+	}
+	dn, _ := request.Children[1].Value.(string)
+	password := request.Children[2].Data.String()
+	if dn == "" && password == "" {
+		return goldap.LDAPResultSuccess
+	}
+	for _, entry := range s.entries {
+		if strings.EqualFold(entry.DN, dn) && entry.Password != "" && entry.Password == password {
+			return goldap.LDAPResultSuccess
+		}
+	}
+	return goldap.LDAPResultInvalidCredentials
+}
+
+func (s *Server) search(request *ber.Packet) ([]*Entry, uint16) {
+	if len(request.Children) < 7 {
+		return nil, goldap.LDAPResultProtocolError
+	}
+	base, _ := request.Children[0].Value.(string)
+	sizeLimit, _ := request.Children[3].Value.(int64)
+	entries := []*Entry{}
+	for _, entry := range s.entries {
+		if !strings.HasSuffix(strings.ToLower(entry.DN), strings.ToLower(base)) || !matches(request.Children[6], entry) {
+			continue
+		}
+		if sizeLimit > 0 && int64(len(entries)) == sizeLimit {
+			return entries, goldap.LDAPResultSizeLimitExceeded
+		}
+		entries = append(entries, entry)
+	}
+	return entries, goldap.LDAPResultSuccess
+}
+
+// matches evaluates the filter on the entry.
+func matches(filter *ber.Packet, entry *Entry) bool {
+	switch filter.Tag {
+	case goldap.FilterAnd:
+		for _, child := range filter.Children {
+			if !matches(child, entry) {
+				return false
+			}
+		}
+		return true
+	case goldap.FilterOr:
+		for _, child := range filter.Children {
+			if matches(child, entry) {
+				return true
+			}
+		}
+		return false
+	case goldap.FilterNot:
+		return len(filter.Children) == 1 && !matches(filter.Children[0], entry)
+	case goldap.FilterEqualityMatch:
+		if len(filter.Children) != 2 {
+			return false
+		}
+		attribute, _ := filter.Children[0].Value.(string)
+		value, _ := filter.Children[1].Value.(string)
+		for _, v := range entry.values(attribute) {
+			if strings.EqualFold(v, value) {
+				return true
+			}
+		}
+		return false
+	case goldap.FilterPresent:
+		return len(entry.values(filter.Data.String())) > 0
+	default:
+		return false
+	}
+}
+
+func (e *Entry) values(attribute string) []string {
+	for name, values := range e.Attributes {
+		if strings.EqualFold(name, attribute) {
+			return values
+		}
+	}
+	return nil
+}
+
+func (*Server) write(w io.Writer, messageID any, tag ber.Tag, resultCode uint16, entry *Entry) {
+	packet := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Response")
+	packet.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, messageID, "Message ID"))
+	response := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "Response")
+	if entry != nil {
+		response.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, entry.DN, "DN"))
+		attributes := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attributes")
+		for name, values := range entry.Attributes {
+			attribute := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attribute")
+			attribute.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, name, "Type"))
+			set := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "Values")
+			for _, value := range values {
+				set.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, value, "Value"))
+			}
+			attribute.AppendChild(set)
+			attributes.AppendChild(attribute)
+		}
+		response.AppendChild(attributes)
+	} else {
+		response.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, int64(resultCode), "Result Code"))
+		response.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Matched DN"))
+		response.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, diagnostic(resultCode), "Diagnostic Message"))
+	}
+	packet.AppendChild(response)
+	w.Write(packet.Bytes())
+}
+
+func diagnostic(resultCode uint16) string {
+	if resultCode == goldap.LDAPResultSuccess {
+		return ""
+	}
+	return fmt.Sprintf("ldaptest: %s", goldap.LDAPResultCodeMap[resultCode])
+}
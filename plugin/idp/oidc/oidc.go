@@ -0,0 +1,379 @@
+// Package oidc is the plugin for OpenID Connect Identity Provider.
+package oidc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
+
+	"github.com/usememos/memos/plugin/idp"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// DefaultScopes are requested if the configuration has no scopes.
+var DefaultScopes = []string{"openid", "profile", "email"}
+
+// defaultFieldMapping maps the standard claims if the configuration has no field mapping.
+var defaultFieldMapping = &storepb.FieldMapping{
+	Identifier:  "sub",
+	DisplayName: "name",
+	Email:       "email",
+	AvatarUrl:   "picture",
+}
+
+// signingMethods are the accepted ID token signing algorithms.
+var signingMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+
+// clockSkew is the tolerated difference between the clocks of the issuer and the server.
+const clockSkew = time.Minute
+
+// Discovery is the OpenID provider metadata served at {issuer}/.well-known/openid-configuration.
+type Discovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserinfoEndpoint      string `json:"userinfo_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// IdentityProvider represents an OpenID Connect Identity Provider.
+type IdentityProvider struct {
+	config *storepb.OIDCConfig
+	client *http.Client
+	// discovery is the metadata of the issuer, fetched once per identity provider.
+	discovery *Discovery
+}
+
+// NewIdentityProvider initializes a new OpenID Connect Identity Provider with the given configuration.
+func NewIdentityProvider(config *storepb.OIDCConfig) (*IdentityProvider, error) {
+	for v, field := range map[string]string{
+		config.IssuerUrl: "issuerUrl",
+		config.ClientId:  "clientId",
+	} {
+		if v == "" {
+			return nil, errors.Errorf(`the field "%s" is empty but required`, field)
+		}
+	}
+	if config.FieldMapping != nil && config.FieldMapping.Identifier == "" {
+		return nil, errors.New(`the field "fieldMapping.identifier" is empty but required`)
+	}
+
+	return &IdentityProvider{
+		config: config,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+// Discover fetches the metadata of the issuer.
+func (p *IdentityProvider) Discover(ctx context.Context) (*Discovery, error) {
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+
+	issuerURL := strings.TrimSuffix(p.config.IssuerUrl, "/")
+	discovery := &Discovery{}
+	if err := p.getJSON(ctx, issuerURL+"/.well-known/openid-configuration", discovery); err != nil {
+		return nil, errors.Wrap(err, "failed to get openid configuration")
+	}
+	// The issuer of the metadata must be the configured one, otherwise anyone serving the document could issue tokens.
+	if strings.TrimSuffix(discovery.Issuer, "/") != issuerURL {
+		return nil, errors.Errorf("the issuer %q of the openid configuration does not match %q", discovery.Issuer, p.config.IssuerUrl)
+	}
+	for v, field := range map[string]string{
+		discovery.AuthorizationEndpoint: "authorization_endpoint",
+		discovery.TokenEndpoint:         "token_endpoint",
+		discovery.JWKSURI:               "jwks_uri",
+	} {
+		if v == "" {
+			return nil, errors.Errorf(`the field "%s" is missing from the openid configuration`, field)
+		}
+	}
+	p.discovery = discovery
+	return discovery, nil
+}
+
+// Scopes returns the scopes to request, always including "openid".
+func (p *IdentityProvider) Scopes() []string {
+	scopes := p.config.Scopes
+	if len(scopes) == 0 {
+		scopes = DefaultScopes
+	}
+	if !slices.Contains(scopes, "openid") {
+		scopes = append([]string{"openid"}, scopes...)
+	}
+	return scopes
+}
+
+// ExchangeToken returns the ID token exchanged for the given authorization code.
+// If codeVerifier is provided, it will be used for PKCE (Proof Key for Code Exchange) validation.
+func (p *IdentityProvider) ExchangeToken(ctx context.Context, redirectURL, code, codeVerifier string) (string, error) {
+	discovery, err := p.Discover(ctx)
+	if err != nil {
+		return "", err
+	}
+	conf := &oauth2.Config{
+		ClientID:     p.config.ClientId,
+		ClientSecret: p.config.ClientSecret,
+		RedirectURL:  redirectURL,
+		Scopes:       p.Scopes(),
+		Endpoint: oauth2.Endpoint{
+			AuthURL:   discovery.AuthorizationEndpoint,
+			TokenURL:  discovery.TokenEndpoint,
+			AuthStyle: oauth2.AuthStyleInParams,
+		},
+	}
+
+	opts := []oauth2.AuthCodeOption{}
+	if codeVerifier != "" {
+		opts = append(opts, oauth2.SetAuthURLParam("code_verifier", codeVerifier))
+	}
+
+	token, err := conf.Exchange(context.WithValue(ctx, oauth2.HTTPClient, p.client), code, opts...)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to exchange token")
+	}
+	idToken, ok := token.Extra("id_token").(string)
+	if !ok || idToken == "" {
+		return "", errors.New("missing id token from token response")
+	}
+	return idToken, nil
+}
+
+// UserInfo verifies the ID token and returns the user information of its claims.
+// The nonce must be the one sent in the authorization request.
+func (p *IdentityProvider) UserInfo(ctx context.Context, idToken, nonce string) (*idp.IdentityProviderUserInfo, error) {
+	claims, err := p.VerifyIDToken(ctx, idToken, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldMapping := p.config.FieldMapping
+	if fieldMapping == nil {
+		fieldMapping = defaultFieldMapping
+	}
+	userInfo := &idp.IdentityProviderUserInfo{}
+	if v, ok := claims[fieldMapping.Identifier].(string); ok {
+		userInfo.Identifier = v
+	}
+	if userInfo.Identifier == "" {
+		return nil, errors.Errorf("the field %q is not found in claims or has empty value", fieldMapping.Identifier)
+	}
+
+	// Best effort to map optional fields
+	if fieldMapping.DisplayName != "" {
+		if v, ok := claims[fieldMapping.DisplayName].(string); ok {
+			userInfo.DisplayName = v
+		}
+	}
+	if userInfo.DisplayName == "" {
+		userInfo.DisplayName = userInfo.Identifier
+	}
+	if fieldMapping.Email != "" {
+		if v, ok := claims[fieldMapping.Email].(string); ok {
+			userInfo.Email = v
+		}
+	}
+	if fieldMapping.AvatarUrl != "" {
+		if v, ok := claims[fieldMapping.AvatarUrl].(string); ok {
+			userInfo.AvatarURL = v
+		}
+	}
+	if p.config.GroupsClaim != "" {
+		userInfo.Groups = stringsClaim(claims[p.config.GroupsClaim])
+	}
+	return userInfo, nil
+}
+
+// IsAdmin reports whether the user is a member of any of the admin groups.
+func (p *IdentityProvider) IsAdmin(userInfo *idp.IdentityProviderUserInfo) bool {
+	for _, group := range userInfo.Groups {
+		if slices.Contains(p.config.AdminGroups, group) {
+			return true
+		}
+	}
+	return false
+}
+
+// VerifyIDToken verifies the signature of the ID token against the keys of the issuer,
+// and checks its issuer, audience, expiry and nonce. It returns the claims of the token.
+func (p *IdentityProvider) VerifyIDToken(ctx context.Context, idToken, nonce string) (jwt.MapClaims, error) {
+	if nonce == "" {
+		return nil, errors.New("nonce is required")
+	}
+	discovery, err := p.Discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	keySet, err := p.fetchKeySet(ctx, discovery.JWKSURI)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := jwt.MapClaims{}
+	_, err = jwt.ParseWithClaims(idToken, claims, keySet.keyFunc,
+		jwt.WithValidMethods(signingMethods),
+		jwt.WithIssuer(discovery.Issuer),
+		jwt.WithAudience(p.config.ClientId),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(clockSkew),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid id token")
+	}
+	// A token issued to several audiences must be issued to this client.
+	audience, _ := claims.GetAudience()
+	azp, _ := claims["azp"].(string)
+	if (len(audience) > 1 || azp != "") && azp != p.config.ClientId {
+		return nil, errors.Errorf("invalid id token: authorized party %q is not the client", azp)
+	}
+	if tokenNonce, _ := claims["nonce"].(string); tokenNonce != nonce {
+		return nil, errors.New("invalid id token: nonce mismatch")
+	}
+	return claims, nil
+}
+
+// keySet is the JSON Web Key Set of the issuer.
+type keySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+// jsonWebKey is a public key of a JSON Web Key Set (RFC 7517).
+type jsonWebKey struct {
+	KeyType string `json:"kty"`
+	KeyID   string `json:"kid"`
+	Use     string `json:"use"`
+	// N and E are the modulus and exponent of RSA keys.
+	N string `json:"n"`
+	E string `json:"e"`
+	// Curve, X and Y are the curve and coordinates of EC keys.
+	Curve string `json:"crv"`
+	X     string `json:"x"`
+	Y     string `json:"y"`
+}
+
+func (p *IdentityProvider) fetchKeySet(ctx context.Context, jwksURI string) (*keySet, error) {
+	set := &keySet{}
+	if err := p.getJSON(ctx, jwksURI, set); err != nil {
+		return nil, errors.Wrap(err, "failed to get json web key set")
+	}
+	// Keys for encryption are never used to verify signatures.
+	set.Keys = slices.DeleteFunc(set.Keys, func(key jsonWebKey) bool {
+		return key.Use != "" && key.Use != "sig"
+	})
+	return set, nil
+}
+
+// keyFunc returns the public key with the key ID of the token header.
+// Tokens without a key ID are accepted only if the set has a single key.
+func (s *keySet) keyFunc(token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+	for _, key := range s.Keys {
+		if key.KeyID == kid || (kid == "" && len(s.Keys) == 1) {
+			return key.publicKey()
+		}
+	}
+	return nil, errors.Errorf("unknown signing key %q", kid)
+}
+
+func (k *jsonWebKey) publicKey() (any, error) {
+	switch k.KeyType {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid RSA modulus")
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid RSA exponent")
+		}
+		exponent := new(big.Int).SetBytes(e)
+		if !exponent.IsInt64() || exponent.Int64() > 1<<31-1 {
+			return nil, errors.New("invalid RSA exponent")
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+	case "EC":
+		curves := map[string]elliptic.Curve{
+			"P-256": elliptic.P256(),
+			"P-384": elliptic.P384(),
+			"P-521": elliptic.P521(),
+		}
+		curve, ok := curves[k.Curve]
+		if !ok {
+			return nil, errors.Errorf("unsupported EC curve %q", k.Curve)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid EC x coordinate")
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid EC y coordinate")
+		}
+		size := (curve.Params().BitSize + 7) / 8
+		if len(x) > size || len(y) > size {
+			return nil, errors.New("invalid EC coordinates")
+		}
+		// The uncompressed point is 0x04 || X || Y with both coordinates padded to the curve size.
+		point := make([]byte, 1+2*size)
+		point[0] = 4
+		copy(point[1+size-len(x):1+size], x)
+		copy(point[1+2*size-len(y):], y)
+		key, err := ecdsa.ParseUncompressedPublicKey(curve, point)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid EC key")
+		}
+		return key, nil
+	default:
+		return nil, errors.Errorf("unsupported key type %q", k.KeyType)
+	}
+}
+
+func (p *IdentityProvider) getJSON(ctx context.Context, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to new http request")
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("unexpected status %s", resp.Status)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return errors.Wrap(err, "failed to decode response body")
+	}
+	return nil
+}
+
+// stringsClaim returns the strings of a claim, which is either a list or a single string.
+func stringsClaim(claim any) []string {
+	switch v := claim.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		values := []string{}
+		for _, item := range v {
+			values = append(values, fmt.Sprint(item))
+		}
+		return values
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,315 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/plugin/idp"
+	"github.com/usememos/memos/plugin/idp/oidc/oidctest"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+const (
+	testClientID = "memos"
+	testCode     = "test-code"
+	testNonce    = "test-nonce"
+)
+
+// fakeIssuer wraps the in-process OpenID provider with the claims and the config of the tests.
+type fakeIssuer struct {
+	*oidctest.Server
+	t *testing.T
+}
+
+func newFakeIssuer(t *testing.T) *fakeIssuer {
+	server, err := oidctest.NewServer()
+	require.NoError(t, err)
+	server.Code = testCode
+	t.Cleanup(server.Close)
+	return &fakeIssuer{Server: server, t: t}
+}
+
+// claims returns valid claims of an ID token, to be modified by the tests.
+func (f *fakeIssuer) claims() jwt.MapClaims {
+	now := time.Now()
+	return jwt.MapClaims{
+		"iss":                f.URL,
+		"aud":                testClientID,
+		"sub":                "0f3a6c1e",
+		"exp":                now.Add(5 * time.Minute).Unix(),
+		"iat":                now.Unix(),
+		"nonce":              testNonce,
+		"preferred_username": "alice",
+		"name":               "Alice",
+		"email":              "alice@example.com",
+		"groups":             []string{"/staff", "/memos-admins"},
+	}
+}
+
+func (f *fakeIssuer) sign(method jwt.SigningMethod, kid string, claims jwt.MapClaims) string {
+	signed, err := f.Sign(method, kid, claims)
+	require.NoError(f.t, err)
+	return signed
+}
+
+func (f *fakeIssuer) config() *storepb.OIDCConfig {
+	return &storepb.OIDCConfig{
+		IssuerUrl: f.URL,
+		ClientId:  testClientID,
+		FieldMapping: &storepb.FieldMapping{
+			Identifier:  "preferred_username",
+			DisplayName: "name",
+			Email:       "email",
+		},
+		GroupsClaim: "groups",
+		AdminGroups: []string{"/memos-admins"},
+	}
+}
+
+func TestNewIdentityProvider(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      *storepb.OIDCConfig
+		containsErr string
+	}{
+		{
+			name:        "no issuerUrl",
+			config:      &storepb.OIDCConfig{ClientId: testClientID},
+			containsErr: `the field "issuerUrl" is empty but required`,
+		},
+		{
+			name:        "no clientId",
+			config:      &storepb.OIDCConfig{IssuerUrl: "https://example.com"},
+			containsErr: `the field "clientId" is empty but required`,
+		},
+		{
+			name: "no field mapping identifier",
+			config: &storepb.OIDCConfig{
+				IssuerUrl:    "https://example.com",
+				ClientId:     testClientID,
+				FieldMapping: &storepb.FieldMapping{DisplayName: "name"},
+			},
+			containsErr: `the field "fieldMapping.identifier" is empty but required`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := NewIdentityProvider(test.config)
+			assert.ErrorContains(t, err, test.containsErr)
+		})
+	}
+}
+
+func TestDiscover(t *testing.T) {
+	ctx := context.Background()
+	issuer := newFakeIssuer(t)
+
+	provider, err := NewIdentityProvider(issuer.config())
+	require.NoError(t, err)
+	discovery, err := provider.Discover(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, issuer.URL, discovery.Issuer)
+	assert.Equal(t, issuer.URL+"/protocol/openid-connect/auth", discovery.AuthorizationEndpoint)
+
+	// A trailing slash of the configured issuer is tolerated.
+	config := issuer.config()
+	config.IssuerUrl += "/"
+	provider, err = NewIdentityProvider(config)
+	require.NoError(t, err)
+	_, err = provider.Discover(ctx)
+	require.NoError(t, err)
+
+	issuer.Issuer = "https://evil.example.com/realms/memos"
+	provider, err = NewIdentityProvider(issuer.config())
+	require.NoError(t, err)
+	_, err = provider.Discover(ctx)
+	assert.ErrorContains(t, err, "does not match")
+}
+
+func TestIdentityProvider(t *testing.T) {
+	ctx := context.Background()
+	issuer := newFakeIssuer(t)
+	issuer.IDToken = issuer.sign(jwt.SigningMethodRS256, oidctest.RSAKeyID, issuer.claims())
+
+	provider, err := NewIdentityProvider(issuer.config())
+	require.NoError(t, err)
+	idToken, err := provider.ExchangeToken(ctx, "https://memos.example.com/auth/callback", testCode, "verifier")
+	require.NoError(t, err)
+	require.Equal(t, issuer.IDToken, idToken)
+
+	userInfo, err := provider.UserInfo(ctx, idToken, testNonce)
+	require.NoError(t, err)
+	assert.Equal(t, &idp.IdentityProviderUserInfo{
+		Identifier:  "alice",
+		DisplayName: "Alice",
+		Email:       "alice@example.com",
+		Groups:      []string{"/staff", "/memos-admins"},
+	}, userInfo)
+	assert.True(t, provider.IsAdmin(userInfo))
+	assert.False(t, provider.IsAdmin(&idp.IdentityProviderUserInfo{Groups: []string{"/staff"}}))
+
+	_, err = provider.ExchangeToken(ctx, "https://memos.example.com/auth/callback", "wrong-code", "")
+	assert.Error(t, err)
+}
+
+func TestDefaultFieldMapping(t *testing.T) {
+	ctx := context.Background()
+	issuer := newFakeIssuer(t)
+	config := issuer.config()
+	config.FieldMapping = nil
+	config.GroupsClaim = ""
+	provider, err := NewIdentityProvider(config)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"openid", "profile", "email"}, provider.Scopes())
+
+	userInfo, err := provider.UserInfo(ctx, issuer.sign(jwt.SigningMethodES256, oidctest.ECKeyID, issuer.claims()), testNonce)
+	require.NoError(t, err)
+	assert.Equal(t, &idp.IdentityProviderUserInfo{
+		Identifier:  "0f3a6c1e",
+		DisplayName: "Alice",
+		Email:       "alice@example.com",
+	}, userInfo)
+	assert.False(t, provider.IsAdmin(userInfo))
+}
+
+func TestVerifyIDToken(t *testing.T) {
+	ctx := context.Background()
+	issuer := newFakeIssuer(t)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		idToken     func() string
+		nonce       string
+		containsErr string
+	}{
+		{
+			name:    "valid RSA token",
+			idToken: func() string { return issuer.sign(jwt.SigningMethodRS256, oidctest.RSAKeyID, issuer.claims()) },
+			nonce:   testNonce,
+		},
+		{
+			name:    "valid EC token",
+			idToken: func() string { return issuer.sign(jwt.SigningMethodES256, oidctest.ECKeyID, issuer.claims()) },
+			nonce:   testNonce,
+		},
+		{
+			name: "token for several audiences authorized to the client",
+			idToken: func() string {
+				claims := issuer.claims()
+				claims["aud"] = []string{testClientID, "other"}
+				claims["azp"] = testClientID
+				return issuer.sign(jwt.SigningMethodRS256, oidctest.RSAKeyID, claims)
+			},
+			nonce: testNonce,
+		},
+		{
+			name:        "missing nonce",
+			idToken:     func() string { return issuer.sign(jwt.SigningMethodRS256, oidctest.RSAKeyID, issuer.claims()) },
+			containsErr: "nonce is required",
+		},
+		{
+			name:        "nonce mismatch",
+			idToken:     func() string { return issuer.sign(jwt.SigningMethodRS256, oidctest.RSAKeyID, issuer.claims()) },
+			nonce:       "other-nonce",
+			containsErr: "nonce mismatch",
+		},
+		{
+			name: "wrong issuer",
+			idToken: func() string {
+				claims := issuer.claims()
+				claims["iss"] = "https://evil.example.com/realms/memos"
+				return issuer.sign(jwt.SigningMethodRS256, oidctest.RSAKeyID, claims)
+			},
+			nonce:       testNonce,
+			containsErr: "invalid issuer",
+		},
+		{
+			name: "wrong audience",
+			idToken: func() string {
+				claims := issuer.claims()
+				claims["aud"] = "other"
+				return issuer.sign(jwt.SigningMethodRS256, oidctest.RSAKeyID, claims)
+			},
+			nonce:       testNonce,
+			containsErr: "invalid audience",
+		},
+		{
+			name: "token for several audiences authorized to another client",
+			idToken: func() string {
+				claims := issuer.claims()
+				claims["aud"] = []string{testClientID, "other"}
+				claims["azp"] = "other"
+				return issuer.sign(jwt.SigningMethodRS256, oidctest.RSAKeyID, claims)
+			},
+			nonce:       testNonce,
+			containsErr: "authorized party",
+		},
+		{
+			name: "expired token",
+			idToken: func() string {
+				claims := issuer.claims()
+				claims["exp"] = time.Now().Add(-time.Hour).Unix()
+				return issuer.sign(jwt.SigningMethodRS256, oidctest.RSAKeyID, claims)
+			},
+			nonce:       testNonce,
+			containsErr: "token is expired",
+		},
+		{
+			name: "signed by another key",
+			idToken: func() string {
+				token := jwt.NewWithClaims(jwt.SigningMethodRS256, issuer.claims())
+				token.Header["kid"] = oidctest.RSAKeyID
+				signed, err := token.SignedString(otherKey)
+				require.NoError(t, err)
+				return signed
+			},
+			nonce:       testNonce,
+			containsErr: "signature is invalid",
+		},
+		{
+			name:        "unknown key",
+			idToken:     func() string { return issuer.sign(jwt.SigningMethodRS256, "unknown", issuer.claims()) },
+			nonce:       testNonce,
+			containsErr: "unknown signing key",
+		},
+		{
+			name:        "encryption key",
+			idToken:     func() string { return issuer.sign(jwt.SigningMethodRS256, oidctest.EncryptionKeyID, issuer.claims()) },
+			nonce:       testNonce,
+			containsErr: "unknown signing key",
+		},
+		{
+			name: "symmetric signature",
+			idToken: func() string {
+				token := jwt.NewWithClaims(jwt.SigningMethodHS256, issuer.claims())
+				token.Header["kid"] = oidctest.RSAKeyID
+				signed, err := token.SignedString([]byte("secret"))
+				require.NoError(t, err)
+				return signed
+			},
+			nonce:       testNonce,
+			containsErr: "signing method HS256 is invalid",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			provider, err := NewIdentityProvider(issuer.config())
+			require.NoError(t, err)
+			_, err = provider.VerifyIDToken(ctx, test.idToken(), test.nonce)
+			if test.containsErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, test.containsErr)
+			}
+		})
+	}
+}
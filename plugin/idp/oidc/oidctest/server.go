@@ -0,0 +1,138 @@
+// Package oidctest provides an in-process OpenID provider for tests.
+// It serves the discovery document, the signing keys and a token endpoint returning the ID token set by the test.
+package oidctest
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	// RSAKeyID is the ID of the RSA signing key.
+	RSAKeyID = "rsa"
+	// ECKeyID is the ID of the P-256 signing key.
+	ECKeyID = "ec"
+	// EncryptionKeyID is the ID of an encryption key, which must never be used to verify signatures.
+	EncryptionKeyID = "enc"
+)
+
+// Server is an OpenID provider listening on a local port.
+// The fields are read by the handlers, so they are set before the requests they apply to.
+type Server struct {
+	// URL is the issuer URL, under which the discovery document is served.
+	URL string
+	// Issuer overrides the issuer of the discovery document if set.
+	Issuer string
+	// Code is the authorization code accepted by the token endpoint, any code is accepted if empty.
+	Code string
+	// IDToken is returned by the token endpoint.
+	IDToken string
+
+	server *httptest.Server
+	rsaKey *rsa.PrivateKey
+	ecKey  *ecdsa.PrivateKey
+}
+
+// NewServer starts an OpenID provider with new signing keys.
+func NewServer() (*Server, error) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	s := &Server{
+		rsaKey: rsaKey,
+		ecKey:  ecKey,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/realms/memos/.well-known/openid-configuration", s.handleDiscovery)
+	mux.HandleFunc("/realms/memos/protocol/openid-connect/certs", s.handleKeys)
+	mux.HandleFunc("/realms/memos/protocol/openid-connect/token", s.handleToken)
+	s.server = httptest.NewServer(mux)
+	s.URL = s.server.URL + "/realms/memos"
+	return s, nil
+}
+
+// Close stops the provider.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Sign returns an ID token of the claims signed with the key of the given ID.
+// The EC key signs with ECDSA methods, the RSA key with the others.
+func (s *Server) Sign(method jwt.SigningMethod, kid string, claims jwt.MapClaims) (string, error) {
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = kid
+	var key any = s.rsaKey
+	if _, ok := method.(*jwt.SigningMethodECDSA); ok {
+		key = s.ecKey
+	}
+	return token.SignedString(key)
+}
+
+func (s *Server) handleDiscovery(w http.ResponseWriter, _ *http.Request) {
+	issuer := s.URL
+	if s.Issuer != "" {
+		issuer = s.Issuer
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"issuer":                 issuer,
+		"authorization_endpoint": s.URL + "/protocol/openid-connect/auth",
+		"token_endpoint":         s.URL + "/protocol/openid-connect/token",
+		"userinfo_endpoint":      s.URL + "/protocol/openid-connect/userinfo",
+		"jwks_uri":               s.URL + "/protocol/openid-connect/certs",
+	})
+}
+
+func (s *Server) handleKeys(w http.ResponseWriter, _ *http.Request) {
+	encode := func(b []byte) string { return base64.RawURLEncoding.EncodeToString(b) }
+	n, e := encode(s.rsaKey.N.Bytes()), encode(big.NewInt(int64(s.rsaKey.E)).Bytes())
+	writeJSON(w, http.StatusOK, map[string]any{
+		"keys": []map[string]any{
+			{"kty": "RSA", "kid": RSAKeyID, "use": "sig", "n": n, "e": e},
+			{"kty": "EC", "kid": ECKeyID, "use": "sig", "crv": "P-256", "x": encode(s.ecKey.X.Bytes()), "y": encode(s.ecKey.Y.Bytes())},
+			{"kty": "RSA", "kid": EncryptionKeyID, "use": "enc", "n": n, "e": e},
+		},
+	})
+}
+
+func (s *Server) handleToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]any{"error": "invalid_request"})
+		return
+	}
+	if s.Code != "" && r.Form.Get("code") != s.Code {
+		writeJSON(w, http.StatusBadRequest, map[string]any{"error": "invalid_grant"})
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token": "test-access-token",
+		"token_type":   "Bearer",
+		"expires_in":   300,
+		"id_token":     s.IDToken,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	w.Write(body)
+}
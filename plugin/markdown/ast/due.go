@@ -0,0 +1,38 @@
+package ast
+
+import (
+	"time"
+
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/text"
+)
+
+// DueNode represents a @due marker, e.g. @2026-11-01 or @2026-11-01 09:00, in the markdown AST.
+type DueNode struct {
+	gast.BaseInline
+
+	// Value is the due marker without the @ prefix
+	Value []byte
+
+	// Time is the wall clock time of the due marker, in UTC as it carries no timezone.
+	// Markers without a time of day are due at the start of the day.
+	Time time.Time
+
+	// Segment is the position of the due marker, including the @ prefix, in the source
+	Segment text.Segment
+}
+
+// KindDue is the NodeKind for DueNode.
+var KindDue = gast.NewNodeKind("Due")
+
+// Kind returns KindDue.
+func (*DueNode) Kind() gast.NodeKind {
+	return KindDue
+}
+
+// Dump implements Node.Dump for debugging.
+func (n *DueNode) Dump(source []byte, level int) {
+	gast.DumpHelper(n, source, level, map[string]string{
+		"Value": string(n.Value),
+	}, nil)
+}
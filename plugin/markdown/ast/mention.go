@@ -0,0 +1,32 @@
+package ast
+
+import (
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/text"
+)
+
+// MentionNode represents a @username mention in the markdown AST.
+type MentionNode struct {
+	gast.BaseInline
+
+	// Username is the mentioned username without the @ prefix
+	Username []byte
+
+	// Segment is the position of the mention, including the @ prefix, in the source
+	Segment text.Segment
+}
+
+// KindMention is the NodeKind for MentionNode.
+var KindMention = gast.NewNodeKind("Mention")
+
+// Kind returns KindMention.
+func (*MentionNode) Kind() gast.NodeKind {
+	return KindMention
+}
+
+// Dump implements Node.Dump for debugging.
+func (n *MentionNode) Dump(source []byte, level int) {
+	gast.DumpHelper(n, source, level, map[string]string{
+		"Username": string(n.Username),
+	}, nil)
+}
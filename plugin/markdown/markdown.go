@@ -2,7 +2,9 @@ package markdown
 
 import (
 	"bytes"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/yuin/goldmark"
 	gast "github.com/yuin/goldmark/ast"
@@ -21,6 +23,18 @@ import (
 type ExtractedData struct {
 	Tags     []string
 	Property *storepb.MemoPayload_Property
+	DueTasks []DueTask
+	Mentions []string
+}
+
+// DueTask is a task list item with a @due marker.
+type DueTask struct {
+	// Content is the text of the task, without the checkbox and the due marker.
+	Content string
+	// Completed reports whether the task is checked.
+	Completed bool
+	// Due is the wall clock time of the due marker, in UTC as it carries no timezone.
+	Due time.Time
 }
 
 // Service handles markdown metadata extraction.
@@ -28,7 +42,7 @@ type ExtractedData struct {
 // HTML rendering is primarily done on frontend using markdown-it, but backend provides
 // RenderHTML for RSS feeds and other server-side rendering needs.
 type Service interface {
-	// ExtractAll extracts tags, properties, and references in a single parse (most efficient)
+	// ExtractAll extracts tags, properties, due tasks, and mentions in a single parse (most efficient)
 	ExtractAll(content []byte) (*ExtractedData, error)
 
 	// ExtractTags returns all #tags found in content
@@ -49,8 +63,11 @@ type Service interface {
 	// ValidateContent checks for syntax errors
 	ValidateContent(content []byte) error
 
-	// RenameTag renames all occurrences of oldTag to newTag in content
+	// RenameTag renames all occurrences of oldTag, and of the tags nested under it, to newTag in content
 	RenameTag(content []byte, oldTag, newTag string) (string, error)
+
+	// RemoveTag removes all occurrences of tag, and of the tags nested under it, from content
+	RemoveTag(content []byte, tag string) (string, error)
 }
 
 // service implements the Service interface.
@@ -62,7 +79,9 @@ type service struct {
 type Option func(*config)
 
 type config struct {
-	enableTags bool
+	enableTags     bool
+	enableDue      bool
+	enableMentions bool
 }
 
 // WithTagExtension enables #tag parsing.
@@ -72,6 +91,20 @@ func WithTagExtension() Option {
 	}
 }
 
+// WithDueExtension enables @due parsing, e.g. @2026-11-01 or @2026-11-01 09:00.
+func WithDueExtension() Option {
+	return func(c *config) {
+		c.enableDue = true
+	}
+}
+
+// WithMentionExtension enables @username parsing.
+func WithMentionExtension() Option {
+	return func(c *config) {
+		c.enableMentions = true
+	}
+}
+
 // NewService creates a new markdown service with the given options.
 func NewService(opts ...Option) Service {
 	cfg := &config{}
@@ -87,6 +120,12 @@ func NewService(opts ...Option) Service {
 	if cfg.enableTags {
 		exts = append(exts, extensions.TagExtension)
 	}
+	if cfg.enableDue {
+		exts = append(exts, extensions.DueExtension)
+	}
+	if cfg.enableMentions {
+		exts = append(exts, extensions.MentionExtension)
+	}
 
 	md := goldmark.New(
 		goldmark.WithExtensions(exts...),
@@ -295,6 +334,7 @@ func (s *service) ExtractAll(content []byte) (*ExtractedData, error) {
 	data := &ExtractedData{
 		Tags:     []string{},
 		Property: &storepb.MemoPayload_Property{},
+		Mentions: []string{},
 	}
 
 	// Single walk to collect all data
@@ -308,6 +348,20 @@ func (s *service) ExtractAll(content []byte) (*ExtractedData, error) {
 			data.Tags = append(data.Tags, string(tagNode.Tag))
 		}
 
+		// Extract due tasks
+		if dueNode, ok := n.(*mast.DueNode); ok {
+			if dueTask, ok := newDueTask(dueNode, content); ok {
+				data.DueTasks = append(data.DueTasks, dueTask)
+			}
+		}
+
+		// Extract mentions
+		if mentionNode, ok := n.(*mast.MentionNode); ok {
+			if username := string(mentionNode.Username); !slices.Contains(data.Mentions, username) {
+				data.Mentions = append(data.Mentions, username)
+			}
+		}
+
 		// Extract properties based on node kind
 		switch n.Kind() {
 		case gast.KindLink:
@@ -340,36 +394,142 @@ func (s *service) ExtractAll(content []byte) (*ExtractedData, error) {
 	return data, nil
 }
 
-// RenameTag renames all occurrences of oldTag to newTag in content.
+// newDueTask returns the task of the task list item containing the due marker.
+// Due markers outside of task list items are not tasks.
+func newDueTask(dueNode *mast.DueNode, source []byte) (DueTask, bool) {
+	var listItem gast.Node
+	for parent := dueNode.Parent(); parent != nil; parent = parent.Parent() {
+		if parent.Kind() == gast.KindListItem {
+			listItem = parent
+			break
+		}
+	}
+	if listItem == nil || listItem.FirstChild() == nil {
+		return DueTask{}, false
+	}
+	// The checkbox is the first inline of the first block of task list items.
+	block := listItem.FirstChild()
+	checkBox, ok := block.FirstChild().(*east.TaskCheckBox)
+	if !ok || dueNode.Parent() != block {
+		return DueTask{}, false
+	}
+
+	var buf strings.Builder
+	writeInlineText(&buf, block, source)
+	return DueTask{
+		Content:   strings.Join(strings.Fields(buf.String()), " "),
+		Completed: checkBox.IsChecked,
+		Due:       dueNode.Time,
+	}, true
+}
+
+// writeInlineText writes the text of the inline children of node, leaving out due markers.
+func writeInlineText(buf *strings.Builder, node gast.Node, source []byte) {
+	for child := node.FirstChild(); child != nil; child = child.NextSibling() {
+		switch n := child.(type) {
+		case *gast.Text:
+			buf.Write(n.Segment.Value(source))
+			if n.SoftLineBreak() || n.HardLineBreak() {
+				buf.WriteByte(' ')
+			}
+		case *gast.String:
+			buf.Write(n.Value)
+		case *mast.TagNode:
+			buf.WriteByte('#')
+			buf.Write(n.Tag)
+		case *mast.DueNode:
+			// Due markers are not part of the task text
+		case *mast.MentionNode:
+			buf.WriteByte('@')
+			buf.Write(n.Username)
+		default:
+			writeInlineText(buf, child, source)
+		}
+	}
+}
+
+// RenameTag renames all occurrences of oldTag, and of the tags nested under it, to newTag in content.
+// Tags are matched ignoring case, and the rest of the content is kept as is.
 func (s *service) RenameTag(content []byte, oldTag, newTag string) (string, error) {
-	root, err := s.parse(content)
+	tagNodes, err := s.findTagNodes(content, oldTag)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	cursor := 0
+	for _, tagNode := range tagNodes {
+		buf.Write(content[cursor:tagNode.Segment.Start])
+		buf.WriteByte('#')
+		buf.WriteString(newTag)
+		// Keep the nested part of the tag, e.g. "work/project" becomes "job/project".
+		buf.Write(tagNode.Tag[len(oldTag):])
+		cursor = tagNode.Segment.Stop
+	}
+	buf.Write(content[cursor:])
+	return buf.String(), nil
+}
+
+// RemoveTag removes all occurrences of tag, and of the tags nested under it, from content.
+// Tags are matched ignoring case, and a space next to each removed tag is removed with it.
+func (s *service) RemoveTag(content []byte, tag string) (string, error) {
+	tagNodes, err := s.findTagNodes(content, tag)
 	if err != nil {
 		return "", err
 	}
 
-	// Walk the AST to find and rename tag nodes
+	var buf bytes.Buffer
+	cursor := 0
+	for _, tagNode := range tagNodes {
+		start, stop := tagNode.Segment.Start, tagNode.Segment.Stop
+		if start > cursor && content[start-1] == ' ' {
+			start--
+		} else if stop < len(content) && content[stop] == ' ' {
+			stop++
+		}
+		buf.Write(content[cursor:start])
+		cursor = stop
+	}
+	buf.Write(content[cursor:])
+	return buf.String(), nil
+}
+
+// findTagNodes returns the tag nodes of content matching tag or nested under it, in source order.
+func (s *service) findTagNodes(content []byte, tag string) ([]*mast.TagNode, error) {
+	root, err := s.parse(content)
+	if err != nil {
+		return nil, err
+	}
+
+	var tagNodes []*mast.TagNode
 	err = gast.Walk(root, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
 		if !entering {
 			return gast.WalkContinue, nil
 		}
 
-		// Check for custom TagNode and rename if it matches
-		if tagNode, ok := n.(*mast.TagNode); ok {
-			if string(tagNode.Tag) == oldTag {
-				tagNode.Tag = []byte(newTag)
-			}
+		if tagNode, ok := n.(*mast.TagNode); ok && matchTag(string(tagNode.Tag), tag) {
+			tagNodes = append(tagNodes, tagNode)
 		}
 
 		return gast.WalkContinue, nil
 	})
 
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	// Render back to markdown using the already-parsed AST
-	mdRenderer := renderer.NewMarkdownRenderer()
-	return mdRenderer.Render(root, content), nil
+	slices.SortFunc(tagNodes, func(a, b *mast.TagNode) int {
+		return a.Segment.Start - b.Segment.Start
+	})
+	return tagNodes, nil
+}
+
+// matchTag reports whether tag is target or nested under it, ignoring case.
+func matchTag(tag, target string) bool {
+	if len(tag) < len(target) || !strings.EqualFold(tag[:len(target)], target) {
+		return false
+	}
+	return len(tag) == len(target) || tag[len(target)] == '/'
 }
 
 // uniqueLowercase returns unique lowercase strings from input.
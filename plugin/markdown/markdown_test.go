@@ -2,6 +2,7 @@ package markdown
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -315,6 +316,242 @@ func TestExtractTags(t *testing.T) {
 	}
 }
 
+func TestExtractDueTasks(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected []DueTask
+	}{
+		{
+			name:     "no due markers",
+			content:  "- [ ] ship it",
+			expected: nil,
+		},
+		{
+			name:    "task with due date and time",
+			content: "- [ ] ship it @2026-11-01 09:00",
+			expected: []DueTask{
+				{Content: "ship it", Due: time.Date(2026, 11, 1, 9, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			name:    "completed task with due date",
+			content: "- [x] @2026-11-01 write **release** notes #work",
+			expected: []DueTask{
+				{Content: "write release notes #work", Completed: true, Due: time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			name:    "nested tasks",
+			content: "- [ ] release @2026-11-02\n  - [ ] changelog @2026-11-01",
+			expected: []DueTask{
+				{Content: "release", Due: time.Date(2026, 11, 2, 0, 0, 0, 0, time.UTC)},
+				{Content: "changelog", Due: time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			name:     "due markers outside of tasks",
+			content:  "Meeting @2026-11-01 09:00\n\n- plain item @2026-11-01",
+			expected: nil,
+		},
+		{
+			name:     "email address",
+			content:  "- [ ] mail someone@2026-11-01.com",
+			expected: nil,
+		},
+	}
+
+	svc := NewService(WithTagExtension(), WithDueExtension())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := svc.ExtractAll([]byte(tt.content))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, data.DueTasks)
+		})
+	}
+}
+
+func TestRenderMarkdownDue(t *testing.T) {
+	svc := NewService(WithDueExtension())
+
+	result, err := svc.RenderMarkdown([]byte("- [ ] ship it @2026-11-01 09:00"))
+	require.NoError(t, err)
+	assert.Equal(t, "- [ ] ship it @2026-11-01 09:00", result)
+}
+
+func TestExtractMentions(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected []string
+	}{
+		{
+			name:     "no mentions",
+			content:  "Mail alice@example.com",
+			expected: []string{},
+		},
+		{
+			name:     "mentions",
+			content:  "@alice and @bob, please review. Thanks @alice!",
+			expected: []string{"alice", "bob"},
+		},
+		{
+			name:     "mentions next to due markers",
+			content:  "- [ ] @bob ship it @2026-11-01 09:00",
+			expected: []string{"bob"},
+		},
+		{
+			name:     "mentions after punctuation",
+			content:  "(@alice) **@bob** \"@carol\" but not dave@example.com",
+			expected: []string{"alice", "bob", "carol"},
+		},
+		{
+			name:     "code untouched",
+			content:  "`@alice`\n\n```\n@bob\n```",
+			expected: []string{},
+		},
+	}
+
+	svc := NewService(WithTagExtension(), WithDueExtension(), WithMentionExtension())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := svc.ExtractAll([]byte(tt.content))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, data.Mentions)
+		})
+	}
+
+	data, err := svc.ExtractAll([]byte("- [ ] @bob ship it @2026-11-01"))
+	require.NoError(t, err)
+	require.Len(t, data.DueTasks, 1)
+	assert.Equal(t, "@bob ship it", data.DueTasks[0].Content)
+}
+
+func TestRenderMarkdownMention(t *testing.T) {
+	svc := NewService(WithMentionExtension())
+
+	result, err := svc.RenderMarkdown([]byte("Thanks @alice!"))
+	require.NoError(t, err)
+	assert.Equal(t, "Thanks @alice!", result)
+}
+
+func TestRenameTag(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		oldTag   string
+		newTag   string
+		expected string
+	}{
+		{
+			name:     "single tag",
+			content:  "Text with #work",
+			oldTag:   "work",
+			newTag:   "job",
+			expected: "Text with #job",
+		},
+		{
+			name:     "case insensitive",
+			content:  "#Work and #WORK",
+			oldTag:   "work",
+			newTag:   "job",
+			expected: "#job and #job",
+		},
+		{
+			name:     "nested tags",
+			content:  "#work/project and #work/测试/项目",
+			oldTag:   "work",
+			newTag:   "job",
+			expected: "#job/project and #job/测试/项目",
+		},
+		{
+			name:     "similar tags untouched",
+			content:  "#workout #work-notes #homework",
+			oldTag:   "work",
+			newTag:   "job",
+			expected: "#workout #work-notes #homework",
+		},
+		{
+			name:     "code untouched",
+			content:  "`#work` #work\n\n```\n#work\n```",
+			oldTag:   "work",
+			newTag:   "job",
+			expected: "`#work` #job\n\n```\n#work\n```",
+		},
+		{
+			name:     "formatting kept",
+			content:  "| a | b |\n| - | - |\n| #work | 1 |\n\n> quote #work",
+			oldTag:   "work",
+			newTag:   "job",
+			expected: "| a | b |\n| - | - |\n| #job | 1 |\n\n> quote #job",
+		},
+	}
+
+	svc := NewService(WithTagExtension())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content, err := svc.RenameTag([]byte(tt.content), tt.oldTag, tt.newTag)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, content)
+		})
+	}
+}
+
+func TestRemoveTag(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		tag      string
+		expected string
+	}{
+		{
+			name:     "tag in text",
+			content:  "Did #work today",
+			tag:      "work",
+			expected: "Did today",
+		},
+		{
+			name:     "tag at end of line",
+			content:  "Did some #work\nnext line",
+			tag:      "work",
+			expected: "Did some\nnext line",
+		},
+		{
+			name:     "adjacent tags",
+			content:  "Notes #work #Work/project #other",
+			tag:      "work",
+			expected: "Notes #other",
+		},
+		{
+			name:     "tag on its own line",
+			content:  "#work\n\nText",
+			tag:      "work",
+			expected: "\n\nText",
+		},
+		{
+			name:     "tag at start of line",
+			content:  "#work #other text",
+			tag:      "work",
+			expected: "#other text",
+		},
+		{
+			name:     "no matching tag",
+			content:  "Text with #homework",
+			tag:      "work",
+			expected: "Text with #homework",
+		},
+	}
+
+	svc := NewService(WithTagExtension())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content, err := svc.RemoveTag([]byte(tt.content), tt.tag)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, content)
+		})
+	}
+}
+
 func TestUniqueLowercase(t *testing.T) {
 	tests := []struct {
 		name     string
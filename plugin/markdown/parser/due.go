@@ -0,0 +1,92 @@
+package parser
+
+import (
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+
+	mast "github.com/usememos/memos/plugin/markdown/ast"
+)
+
+const (
+	dueDateLayout = "2006-01-02"
+	dueTimeLayout = "15:04"
+)
+
+type dueParser struct{}
+
+// NewDueParser creates a new inline parser for @due syntax.
+func NewDueParser() parser.InlineParser {
+	return &dueParser{}
+}
+
+// Trigger returns the characters that trigger this parser.
+func (*dueParser) Trigger() []byte {
+	return []byte{'@'}
+}
+
+// Parse parses @due syntax.
+// Due markers follow these rules:
+//   - Must start with @ at the start of the line or after whitespace
+//   - Followed by a date (2006-01-02), optionally followed by a space and a time of day (15:04)
+//   - Must not be followed by a letter or digit
+//
+// Anything else is left to the other parsers triggered by @.
+func (*dueParser) Parse(_ gast.Node, block text.Reader, _ parser.Context) gast.Node {
+	line, segment := block.PeekLine()
+	if len(line) == 0 || line[0] != '@' {
+		return nil
+	}
+
+	// Must not be part of a word, e.g. an email address
+	if source := block.Source(); segment.Start > 0 {
+		r, _ := utf8.DecodeLastRune(source[:segment.Start])
+		if !unicode.IsSpace(r) {
+			return nil
+		}
+	}
+
+	pos := 1 + len(dueDateLayout)
+	if len(line) < pos {
+		return nil
+	}
+	due, err := time.Parse(dueDateLayout, string(line[1:pos]))
+	if err != nil {
+		return nil
+	}
+	if timeEnd := pos + 1 + len(dueTimeLayout); len(line) >= timeEnd && line[pos] == ' ' && isDueBoundary(line[timeEnd:]) {
+		if timeOfDay, err := time.Parse(dueTimeLayout, string(line[pos+1:timeEnd])); err == nil {
+			due = due.Add(time.Duration(timeOfDay.Hour())*time.Hour + time.Duration(timeOfDay.Minute())*time.Minute)
+			pos = timeEnd
+		}
+	}
+	if !isDueBoundary(line[pos:]) {
+		return nil
+	}
+
+	// Make a copy of the value
+	value := make([]byte, pos-1)
+	copy(value, line[1:pos])
+
+	// Advance reader
+	block.Advance(pos)
+
+	return &mast.DueNode{
+		Value:   value,
+		Time:    due,
+		Segment: text.NewSegment(segment.Start, segment.Start+pos),
+	}
+}
+
+// isDueBoundary reports whether rest can follow a due marker, i.e. it is empty or does not start with a letter or digit.
+func isDueBoundary(rest []byte) bool {
+	if len(rest) == 0 {
+		return true
+	}
+	r, _ := utf8.DecodeRune(rest)
+	return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+}
@@ -0,0 +1,114 @@
+package parser
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+
+	mast "github.com/usememos/memos/plugin/markdown/ast"
+)
+
+func TestDueParser(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         string
+		expectedValue string
+		expectedTime  time.Time
+		shouldParse   bool
+	}{
+		{
+			name:          "date",
+			input:         "@2026-11-01",
+			expectedValue: "2026-11-01",
+			expectedTime:  time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC),
+			shouldParse:   true,
+		},
+		{
+			name:          "date and time",
+			input:         "@2026-11-01 09:00",
+			expectedValue: "2026-11-01 09:00",
+			expectedTime:  time.Date(2026, 11, 1, 9, 0, 0, 0, time.UTC),
+			shouldParse:   true,
+		},
+		{
+			name:          "date followed by text",
+			input:         "@2026-11-01 ship it",
+			expectedValue: "2026-11-01",
+			expectedTime:  time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC),
+			shouldParse:   true,
+		},
+		{
+			name:          "date and time followed by punctuation",
+			input:         "@2026-11-01 23:30.",
+			expectedValue: "2026-11-01 23:30",
+			expectedTime:  time.Date(2026, 11, 1, 23, 30, 0, 0, time.UTC),
+			shouldParse:   true,
+		},
+		{
+			name:          "invalid time of day",
+			input:         "@2026-11-01 25:00",
+			expectedValue: "2026-11-01",
+			expectedTime:  time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC),
+			shouldParse:   true,
+		},
+		{
+			name:        "invalid date",
+			input:       "@2026-13-01",
+			shouldParse: false,
+		},
+		{
+			name:        "date followed by letters",
+			input:       "@2026-11-01abc",
+			shouldParse: false,
+		},
+		{
+			name:        "mention",
+			input:       "@alice",
+			shouldParse: false,
+		},
+		{
+			name:        "lone at",
+			input:       "@",
+			shouldParse: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewDueParser()
+			reader := text.NewReader([]byte(tt.input))
+			ctx := parser.NewContext()
+
+			node := p.Parse(nil, reader, ctx)
+
+			if tt.shouldParse {
+				require.NotNil(t, node, "Expected due marker to be parsed")
+				dueNode, ok := node.(*mast.DueNode)
+				require.True(t, ok, "Expected node to be *mast.DueNode")
+				assert.Equal(t, tt.expectedValue, string(dueNode.Value))
+				assert.Equal(t, tt.expectedTime, dueNode.Time)
+			} else {
+				assert.Nil(t, node, "Expected due marker NOT to be parsed")
+			}
+		})
+	}
+}
+
+func TestDueParser_InsideWord(t *testing.T) {
+	p := NewDueParser()
+	reader := text.NewReader([]byte("mail@2026-11-01"))
+	reader.Advance(4)
+
+	assert.Nil(t, p.Parse(nil, reader, parser.NewContext()))
+}
+
+func TestDueParser_Trigger(t *testing.T) {
+	p := NewDueParser()
+	triggers := p.Trigger()
+
+	assert.Equal(t, []byte{'@'}, triggers)
+}
@@ -0,0 +1,82 @@
+package parser
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+
+	mast "github.com/usememos/memos/plugin/markdown/ast"
+)
+
+const (
+	// MaxMentionLength defines the maximum number of characters allowed in a mentioned username.
+	MaxMentionLength = 32
+)
+
+type mentionParser struct{}
+
+// NewMentionParser creates a new inline parser for @username syntax.
+func NewMentionParser() parser.InlineParser {
+	return &mentionParser{}
+}
+
+// Trigger returns the characters that trigger this parser.
+func (*mentionParser) Trigger() []byte {
+	return []byte{'@'}
+}
+
+// isValidMentionByte checks if a byte is valid in a username.
+func isValidMentionByte(b byte) bool {
+	return (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z') || (b >= '0' && b <= '9') || b == '-'
+}
+
+// Parse parses @username syntax.
+// Mentions follow the username rules:
+//   - Must start with @ at the start of the line or after a non-word character, e.g. whitespace or punctuation
+//   - Valid characters: ASCII letters, digits and hyphen (-), not ending with a hyphen
+//   - Maximum length: 32 characters
+//   - Must not be followed by a letter or digit, e.g. of another script
+func (*mentionParser) Parse(_ gast.Node, block text.Reader, _ parser.Context) gast.Node {
+	line, segment := block.PeekLine()
+	if len(line) == 0 || line[0] != '@' {
+		return nil
+	}
+
+	// Must not be part of a word, e.g. an email address
+	if source := block.Source(); segment.Start > 0 {
+		r, _ := utf8.DecodeLastRune(source[:segment.Start])
+		if unicode.IsLetter(r) || unicode.IsNumber(r) || r == '_' {
+			return nil
+		}
+	}
+
+	pos := 1
+	for pos < len(line) && isValidMentionByte(line[pos]) {
+		pos++
+	}
+	// Trailing hyphens are punctuation, not part of the username
+	for pos > 1 && line[pos-1] == '-' {
+		pos--
+	}
+	if pos == 1 || pos-1 > MaxMentionLength || line[1] == '-' {
+		return nil
+	}
+	if r, _ := utf8.DecodeRune(line[pos:]); pos < len(line) && (unicode.IsLetter(r) || unicode.IsNumber(r)) {
+		return nil
+	}
+
+	// Make a copy of the username
+	username := make([]byte, pos-1)
+	copy(username, line[1:pos])
+
+	// Advance reader
+	block.Advance(pos)
+
+	return &mast.MentionNode{
+		Username: username,
+		Segment:  text.NewSegment(segment.Start, segment.Start+pos),
+	}
+}
@@ -0,0 +1,100 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+
+	mast "github.com/usememos/memos/plugin/markdown/ast"
+)
+
+func TestMentionParser(t *testing.T) {
+	tests := []struct {
+		name             string
+		input            string
+		expectedUsername string
+		shouldParse      bool
+	}{
+		{
+			name:             "username",
+			input:            "@alice",
+			expectedUsername: "alice",
+			shouldParse:      true,
+		},
+		{
+			name:             "username with hyphen and digits",
+			input:            "@alice-2 hi",
+			expectedUsername: "alice-2",
+			shouldParse:      true,
+		},
+		{
+			name:             "username followed by punctuation",
+			input:            "@alice, @bob",
+			expectedUsername: "alice",
+			shouldParse:      true,
+		},
+		{
+			name:             "trailing hyphen",
+			input:            "@alice-",
+			expectedUsername: "alice",
+			shouldParse:      true,
+		},
+		{
+			name:        "leading hyphen",
+			input:       "@-alice",
+			shouldParse: false,
+		},
+		{
+			name:        "followed by other letters",
+			input:       "@aliceé",
+			shouldParse: false,
+		},
+		{
+			name:        "too long",
+			input:       "@abcdefghijklmnopqrstuvwxyz0123456",
+			shouldParse: false,
+		},
+		{
+			name:        "lone at",
+			input:       "@ alice",
+			shouldParse: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewMentionParser()
+			reader := text.NewReader([]byte(tt.input))
+			ctx := parser.NewContext()
+
+			node := p.Parse(nil, reader, ctx)
+
+			if tt.shouldParse {
+				require.NotNil(t, node, "Expected mention to be parsed")
+				mentionNode, ok := node.(*mast.MentionNode)
+				require.True(t, ok, "Expected node to be *mast.MentionNode")
+				assert.Equal(t, tt.expectedUsername, string(mentionNode.Username))
+			} else {
+				assert.Nil(t, node, "Expected mention NOT to be parsed")
+			}
+		})
+	}
+}
+
+func TestMentionParser_InsideWord(t *testing.T) {
+	p := NewMentionParser()
+	reader := text.NewReader([]byte("alice@example.com"))
+	reader.Advance(5)
+
+	assert.Nil(t, p.Parse(nil, reader, parser.NewContext()))
+}
+
+func TestMentionParser_Trigger(t *testing.T) {
+	p := NewMentionParser()
+	triggers := p.Trigger()
+
+	assert.Equal(t, []byte{'@'}, triggers)
+}
@@ -65,7 +65,7 @@ func isValidTagRune(r rune) bool {
 //   - Maximum length: 100 runes (Unicode characters)
 //   - Stops at: whitespace, punctuation, or other invalid characters
 func (*tagParser) Parse(_ gast.Node, block text.Reader, _ parser.Context) gast.Node {
-	line, _ := block.PeekLine()
+	line, segment := block.PeekLine()
 
 	// Must start with #
 	if len(line) == 0 || line[0] != '#' {
@@ -131,7 +131,8 @@ func (*tagParser) Parse(_ gast.Node, block text.Reader, _ parser.Context) gast.N
 
 	// Create node
 	node := &mast.TagNode{
-		Tag: tagCopy,
+		Tag:     tagCopy,
+		Segment: text.NewSegment(segment.Start, segment.Start+pos),
 	}
 
 	return node
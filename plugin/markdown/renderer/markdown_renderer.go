@@ -156,6 +156,14 @@ func (r *MarkdownRenderer) renderNode(node gast.Node, source []byte, depth int)
 		r.buf.WriteByte('#')
 		r.buf.Write(n.Tag)
 
+	case *mast.DueNode:
+		r.buf.WriteByte('@')
+		r.buf.Write(n.Value)
+
+	case *mast.MentionNode:
+		r.buf.WriteByte('@')
+		r.buf.Write(n.Username)
+
 	default:
 		// For unknown nodes, try to render children
 		r.renderChildren(n, source, depth)
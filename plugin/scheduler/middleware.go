@@ -96,6 +96,7 @@ type contextKey int
 
 const (
 	jobNameKey contextKey = iota
+	triggeredKey
 )
 
 // withJobName adds the job name to the context.
@@ -103,6 +104,17 @@ func withJobName(ctx context.Context, name string) context.Context {
 	return context.WithValue(ctx, jobNameKey, name)
 }
 
+// withTriggered marks the run as triggered manually.
+func withTriggered(ctx context.Context) context.Context {
+	return context.WithValue(ctx, triggeredKey, true)
+}
+
+// IsTriggered reports whether the run was triggered manually with Scheduler.Trigger rather than by the schedule.
+func IsTriggered(ctx context.Context) bool {
+	triggered, _ := ctx.Value(triggeredKey).(bool)
+	return triggered
+}
+
 // getJobName retrieves the job name from the context.
 func getJobName(ctx context.Context) string {
 	if name, ok := ctx.Value(jobNameKey).(string); ok {
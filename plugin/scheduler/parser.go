@@ -122,7 +122,22 @@ func (s *Schedule) matches(t time.Time) bool {
 		s.minutes.matches(t.Minute()) &&
 		s.hours.matches(t.Hour()) &&
 		s.months.matches(int(t.Month())) &&
-		(s.days.matches(t.Day()) || s.weekdays.matches(int(t.Weekday())))
+		s.matchesDay(t)
+}
+
+// matchesDay checks the day of month and the weekday of the given time.
+// As in standard cron, a day matches either field if both are restricted, otherwise the restricted one.
+func (s *Schedule) matchesDay(t time.Time) bool {
+	_, anyDay := s.days.(*wildcardMatcher)
+	_, anyWeekday := s.weekdays.(*wildcardMatcher)
+	switch {
+	case anyDay && !anyWeekday:
+		return s.weekdays.matches(int(t.Weekday()))
+	case !anyDay && anyWeekday:
+		return s.days.matches(t.Day())
+	default:
+		return s.days.matches(t.Day()) || s.weekdays.matches(int(t.Weekday()))
+	}
 }
 
 // parseField parses a single cron field (supports *, ranges, lists, steps).
@@ -89,6 +89,24 @@ func TestScheduleNext(t *testing.T) {
 			from:     time.Date(2025, 1, 1, 10, 7, 0, 0, time.UTC),
 			expected: time.Date(2025, 1, 1, 10, 15, 0, 0, time.UTC),
 		},
+		{
+			name:     "weekdays at 9 AM from friday",
+			expr:     "0 9 * * 1-5",
+			from:     time.Date(2025, 1, 3, 10, 0, 0, 0, time.UTC),
+			expected: time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "first day of month",
+			expr:     "0 0 1 * *",
+			from:     time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC),
+			expected: time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "first day of month or monday",
+			expr:     "0 0 1 * 1",
+			from:     time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC),
+			expected: time.Date(2025, 1, 20, 0, 0, 0, 0, time.UTC),
+		},
 	}
 
 	for _, tt := range tests {
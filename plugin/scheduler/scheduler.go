@@ -2,6 +2,8 @@ package scheduler
 
 import (
 	"context"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
@@ -24,6 +26,8 @@ type Scheduler struct {
 type registeredJob struct {
 	job      *Job
 	cancelFn context.CancelFunc
+	// triggerCh holds a pending manual run of the job.
+	triggerCh chan struct{}
 }
 
 // Option configures a Scheduler.
@@ -83,10 +87,78 @@ func (s *Scheduler) Register(job *Job) error {
 		return errors.Errorf("job with name %q already registered", job.Name)
 	}
 
-	s.jobs[job.Name] = &registeredJob{job: job}
+	s.jobs[job.Name] = &registeredJob{job: job, triggerCh: make(chan struct{}, 1)}
 	return nil
 }
 
+// Jobs returns the registered jobs, ordered by name.
+func (s *Scheduler) Jobs() []*Job {
+	s.jobsMu.RLock()
+	defer s.jobsMu.RUnlock()
+
+	jobs := make([]*Job, 0, len(s.jobs))
+	for _, rj := range s.jobs {
+		jobs = append(jobs, rj.job)
+	}
+	slices.SortFunc(jobs, func(a, b *Job) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return jobs
+}
+
+// Trigger runs the job now, outside of its schedule.
+// The run is queued if the job is running, and runs of a job never overlap.
+func (s *Scheduler) Trigger(name string) error {
+	s.runningMu.RLock()
+	defer s.runningMu.RUnlock()
+	if !s.running {
+		return errors.New("scheduler not running")
+	}
+
+	s.jobsMu.RLock()
+	rj, ok := s.jobs[name]
+	s.jobsMu.RUnlock()
+	if !ok {
+		return errors.Errorf("job %q not found", name)
+	}
+
+	select {
+	case rj.triggerCh <- struct{}{}:
+	default:
+		// A manual run is already pending.
+	}
+	return nil
+}
+
+// NextRun returns the time the job is next scheduled to run after now.
+func (s *Scheduler) NextRun(name string) (time.Time, error) {
+	s.jobsMu.RLock()
+	rj, ok := s.jobs[name]
+	s.jobsMu.RUnlock()
+	if !ok {
+		return time.Time{}, errors.Errorf("job %q not found", name)
+	}
+
+	schedule, err := ParseCronExpression(rj.job.Schedule)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "failed to parse schedule for job %q", name)
+	}
+	return schedule.Next(time.Now().In(s.location(rj.job))), nil
+}
+
+// location returns the timezone the schedule of the job is evaluated in.
+func (s *Scheduler) location(job *Job) *time.Location {
+	if job.Timezone != "" {
+		if loc, err := time.LoadLocation(job.Timezone); err == nil {
+			return loc
+		}
+	}
+	if s.timezone != nil {
+		return s.timezone
+	}
+	return time.UTC
+}
+
 // Start begins executing scheduled jobs.
 func (s *Scheduler) Start() error {
 	s.runningMu.Lock()
@@ -129,17 +201,7 @@ func (s *Scheduler) runJobWithSchedule(ctx context.Context, rj *registeredJob, s
 
 	for {
 		// Calculate next run time
-		now := time.Now()
-		if rj.job.Timezone != "" {
-			loc, err := time.LoadLocation(rj.job.Timezone)
-			if err == nil {
-				now = now.In(loc)
-			}
-		} else if s.timezone != nil {
-			now = now.In(s.timezone)
-		}
-
-		next := schedule.Next(now)
+		next := schedule.Next(time.Now().In(s.location(rj.job)))
 		duration := time.Until(next)
 
 		timer := time.NewTimer(duration)
@@ -152,6 +214,13 @@ func (s *Scheduler) runJobWithSchedule(ctx context.Context, rj *registeredJob, s
 				// Error already handled by middleware (if any)
 				_ = err
 			}
+		case <-rj.triggerCh:
+			timer.Stop()
+			jobCtx := withTriggered(withJobName(ctx, rj.job.Name))
+			if err := handler(jobCtx); err != nil {
+				// Error already handled by middleware (if any)
+				_ = err
+			}
 		case <-ctx.Done():
 			// Stop the timer to prevent it from firing. The timer will be garbage collected.
 			timer.Stop()
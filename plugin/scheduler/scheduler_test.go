@@ -163,3 +163,74 @@ func TestSchedulerWithMiddleware(t *testing.T) {
 		t.Error("expected job completion log")
 	}
 }
+
+func TestSchedulerTrigger(t *testing.T) {
+	s := New()
+
+	triggered := make(chan bool, 1)
+	job := &Job{
+		Name:     "test-trigger",
+		Schedule: "0 0 1 1 *", // Yearly, never due during the test
+		Handler: func(ctx context.Context) error {
+			triggered <- IsTriggered(ctx)
+			return nil
+		},
+	}
+	if err := s.Register(job); err != nil {
+		t.Fatalf("failed to register job: %v", err)
+	}
+
+	if err := s.Trigger("test-trigger"); err == nil {
+		t.Error("expected error when triggering before start")
+	}
+	if err := s.Start(); err != nil {
+		t.Fatalf("failed to start scheduler: %v", err)
+	}
+	defer s.Stop(context.Background())
+
+	if err := s.Trigger("unknown"); err == nil {
+		t.Error("expected error when triggering unknown job")
+	}
+	if err := s.Trigger("test-trigger"); err != nil {
+		t.Fatalf("failed to trigger job: %v", err)
+	}
+	select {
+	case isTriggered := <-triggered:
+		if !isTriggered {
+			t.Error("expected the run to be marked as triggered")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("triggered job did not run")
+	}
+}
+
+func TestSchedulerJobsAndNextRun(t *testing.T) {
+	s := New(WithTimezone("UTC"))
+	for _, name := range []string{"b-job", "a-job"} {
+		if err := s.Register(&Job{
+			Name:     name,
+			Schedule: "0 3 * * *",
+			Timezone: "America/New_York",
+			Handler:  func(_ context.Context) error { return nil },
+		}); err != nil {
+			t.Fatalf("failed to register job: %v", err)
+		}
+	}
+
+	jobs := s.Jobs()
+	if len(jobs) != 2 || jobs[0].Name != "a-job" || jobs[1].Name != "b-job" {
+		t.Fatalf("expected jobs ordered by name, got %v", jobs)
+	}
+
+	next, err := s.NextRun("a-job")
+	if err != nil {
+		t.Fatalf("failed to get next run: %v", err)
+	}
+	loc, _ := time.LoadLocation("America/New_York")
+	if next.In(loc).Hour() != 3 || next.Minute() != 0 || !next.After(time.Now()) {
+		t.Errorf("expected next run at 3 AM New York time, got %v", next)
+	}
+	if _, err := s.NextRun("unknown"); err == nil {
+		t.Error("expected error for unknown job")
+	}
+}
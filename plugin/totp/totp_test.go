@@ -0,0 +1,76 @@
+package totp
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// rfcSecret is the base32 encoding of the SHA1 secret of the RFC 6238 test vectors.
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestGenerateCode(t *testing.T) {
+	// The RFC 6238 test vectors are 8 digits long, the codes are their last 6 digits.
+	tests := []struct {
+		unix     int64
+		expected string
+	}{
+		{unix: 59, expected: "287082"},
+		{unix: 1111111109, expected: "081804"},
+		{unix: 1111111111, expected: "050471"},
+		{unix: 1234567890, expected: "005924"},
+		{unix: 2000000000, expected: "279037"},
+	}
+	for _, test := range tests {
+		code, err := GenerateCode(rfcSecret, Step(time.Unix(test.unix, 0)))
+		require.NoError(t, err)
+		require.Equal(t, test.expected, code, "unix time %d", test.unix)
+	}
+
+	_, err := GenerateCode("not base32!", 1)
+	require.Error(t, err)
+}
+
+func TestValidate(t *testing.T) {
+	secret, err := GenerateSecret()
+	require.NoError(t, err)
+	now := time.Unix(1_800_000_000, 0)
+
+	code, err := GenerateCode(secret, Step(now))
+	require.NoError(t, err)
+	step, ok := Validate(secret, code, now)
+	require.True(t, ok)
+	require.Equal(t, Step(now), step)
+
+	// Codes of the adjacent periods are accepted for clock drift.
+	previous, err := GenerateCode(secret, Step(now)-1)
+	require.NoError(t, err)
+	step, ok = Validate(secret, " "+previous+" ", now)
+	require.True(t, ok)
+	require.Equal(t, Step(now)-1, step)
+
+	// Older codes are not.
+	old, err := GenerateCode(secret, Step(now)-2)
+	require.NoError(t, err)
+	_, ok = Validate(secret, old, now)
+	require.False(t, ok)
+
+	_, ok = Validate(secret, "12345", now)
+	require.False(t, ok)
+	_, ok = Validate(secret, "abcdef", now)
+	require.False(t, ok)
+}
+
+func TestURI(t *testing.T) {
+	uri, err := url.Parse(URI("Memos", "john doe", "SECRET"))
+	require.NoError(t, err)
+	require.Equal(t, "otpauth", uri.Scheme)
+	require.Equal(t, "totp", uri.Host)
+	require.Equal(t, "/Memos:john doe", uri.Path)
+	require.Equal(t, "SECRET", uri.Query().Get("secret"))
+	require.Equal(t, "Memos", uri.Query().Get("issuer"))
+	require.Equal(t, "6", uri.Query().Get("digits"))
+	require.Equal(t, "30", uri.Query().Get("period"))
+}
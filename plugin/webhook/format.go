@@ -0,0 +1,256 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Format is the format of a webhook request body.
+type Format string
+
+const (
+	// FormatJSON is the memos JSON payload.
+	FormatJSON Format = "JSON"
+	// FormatSlack is a Slack incoming webhook message with blocks.
+	FormatSlack Format = "SLACK"
+	// FormatDiscord is a Discord webhook message with an embed.
+	FormatDiscord Format = "DISCORD"
+	// FormatMatrix is the content of a Matrix m.room.message event.
+	FormatMatrix Format = "MATRIX"
+	// FormatTemplate is a body rendered from a user supplied Go text/template.
+	FormatTemplate Format = "TEMPLATE"
+)
+
+// Message is the human readable summary of an event used by the chat formats.
+type Message struct {
+	// A plain text snippet of the memo or comment content.
+	Snippet string
+	// The URL of the memo on the instance, empty if the instance URL is not configured.
+	URL string
+}
+
+// TemplateData is the data a user supplied template is executed with.
+type TemplateData struct {
+	*WebhookRequestPayload
+
+	// A short description of the event, e.g. "Memo created".
+	Title   string
+	Snippet string
+	URL     string
+}
+
+var activityTitles = map[string]string{
+	"memos.memo.created":             "Memo created",
+	"memos.memo.updated":             "Memo updated",
+	"memos.memo.deleted":             "Memo deleted",
+	"memos.memo.comment.created":     "New comment",
+	"memos.memo.relations.updated":   "Memo relations updated",
+	"memos.memo.attachments.updated": "Memo attachments updated",
+	"memos.memo.task.due":            "Task due",
+	"memos.reaction.created":         "New reaction",
+	"memos.reaction.deleted":         "Reaction removed",
+}
+
+// Title returns a short human readable description of the activity type.
+func Title(activityType string) string {
+	if title, ok := activityTitles[activityType]; ok {
+		return title
+	}
+	return activityType
+}
+
+var templateFuncs = template.FuncMap{
+	// json encodes a value so that it can be embedded in a JSON template.
+	"json": func(v any) (string, error) {
+		data, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return string(data), nil
+	},
+}
+
+// ParseTemplate parses a user supplied body template.
+func ParseTemplate(text string) (*template.Template, error) {
+	if strings.TrimSpace(text) == "" {
+		return nil, errors.New("template is empty")
+	}
+	tmpl, err := template.New("webhook").Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse template")
+	}
+	return tmpl, nil
+}
+
+// NewFormattedRequest renders the payload in the given format into a request for its target URL.
+// The template is only used by FormatTemplate.
+func NewFormattedRequest(payload *WebhookRequestPayload, format Format, text string, message *Message) (*Request, error) {
+	if message == nil {
+		message = &Message{}
+	}
+
+	var body any
+	switch format {
+	case "", FormatJSON:
+		return NewRequest(payload)
+	case FormatSlack:
+		body = renderSlack(payload, message)
+	case FormatDiscord:
+		body = renderDiscord(payload, message)
+	case FormatMatrix:
+		body = renderMatrix(payload, message)
+	case FormatTemplate:
+		tmpl, err := ParseTemplate(text)
+		if err != nil {
+			return nil, err
+		}
+		buf := &bytes.Buffer{}
+		if err := tmpl.Execute(buf, &TemplateData{
+			WebhookRequestPayload: payload,
+			Title:                 Title(payload.ActivityType),
+			Snippet:               message.Snippet,
+			URL:                   message.URL,
+		}); err != nil {
+			return nil, errors.Wrap(err, "failed to execute template")
+		}
+		return &Request{
+			URL:  payload.URL,
+			Body: buf.Bytes(),
+		}, nil
+	default:
+		return nil, errors.Errorf("unsupported webhook format: %s", format)
+	}
+
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal webhook request to %s", payload.URL)
+	}
+	return &Request{
+		URL:  payload.URL,
+		Body: data,
+	}, nil
+}
+
+type slackText struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+type slackBlock struct {
+	Type     string       `json:"type"`
+	Text     *slackText   `json:"text,omitempty"`
+	Elements []*slackText `json:"elements,omitempty"`
+}
+
+type slackMessage struct {
+	// Text is the fallback shown in notifications.
+	Text   string        `json:"text"`
+	Blocks []*slackBlock `json:"blocks"`
+}
+
+// slackEscaper escapes the control characters of Slack mrkdwn.
+var slackEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+func renderSlack(payload *WebhookRequestPayload, message *Message) *slackMessage {
+	title := Title(payload.ActivityType)
+	heading := "*" + slackEscaper.Replace(title) + "*"
+	if message.URL != "" {
+		heading = fmt.Sprintf("*<%s|%s>*", message.URL, slackEscaper.Replace(title))
+	}
+	text := heading
+	if message.Snippet != "" {
+		text += "\n" + slackEscaper.Replace(message.Snippet)
+	}
+
+	blocks := []*slackBlock{
+		{
+			Type: "section",
+			Text: &slackText{Type: "mrkdwn", Text: text},
+		},
+	}
+	if payload.Creator != "" {
+		blocks = append(blocks, &slackBlock{
+			Type:     "context",
+			Elements: []*slackText{{Type: "mrkdwn", Text: slackEscaper.Replace(payload.Creator)}},
+		})
+	}
+	return &slackMessage{
+		Text:   joinNonEmpty(": ", title, message.Snippet),
+		Blocks: blocks,
+	}
+}
+
+type discordEmbedFooter struct {
+	Text string `json:"text"`
+}
+
+type discordEmbed struct {
+	Title       string              `json:"title"`
+	URL         string              `json:"url,omitempty"`
+	Description string              `json:"description,omitempty"`
+	Timestamp   string              `json:"timestamp,omitempty"`
+	Footer      *discordEmbedFooter `json:"footer,omitempty"`
+}
+
+type discordMessage struct {
+	Embeds []*discordEmbed `json:"embeds"`
+}
+
+func renderDiscord(payload *WebhookRequestPayload, message *Message) *discordMessage {
+	embed := &discordEmbed{
+		Title:       Title(payload.ActivityType),
+		URL:         message.URL,
+		Description: message.Snippet,
+	}
+	if payload.Memo != nil && payload.Memo.UpdateTime != nil {
+		embed.Timestamp = payload.Memo.UpdateTime.AsTime().Format(time.RFC3339)
+	}
+	if payload.Creator != "" {
+		embed.Footer = &discordEmbedFooter{Text: payload.Creator}
+	}
+	return &discordMessage{
+		Embeds: []*discordEmbed{embed},
+	}
+}
+
+type matrixMessage struct {
+	MsgType       string `json:"msgtype"`
+	Body          string `json:"body"`
+	Format        string `json:"format"`
+	FormattedBody string `json:"formatted_body"`
+}
+
+func renderMatrix(payload *WebhookRequestPayload, message *Message) *matrixMessage {
+	title := Title(payload.ActivityType)
+	formattedTitle := "<strong>" + html.EscapeString(title) + "</strong>"
+	if message.URL != "" {
+		formattedTitle = fmt.Sprintf(`<a href="%s">%s</a>`, html.EscapeString(message.URL), formattedTitle)
+	}
+	formattedBody := formattedTitle
+	if message.Snippet != "" {
+		formattedBody += "<br>" + html.EscapeString(message.Snippet)
+	}
+	return &matrixMessage{
+		MsgType:       "m.text",
+		Body:          joinNonEmpty("\n", title, message.Snippet, message.URL),
+		Format:        "org.matrix.custom.html",
+		FormattedBody: formattedBody,
+	}
+}
+
+func joinNonEmpty(sep string, elems ...string) string {
+	list := []string{}
+	for _, elem := range elems {
+		if elem != "" {
+			list = append(list, elem)
+		}
+	}
+	return strings.Join(list, sep)
+}
@@ -0,0 +1,86 @@
+package webhook
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestNewFormattedRequest(t *testing.T) {
+	payload := &WebhookRequestPayload{
+		URL:          "https://example.com/hook",
+		ActivityType: "memos.memo.created",
+		Creator:      "users/1",
+		Memo: &v1pb.Memo{
+			Name:    "memos/abc",
+			Content: "Hello <world> & #tag",
+		},
+	}
+	message := &Message{
+		Snippet: "Hello <world> & #tag",
+		URL:     "https://memos.example.com/memos/abc",
+	}
+
+	t.Run("json", func(t *testing.T) {
+		request, err := NewFormattedRequest(payload, FormatJSON, "", message)
+		require.NoError(t, err)
+		expected, err := NewRequest(payload)
+		require.NoError(t, err)
+		require.Equal(t, expected, request)
+	})
+
+	t.Run("slack", func(t *testing.T) {
+		request, err := NewFormattedRequest(payload, FormatSlack, "", message)
+		require.NoError(t, err)
+		require.Equal(t, payload.URL, request.URL)
+		body := map[string]any{}
+		require.NoError(t, json.Unmarshal(request.Body, &body))
+		require.Equal(t, "Memo created: Hello <world> & #tag", body["text"])
+		blocks := body["blocks"].([]any)
+		require.Len(t, blocks, 2)
+		section := blocks[0].(map[string]any)["text"].(map[string]any)
+		require.Equal(t, "*<https://memos.example.com/memos/abc|Memo created>*\nHello &lt;world&gt; &amp; #tag", section["text"])
+	})
+
+	t.Run("discord", func(t *testing.T) {
+		request, err := NewFormattedRequest(payload, FormatDiscord, "", message)
+		require.NoError(t, err)
+		body := map[string]any{}
+		require.NoError(t, json.Unmarshal(request.Body, &body))
+		embed := body["embeds"].([]any)[0].(map[string]any)
+		require.Equal(t, "Memo created", embed["title"])
+		require.Equal(t, message.URL, embed["url"])
+		require.Equal(t, message.Snippet, embed["description"])
+	})
+
+	t.Run("matrix", func(t *testing.T) {
+		request, err := NewFormattedRequest(payload, FormatMatrix, "", message)
+		require.NoError(t, err)
+		body := map[string]any{}
+		require.NoError(t, json.Unmarshal(request.Body, &body))
+		require.Equal(t, "m.text", body["msgtype"])
+		require.Equal(t, "Memo created\nHello <world> & #tag\nhttps://memos.example.com/memos/abc", body["body"])
+		require.Equal(t, `<a href="https://memos.example.com/memos/abc"><strong>Memo created</strong></a><br>Hello &lt;world&gt; &amp; #tag`, body["formatted_body"])
+	})
+
+	t.Run("template", func(t *testing.T) {
+		request, err := NewFormattedRequest(payload, FormatTemplate, `{"title": {{json .Title}}, "memo": {{json .Memo.Name}}, "url": "{{.URL}}"}`, message)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"title": "Memo created", "memo": "memos/abc", "url": "https://memos.example.com/memos/abc"}`, string(request.Body))
+
+		_, err = NewFormattedRequest(payload, FormatTemplate, `{{.Unknown}}`, message)
+		require.Error(t, err)
+		_, err = NewFormattedRequest(payload, FormatTemplate, "", message)
+		require.Error(t, err)
+	})
+}
+
+func TestParseTemplate(t *testing.T) {
+	_, err := ParseTemplate(`{{.Title}}`)
+	require.NoError(t, err)
+	_, err = ParseTemplate(`{{.Title`)
+	require.Error(t, err)
+}
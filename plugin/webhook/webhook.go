@@ -2,10 +2,15 @@ package webhook
 
 import (
 	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"io"
-	"log/slog"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -18,6 +23,16 @@ var (
 	timeout = 30 * time.Second
 )
 
+const (
+	// SignatureHeader is the header carrying the HMAC-SHA256 signature of a signed request.
+	// Format: sha256={hex digest}
+	SignatureHeader = "X-Memos-Signature"
+	// TimestampHeader is the header carrying the unix timestamp included in the signature.
+	TimestampHeader = "X-Memos-Timestamp"
+
+	signaturePrefix = "sha256="
+)
+
 type WebhookRequestPayload struct {
 	// The target URL for the webhook request.
 	URL string `json:"url"`
@@ -27,64 +42,118 @@ type WebhookRequestPayload struct {
 	Creator string `json:"creator"`
 	// The memo that triggered this webhook (if applicable).
 	Memo *v1pb.Memo `json:"memo"`
+	// The comment added to the memo, set for comment events.
+	Comment *v1pb.Memo `json:"comment,omitempty"`
+	// The reaction on the memo, set for reaction events.
+	Reaction *v1pb.Reaction `json:"reaction,omitempty"`
+	// The relations of the memo after the change, set for relation events.
+	Relations []*v1pb.MemoRelation `json:"relations,omitempty"`
+	// The attachments of the memo after the change, set for attachment events.
+	Attachments []*v1pb.Attachment `json:"attachments,omitempty"`
+	// The task of the memo which came due, set for task due events.
+	Task *Task `json:"task,omitempty"`
+}
+
+// Task is a task of a memo with a due marker.
+type Task struct {
+	// The text of the task.
+	Content string `json:"content"`
+	// The due time of the task.
+	DueTime time.Time `json:"dueTime"`
 }
 
-// Post posts the message to webhook endpoint.
-func Post(requestPayload *WebhookRequestPayload) error {
+// Request is a serialized webhook request ready to be delivered.
+type Request struct {
+	// The target URL for the webhook request.
+	URL string
+	// The JSON encoded request body.
+	Body []byte
+	// The secret used to sign the request, the request is sent unsigned if empty.
+	Secret string
+}
+
+// NewRequest serializes the payload into a request for its target URL.
+func NewRequest(requestPayload *WebhookRequestPayload) (*Request, error) {
 	body, err := json.Marshal(requestPayload)
 	if err != nil {
-		return errors.Wrapf(err, "failed to marshal webhook request to %s", requestPayload.URL)
+		return nil, errors.Wrapf(err, "failed to marshal webhook request to %s", requestPayload.URL)
 	}
+	return &Request{
+		URL:  requestPayload.URL,
+		Body: body,
+	}, nil
+}
 
-	req, err := http.NewRequest("POST", requestPayload.URL, bytes.NewBuffer(body))
+// Post posts the request to webhook endpoint.
+// It returns the HTTP status code of the response, or 0 if no response was received.
+func Post(ctx context.Context, request *Request) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, request.URL, bytes.NewReader(request.Body))
 	if err != nil {
-		return errors.Wrapf(err, "failed to construct webhook request to %s", requestPayload.URL)
+		return 0, errors.Wrapf(err, "failed to construct webhook request to %s", request.URL)
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	if request.Secret != "" {
+		timestamp := time.Now().Unix()
+		req.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+		req.Header.Set(SignatureHeader, Sign(request.Secret, timestamp, request.Body))
+	}
 	client := &http.Client{
 		Timeout: timeout,
 	}
 	resp, err := client.Do(req)
 	if err != nil {
-		return errors.Wrapf(err, "failed to post webhook to %s", requestPayload.URL)
+		return 0, errors.Wrapf(err, "failed to post webhook to %s", request.URL)
 	}
 	defer resp.Body.Close()
 
 	b, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return errors.Wrapf(err, "failed to read webhook response from %s", requestPayload.URL)
+		return resp.StatusCode, errors.Wrapf(err, "failed to read webhook response from %s", request.URL)
 	}
 
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		return errors.Errorf("failed to post webhook %s, status code: %d, response body: %s", requestPayload.URL, resp.StatusCode, b)
+		return resp.StatusCode, errors.Errorf("failed to post webhook %s, status code: %d, response body: %s", request.URL, resp.StatusCode, b)
 	}
 
+	// Receivers may reply with an empty or non-JSON body, only a JSON error code is treated as a failure.
 	response := &struct {
 		Code    int    `json:"code"`
 		Message string `json:"message"`
 	}{}
-	if err := json.Unmarshal(b, response); err != nil {
-		return errors.Wrapf(err, "failed to unmarshal webhook response from %s", requestPayload.URL)
+	if err := json.Unmarshal(b, response); err == nil && response.Code != 0 {
+		return resp.StatusCode, errors.Errorf("receive error code sent by webhook server, code %d, msg: %s", response.Code, response.Message)
 	}
 
-	if response.Code != 0 {
-		return errors.Errorf("receive error code sent by webhook server, code %d, msg: %s", response.Code, response.Message)
-	}
+	return resp.StatusCode, nil
+}
 
-	return nil
+// Sign computes the signature of a request body sent at the given unix timestamp.
+// The signature is an HMAC-SHA256 of "{timestamp}.{body}" keyed with the webhook secret,
+// so that a captured request can't be replayed with a fresh timestamp.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
 }
 
-// PostAsync posts the message to webhook endpoint asynchronously.
-// It spawns a new goroutine to handle the request and does not wait for the response.
-func PostAsync(requestPayload *WebhookRequestPayload) {
-	go func() {
-		if err := Post(requestPayload); err != nil {
-			// Since we're in a goroutine, we can only log the error
-			slog.Warn("Failed to dispatch webhook asynchronously",
-				slog.String("url", requestPayload.URL),
-				slog.String("activityType", requestPayload.ActivityType),
-				slog.Any("err", err))
-		}
-	}()
+// Verify checks the signature and timestamp headers of a received request.
+// Requests whose timestamp is further than tolerance away from now are rejected as replays.
+func Verify(secret string, body []byte, signature, timestamp string, tolerance time.Duration, now time.Time) error {
+	if !strings.HasPrefix(signature, signaturePrefix) {
+		return errors.New("missing or malformed signature")
+	}
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid timestamp")
+	}
+	if delta := now.Sub(time.Unix(ts, 0)); delta > tolerance || delta < -tolerance {
+		return errors.Errorf("timestamp %d is outside the tolerance", ts)
+	}
+	if !hmac.Equal([]byte(signature), []byte(Sign(secret, ts, body))) {
+		return errors.New("signature mismatch")
+	}
+	return nil
 }
@@ -1 +1,101 @@
 package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPost(t *testing.T) {
+	var received []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		received, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	request, err := NewRequest(&WebhookRequestPayload{
+		URL:          server.URL,
+		ActivityType: "memos.memo.created",
+		Creator:      "users/1",
+	})
+	require.NoError(t, err)
+	code, err := Post(context.Background(), request)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, code)
+	require.JSONEq(t, `{"url":"`+server.URL+`","activityType":"memos.memo.created","creator":"users/1","memo":null}`, string(received))
+}
+
+func TestPostFailure(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		body       string
+	}{
+		{
+			name:       "server error",
+			statusCode: http.StatusInternalServerError,
+			body:       "internal error",
+		},
+		{
+			name:       "error code in response",
+			statusCode: http.StatusOK,
+			body:       `{"code":1,"message":"rejected"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(test.statusCode)
+				_, _ = w.Write([]byte(test.body))
+			}))
+			defer server.Close()
+
+			code, err := Post(context.Background(), &Request{URL: server.URL, Body: []byte("{}")})
+			require.Error(t, err)
+			require.Equal(t, test.statusCode, code)
+		})
+	}
+}
+
+func TestPostSigned(t *testing.T) {
+	const secret = "test-secret"
+	var verifyErr error
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		verifyErr = Verify(secret, body, r.Header.Get(SignatureHeader), r.Header.Get(TimestampHeader), time.Minute, time.Now())
+	}))
+	defer server.Close()
+
+	_, err := Post(context.Background(), &Request{URL: server.URL, Body: []byte(`{"activityType":"memos.memo.created"}`), Secret: secret})
+	require.NoError(t, err)
+	require.NoError(t, verifyErr)
+}
+
+func TestVerify(t *testing.T) {
+	const secret = "test-secret"
+	body := []byte(`{"activityType":"memos.memo.created"}`)
+	now := time.Unix(1700000000, 0)
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	signature := Sign(secret, now.Unix(), body)
+
+	require.NoError(t, Verify(secret, body, signature, timestamp, time.Minute, now))
+	// Tampered body.
+	require.Error(t, Verify(secret, []byte(`{"activityType":"memos.memo.deleted"}`), signature, timestamp, time.Minute, now))
+	// Wrong secret.
+	require.Error(t, Verify("other-secret", body, signature, timestamp, time.Minute, now))
+	// Replayed with a new timestamp.
+	require.Error(t, Verify(secret, body, signature, strconv.FormatInt(now.Unix()+1, 10), time.Minute, now))
+	// Stale timestamp.
+	require.Error(t, Verify(secret, body, signature, timestamp, time.Minute, now.Add(2*time.Minute)))
+	// Missing signature.
+	require.Error(t, Verify(secret, body, "", timestamp, time.Minute, now))
+}
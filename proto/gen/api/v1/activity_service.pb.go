@@ -31,17 +31,78 @@ const (
 	Activity_TYPE_UNSPECIFIED Activity_Type = 0
 	// Memo comment activity.
 	Activity_MEMO_COMMENT Activity_Type = 1
+	// Failed sign-in attempt, only visible to admins.
+	Activity_SIGN_IN_FAILED Activity_Type = 2
+	// Audit events, only visible to admins.
+	// Successful sign-in.
+	Activity_SIGN_IN Activity_Type = 3
+	// User created.
+	Activity_USER_CREATED Activity_Type = 4
+	// User role changed.
+	Activity_USER_ROLE_CHANGED Activity_Type = 5
+	// User archived.
+	Activity_USER_ARCHIVED Activity_Type = 6
+	// Archived user restored.
+	Activity_USER_RESTORED Activity_Type = 7
+	// User deleted.
+	Activity_USER_DELETED Activity_Type = 8
+	// Personal access token created.
+	Activity_PERSONAL_ACCESS_TOKEN_CREATED Activity_Type = 9
+	// Personal access token deleted.
+	Activity_PERSONAL_ACCESS_TOKEN_DELETED Activity_Type = 10
+	// Instance setting updated.
+	Activity_INSTANCE_SETTING_UPDATED Activity_Type = 11
+	// Identity provider created.
+	Activity_IDENTITY_PROVIDER_CREATED Activity_Type = 12
+	// Identity provider updated.
+	Activity_IDENTITY_PROVIDER_UPDATED Activity_Type = 13
+	// Identity provider deleted.
+	Activity_IDENTITY_PROVIDER_DELETED Activity_Type = 14
+	// Task of a memo came due.
+	Activity_TASK_DUE Activity_Type = 15
+	// User was mentioned in a memo.
+	Activity_MEMO_MENTION Activity_Type = 16
 )
 
 // Enum value maps for Activity_Type.
 var (
 	Activity_Type_name = map[int32]string{
-		0: "TYPE_UNSPECIFIED",
-		1: "MEMO_COMMENT",
+		0:  "TYPE_UNSPECIFIED",
+		1:  "MEMO_COMMENT",
+		2:  "SIGN_IN_FAILED",
+		3:  "SIGN_IN",
+		4:  "USER_CREATED",
+		5:  "USER_ROLE_CHANGED",
+		6:  "USER_ARCHIVED",
+		7:  "USER_RESTORED",
+		8:  "USER_DELETED",
+		9:  "PERSONAL_ACCESS_TOKEN_CREATED",
+		10: "PERSONAL_ACCESS_TOKEN_DELETED",
+		11: "INSTANCE_SETTING_UPDATED",
+		12: "IDENTITY_PROVIDER_CREATED",
+		13: "IDENTITY_PROVIDER_UPDATED",
+		14: "IDENTITY_PROVIDER_DELETED",
+		15: "TASK_DUE",
+		16: "MEMO_MENTION",
 	}
 	Activity_Type_value = map[string]int32{
-		"TYPE_UNSPECIFIED": 0,
-		"MEMO_COMMENT":     1,
+		"TYPE_UNSPECIFIED":              0,
+		"MEMO_COMMENT":                  1,
+		"SIGN_IN_FAILED":                2,
+		"SIGN_IN":                       3,
+		"USER_CREATED":                  4,
+		"USER_ROLE_CHANGED":             5,
+		"USER_ARCHIVED":                 6,
+		"USER_RESTORED":                 7,
+		"USER_DELETED":                  8,
+		"PERSONAL_ACCESS_TOKEN_CREATED": 9,
+		"PERSONAL_ACCESS_TOKEN_DELETED": 10,
+		"INSTANCE_SETTING_UPDATED":      11,
+		"IDENTITY_PROVIDER_CREATED":     12,
+		"IDENTITY_PROVIDER_UPDATED":     13,
+		"IDENTITY_PROVIDER_DELETED":     14,
+		"TASK_DUE":                      15,
+		"MEMO_MENTION":                  16,
 	}
 )
 
@@ -226,6 +287,10 @@ type ActivityPayload struct {
 	// Types that are valid to be assigned to Payload:
 	//
 	//	*ActivityPayload_MemoComment
+	//	*ActivityPayload_SignInFailed
+	//	*ActivityPayload_Audit
+	//	*ActivityPayload_TaskDue
+	//	*ActivityPayload_MemoMention
 	Payload       isActivityPayload_Payload `protobuf_oneof:"payload"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -277,6 +342,42 @@ func (x *ActivityPayload) GetMemoComment() *ActivityMemoCommentPayload {
 	return nil
 }
 
+func (x *ActivityPayload) GetSignInFailed() *ActivitySignInFailedPayload {
+	if x != nil {
+		if x, ok := x.Payload.(*ActivityPayload_SignInFailed); ok {
+			return x.SignInFailed
+		}
+	}
+	return nil
+}
+
+func (x *ActivityPayload) GetAudit() *ActivityAuditPayload {
+	if x != nil {
+		if x, ok := x.Payload.(*ActivityPayload_Audit); ok {
+			return x.Audit
+		}
+	}
+	return nil
+}
+
+func (x *ActivityPayload) GetTaskDue() *ActivityTaskDuePayload {
+	if x != nil {
+		if x, ok := x.Payload.(*ActivityPayload_TaskDue); ok {
+			return x.TaskDue
+		}
+	}
+	return nil
+}
+
+func (x *ActivityPayload) GetMemoMention() *ActivityMemoMentionPayload {
+	if x != nil {
+		if x, ok := x.Payload.(*ActivityPayload_MemoMention); ok {
+			return x.MemoMention
+		}
+	}
+	return nil
+}
+
 type isActivityPayload_Payload interface {
 	isActivityPayload_Payload()
 }
@@ -286,8 +387,193 @@ type ActivityPayload_MemoComment struct {
 	MemoComment *ActivityMemoCommentPayload `protobuf:"bytes,1,opt,name=memo_comment,json=memoComment,proto3,oneof"`
 }
 
+type ActivityPayload_SignInFailed struct {
+	// Failed sign-in activity payload.
+	SignInFailed *ActivitySignInFailedPayload `protobuf:"bytes,2,opt,name=sign_in_failed,json=signInFailed,proto3,oneof"`
+}
+
+type ActivityPayload_Audit struct {
+	// Audit event payload.
+	Audit *ActivityAuditPayload `protobuf:"bytes,3,opt,name=audit,proto3,oneof"`
+}
+
+type ActivityPayload_TaskDue struct {
+	// Task due activity payload.
+	TaskDue *ActivityTaskDuePayload `protobuf:"bytes,4,opt,name=task_due,json=taskDue,proto3,oneof"`
+}
+
+type ActivityPayload_MemoMention struct {
+	// Memo mention activity payload.
+	MemoMention *ActivityMemoMentionPayload `protobuf:"bytes,5,opt,name=memo_mention,json=memoMention,proto3,oneof"`
+}
+
 func (*ActivityPayload_MemoComment) isActivityPayload_Payload() {}
 
+func (*ActivityPayload_SignInFailed) isActivityPayload_Payload() {}
+
+func (*ActivityPayload_Audit) isActivityPayload_Payload() {}
+
+func (*ActivityPayload_TaskDue) isActivityPayload_Payload() {}
+
+func (*ActivityPayload_MemoMention) isActivityPayload_Payload() {}
+
+// ActivityAuditPayload represents the payload of an audit event.
+// The creator of the activity is the user who caused the event.
+type ActivityAuditPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the resource the event is about.
+	// e.g. users/1, users/1/personalAccessTokens/{id}, instance/settings/GENERAL or identity-providers/1.
+	Resource string `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	// The IP address of the client.
+	IpAddress string `protobuf:"bytes,2,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	// The user agent of the client.
+	UserAgent string `protobuf:"bytes,3,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	// The value before the change, e.g. the previous role of a role change.
+	OldValue string `protobuf:"bytes,4,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	// The value after the change, e.g. the new role of a role change.
+	NewValue      string `protobuf:"bytes,5,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityAuditPayload) Reset() {
+	*x = ActivityAuditPayload{}
+	mi := &file_api_v1_activity_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityAuditPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityAuditPayload) ProtoMessage() {}
+
+func (x *ActivityAuditPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_activity_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityAuditPayload.ProtoReflect.Descriptor instead.
+func (*ActivityAuditPayload) Descriptor() ([]byte, []int) {
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ActivityAuditPayload) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *ActivityAuditPayload) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *ActivityAuditPayload) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *ActivityAuditPayload) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *ActivityAuditPayload) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+// ActivitySignInFailedPayload represents the payload of a failed sign-in activity.
+type ActivitySignInFailedPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The username the sign-in was attempted with.
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// The IP address of the client.
+	IpAddress string `protobuf:"bytes,2,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	// The user agent of the client.
+	UserAgent string `protobuf:"bytes,3,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	// Why the sign-in failed.
+	Reason        string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivitySignInFailedPayload) Reset() {
+	*x = ActivitySignInFailedPayload{}
+	mi := &file_api_v1_activity_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivitySignInFailedPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivitySignInFailedPayload) ProtoMessage() {}
+
+func (x *ActivitySignInFailedPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_activity_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivitySignInFailedPayload.ProtoReflect.Descriptor instead.
+func (*ActivitySignInFailedPayload) Descriptor() ([]byte, []int) {
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ActivitySignInFailedPayload) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ActivitySignInFailedPayload) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *ActivitySignInFailedPayload) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *ActivitySignInFailedPayload) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 // ActivityMemoCommentPayload represents the payload of a memo comment activity.
 type ActivityMemoCommentPayload struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -303,7 +589,7 @@ type ActivityMemoCommentPayload struct {
 
 func (x *ActivityMemoCommentPayload) Reset() {
 	*x = ActivityMemoCommentPayload{}
-	mi := &file_api_v1_activity_service_proto_msgTypes[2]
+	mi := &file_api_v1_activity_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -315,7 +601,7 @@ func (x *ActivityMemoCommentPayload) String() string {
 func (*ActivityMemoCommentPayload) ProtoMessage() {}
 
 func (x *ActivityMemoCommentPayload) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_activity_service_proto_msgTypes[2]
+	mi := &file_api_v1_activity_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -328,7 +614,7 @@ func (x *ActivityMemoCommentPayload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ActivityMemoCommentPayload.ProtoReflect.Descriptor instead.
 func (*ActivityMemoCommentPayload) Descriptor() ([]byte, []int) {
-	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{2}
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ActivityMemoCommentPayload) GetMemo() string {
@@ -345,6 +631,118 @@ func (x *ActivityMemoCommentPayload) GetRelatedMemo() string {
 	return ""
 }
 
+// ActivityMemoMentionPayload represents the payload of a memo mention activity.
+type ActivityMemoMentionPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo mentioning the user, empty if the memo was deleted.
+	// Format: memos/{memo}
+	Memo          string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityMemoMentionPayload) Reset() {
+	*x = ActivityMemoMentionPayload{}
+	mi := &file_api_v1_activity_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityMemoMentionPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityMemoMentionPayload) ProtoMessage() {}
+
+func (x *ActivityMemoMentionPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_activity_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityMemoMentionPayload.ProtoReflect.Descriptor instead.
+func (*ActivityMemoMentionPayload) Descriptor() ([]byte, []int) {
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ActivityMemoMentionPayload) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+// ActivityTaskDuePayload represents the payload of a task due activity.
+type ActivityTaskDuePayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo of the task, empty if the memo was deleted.
+	// Format: memos/{memo}
+	Memo string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	// The text of the task.
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// The due time of the task.
+	DueTime       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=due_time,json=dueTime,proto3" json:"due_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityTaskDuePayload) Reset() {
+	*x = ActivityTaskDuePayload{}
+	mi := &file_api_v1_activity_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityTaskDuePayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityTaskDuePayload) ProtoMessage() {}
+
+func (x *ActivityTaskDuePayload) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_activity_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityTaskDuePayload.ProtoReflect.Descriptor instead.
+func (*ActivityTaskDuePayload) Descriptor() ([]byte, []int) {
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ActivityTaskDuePayload) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *ActivityTaskDuePayload) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ActivityTaskDuePayload) GetDueTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueTime
+	}
+	return nil
+}
+
 type ListActivitiesRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The maximum number of activities to return.
@@ -354,14 +752,20 @@ type ListActivitiesRequest struct {
 	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	// A page token, received from a previous `ListActivities` call.
 	// Provide this to retrieve the subsequent page.
-	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional. Filter to apply to the list results.
+	// Example: "activity_type == \"MEMO_COMMENT\" && create_time > now() - 86400"
+	// Supported fields and their operators:
+	//   - activity_type, level, creator_id: ==, !=, in
+	//   - create_time: ==, !=, <, <=, >, >=
+	Filter        string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListActivitiesRequest) Reset() {
 	*x = ListActivitiesRequest{}
-	mi := &file_api_v1_activity_service_proto_msgTypes[3]
+	mi := &file_api_v1_activity_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -373,7 +777,7 @@ func (x *ListActivitiesRequest) String() string {
 func (*ListActivitiesRequest) ProtoMessage() {}
 
 func (x *ListActivitiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_activity_service_proto_msgTypes[3]
+	mi := &file_api_v1_activity_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -386,7 +790,7 @@ func (x *ListActivitiesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListActivitiesRequest.ProtoReflect.Descriptor instead.
 func (*ListActivitiesRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{3}
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListActivitiesRequest) GetPageSize() int32 {
@@ -403,6 +807,13 @@ func (x *ListActivitiesRequest) GetPageToken() string {
 	return ""
 }
 
+func (x *ListActivitiesRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
 type ListActivitiesResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The activities.
@@ -417,7 +828,7 @@ type ListActivitiesResponse struct {
 
 func (x *ListActivitiesResponse) Reset() {
 	*x = ListActivitiesResponse{}
-	mi := &file_api_v1_activity_service_proto_msgTypes[4]
+	mi := &file_api_v1_activity_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -429,7 +840,7 @@ func (x *ListActivitiesResponse) String() string {
 func (*ListActivitiesResponse) ProtoMessage() {}
 
 func (x *ListActivitiesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_activity_service_proto_msgTypes[4]
+	mi := &file_api_v1_activity_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -442,7 +853,7 @@ func (x *ListActivitiesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListActivitiesResponse.ProtoReflect.Descriptor instead.
 func (*ListActivitiesResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{4}
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListActivitiesResponse) GetActivities() []*Activity {
@@ -470,7 +881,7 @@ type GetActivityRequest struct {
 
 func (x *GetActivityRequest) Reset() {
 	*x = GetActivityRequest{}
-	mi := &file_api_v1_activity_service_proto_msgTypes[5]
+	mi := &file_api_v1_activity_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -482,7 +893,7 @@ func (x *GetActivityRequest) String() string {
 func (*GetActivityRequest) ProtoMessage() {}
 
 func (x *GetActivityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_activity_service_proto_msgTypes[5]
+	mi := &file_api_v1_activity_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -495,7 +906,7 @@ func (x *GetActivityRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetActivityRequest.ProtoReflect.Descriptor instead.
 func (*GetActivityRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{5}
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetActivityRequest) GetName() string {
@@ -505,11 +916,244 @@ func (x *GetActivityRequest) GetName() string {
 	return ""
 }
 
+type ListAuditEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The maximum number of audit events to return.
+	// The service may return fewer than this value.
+	// If unspecified, at most 100 audit events will be returned.
+	// The maximum value is 1000; values above 1000 will be coerced to 1000.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// A page token, received from a previous `ListAuditEvents` call.
+	// Provide this to retrieve the subsequent page.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional. Filter to apply to the list results, with the fields of the `ListActivities` filter.
+	// Example: "activity_type == \"USER_ROLE_CHANGED\" && create_time > now() - 86400"
+	Filter        string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
+	mi := &file_api_v1_activity_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsRequest) ProtoMessage() {}
+
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_activity_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListAuditEventsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+type ListAuditEventsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The audit events, from the newest.
+	AuditEvents []*Activity `protobuf:"bytes,1,rep,name=audit_events,json=auditEvents,proto3" json:"audit_events,omitempty"`
+	// A token to retrieve the next page of results.
+	// Pass this value in the page_token field in the subsequent call to `ListAuditEvents`
+	// method to retrieve the next page of results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsResponse) Reset() {
+	*x = ListAuditEventsResponse{}
+	mi := &file_api_v1_activity_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsResponse) ProtoMessage() {}
+
+func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_activity_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListAuditEventsResponse) GetAuditEvents() []*Activity {
+	if x != nil {
+		return x.AuditEvents
+	}
+	return nil
+}
+
+func (x *ListAuditEventsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ExportAuditEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. Filter to apply to the exported audit events, see `ListAuditEventsRequest.filter`.
+	Filter string `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Optional. The next page token of a previous export, to export the following audit events.
+	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportAuditEventsRequest) Reset() {
+	*x = ExportAuditEventsRequest{}
+	mi := &file_api_v1_activity_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportAuditEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAuditEventsRequest) ProtoMessage() {}
+
+func (x *ExportAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_activity_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ExportAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ExportAuditEventsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ExportAuditEventsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ExportAuditEventsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The audit events as JSON lines, one JSON encoded activity per line from the newest.
+	Content []byte `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	// A token to export the following audit events.
+	// If this field is omitted, there are no more audit events.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportAuditEventsResponse) Reset() {
+	*x = ExportAuditEventsResponse{}
+	mi := &file_api_v1_activity_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportAuditEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAuditEventsResponse) ProtoMessage() {}
+
+func (x *ExportAuditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_activity_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAuditEventsResponse.ProtoReflect.Descriptor instead.
+func (*ExportAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ExportAuditEventsResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ExportAuditEventsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 var File_api_v1_activity_service_proto protoreflect.FileDescriptor
 
 const file_api_v1_activity_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1dapi/v1/activity_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf2\x03\n" +
+	"\x1dapi/v1/activity_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd6\x06\n" +
 	"\bActivity\x12\x1a\n" +
 	"\x04name\x18\x01 \x01(\tB\x06\xe0A\x03\xe0A\bR\x04name\x12\x1d\n" +
 	"\acreator\x18\x02 \x01(\tB\x03\xe0A\x03R\acreator\x124\n" +
@@ -517,27 +1161,69 @@ const file_api_v1_activity_service_proto_rawDesc = "" +
 	"\x05level\x18\x04 \x01(\x0e2\x1c.memos.api.v1.Activity.LevelB\x03\xe0A\x03R\x05level\x12@\n" +
 	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
 	"createTime\x12<\n" +
-	"\apayload\x18\x06 \x01(\v2\x1d.memos.api.v1.ActivityPayloadB\x03\xe0A\x03R\apayload\".\n" +
+	"\apayload\x18\x06 \x01(\v2\x1d.memos.api.v1.ActivityPayloadB\x03\xe0A\x03R\apayload\"\x91\x03\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x10\n" +
-	"\fMEMO_COMMENT\x10\x01\"=\n" +
+	"\fMEMO_COMMENT\x10\x01\x12\x12\n" +
+	"\x0eSIGN_IN_FAILED\x10\x02\x12\v\n" +
+	"\aSIGN_IN\x10\x03\x12\x10\n" +
+	"\fUSER_CREATED\x10\x04\x12\x15\n" +
+	"\x11USER_ROLE_CHANGED\x10\x05\x12\x11\n" +
+	"\rUSER_ARCHIVED\x10\x06\x12\x11\n" +
+	"\rUSER_RESTORED\x10\a\x12\x10\n" +
+	"\fUSER_DELETED\x10\b\x12!\n" +
+	"\x1dPERSONAL_ACCESS_TOKEN_CREATED\x10\t\x12!\n" +
+	"\x1dPERSONAL_ACCESS_TOKEN_DELETED\x10\n" +
+	"\x12\x1c\n" +
+	"\x18INSTANCE_SETTING_UPDATED\x10\v\x12\x1d\n" +
+	"\x19IDENTITY_PROVIDER_CREATED\x10\f\x12\x1d\n" +
+	"\x19IDENTITY_PROVIDER_UPDATED\x10\r\x12\x1d\n" +
+	"\x19IDENTITY_PROVIDER_DELETED\x10\x0e\x12\f\n" +
+	"\bTASK_DUE\x10\x0f\x12\x10\n" +
+	"\fMEMO_MENTION\x10\x10\"=\n" +
 	"\x05Level\x12\x15\n" +
 	"\x11LEVEL_UNSPECIFIED\x10\x00\x12\b\n" +
 	"\x04INFO\x10\x01\x12\b\n" +
 	"\x04WARN\x10\x02\x12\t\n" +
 	"\x05ERROR\x10\x03:M\xeaAJ\n" +
 	"\x15memos.api.v1/Activity\x12\x15activities/{activity}\x1a\x04name*\n" +
-	"activities2\bactivity\"k\n" +
+	"activities2\bactivity\"\x8c\x03\n" +
 	"\x0fActivityPayload\x12M\n" +
-	"\fmemo_comment\x18\x01 \x01(\v2(.memos.api.v1.ActivityMemoCommentPayloadH\x00R\vmemoCommentB\t\n" +
-	"\apayload\"S\n" +
+	"\fmemo_comment\x18\x01 \x01(\v2(.memos.api.v1.ActivityMemoCommentPayloadH\x00R\vmemoComment\x12Q\n" +
+	"\x0esign_in_failed\x18\x02 \x01(\v2).memos.api.v1.ActivitySignInFailedPayloadH\x00R\fsignInFailed\x12:\n" +
+	"\x05audit\x18\x03 \x01(\v2\".memos.api.v1.ActivityAuditPayloadH\x00R\x05audit\x12A\n" +
+	"\btask_due\x18\x04 \x01(\v2$.memos.api.v1.ActivityTaskDuePayloadH\x00R\ataskDue\x12M\n" +
+	"\fmemo_mention\x18\x05 \x01(\v2(.memos.api.v1.ActivityMemoMentionPayloadH\x00R\vmemoMentionB\t\n" +
+	"\apayload\"\xaa\x01\n" +
+	"\x14ActivityAuditPayload\x12\x1a\n" +
+	"\bresource\x18\x01 \x01(\tR\bresource\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x02 \x01(\tR\tipAddress\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x03 \x01(\tR\tuserAgent\x12\x1b\n" +
+	"\told_value\x18\x04 \x01(\tR\boldValue\x12\x1b\n" +
+	"\tnew_value\x18\x05 \x01(\tR\bnewValue\"\x8f\x01\n" +
+	"\x1bActivitySignInFailedPayload\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x02 \x01(\tR\tipAddress\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x03 \x01(\tR\tuserAgent\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"S\n" +
 	"\x1aActivityMemoCommentPayload\x12\x12\n" +
 	"\x04memo\x18\x01 \x01(\tR\x04memo\x12!\n" +
-	"\frelated_memo\x18\x02 \x01(\tR\vrelatedMemo\"S\n" +
+	"\frelated_memo\x18\x02 \x01(\tR\vrelatedMemo\"0\n" +
+	"\x1aActivityMemoMentionPayload\x12\x12\n" +
+	"\x04memo\x18\x01 \x01(\tR\x04memo\"}\n" +
+	"\x16ActivityTaskDuePayload\x12\x12\n" +
+	"\x04memo\x18\x01 \x01(\tR\x04memo\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x125\n" +
+	"\bdue_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\adueTime\"p\n" +
 	"\x15ListActivitiesRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x02 \x01(\tR\tpageToken\"x\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x1b\n" +
+	"\x06filter\x18\x03 \x01(\tB\x03\xe0A\x01R\x06filter\"x\n" +
 	"\x16ListActivitiesResponse\x126\n" +
 	"\n" +
 	"activities\x18\x01 \x03(\v2\x16.memos.api.v1.ActivityR\n" +
@@ -545,10 +1231,27 @@ const file_api_v1_activity_service_proto_rawDesc = "" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"G\n" +
 	"\x12GetActivityRequest\x121\n" +
 	"\x04name\x18\x01 \x01(\tB\x1d\xe0A\x02\xfaA\x17\n" +
-	"\x15memos.api.v1/ActivityR\x04name2\xff\x01\n" +
+	"\x15memos.api.v1/ActivityR\x04name\"{\n" +
+	"\x16ListAuditEventsRequest\x12 \n" +
+	"\tpage_size\x18\x01 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tB\x03\xe0A\x01R\tpageToken\x12\x1b\n" +
+	"\x06filter\x18\x03 \x01(\tB\x03\xe0A\x01R\x06filter\"|\n" +
+	"\x17ListAuditEventsResponse\x129\n" +
+	"\faudit_events\x18\x01 \x03(\v2\x16.memos.api.v1.ActivityR\vauditEvents\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"[\n" +
+	"\x18ExportAuditEventsRequest\x12\x1b\n" +
+	"\x06filter\x18\x01 \x01(\tB\x03\xe0A\x01R\x06filter\x12\"\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tB\x03\xe0A\x01R\tpageToken\"]\n" +
+	"\x19ExportAuditEventsResponse\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\fR\acontent\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken2\x87\x04\n" +
 	"\x0fActivityService\x12w\n" +
 	"\x0eListActivities\x12#.memos.api.v1.ListActivitiesRequest\x1a$.memos.api.v1.ListActivitiesResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/api/v1/activities\x12s\n" +
-	"\vGetActivity\x12 .memos.api.v1.GetActivityRequest\x1a\x16.memos.api.v1.Activity\"*\xdaA\x04name\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/v1/{name=activities/*}B\xac\x01\n" +
+	"\vGetActivity\x12 .memos.api.v1.GetActivityRequest\x1a\x16.memos.api.v1.Activity\"*\xdaA\x04name\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/v1/{name=activities/*}\x12{\n" +
+	"\x0fListAuditEvents\x12$.memos.api.v1.ListAuditEventsRequest\x1a%.memos.api.v1.ListAuditEventsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/auditEvents\x12\x88\x01\n" +
+	"\x11ExportAuditEvents\x12&.memos.api.v1.ExportAuditEventsRequest\x1a'.memos.api.v1.ExportAuditEventsResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1/auditEvents:exportB\xac\x01\n" +
 	"\x10com.memos.api.v1B\x14ActivityServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
 
 var (
@@ -564,34 +1267,52 @@ func file_api_v1_activity_service_proto_rawDescGZIP() []byte {
 }
 
 var file_api_v1_activity_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_api_v1_activity_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_api_v1_activity_service_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_api_v1_activity_service_proto_goTypes = []any{
-	(Activity_Type)(0),                 // 0: memos.api.v1.Activity.Type
-	(Activity_Level)(0),                // 1: memos.api.v1.Activity.Level
-	(*Activity)(nil),                   // 2: memos.api.v1.Activity
-	(*ActivityPayload)(nil),            // 3: memos.api.v1.ActivityPayload
-	(*ActivityMemoCommentPayload)(nil), // 4: memos.api.v1.ActivityMemoCommentPayload
-	(*ListActivitiesRequest)(nil),      // 5: memos.api.v1.ListActivitiesRequest
-	(*ListActivitiesResponse)(nil),     // 6: memos.api.v1.ListActivitiesResponse
-	(*GetActivityRequest)(nil),         // 7: memos.api.v1.GetActivityRequest
-	(*timestamppb.Timestamp)(nil),      // 8: google.protobuf.Timestamp
+	(Activity_Type)(0),                  // 0: memos.api.v1.Activity.Type
+	(Activity_Level)(0),                 // 1: memos.api.v1.Activity.Level
+	(*Activity)(nil),                    // 2: memos.api.v1.Activity
+	(*ActivityPayload)(nil),             // 3: memos.api.v1.ActivityPayload
+	(*ActivityAuditPayload)(nil),        // 4: memos.api.v1.ActivityAuditPayload
+	(*ActivitySignInFailedPayload)(nil), // 5: memos.api.v1.ActivitySignInFailedPayload
+	(*ActivityMemoCommentPayload)(nil),  // 6: memos.api.v1.ActivityMemoCommentPayload
+	(*ActivityMemoMentionPayload)(nil),  // 7: memos.api.v1.ActivityMemoMentionPayload
+	(*ActivityTaskDuePayload)(nil),      // 8: memos.api.v1.ActivityTaskDuePayload
+	(*ListActivitiesRequest)(nil),       // 9: memos.api.v1.ListActivitiesRequest
+	(*ListActivitiesResponse)(nil),      // 10: memos.api.v1.ListActivitiesResponse
+	(*GetActivityRequest)(nil),          // 11: memos.api.v1.GetActivityRequest
+	(*ListAuditEventsRequest)(nil),      // 12: memos.api.v1.ListAuditEventsRequest
+	(*ListAuditEventsResponse)(nil),     // 13: memos.api.v1.ListAuditEventsResponse
+	(*ExportAuditEventsRequest)(nil),    // 14: memos.api.v1.ExportAuditEventsRequest
+	(*ExportAuditEventsResponse)(nil),   // 15: memos.api.v1.ExportAuditEventsResponse
+	(*timestamppb.Timestamp)(nil),       // 16: google.protobuf.Timestamp
 }
 var file_api_v1_activity_service_proto_depIdxs = []int32{
-	0, // 0: memos.api.v1.Activity.type:type_name -> memos.api.v1.Activity.Type
-	1, // 1: memos.api.v1.Activity.level:type_name -> memos.api.v1.Activity.Level
-	8, // 2: memos.api.v1.Activity.create_time:type_name -> google.protobuf.Timestamp
-	3, // 3: memos.api.v1.Activity.payload:type_name -> memos.api.v1.ActivityPayload
-	4, // 4: memos.api.v1.ActivityPayload.memo_comment:type_name -> memos.api.v1.ActivityMemoCommentPayload
-	2, // 5: memos.api.v1.ListActivitiesResponse.activities:type_name -> memos.api.v1.Activity
-	5, // 6: memos.api.v1.ActivityService.ListActivities:input_type -> memos.api.v1.ListActivitiesRequest
-	7, // 7: memos.api.v1.ActivityService.GetActivity:input_type -> memos.api.v1.GetActivityRequest
-	6, // 8: memos.api.v1.ActivityService.ListActivities:output_type -> memos.api.v1.ListActivitiesResponse
-	2, // 9: memos.api.v1.ActivityService.GetActivity:output_type -> memos.api.v1.Activity
-	8, // [8:10] is the sub-list for method output_type
-	6, // [6:8] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	0,  // 0: memos.api.v1.Activity.type:type_name -> memos.api.v1.Activity.Type
+	1,  // 1: memos.api.v1.Activity.level:type_name -> memos.api.v1.Activity.Level
+	16, // 2: memos.api.v1.Activity.create_time:type_name -> google.protobuf.Timestamp
+	3,  // 3: memos.api.v1.Activity.payload:type_name -> memos.api.v1.ActivityPayload
+	6,  // 4: memos.api.v1.ActivityPayload.memo_comment:type_name -> memos.api.v1.ActivityMemoCommentPayload
+	5,  // 5: memos.api.v1.ActivityPayload.sign_in_failed:type_name -> memos.api.v1.ActivitySignInFailedPayload
+	4,  // 6: memos.api.v1.ActivityPayload.audit:type_name -> memos.api.v1.ActivityAuditPayload
+	8,  // 7: memos.api.v1.ActivityPayload.task_due:type_name -> memos.api.v1.ActivityTaskDuePayload
+	7,  // 8: memos.api.v1.ActivityPayload.memo_mention:type_name -> memos.api.v1.ActivityMemoMentionPayload
+	16, // 9: memos.api.v1.ActivityTaskDuePayload.due_time:type_name -> google.protobuf.Timestamp
+	2,  // 10: memos.api.v1.ListActivitiesResponse.activities:type_name -> memos.api.v1.Activity
+	2,  // 11: memos.api.v1.ListAuditEventsResponse.audit_events:type_name -> memos.api.v1.Activity
+	9,  // 12: memos.api.v1.ActivityService.ListActivities:input_type -> memos.api.v1.ListActivitiesRequest
+	11, // 13: memos.api.v1.ActivityService.GetActivity:input_type -> memos.api.v1.GetActivityRequest
+	12, // 14: memos.api.v1.ActivityService.ListAuditEvents:input_type -> memos.api.v1.ListAuditEventsRequest
+	14, // 15: memos.api.v1.ActivityService.ExportAuditEvents:input_type -> memos.api.v1.ExportAuditEventsRequest
+	10, // 16: memos.api.v1.ActivityService.ListActivities:output_type -> memos.api.v1.ListActivitiesResponse
+	2,  // 17: memos.api.v1.ActivityService.GetActivity:output_type -> memos.api.v1.Activity
+	13, // 18: memos.api.v1.ActivityService.ListAuditEvents:output_type -> memos.api.v1.ListAuditEventsResponse
+	15, // 19: memos.api.v1.ActivityService.ExportAuditEvents:output_type -> memos.api.v1.ExportAuditEventsResponse
+	16, // [16:20] is the sub-list for method output_type
+	12, // [12:16] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_activity_service_proto_init() }
@@ -601,6 +1322,10 @@ func file_api_v1_activity_service_proto_init() {
 	}
 	file_api_v1_activity_service_proto_msgTypes[1].OneofWrappers = []any{
 		(*ActivityPayload_MemoComment)(nil),
+		(*ActivityPayload_SignInFailed)(nil),
+		(*ActivityPayload_Audit)(nil),
+		(*ActivityPayload_TaskDue)(nil),
+		(*ActivityPayload_MemoMention)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -608,7 +1333,7 @@ func file_api_v1_activity_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_activity_service_proto_rawDesc), len(file_api_v1_activity_service_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   6,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
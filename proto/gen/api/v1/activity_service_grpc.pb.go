@@ -19,8 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ActivityService_ListActivities_FullMethodName = "/memos.api.v1.ActivityService/ListActivities"
-	ActivityService_GetActivity_FullMethodName    = "/memos.api.v1.ActivityService/GetActivity"
+	ActivityService_ListActivities_FullMethodName    = "/memos.api.v1.ActivityService/ListActivities"
+	ActivityService_GetActivity_FullMethodName       = "/memos.api.v1.ActivityService/GetActivity"
+	ActivityService_ListAuditEvents_FullMethodName   = "/memos.api.v1.ActivityService/ListAuditEvents"
+	ActivityService_ExportAuditEvents_FullMethodName = "/memos.api.v1.ActivityService/ExportAuditEvents"
 )
 
 // ActivityServiceClient is the client API for ActivityService service.
@@ -31,6 +33,13 @@ type ActivityServiceClient interface {
 	ListActivities(ctx context.Context, in *ListActivitiesRequest, opts ...grpc.CallOption) (*ListActivitiesResponse, error)
 	// GetActivity returns the activity with the given id.
 	GetActivity(ctx context.Context, in *GetActivityRequest, opts ...grpc.CallOption) (*Activity, error)
+	// ListAuditEvents returns the audit events, which record administrative and security events.
+	// Only admins can list audit events.
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+	// ExportAuditEvents exports the audit events matching the filter as JSON lines.
+	// An export holds at most 10000 audit events, the following ones are exported with the next page token.
+	// Only admins can export audit events.
+	ExportAuditEvents(ctx context.Context, in *ExportAuditEventsRequest, opts ...grpc.CallOption) (*ExportAuditEventsResponse, error)
 }
 
 type activityServiceClient struct {
@@ -61,6 +70,26 @@ func (c *activityServiceClient) GetActivity(ctx context.Context, in *GetActivity
 	return out, nil
 }
 
+func (c *activityServiceClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, ActivityService_ListAuditEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *activityServiceClient) ExportAuditEvents(ctx context.Context, in *ExportAuditEventsRequest, opts ...grpc.CallOption) (*ExportAuditEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportAuditEventsResponse)
+	err := c.cc.Invoke(ctx, ActivityService_ExportAuditEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ActivityServiceServer is the server API for ActivityService service.
 // All implementations must embed UnimplementedActivityServiceServer
 // for forward compatibility.
@@ -69,6 +98,13 @@ type ActivityServiceServer interface {
 	ListActivities(context.Context, *ListActivitiesRequest) (*ListActivitiesResponse, error)
 	// GetActivity returns the activity with the given id.
 	GetActivity(context.Context, *GetActivityRequest) (*Activity, error)
+	// ListAuditEvents returns the audit events, which record administrative and security events.
+	// Only admins can list audit events.
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+	// ExportAuditEvents exports the audit events matching the filter as JSON lines.
+	// An export holds at most 10000 audit events, the following ones are exported with the next page token.
+	// Only admins can export audit events.
+	ExportAuditEvents(context.Context, *ExportAuditEventsRequest) (*ExportAuditEventsResponse, error)
 	mustEmbedUnimplementedActivityServiceServer()
 }
 
@@ -85,6 +121,12 @@ func (UnimplementedActivityServiceServer) ListActivities(context.Context, *ListA
 func (UnimplementedActivityServiceServer) GetActivity(context.Context, *GetActivityRequest) (*Activity, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetActivity not implemented")
 }
+func (UnimplementedActivityServiceServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (UnimplementedActivityServiceServer) ExportAuditEvents(context.Context, *ExportAuditEventsRequest) (*ExportAuditEventsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportAuditEvents not implemented")
+}
 func (UnimplementedActivityServiceServer) mustEmbedUnimplementedActivityServiceServer() {}
 func (UnimplementedActivityServiceServer) testEmbeddedByValue()                         {}
 
@@ -142,6 +184,42 @@ func _ActivityService_GetActivity_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ActivityService_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_ListAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActivityService_ExportAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActivityServiceServer).ExportAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActivityService_ExportAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActivityServiceServer).ExportAuditEvents(ctx, req.(*ExportAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ActivityService_ServiceDesc is the grpc.ServiceDesc for ActivityService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -157,6 +235,14 @@ var ActivityService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetActivity",
 			Handler:    _ActivityService_GetActivity_Handler,
 		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _ActivityService_ListAuditEvents_Handler,
+		},
+		{
+			MethodName: "ExportAuditEvents",
+			Handler:    _ActivityService_ExportAuditEvents_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/activity_service.proto",
@@ -39,6 +39,12 @@ const (
 	// ActivityServiceGetActivityProcedure is the fully-qualified name of the ActivityService's
 	// GetActivity RPC.
 	ActivityServiceGetActivityProcedure = "/memos.api.v1.ActivityService/GetActivity"
+	// ActivityServiceListAuditEventsProcedure is the fully-qualified name of the ActivityService's
+	// ListAuditEvents RPC.
+	ActivityServiceListAuditEventsProcedure = "/memos.api.v1.ActivityService/ListAuditEvents"
+	// ActivityServiceExportAuditEventsProcedure is the fully-qualified name of the ActivityService's
+	// ExportAuditEvents RPC.
+	ActivityServiceExportAuditEventsProcedure = "/memos.api.v1.ActivityService/ExportAuditEvents"
 )
 
 // ActivityServiceClient is a client for the memos.api.v1.ActivityService service.
@@ -47,6 +53,13 @@ type ActivityServiceClient interface {
 	ListActivities(context.Context, *connect.Request[v1.ListActivitiesRequest]) (*connect.Response[v1.ListActivitiesResponse], error)
 	// GetActivity returns the activity with the given id.
 	GetActivity(context.Context, *connect.Request[v1.GetActivityRequest]) (*connect.Response[v1.Activity], error)
+	// ListAuditEvents returns the audit events, which record administrative and security events.
+	// Only admins can list audit events.
+	ListAuditEvents(context.Context, *connect.Request[v1.ListAuditEventsRequest]) (*connect.Response[v1.ListAuditEventsResponse], error)
+	// ExportAuditEvents exports the audit events matching the filter as JSON lines.
+	// An export holds at most 10000 audit events, the following ones are exported with the next page token.
+	// Only admins can export audit events.
+	ExportAuditEvents(context.Context, *connect.Request[v1.ExportAuditEventsRequest]) (*connect.Response[v1.ExportAuditEventsResponse], error)
 }
 
 // NewActivityServiceClient constructs a client for the memos.api.v1.ActivityService service. By
@@ -72,13 +85,27 @@ func NewActivityServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(activityServiceMethods.ByName("GetActivity")),
 			connect.WithClientOptions(opts...),
 		),
+		listAuditEvents: connect.NewClient[v1.ListAuditEventsRequest, v1.ListAuditEventsResponse](
+			httpClient,
+			baseURL+ActivityServiceListAuditEventsProcedure,
+			connect.WithSchema(activityServiceMethods.ByName("ListAuditEvents")),
+			connect.WithClientOptions(opts...),
+		),
+		exportAuditEvents: connect.NewClient[v1.ExportAuditEventsRequest, v1.ExportAuditEventsResponse](
+			httpClient,
+			baseURL+ActivityServiceExportAuditEventsProcedure,
+			connect.WithSchema(activityServiceMethods.ByName("ExportAuditEvents")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // activityServiceClient implements ActivityServiceClient.
 type activityServiceClient struct {
-	listActivities *connect.Client[v1.ListActivitiesRequest, v1.ListActivitiesResponse]
-	getActivity    *connect.Client[v1.GetActivityRequest, v1.Activity]
+	listActivities    *connect.Client[v1.ListActivitiesRequest, v1.ListActivitiesResponse]
+	getActivity       *connect.Client[v1.GetActivityRequest, v1.Activity]
+	listAuditEvents   *connect.Client[v1.ListAuditEventsRequest, v1.ListAuditEventsResponse]
+	exportAuditEvents *connect.Client[v1.ExportAuditEventsRequest, v1.ExportAuditEventsResponse]
 }
 
 // ListActivities calls memos.api.v1.ActivityService.ListActivities.
@@ -91,12 +118,29 @@ func (c *activityServiceClient) GetActivity(ctx context.Context, req *connect.Re
 	return c.getActivity.CallUnary(ctx, req)
 }
 
+// ListAuditEvents calls memos.api.v1.ActivityService.ListAuditEvents.
+func (c *activityServiceClient) ListAuditEvents(ctx context.Context, req *connect.Request[v1.ListAuditEventsRequest]) (*connect.Response[v1.ListAuditEventsResponse], error) {
+	return c.listAuditEvents.CallUnary(ctx, req)
+}
+
+// ExportAuditEvents calls memos.api.v1.ActivityService.ExportAuditEvents.
+func (c *activityServiceClient) ExportAuditEvents(ctx context.Context, req *connect.Request[v1.ExportAuditEventsRequest]) (*connect.Response[v1.ExportAuditEventsResponse], error) {
+	return c.exportAuditEvents.CallUnary(ctx, req)
+}
+
 // ActivityServiceHandler is an implementation of the memos.api.v1.ActivityService service.
 type ActivityServiceHandler interface {
 	// ListActivities returns a list of activities.
 	ListActivities(context.Context, *connect.Request[v1.ListActivitiesRequest]) (*connect.Response[v1.ListActivitiesResponse], error)
 	// GetActivity returns the activity with the given id.
 	GetActivity(context.Context, *connect.Request[v1.GetActivityRequest]) (*connect.Response[v1.Activity], error)
+	// ListAuditEvents returns the audit events, which record administrative and security events.
+	// Only admins can list audit events.
+	ListAuditEvents(context.Context, *connect.Request[v1.ListAuditEventsRequest]) (*connect.Response[v1.ListAuditEventsResponse], error)
+	// ExportAuditEvents exports the audit events matching the filter as JSON lines.
+	// An export holds at most 10000 audit events, the following ones are exported with the next page token.
+	// Only admins can export audit events.
+	ExportAuditEvents(context.Context, *connect.Request[v1.ExportAuditEventsRequest]) (*connect.Response[v1.ExportAuditEventsResponse], error)
 }
 
 // NewActivityServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -118,12 +162,28 @@ func NewActivityServiceHandler(svc ActivityServiceHandler, opts ...connect.Handl
 		connect.WithSchema(activityServiceMethods.ByName("GetActivity")),
 		connect.WithHandlerOptions(opts...),
 	)
+	activityServiceListAuditEventsHandler := connect.NewUnaryHandler(
+		ActivityServiceListAuditEventsProcedure,
+		svc.ListAuditEvents,
+		connect.WithSchema(activityServiceMethods.ByName("ListAuditEvents")),
+		connect.WithHandlerOptions(opts...),
+	)
+	activityServiceExportAuditEventsHandler := connect.NewUnaryHandler(
+		ActivityServiceExportAuditEventsProcedure,
+		svc.ExportAuditEvents,
+		connect.WithSchema(activityServiceMethods.ByName("ExportAuditEvents")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/memos.api.v1.ActivityService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ActivityServiceListActivitiesProcedure:
 			activityServiceListActivitiesHandler.ServeHTTP(w, r)
 		case ActivityServiceGetActivityProcedure:
 			activityServiceGetActivityHandler.ServeHTTP(w, r)
+		case ActivityServiceListAuditEventsProcedure:
+			activityServiceListAuditEventsHandler.ServeHTTP(w, r)
+		case ActivityServiceExportAuditEventsProcedure:
+			activityServiceExportAuditEventsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -140,3 +200,11 @@ func (UnimplementedActivityServiceHandler) ListActivities(context.Context, *conn
 func (UnimplementedActivityServiceHandler) GetActivity(context.Context, *connect.Request[v1.GetActivityRequest]) (*connect.Response[v1.Activity], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.ActivityService.GetActivity is not implemented"))
 }
+
+func (UnimplementedActivityServiceHandler) ListAuditEvents(context.Context, *connect.Request[v1.ListAuditEventsRequest]) (*connect.Response[v1.ListAuditEventsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.ActivityService.ListAuditEvents is not implemented"))
+}
+
+func (UnimplementedActivityServiceHandler) ExportAuditEvents(context.Context, *connect.Request[v1.ExportAuditEventsRequest]) (*connect.Response[v1.ExportAuditEventsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.ActivityService.ExportAuditEvents is not implemented"))
+}
@@ -55,6 +55,8 @@ type AuthServiceClient interface {
 	// SignIn authenticates a user with credentials and returns tokens.
 	// On success, returns an access token and sets a refresh token cookie.
 	// Supports password-based and SSO authentication methods.
+	// If the user enabled two-factor authentication, a successful password sign-in returns
+	// a challenge instead, which is exchanged for tokens with TwoFactorCredentials.
 	SignIn(context.Context, *connect.Request[v1.SignInRequest]) (*connect.Response[v1.SignInResponse], error)
 	// SignOut terminates the user's authentication.
 	// Revokes the refresh token and clears the authentication cookie.
@@ -140,6 +142,8 @@ type AuthServiceHandler interface {
 	// SignIn authenticates a user with credentials and returns tokens.
 	// On success, returns an access token and sets a refresh token cookie.
 	// Supports password-based and SSO authentication methods.
+	// If the user enabled two-factor authentication, a successful password sign-in returns
+	// a challenge instead, which is exchanged for tokens with TwoFactorCredentials.
 	SignIn(context.Context, *connect.Request[v1.SignInRequest]) (*connect.Response[v1.SignInResponse], error)
 	// SignOut terminates the user's authentication.
 	// Revokes the refresh token and clears the authentication cookie.
@@ -71,6 +71,17 @@ const (
 	// MemoServiceDeleteMemoReactionProcedure is the fully-qualified name of the MemoService's
 	// DeleteMemoReaction RPC.
 	MemoServiceDeleteMemoReactionProcedure = "/memos.api.v1.MemoService/DeleteMemoReaction"
+	// MemoServiceSearchMemosProcedure is the fully-qualified name of the MemoService's SearchMemos RPC.
+	MemoServiceSearchMemosProcedure = "/memos.api.v1.MemoService/SearchMemos"
+	// MemoServiceListMemoRevisionsProcedure is the fully-qualified name of the MemoService's
+	// ListMemoRevisions RPC.
+	MemoServiceListMemoRevisionsProcedure = "/memos.api.v1.MemoService/ListMemoRevisions"
+	// MemoServiceGetMemoRevisionProcedure is the fully-qualified name of the MemoService's
+	// GetMemoRevision RPC.
+	MemoServiceGetMemoRevisionProcedure = "/memos.api.v1.MemoService/GetMemoRevision"
+	// MemoServiceRestoreMemoRevisionProcedure is the fully-qualified name of the MemoService's
+	// RestoreMemoRevision RPC.
+	MemoServiceRestoreMemoRevisionProcedure = "/memos.api.v1.MemoService/RestoreMemoRevision"
 )
 
 // MemoServiceClient is a client for the memos.api.v1.MemoService service.
@@ -103,6 +114,14 @@ type MemoServiceClient interface {
 	UpsertMemoReaction(context.Context, *connect.Request[v1.UpsertMemoReactionRequest]) (*connect.Response[v1.Reaction], error)
 	// DeleteMemoReaction deletes a reaction for a memo.
 	DeleteMemoReaction(context.Context, *connect.Request[v1.DeleteMemoReactionRequest]) (*connect.Response[emptypb.Empty], error)
+	// SearchMemos searches memos by semantic similarity to a query.
+	SearchMemos(context.Context, *connect.Request[v1.SearchMemosRequest]) (*connect.Response[v1.SearchMemosResponse], error)
+	// ListMemoRevisions lists the revisions of a memo, newest first.
+	ListMemoRevisions(context.Context, *connect.Request[v1.ListMemoRevisionsRequest]) (*connect.Response[v1.ListMemoRevisionsResponse], error)
+	// GetMemoRevision gets a revision of a memo.
+	GetMemoRevision(context.Context, *connect.Request[v1.GetMemoRevisionRequest]) (*connect.Response[v1.MemoRevision], error)
+	// RestoreMemoRevision restores the content and visibility of a memo to a revision.
+	RestoreMemoRevision(context.Context, *connect.Request[v1.RestoreMemoRevisionRequest]) (*connect.Response[v1.Memo], error)
 }
 
 // NewMemoServiceClient constructs a client for the memos.api.v1.MemoService service. By default, it
@@ -200,6 +219,30 @@ func NewMemoServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(memoServiceMethods.ByName("DeleteMemoReaction")),
 			connect.WithClientOptions(opts...),
 		),
+		searchMemos: connect.NewClient[v1.SearchMemosRequest, v1.SearchMemosResponse](
+			httpClient,
+			baseURL+MemoServiceSearchMemosProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("SearchMemos")),
+			connect.WithClientOptions(opts...),
+		),
+		listMemoRevisions: connect.NewClient[v1.ListMemoRevisionsRequest, v1.ListMemoRevisionsResponse](
+			httpClient,
+			baseURL+MemoServiceListMemoRevisionsProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("ListMemoRevisions")),
+			connect.WithClientOptions(opts...),
+		),
+		getMemoRevision: connect.NewClient[v1.GetMemoRevisionRequest, v1.MemoRevision](
+			httpClient,
+			baseURL+MemoServiceGetMemoRevisionProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("GetMemoRevision")),
+			connect.WithClientOptions(opts...),
+		),
+		restoreMemoRevision: connect.NewClient[v1.RestoreMemoRevisionRequest, v1.Memo](
+			httpClient,
+			baseURL+MemoServiceRestoreMemoRevisionProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("RestoreMemoRevision")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -219,6 +262,10 @@ type memoServiceClient struct {
 	listMemoReactions   *connect.Client[v1.ListMemoReactionsRequest, v1.ListMemoReactionsResponse]
 	upsertMemoReaction  *connect.Client[v1.UpsertMemoReactionRequest, v1.Reaction]
 	deleteMemoReaction  *connect.Client[v1.DeleteMemoReactionRequest, emptypb.Empty]
+	searchMemos         *connect.Client[v1.SearchMemosRequest, v1.SearchMemosResponse]
+	listMemoRevisions   *connect.Client[v1.ListMemoRevisionsRequest, v1.ListMemoRevisionsResponse]
+	getMemoRevision     *connect.Client[v1.GetMemoRevisionRequest, v1.MemoRevision]
+	restoreMemoRevision *connect.Client[v1.RestoreMemoRevisionRequest, v1.Memo]
 }
 
 // CreateMemo calls memos.api.v1.MemoService.CreateMemo.
@@ -291,6 +338,26 @@ func (c *memoServiceClient) DeleteMemoReaction(ctx context.Context, req *connect
 	return c.deleteMemoReaction.CallUnary(ctx, req)
 }
 
+// SearchMemos calls memos.api.v1.MemoService.SearchMemos.
+func (c *memoServiceClient) SearchMemos(ctx context.Context, req *connect.Request[v1.SearchMemosRequest]) (*connect.Response[v1.SearchMemosResponse], error) {
+	return c.searchMemos.CallUnary(ctx, req)
+}
+
+// ListMemoRevisions calls memos.api.v1.MemoService.ListMemoRevisions.
+func (c *memoServiceClient) ListMemoRevisions(ctx context.Context, req *connect.Request[v1.ListMemoRevisionsRequest]) (*connect.Response[v1.ListMemoRevisionsResponse], error) {
+	return c.listMemoRevisions.CallUnary(ctx, req)
+}
+
+// GetMemoRevision calls memos.api.v1.MemoService.GetMemoRevision.
+func (c *memoServiceClient) GetMemoRevision(ctx context.Context, req *connect.Request[v1.GetMemoRevisionRequest]) (*connect.Response[v1.MemoRevision], error) {
+	return c.getMemoRevision.CallUnary(ctx, req)
+}
+
+// RestoreMemoRevision calls memos.api.v1.MemoService.RestoreMemoRevision.
+func (c *memoServiceClient) RestoreMemoRevision(ctx context.Context, req *connect.Request[v1.RestoreMemoRevisionRequest]) (*connect.Response[v1.Memo], error) {
+	return c.restoreMemoRevision.CallUnary(ctx, req)
+}
+
 // MemoServiceHandler is an implementation of the memos.api.v1.MemoService service.
 type MemoServiceHandler interface {
 	// CreateMemo creates a memo.
@@ -321,6 +388,14 @@ type MemoServiceHandler interface {
 	UpsertMemoReaction(context.Context, *connect.Request[v1.UpsertMemoReactionRequest]) (*connect.Response[v1.Reaction], error)
 	// DeleteMemoReaction deletes a reaction for a memo.
 	DeleteMemoReaction(context.Context, *connect.Request[v1.DeleteMemoReactionRequest]) (*connect.Response[emptypb.Empty], error)
+	// SearchMemos searches memos by semantic similarity to a query.
+	SearchMemos(context.Context, *connect.Request[v1.SearchMemosRequest]) (*connect.Response[v1.SearchMemosResponse], error)
+	// ListMemoRevisions lists the revisions of a memo, newest first.
+	ListMemoRevisions(context.Context, *connect.Request[v1.ListMemoRevisionsRequest]) (*connect.Response[v1.ListMemoRevisionsResponse], error)
+	// GetMemoRevision gets a revision of a memo.
+	GetMemoRevision(context.Context, *connect.Request[v1.GetMemoRevisionRequest]) (*connect.Response[v1.MemoRevision], error)
+	// RestoreMemoRevision restores the content and visibility of a memo to a revision.
+	RestoreMemoRevision(context.Context, *connect.Request[v1.RestoreMemoRevisionRequest]) (*connect.Response[v1.Memo], error)
 }
 
 // NewMemoServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -414,6 +489,30 @@ func NewMemoServiceHandler(svc MemoServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(memoServiceMethods.ByName("DeleteMemoReaction")),
 		connect.WithHandlerOptions(opts...),
 	)
+	memoServiceSearchMemosHandler := connect.NewUnaryHandler(
+		MemoServiceSearchMemosProcedure,
+		svc.SearchMemos,
+		connect.WithSchema(memoServiceMethods.ByName("SearchMemos")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceListMemoRevisionsHandler := connect.NewUnaryHandler(
+		MemoServiceListMemoRevisionsProcedure,
+		svc.ListMemoRevisions,
+		connect.WithSchema(memoServiceMethods.ByName("ListMemoRevisions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceGetMemoRevisionHandler := connect.NewUnaryHandler(
+		MemoServiceGetMemoRevisionProcedure,
+		svc.GetMemoRevision,
+		connect.WithSchema(memoServiceMethods.ByName("GetMemoRevision")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceRestoreMemoRevisionHandler := connect.NewUnaryHandler(
+		MemoServiceRestoreMemoRevisionProcedure,
+		svc.RestoreMemoRevision,
+		connect.WithSchema(memoServiceMethods.ByName("RestoreMemoRevision")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/memos.api.v1.MemoService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case MemoServiceCreateMemoProcedure:
@@ -444,6 +543,14 @@ func NewMemoServiceHandler(svc MemoServiceHandler, opts ...connect.HandlerOption
 			memoServiceUpsertMemoReactionHandler.ServeHTTP(w, r)
 		case MemoServiceDeleteMemoReactionProcedure:
 			memoServiceDeleteMemoReactionHandler.ServeHTTP(w, r)
+		case MemoServiceSearchMemosProcedure:
+			memoServiceSearchMemosHandler.ServeHTTP(w, r)
+		case MemoServiceListMemoRevisionsProcedure:
+			memoServiceListMemoRevisionsHandler.ServeHTTP(w, r)
+		case MemoServiceGetMemoRevisionProcedure:
+			memoServiceGetMemoRevisionHandler.ServeHTTP(w, r)
+		case MemoServiceRestoreMemoRevisionProcedure:
+			memoServiceRestoreMemoRevisionHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -508,3 +615,19 @@ func (UnimplementedMemoServiceHandler) UpsertMemoReaction(context.Context, *conn
 func (UnimplementedMemoServiceHandler) DeleteMemoReaction(context.Context, *connect.Request[v1.DeleteMemoReactionRequest]) (*connect.Response[emptypb.Empty], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.DeleteMemoReaction is not implemented"))
 }
+
+func (UnimplementedMemoServiceHandler) SearchMemos(context.Context, *connect.Request[v1.SearchMemosRequest]) (*connect.Response[v1.SearchMemosResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.SearchMemos is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) ListMemoRevisions(context.Context, *connect.Request[v1.ListMemoRevisionsRequest]) (*connect.Response[v1.ListMemoRevisionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.ListMemoRevisions is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) GetMemoRevision(context.Context, *connect.Request[v1.GetMemoRevisionRequest]) (*connect.Response[v1.MemoRevision], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.GetMemoRevision is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) RestoreMemoRevision(context.Context, *connect.Request[v1.RestoreMemoRevisionRequest]) (*connect.Response[v1.Memo], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.RestoreMemoRevision is not implemented"))
+}
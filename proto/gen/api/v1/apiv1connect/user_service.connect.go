@@ -68,6 +68,21 @@ const (
 	// UserServiceDeletePersonalAccessTokenProcedure is the fully-qualified name of the UserService's
 	// DeletePersonalAccessToken RPC.
 	UserServiceDeletePersonalAccessTokenProcedure = "/memos.api.v1.UserService/DeletePersonalAccessToken"
+	// UserServiceGetTwoFactorStatusProcedure is the fully-qualified name of the UserService's
+	// GetTwoFactorStatus RPC.
+	UserServiceGetTwoFactorStatusProcedure = "/memos.api.v1.UserService/GetTwoFactorStatus"
+	// UserServiceSetupTwoFactorProcedure is the fully-qualified name of the UserService's
+	// SetupTwoFactor RPC.
+	UserServiceSetupTwoFactorProcedure = "/memos.api.v1.UserService/SetupTwoFactor"
+	// UserServiceEnableTwoFactorProcedure is the fully-qualified name of the UserService's
+	// EnableTwoFactor RPC.
+	UserServiceEnableTwoFactorProcedure = "/memos.api.v1.UserService/EnableTwoFactor"
+	// UserServiceDisableTwoFactorProcedure is the fully-qualified name of the UserService's
+	// DisableTwoFactor RPC.
+	UserServiceDisableTwoFactorProcedure = "/memos.api.v1.UserService/DisableTwoFactor"
+	// UserServiceRegenerateRecoveryCodesProcedure is the fully-qualified name of the UserService's
+	// RegenerateRecoveryCodes RPC.
+	UserServiceRegenerateRecoveryCodesProcedure = "/memos.api.v1.UserService/RegenerateRecoveryCodes"
 	// UserServiceListUserWebhooksProcedure is the fully-qualified name of the UserService's
 	// ListUserWebhooks RPC.
 	UserServiceListUserWebhooksProcedure = "/memos.api.v1.UserService/ListUserWebhooks"
@@ -80,6 +95,9 @@ const (
 	// UserServiceDeleteUserWebhookProcedure is the fully-qualified name of the UserService's
 	// DeleteUserWebhook RPC.
 	UserServiceDeleteUserWebhookProcedure = "/memos.api.v1.UserService/DeleteUserWebhook"
+	// UserServiceListWebhookDeliveriesProcedure is the fully-qualified name of the UserService's
+	// ListWebhookDeliveries RPC.
+	UserServiceListWebhookDeliveriesProcedure = "/memos.api.v1.UserService/ListWebhookDeliveries"
 	// UserServiceListUserNotificationsProcedure is the fully-qualified name of the UserService's
 	// ListUserNotifications RPC.
 	UserServiceListUserNotificationsProcedure = "/memos.api.v1.UserService/ListUserNotifications"
@@ -124,6 +142,19 @@ type UserServiceClient interface {
 	CreatePersonalAccessToken(context.Context, *connect.Request[v1.CreatePersonalAccessTokenRequest]) (*connect.Response[v1.CreatePersonalAccessTokenResponse], error)
 	// DeletePersonalAccessToken deletes a Personal Access Token.
 	DeletePersonalAccessToken(context.Context, *connect.Request[v1.DeletePersonalAccessTokenRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetTwoFactorStatus returns whether two-factor authentication is enabled for a user.
+	GetTwoFactorStatus(context.Context, *connect.Request[v1.GetTwoFactorStatusRequest]) (*connect.Response[v1.TwoFactorStatus], error)
+	// SetupTwoFactor starts the enrollment of an authenticator app with a new TOTP secret.
+	// Two-factor authentication is enabled once a code is verified with EnableTwoFactor.
+	SetupTwoFactor(context.Context, *connect.Request[v1.SetupTwoFactorRequest]) (*connect.Response[v1.SetupTwoFactorResponse], error)
+	// EnableTwoFactor verifies a code of the enrolled authenticator app and enables two-factor authentication.
+	// The recovery codes are only returned once.
+	EnableTwoFactor(context.Context, *connect.Request[v1.EnableTwoFactorRequest]) (*connect.Response[v1.EnableTwoFactorResponse], error)
+	// DisableTwoFactor disables two-factor authentication for a user.
+	DisableTwoFactor(context.Context, *connect.Request[v1.DisableTwoFactorRequest]) (*connect.Response[emptypb.Empty], error)
+	// RegenerateRecoveryCodes replaces the recovery codes of a user.
+	// The new recovery codes are only returned once.
+	RegenerateRecoveryCodes(context.Context, *connect.Request[v1.RegenerateRecoveryCodesRequest]) (*connect.Response[v1.RegenerateRecoveryCodesResponse], error)
 	// ListUserWebhooks returns a list of webhooks for a user.
 	ListUserWebhooks(context.Context, *connect.Request[v1.ListUserWebhooksRequest]) (*connect.Response[v1.ListUserWebhooksResponse], error)
 	// CreateUserWebhook creates a new webhook for a user.
@@ -132,6 +163,8 @@ type UserServiceClient interface {
 	UpdateUserWebhook(context.Context, *connect.Request[v1.UpdateUserWebhookRequest]) (*connect.Response[v1.UserWebhook], error)
 	// DeleteUserWebhook deletes a webhook for a user.
 	DeleteUserWebhook(context.Context, *connect.Request[v1.DeleteUserWebhookRequest]) (*connect.Response[emptypb.Empty], error)
+	// ListWebhookDeliveries lists the delivery attempts of a user's webhooks.
+	ListWebhookDeliveries(context.Context, *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error)
 	// ListUserNotifications lists notifications for a user.
 	ListUserNotifications(context.Context, *connect.Request[v1.ListUserNotificationsRequest]) (*connect.Response[v1.ListUserNotificationsResponse], error)
 	// UpdateUserNotification updates a notification.
@@ -229,6 +262,36 @@ func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(userServiceMethods.ByName("DeletePersonalAccessToken")),
 			connect.WithClientOptions(opts...),
 		),
+		getTwoFactorStatus: connect.NewClient[v1.GetTwoFactorStatusRequest, v1.TwoFactorStatus](
+			httpClient,
+			baseURL+UserServiceGetTwoFactorStatusProcedure,
+			connect.WithSchema(userServiceMethods.ByName("GetTwoFactorStatus")),
+			connect.WithClientOptions(opts...),
+		),
+		setupTwoFactor: connect.NewClient[v1.SetupTwoFactorRequest, v1.SetupTwoFactorResponse](
+			httpClient,
+			baseURL+UserServiceSetupTwoFactorProcedure,
+			connect.WithSchema(userServiceMethods.ByName("SetupTwoFactor")),
+			connect.WithClientOptions(opts...),
+		),
+		enableTwoFactor: connect.NewClient[v1.EnableTwoFactorRequest, v1.EnableTwoFactorResponse](
+			httpClient,
+			baseURL+UserServiceEnableTwoFactorProcedure,
+			connect.WithSchema(userServiceMethods.ByName("EnableTwoFactor")),
+			connect.WithClientOptions(opts...),
+		),
+		disableTwoFactor: connect.NewClient[v1.DisableTwoFactorRequest, emptypb.Empty](
+			httpClient,
+			baseURL+UserServiceDisableTwoFactorProcedure,
+			connect.WithSchema(userServiceMethods.ByName("DisableTwoFactor")),
+			connect.WithClientOptions(opts...),
+		),
+		regenerateRecoveryCodes: connect.NewClient[v1.RegenerateRecoveryCodesRequest, v1.RegenerateRecoveryCodesResponse](
+			httpClient,
+			baseURL+UserServiceRegenerateRecoveryCodesProcedure,
+			connect.WithSchema(userServiceMethods.ByName("RegenerateRecoveryCodes")),
+			connect.WithClientOptions(opts...),
+		),
 		listUserWebhooks: connect.NewClient[v1.ListUserWebhooksRequest, v1.ListUserWebhooksResponse](
 			httpClient,
 			baseURL+UserServiceListUserWebhooksProcedure,
@@ -253,6 +316,12 @@ func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(userServiceMethods.ByName("DeleteUserWebhook")),
 			connect.WithClientOptions(opts...),
 		),
+		listWebhookDeliveries: connect.NewClient[v1.ListWebhookDeliveriesRequest, v1.ListWebhookDeliveriesResponse](
+			httpClient,
+			baseURL+UserServiceListWebhookDeliveriesProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ListWebhookDeliveries")),
+			connect.WithClientOptions(opts...),
+		),
 		listUserNotifications: connect.NewClient[v1.ListUserNotificationsRequest, v1.ListUserNotificationsResponse](
 			httpClient,
 			baseURL+UserServiceListUserNotificationsProcedure,
@@ -289,10 +358,16 @@ type userServiceClient struct {
 	listPersonalAccessTokens  *connect.Client[v1.ListPersonalAccessTokensRequest, v1.ListPersonalAccessTokensResponse]
 	createPersonalAccessToken *connect.Client[v1.CreatePersonalAccessTokenRequest, v1.CreatePersonalAccessTokenResponse]
 	deletePersonalAccessToken *connect.Client[v1.DeletePersonalAccessTokenRequest, emptypb.Empty]
+	getTwoFactorStatus        *connect.Client[v1.GetTwoFactorStatusRequest, v1.TwoFactorStatus]
+	setupTwoFactor            *connect.Client[v1.SetupTwoFactorRequest, v1.SetupTwoFactorResponse]
+	enableTwoFactor           *connect.Client[v1.EnableTwoFactorRequest, v1.EnableTwoFactorResponse]
+	disableTwoFactor          *connect.Client[v1.DisableTwoFactorRequest, emptypb.Empty]
+	regenerateRecoveryCodes   *connect.Client[v1.RegenerateRecoveryCodesRequest, v1.RegenerateRecoveryCodesResponse]
 	listUserWebhooks          *connect.Client[v1.ListUserWebhooksRequest, v1.ListUserWebhooksResponse]
 	createUserWebhook         *connect.Client[v1.CreateUserWebhookRequest, v1.UserWebhook]
 	updateUserWebhook         *connect.Client[v1.UpdateUserWebhookRequest, v1.UserWebhook]
 	deleteUserWebhook         *connect.Client[v1.DeleteUserWebhookRequest, emptypb.Empty]
+	listWebhookDeliveries     *connect.Client[v1.ListWebhookDeliveriesRequest, v1.ListWebhookDeliveriesResponse]
 	listUserNotifications     *connect.Client[v1.ListUserNotificationsRequest, v1.ListUserNotificationsResponse]
 	updateUserNotification    *connect.Client[v1.UpdateUserNotificationRequest, v1.UserNotification]
 	deleteUserNotification    *connect.Client[v1.DeleteUserNotificationRequest, emptypb.Empty]
@@ -363,6 +438,31 @@ func (c *userServiceClient) DeletePersonalAccessToken(ctx context.Context, req *
 	return c.deletePersonalAccessToken.CallUnary(ctx, req)
 }
 
+// GetTwoFactorStatus calls memos.api.v1.UserService.GetTwoFactorStatus.
+func (c *userServiceClient) GetTwoFactorStatus(ctx context.Context, req *connect.Request[v1.GetTwoFactorStatusRequest]) (*connect.Response[v1.TwoFactorStatus], error) {
+	return c.getTwoFactorStatus.CallUnary(ctx, req)
+}
+
+// SetupTwoFactor calls memos.api.v1.UserService.SetupTwoFactor.
+func (c *userServiceClient) SetupTwoFactor(ctx context.Context, req *connect.Request[v1.SetupTwoFactorRequest]) (*connect.Response[v1.SetupTwoFactorResponse], error) {
+	return c.setupTwoFactor.CallUnary(ctx, req)
+}
+
+// EnableTwoFactor calls memos.api.v1.UserService.EnableTwoFactor.
+func (c *userServiceClient) EnableTwoFactor(ctx context.Context, req *connect.Request[v1.EnableTwoFactorRequest]) (*connect.Response[v1.EnableTwoFactorResponse], error) {
+	return c.enableTwoFactor.CallUnary(ctx, req)
+}
+
+// DisableTwoFactor calls memos.api.v1.UserService.DisableTwoFactor.
+func (c *userServiceClient) DisableTwoFactor(ctx context.Context, req *connect.Request[v1.DisableTwoFactorRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.disableTwoFactor.CallUnary(ctx, req)
+}
+
+// RegenerateRecoveryCodes calls memos.api.v1.UserService.RegenerateRecoveryCodes.
+func (c *userServiceClient) RegenerateRecoveryCodes(ctx context.Context, req *connect.Request[v1.RegenerateRecoveryCodesRequest]) (*connect.Response[v1.RegenerateRecoveryCodesResponse], error) {
+	return c.regenerateRecoveryCodes.CallUnary(ctx, req)
+}
+
 // ListUserWebhooks calls memos.api.v1.UserService.ListUserWebhooks.
 func (c *userServiceClient) ListUserWebhooks(ctx context.Context, req *connect.Request[v1.ListUserWebhooksRequest]) (*connect.Response[v1.ListUserWebhooksResponse], error) {
 	return c.listUserWebhooks.CallUnary(ctx, req)
@@ -383,6 +483,11 @@ func (c *userServiceClient) DeleteUserWebhook(ctx context.Context, req *connect.
 	return c.deleteUserWebhook.CallUnary(ctx, req)
 }
 
+// ListWebhookDeliveries calls memos.api.v1.UserService.ListWebhookDeliveries.
+func (c *userServiceClient) ListWebhookDeliveries(ctx context.Context, req *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error) {
+	return c.listWebhookDeliveries.CallUnary(ctx, req)
+}
+
 // ListUserNotifications calls memos.api.v1.UserService.ListUserNotifications.
 func (c *userServiceClient) ListUserNotifications(ctx context.Context, req *connect.Request[v1.ListUserNotificationsRequest]) (*connect.Response[v1.ListUserNotificationsResponse], error) {
 	return c.listUserNotifications.CallUnary(ctx, req)
@@ -431,6 +536,19 @@ type UserServiceHandler interface {
 	CreatePersonalAccessToken(context.Context, *connect.Request[v1.CreatePersonalAccessTokenRequest]) (*connect.Response[v1.CreatePersonalAccessTokenResponse], error)
 	// DeletePersonalAccessToken deletes a Personal Access Token.
 	DeletePersonalAccessToken(context.Context, *connect.Request[v1.DeletePersonalAccessTokenRequest]) (*connect.Response[emptypb.Empty], error)
+	// GetTwoFactorStatus returns whether two-factor authentication is enabled for a user.
+	GetTwoFactorStatus(context.Context, *connect.Request[v1.GetTwoFactorStatusRequest]) (*connect.Response[v1.TwoFactorStatus], error)
+	// SetupTwoFactor starts the enrollment of an authenticator app with a new TOTP secret.
+	// Two-factor authentication is enabled once a code is verified with EnableTwoFactor.
+	SetupTwoFactor(context.Context, *connect.Request[v1.SetupTwoFactorRequest]) (*connect.Response[v1.SetupTwoFactorResponse], error)
+	// EnableTwoFactor verifies a code of the enrolled authenticator app and enables two-factor authentication.
+	// The recovery codes are only returned once.
+	EnableTwoFactor(context.Context, *connect.Request[v1.EnableTwoFactorRequest]) (*connect.Response[v1.EnableTwoFactorResponse], error)
+	// DisableTwoFactor disables two-factor authentication for a user.
+	DisableTwoFactor(context.Context, *connect.Request[v1.DisableTwoFactorRequest]) (*connect.Response[emptypb.Empty], error)
+	// RegenerateRecoveryCodes replaces the recovery codes of a user.
+	// The new recovery codes are only returned once.
+	RegenerateRecoveryCodes(context.Context, *connect.Request[v1.RegenerateRecoveryCodesRequest]) (*connect.Response[v1.RegenerateRecoveryCodesResponse], error)
 	// ListUserWebhooks returns a list of webhooks for a user.
 	ListUserWebhooks(context.Context, *connect.Request[v1.ListUserWebhooksRequest]) (*connect.Response[v1.ListUserWebhooksResponse], error)
 	// CreateUserWebhook creates a new webhook for a user.
@@ -439,6 +557,8 @@ type UserServiceHandler interface {
 	UpdateUserWebhook(context.Context, *connect.Request[v1.UpdateUserWebhookRequest]) (*connect.Response[v1.UserWebhook], error)
 	// DeleteUserWebhook deletes a webhook for a user.
 	DeleteUserWebhook(context.Context, *connect.Request[v1.DeleteUserWebhookRequest]) (*connect.Response[emptypb.Empty], error)
+	// ListWebhookDeliveries lists the delivery attempts of a user's webhooks.
+	ListWebhookDeliveries(context.Context, *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error)
 	// ListUserNotifications lists notifications for a user.
 	ListUserNotifications(context.Context, *connect.Request[v1.ListUserNotificationsRequest]) (*connect.Response[v1.ListUserNotificationsResponse], error)
 	// UpdateUserNotification updates a notification.
@@ -532,6 +652,36 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(userServiceMethods.ByName("DeletePersonalAccessToken")),
 		connect.WithHandlerOptions(opts...),
 	)
+	userServiceGetTwoFactorStatusHandler := connect.NewUnaryHandler(
+		UserServiceGetTwoFactorStatusProcedure,
+		svc.GetTwoFactorStatus,
+		connect.WithSchema(userServiceMethods.ByName("GetTwoFactorStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceSetupTwoFactorHandler := connect.NewUnaryHandler(
+		UserServiceSetupTwoFactorProcedure,
+		svc.SetupTwoFactor,
+		connect.WithSchema(userServiceMethods.ByName("SetupTwoFactor")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceEnableTwoFactorHandler := connect.NewUnaryHandler(
+		UserServiceEnableTwoFactorProcedure,
+		svc.EnableTwoFactor,
+		connect.WithSchema(userServiceMethods.ByName("EnableTwoFactor")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceDisableTwoFactorHandler := connect.NewUnaryHandler(
+		UserServiceDisableTwoFactorProcedure,
+		svc.DisableTwoFactor,
+		connect.WithSchema(userServiceMethods.ByName("DisableTwoFactor")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceRegenerateRecoveryCodesHandler := connect.NewUnaryHandler(
+		UserServiceRegenerateRecoveryCodesProcedure,
+		svc.RegenerateRecoveryCodes,
+		connect.WithSchema(userServiceMethods.ByName("RegenerateRecoveryCodes")),
+		connect.WithHandlerOptions(opts...),
+	)
 	userServiceListUserWebhooksHandler := connect.NewUnaryHandler(
 		UserServiceListUserWebhooksProcedure,
 		svc.ListUserWebhooks,
@@ -556,6 +706,12 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(userServiceMethods.ByName("DeleteUserWebhook")),
 		connect.WithHandlerOptions(opts...),
 	)
+	userServiceListWebhookDeliveriesHandler := connect.NewUnaryHandler(
+		UserServiceListWebhookDeliveriesProcedure,
+		svc.ListWebhookDeliveries,
+		connect.WithSchema(userServiceMethods.ByName("ListWebhookDeliveries")),
+		connect.WithHandlerOptions(opts...),
+	)
 	userServiceListUserNotificationsHandler := connect.NewUnaryHandler(
 		UserServiceListUserNotificationsProcedure,
 		svc.ListUserNotifications,
@@ -602,6 +758,16 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 			userServiceCreatePersonalAccessTokenHandler.ServeHTTP(w, r)
 		case UserServiceDeletePersonalAccessTokenProcedure:
 			userServiceDeletePersonalAccessTokenHandler.ServeHTTP(w, r)
+		case UserServiceGetTwoFactorStatusProcedure:
+			userServiceGetTwoFactorStatusHandler.ServeHTTP(w, r)
+		case UserServiceSetupTwoFactorProcedure:
+			userServiceSetupTwoFactorHandler.ServeHTTP(w, r)
+		case UserServiceEnableTwoFactorProcedure:
+			userServiceEnableTwoFactorHandler.ServeHTTP(w, r)
+		case UserServiceDisableTwoFactorProcedure:
+			userServiceDisableTwoFactorHandler.ServeHTTP(w, r)
+		case UserServiceRegenerateRecoveryCodesProcedure:
+			userServiceRegenerateRecoveryCodesHandler.ServeHTTP(w, r)
 		case UserServiceListUserWebhooksProcedure:
 			userServiceListUserWebhooksHandler.ServeHTTP(w, r)
 		case UserServiceCreateUserWebhookProcedure:
@@ -610,6 +776,8 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 			userServiceUpdateUserWebhookHandler.ServeHTTP(w, r)
 		case UserServiceDeleteUserWebhookProcedure:
 			userServiceDeleteUserWebhookHandler.ServeHTTP(w, r)
+		case UserServiceListWebhookDeliveriesProcedure:
+			userServiceListWebhookDeliveriesHandler.ServeHTTP(w, r)
 		case UserServiceListUserNotificationsProcedure:
 			userServiceListUserNotificationsHandler.ServeHTTP(w, r)
 		case UserServiceUpdateUserNotificationProcedure:
@@ -677,6 +845,26 @@ func (UnimplementedUserServiceHandler) DeletePersonalAccessToken(context.Context
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.DeletePersonalAccessToken is not implemented"))
 }
 
+func (UnimplementedUserServiceHandler) GetTwoFactorStatus(context.Context, *connect.Request[v1.GetTwoFactorStatusRequest]) (*connect.Response[v1.TwoFactorStatus], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.GetTwoFactorStatus is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) SetupTwoFactor(context.Context, *connect.Request[v1.SetupTwoFactorRequest]) (*connect.Response[v1.SetupTwoFactorResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.SetupTwoFactor is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) EnableTwoFactor(context.Context, *connect.Request[v1.EnableTwoFactorRequest]) (*connect.Response[v1.EnableTwoFactorResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.EnableTwoFactor is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) DisableTwoFactor(context.Context, *connect.Request[v1.DisableTwoFactorRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.DisableTwoFactor is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) RegenerateRecoveryCodes(context.Context, *connect.Request[v1.RegenerateRecoveryCodesRequest]) (*connect.Response[v1.RegenerateRecoveryCodesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.RegenerateRecoveryCodes is not implemented"))
+}
+
 func (UnimplementedUserServiceHandler) ListUserWebhooks(context.Context, *connect.Request[v1.ListUserWebhooksRequest]) (*connect.Response[v1.ListUserWebhooksResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.ListUserWebhooks is not implemented"))
 }
@@ -693,6 +881,10 @@ func (UnimplementedUserServiceHandler) DeleteUserWebhook(context.Context, *conne
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.DeleteUserWebhook is not implemented"))
 }
 
+func (UnimplementedUserServiceHandler) ListWebhookDeliveries(context.Context, *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.ListWebhookDeliveries is not implemented"))
+}
+
 func (UnimplementedUserServiceHandler) ListUserNotifications(context.Context, *connect.Request[v1.ListUserNotificationsRequest]) (*connect.Response[v1.ListUserNotificationsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.ListUserNotifications is not implemented"))
 }
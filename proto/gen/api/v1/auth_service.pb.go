@@ -113,6 +113,7 @@ type SignInRequest struct {
 	//
 	//	*SignInRequest_PasswordCredentials_
 	//	*SignInRequest_SsoCredentials
+	//	*SignInRequest_TwoFactorCredentials_
 	Credentials   isSignInRequest_Credentials `protobuf_oneof:"credentials"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -173,6 +174,15 @@ func (x *SignInRequest) GetSsoCredentials() *SignInRequest_SSOCredentials {
 	return nil
 }
 
+func (x *SignInRequest) GetTwoFactorCredentials() *SignInRequest_TwoFactorCredentials {
+	if x != nil {
+		if x, ok := x.Credentials.(*SignInRequest_TwoFactorCredentials_); ok {
+			return x.TwoFactorCredentials
+		}
+	}
+	return nil
+}
+
 type isSignInRequest_Credentials interface {
 	isSignInRequest_Credentials()
 }
@@ -187,10 +197,17 @@ type SignInRequest_SsoCredentials struct {
 	SsoCredentials *SignInRequest_SSOCredentials `protobuf:"bytes,2,opt,name=sso_credentials,json=ssoCredentials,proto3,oneof"`
 }
 
+type SignInRequest_TwoFactorCredentials_ struct {
+	// Second factor of a password sign-in.
+	TwoFactorCredentials *SignInRequest_TwoFactorCredentials `protobuf:"bytes,3,opt,name=two_factor_credentials,json=twoFactorCredentials,proto3,oneof"`
+}
+
 func (*SignInRequest_PasswordCredentials_) isSignInRequest_Credentials() {}
 
 func (*SignInRequest_SsoCredentials) isSignInRequest_Credentials() {}
 
+func (*SignInRequest_TwoFactorCredentials_) isSignInRequest_Credentials() {}
+
 type SignInResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The authenticated user's information.
@@ -201,8 +218,13 @@ type SignInResponse struct {
 	// When the access token expires.
 	// Client should call RefreshToken before this time.
 	AccessTokenExpiresAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=access_token_expires_at,json=accessTokenExpiresAt,proto3" json:"access_token_expires_at,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	// Set instead of the user and tokens when the password is correct but a second factor is required.
+	// Pass it back with TwoFactorCredentials before it expires.
+	TwoFactorChallenge string `protobuf:"bytes,4,opt,name=two_factor_challenge,json=twoFactorChallenge,proto3" json:"two_factor_challenge,omitempty"`
+	// When the two-factor challenge expires.
+	TwoFactorChallengeExpiresAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=two_factor_challenge_expires_at,json=twoFactorChallengeExpiresAt,proto3" json:"two_factor_challenge_expires_at,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
 }
 
 func (x *SignInResponse) Reset() {
@@ -256,6 +278,20 @@ func (x *SignInResponse) GetAccessTokenExpiresAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *SignInResponse) GetTwoFactorChallenge() string {
+	if x != nil {
+		return x.TwoFactorChallenge
+	}
+	return ""
+}
+
+func (x *SignInResponse) GetTwoFactorChallengeExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TwoFactorChallengeExpiresAt
+	}
+	return nil
+}
+
 type SignOutRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -448,7 +484,10 @@ type SignInRequest_SSOCredentials struct {
 	RedirectUri string `protobuf:"bytes,3,opt,name=redirect_uri,json=redirectUri,proto3" json:"redirect_uri,omitempty"`
 	// The PKCE code verifier for enhanced security (RFC 7636).
 	// Optional - enables PKCE flow protection against authorization code interception.
-	CodeVerifier  string `protobuf:"bytes,4,opt,name=code_verifier,json=codeVerifier,proto3" json:"code_verifier,omitempty"`
+	CodeVerifier string `protobuf:"bytes,4,opt,name=code_verifier,json=codeVerifier,proto3" json:"code_verifier,omitempty"`
+	// The nonce sent in the authorization request.
+	// Required for OpenID Connect providers, it must match the nonce of the ID token.
+	Nonce         string `protobuf:"bytes,5,opt,name=nonce,proto3" json:"nonce,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -511,6 +550,68 @@ func (x *SignInRequest_SSOCredentials) GetCodeVerifier() string {
 	return ""
 }
 
+func (x *SignInRequest_SSOCredentials) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+// Nested message for the second step of a two-factor sign-in.
+type SignInRequest_TwoFactorCredentials struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The challenge returned by the password sign-in.
+	Challenge string `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	// The code of the authenticator app, or an unused recovery code.
+	Code          string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignInRequest_TwoFactorCredentials) Reset() {
+	*x = SignInRequest_TwoFactorCredentials{}
+	mi := &file_api_v1_auth_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignInRequest_TwoFactorCredentials) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignInRequest_TwoFactorCredentials) ProtoMessage() {}
+
+func (x *SignInRequest_TwoFactorCredentials) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignInRequest_TwoFactorCredentials.ProtoReflect.Descriptor instead.
+func (*SignInRequest_TwoFactorCredentials) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{2, 2}
+}
+
+func (x *SignInRequest_TwoFactorCredentials) GetChallenge() string {
+	if x != nil {
+		return x.Challenge
+	}
+	return ""
+}
+
+func (x *SignInRequest_TwoFactorCredentials) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
 var File_api_v1_auth_service_proto protoreflect.FileDescriptor
 
 const file_api_v1_auth_service_proto_rawDesc = "" +
@@ -518,23 +619,30 @@ const file_api_v1_auth_service_proto_rawDesc = "" +
 	"\x19api/v1/auth_service.proto\x12\fmemos.api.v1\x1a\x19api/v1/user_service.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x17\n" +
 	"\x15GetCurrentUserRequest\"@\n" +
 	"\x16GetCurrentUserResponse\x12&\n" +
-	"\x04user\x18\x01 \x01(\v2\x12.memos.api.v1.UserR\x04user\"\xce\x03\n" +
+	"\x04user\x18\x01 \x01(\v2\x12.memos.api.v1.UserR\x04user\"\xa7\x05\n" +
 	"\rSignInRequest\x12d\n" +
 	"\x14password_credentials\x18\x01 \x01(\v2/.memos.api.v1.SignInRequest.PasswordCredentialsH\x00R\x13passwordCredentials\x12U\n" +
-	"\x0fsso_credentials\x18\x02 \x01(\v2*.memos.api.v1.SignInRequest.SSOCredentialsH\x00R\x0essoCredentials\x1aW\n" +
+	"\x0fsso_credentials\x18\x02 \x01(\v2*.memos.api.v1.SignInRequest.SSOCredentialsH\x00R\x0essoCredentials\x12h\n" +
+	"\x16two_factor_credentials\x18\x03 \x01(\v20.memos.api.v1.SignInRequest.TwoFactorCredentialsH\x00R\x14twoFactorCredentials\x1aW\n" +
 	"\x13PasswordCredentials\x12\x1f\n" +
 	"\busername\x18\x01 \x01(\tB\x03\xe0A\x02R\busername\x12\x1f\n" +
-	"\bpassword\x18\x02 \x01(\tB\x03\xe0A\x02R\bpassword\x1a\x97\x01\n" +
+	"\bpassword\x18\x02 \x01(\tB\x03\xe0A\x02R\bpassword\x1a\xb2\x01\n" +
 	"\x0eSSOCredentials\x12\x1a\n" +
 	"\x06idp_id\x18\x01 \x01(\x05B\x03\xe0A\x02R\x05idpId\x12\x17\n" +
 	"\x04code\x18\x02 \x01(\tB\x03\xe0A\x02R\x04code\x12&\n" +
 	"\fredirect_uri\x18\x03 \x01(\tB\x03\xe0A\x02R\vredirectUri\x12(\n" +
-	"\rcode_verifier\x18\x04 \x01(\tB\x03\xe0A\x01R\fcodeVerifierB\r\n" +
-	"\vcredentials\"\xae\x01\n" +
+	"\rcode_verifier\x18\x04 \x01(\tB\x03\xe0A\x01R\fcodeVerifier\x12\x19\n" +
+	"\x05nonce\x18\x05 \x01(\tB\x03\xe0A\x01R\x05nonce\x1aR\n" +
+	"\x14TwoFactorCredentials\x12!\n" +
+	"\tchallenge\x18\x01 \x01(\tB\x03\xe0A\x02R\tchallenge\x12\x17\n" +
+	"\x04code\x18\x02 \x01(\tB\x03\xe0A\x02R\x04codeB\r\n" +
+	"\vcredentials\"\xc2\x02\n" +
 	"\x0eSignInResponse\x12&\n" +
 	"\x04user\x18\x01 \x01(\v2\x12.memos.api.v1.UserR\x04user\x12!\n" +
 	"\faccess_token\x18\x02 \x01(\tR\vaccessToken\x12Q\n" +
-	"\x17access_token_expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x14accessTokenExpiresAt\"\x10\n" +
+	"\x17access_token_expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x14accessTokenExpiresAt\x120\n" +
+	"\x14two_factor_challenge\x18\x04 \x01(\tR\x12twoFactorChallenge\x12`\n" +
+	"\x1ftwo_factor_challenge_expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x1btwoFactorChallengeExpiresAt\"\x10\n" +
 	"\x0eSignOutRequest\"\x15\n" +
 	"\x13RefreshTokenRequest\"t\n" +
 	"\x14RefreshTokenResponse\x12!\n" +
@@ -560,41 +668,44 @@ func file_api_v1_auth_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_auth_service_proto_rawDescData
 }
 
-var file_api_v1_auth_service_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_api_v1_auth_service_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_api_v1_auth_service_proto_goTypes = []any{
-	(*GetCurrentUserRequest)(nil),             // 0: memos.api.v1.GetCurrentUserRequest
-	(*GetCurrentUserResponse)(nil),            // 1: memos.api.v1.GetCurrentUserResponse
-	(*SignInRequest)(nil),                     // 2: memos.api.v1.SignInRequest
-	(*SignInResponse)(nil),                    // 3: memos.api.v1.SignInResponse
-	(*SignOutRequest)(nil),                    // 4: memos.api.v1.SignOutRequest
-	(*RefreshTokenRequest)(nil),               // 5: memos.api.v1.RefreshTokenRequest
-	(*RefreshTokenResponse)(nil),              // 6: memos.api.v1.RefreshTokenResponse
-	(*SignInRequest_PasswordCredentials)(nil), // 7: memos.api.v1.SignInRequest.PasswordCredentials
-	(*SignInRequest_SSOCredentials)(nil),      // 8: memos.api.v1.SignInRequest.SSOCredentials
-	(*User)(nil),                              // 9: memos.api.v1.User
-	(*timestamppb.Timestamp)(nil),             // 10: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                     // 11: google.protobuf.Empty
+	(*GetCurrentUserRequest)(nil),              // 0: memos.api.v1.GetCurrentUserRequest
+	(*GetCurrentUserResponse)(nil),             // 1: memos.api.v1.GetCurrentUserResponse
+	(*SignInRequest)(nil),                      // 2: memos.api.v1.SignInRequest
+	(*SignInResponse)(nil),                     // 3: memos.api.v1.SignInResponse
+	(*SignOutRequest)(nil),                     // 4: memos.api.v1.SignOutRequest
+	(*RefreshTokenRequest)(nil),                // 5: memos.api.v1.RefreshTokenRequest
+	(*RefreshTokenResponse)(nil),               // 6: memos.api.v1.RefreshTokenResponse
+	(*SignInRequest_PasswordCredentials)(nil),  // 7: memos.api.v1.SignInRequest.PasswordCredentials
+	(*SignInRequest_SSOCredentials)(nil),       // 8: memos.api.v1.SignInRequest.SSOCredentials
+	(*SignInRequest_TwoFactorCredentials)(nil), // 9: memos.api.v1.SignInRequest.TwoFactorCredentials
+	(*User)(nil),                               // 10: memos.api.v1.User
+	(*timestamppb.Timestamp)(nil),              // 11: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                      // 12: google.protobuf.Empty
 }
 var file_api_v1_auth_service_proto_depIdxs = []int32{
-	9,  // 0: memos.api.v1.GetCurrentUserResponse.user:type_name -> memos.api.v1.User
+	10, // 0: memos.api.v1.GetCurrentUserResponse.user:type_name -> memos.api.v1.User
 	7,  // 1: memos.api.v1.SignInRequest.password_credentials:type_name -> memos.api.v1.SignInRequest.PasswordCredentials
 	8,  // 2: memos.api.v1.SignInRequest.sso_credentials:type_name -> memos.api.v1.SignInRequest.SSOCredentials
-	9,  // 3: memos.api.v1.SignInResponse.user:type_name -> memos.api.v1.User
-	10, // 4: memos.api.v1.SignInResponse.access_token_expires_at:type_name -> google.protobuf.Timestamp
-	10, // 5: memos.api.v1.RefreshTokenResponse.expires_at:type_name -> google.protobuf.Timestamp
-	0,  // 6: memos.api.v1.AuthService.GetCurrentUser:input_type -> memos.api.v1.GetCurrentUserRequest
-	2,  // 7: memos.api.v1.AuthService.SignIn:input_type -> memos.api.v1.SignInRequest
-	4,  // 8: memos.api.v1.AuthService.SignOut:input_type -> memos.api.v1.SignOutRequest
-	5,  // 9: memos.api.v1.AuthService.RefreshToken:input_type -> memos.api.v1.RefreshTokenRequest
-	1,  // 10: memos.api.v1.AuthService.GetCurrentUser:output_type -> memos.api.v1.GetCurrentUserResponse
-	3,  // 11: memos.api.v1.AuthService.SignIn:output_type -> memos.api.v1.SignInResponse
-	11, // 12: memos.api.v1.AuthService.SignOut:output_type -> google.protobuf.Empty
-	6,  // 13: memos.api.v1.AuthService.RefreshToken:output_type -> memos.api.v1.RefreshTokenResponse
-	10, // [10:14] is the sub-list for method output_type
-	6,  // [6:10] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	9,  // 3: memos.api.v1.SignInRequest.two_factor_credentials:type_name -> memos.api.v1.SignInRequest.TwoFactorCredentials
+	10, // 4: memos.api.v1.SignInResponse.user:type_name -> memos.api.v1.User
+	11, // 5: memos.api.v1.SignInResponse.access_token_expires_at:type_name -> google.protobuf.Timestamp
+	11, // 6: memos.api.v1.SignInResponse.two_factor_challenge_expires_at:type_name -> google.protobuf.Timestamp
+	11, // 7: memos.api.v1.RefreshTokenResponse.expires_at:type_name -> google.protobuf.Timestamp
+	0,  // 8: memos.api.v1.AuthService.GetCurrentUser:input_type -> memos.api.v1.GetCurrentUserRequest
+	2,  // 9: memos.api.v1.AuthService.SignIn:input_type -> memos.api.v1.SignInRequest
+	4,  // 10: memos.api.v1.AuthService.SignOut:input_type -> memos.api.v1.SignOutRequest
+	5,  // 11: memos.api.v1.AuthService.RefreshToken:input_type -> memos.api.v1.RefreshTokenRequest
+	1,  // 12: memos.api.v1.AuthService.GetCurrentUser:output_type -> memos.api.v1.GetCurrentUserResponse
+	3,  // 13: memos.api.v1.AuthService.SignIn:output_type -> memos.api.v1.SignInResponse
+	12, // 14: memos.api.v1.AuthService.SignOut:output_type -> google.protobuf.Empty
+	6,  // 15: memos.api.v1.AuthService.RefreshToken:output_type -> memos.api.v1.RefreshTokenResponse
+	12, // [12:16] is the sub-list for method output_type
+	8,  // [8:12] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_auth_service_proto_init() }
@@ -606,6 +717,7 @@ func file_api_v1_auth_service_proto_init() {
 	file_api_v1_auth_service_proto_msgTypes[2].OneofWrappers = []any{
 		(*SignInRequest_PasswordCredentials_)(nil),
 		(*SignInRequest_SsoCredentials)(nil),
+		(*SignInRequest_TwoFactorCredentials_)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -613,7 +725,7 @@ func file_api_v1_auth_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_auth_service_proto_rawDesc), len(file_api_v1_auth_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
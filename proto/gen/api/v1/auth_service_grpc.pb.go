@@ -37,6 +37,8 @@ type AuthServiceClient interface {
 	// SignIn authenticates a user with credentials and returns tokens.
 	// On success, returns an access token and sets a refresh token cookie.
 	// Supports password-based and SSO authentication methods.
+	// If the user enabled two-factor authentication, a successful password sign-in returns
+	// a challenge instead, which is exchanged for tokens with TwoFactorCredentials.
 	SignIn(ctx context.Context, in *SignInRequest, opts ...grpc.CallOption) (*SignInResponse, error)
 	// SignOut terminates the user's authentication.
 	// Revokes the refresh token and clears the authentication cookie.
@@ -106,6 +108,8 @@ type AuthServiceServer interface {
 	// SignIn authenticates a user with credentials and returns tokens.
 	// On success, returns an access token and sets a refresh token cookie.
 	// Supports password-based and SSO authentication methods.
+	// If the user enabled two-factor authentication, a successful password sign-in returns
+	// a challenge instead, which is exchanged for tokens with TwoFactorCredentials.
 	SignIn(context.Context, *SignInRequest) (*SignInResponse, error)
 	// SignOut terminates the user's authentication.
 	// Revokes the refresh token and clears the authentication cookie.
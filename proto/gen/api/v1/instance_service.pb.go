@@ -10,7 +10,9 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -34,6 +36,10 @@ const (
 	InstanceSetting_STORAGE InstanceSetting_Key = 2
 	// MEMO_RELATED is the key for memo related settings.
 	InstanceSetting_MEMO_RELATED InstanceSetting_Key = 3
+	// EMBEDDING is the key for embedding provider settings.
+	InstanceSetting_EMBEDDING InstanceSetting_Key = 4
+	// SMTP is the key for the SMTP server used to send emails.
+	InstanceSetting_SMTP InstanceSetting_Key = 5
 )
 
 // Enum value maps for InstanceSetting_Key.
@@ -43,12 +49,16 @@ var (
 		1: "GENERAL",
 		2: "STORAGE",
 		3: "MEMO_RELATED",
+		4: "EMBEDDING",
+		5: "SMTP",
 	}
 	InstanceSetting_Key_value = map[string]int32{
 		"KEY_UNSPECIFIED": 0,
 		"GENERAL":         1,
 		"STORAGE":         2,
 		"MEMO_RELATED":    3,
+		"EMBEDDING":       4,
+		"SMTP":            5,
 	}
 )
 
@@ -135,6 +145,62 @@ func (InstanceSetting_StorageSetting_StorageType) EnumDescriptor() ([]byte, []in
 	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{2, 1, 0}
 }
 
+// Embedding provider enumeration.
+type InstanceSetting_EmbeddingSetting_Provider int32
+
+const (
+	InstanceSetting_EmbeddingSetting_PROVIDER_UNSPECIFIED InstanceSetting_EmbeddingSetting_Provider = 0
+	// OPENAI is an OpenAI compatible /v1/embeddings endpoint, e.g. a local Ollama or llama.cpp server.
+	InstanceSetting_EmbeddingSetting_OPENAI InstanceSetting_EmbeddingSetting_Provider = 1
+	// HUGGINGFACE is the HuggingFace inference API.
+	InstanceSetting_EmbeddingSetting_HUGGINGFACE InstanceSetting_EmbeddingSetting_Provider = 2
+	// HASHING is a deterministic local provider hashing words into vectors, mainly for testing.
+	InstanceSetting_EmbeddingSetting_HASHING InstanceSetting_EmbeddingSetting_Provider = 3
+)
+
+// Enum value maps for InstanceSetting_EmbeddingSetting_Provider.
+var (
+	InstanceSetting_EmbeddingSetting_Provider_name = map[int32]string{
+		0: "PROVIDER_UNSPECIFIED",
+		1: "OPENAI",
+		2: "HUGGINGFACE",
+		3: "HASHING",
+	}
+	InstanceSetting_EmbeddingSetting_Provider_value = map[string]int32{
+		"PROVIDER_UNSPECIFIED": 0,
+		"OPENAI":               1,
+		"HUGGINGFACE":          2,
+		"HASHING":              3,
+	}
+)
+
+func (x InstanceSetting_EmbeddingSetting_Provider) Enum() *InstanceSetting_EmbeddingSetting_Provider {
+	p := new(InstanceSetting_EmbeddingSetting_Provider)
+	*p = x
+	return p
+}
+
+func (x InstanceSetting_EmbeddingSetting_Provider) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InstanceSetting_EmbeddingSetting_Provider) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_instance_service_proto_enumTypes[2].Descriptor()
+}
+
+func (InstanceSetting_EmbeddingSetting_Provider) Type() protoreflect.EnumType {
+	return &file_api_v1_instance_service_proto_enumTypes[2]
+}
+
+func (x InstanceSetting_EmbeddingSetting_Provider) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InstanceSetting_EmbeddingSetting_Provider.Descriptor instead.
+func (InstanceSetting_EmbeddingSetting_Provider) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{2, 3, 0}
+}
+
 // Instance profile message containing basic instance information.
 type InstanceProfile struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -258,6 +324,8 @@ type InstanceSetting struct {
 	//	*InstanceSetting_GeneralSetting_
 	//	*InstanceSetting_StorageSetting_
 	//	*InstanceSetting_MemoRelatedSetting_
+	//	*InstanceSetting_EmbeddingSetting_
+	//	*InstanceSetting_SmtpSetting_
 	Value         isInstanceSetting_Value `protobuf_oneof:"value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -334,6 +402,24 @@ func (x *InstanceSetting) GetMemoRelatedSetting() *InstanceSetting_MemoRelatedSe
 	return nil
 }
 
+func (x *InstanceSetting) GetEmbeddingSetting() *InstanceSetting_EmbeddingSetting {
+	if x != nil {
+		if x, ok := x.Value.(*InstanceSetting_EmbeddingSetting_); ok {
+			return x.EmbeddingSetting
+		}
+	}
+	return nil
+}
+
+func (x *InstanceSetting) GetSmtpSetting() *InstanceSetting_SmtpSetting {
+	if x != nil {
+		if x, ok := x.Value.(*InstanceSetting_SmtpSetting_); ok {
+			return x.SmtpSetting
+		}
+	}
+	return nil
+}
+
 type isInstanceSetting_Value interface {
 	isInstanceSetting_Value()
 }
@@ -350,12 +436,24 @@ type InstanceSetting_MemoRelatedSetting_ struct {
 	MemoRelatedSetting *InstanceSetting_MemoRelatedSetting `protobuf:"bytes,4,opt,name=memo_related_setting,json=memoRelatedSetting,proto3,oneof"`
 }
 
+type InstanceSetting_EmbeddingSetting_ struct {
+	EmbeddingSetting *InstanceSetting_EmbeddingSetting `protobuf:"bytes,5,opt,name=embedding_setting,json=embeddingSetting,proto3,oneof"`
+}
+
+type InstanceSetting_SmtpSetting_ struct {
+	SmtpSetting *InstanceSetting_SmtpSetting `protobuf:"bytes,6,opt,name=smtp_setting,json=smtpSetting,proto3,oneof"`
+}
+
 func (*InstanceSetting_GeneralSetting_) isInstanceSetting_Value() {}
 
 func (*InstanceSetting_StorageSetting_) isInstanceSetting_Value() {}
 
 func (*InstanceSetting_MemoRelatedSetting_) isInstanceSetting_Value() {}
 
+func (*InstanceSetting_EmbeddingSetting_) isInstanceSetting_Value() {}
+
+func (*InstanceSetting_SmtpSetting_) isInstanceSetting_Value() {}
+
 // Request message for GetInstanceSetting method.
 type GetInstanceSettingRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -458,6 +556,341 @@ func (x *UpdateInstanceSettingRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
 	return nil
 }
 
+// A background job run by the instance on a schedule.
+type InstanceJob struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the job.
+	// Format: instance/jobs/{job}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The description of what the job does.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// The cron expression of the job schedule.
+	Schedule string `protobuf:"bytes,3,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// Whether the scheduled runs of the job are paused.
+	Paused bool `protobuf:"varint,4,opt,name=paused,proto3" json:"paused,omitempty"`
+	// The time of the last run, unset if the job never ran.
+	// Scheduled runs which end like the previous one are recorded at most every
+	// ten minutes, so it may lag behind for jobs running more often.
+	LastRunTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_run_time,json=lastRunTime,proto3" json:"last_run_time,omitempty"`
+	// The error of the last run, empty if it succeeded.
+	LastError string `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// The time of the next scheduled run.
+	NextRunTime   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=next_run_time,json=nextRunTime,proto3" json:"next_run_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstanceJob) Reset() {
+	*x = InstanceJob{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceJob) ProtoMessage() {}
+
+func (x *InstanceJob) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceJob.ProtoReflect.Descriptor instead.
+func (*InstanceJob) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *InstanceJob) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InstanceJob) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *InstanceJob) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *InstanceJob) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *InstanceJob) GetLastRunTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRunTime
+	}
+	return nil
+}
+
+func (x *InstanceJob) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *InstanceJob) GetNextRunTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRunTime
+	}
+	return nil
+}
+
+// Request message for ListInstanceJobs method.
+type ListInstanceJobsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInstanceJobsRequest) Reset() {
+	*x = ListInstanceJobsRequest{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInstanceJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInstanceJobsRequest) ProtoMessage() {}
+
+func (x *ListInstanceJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInstanceJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListInstanceJobsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{6}
+}
+
+// Response message for ListInstanceJobs method.
+type ListInstanceJobsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The jobs ordered by name.
+	Jobs          []*InstanceJob `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInstanceJobsResponse) Reset() {
+	*x = ListInstanceJobsResponse{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInstanceJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInstanceJobsResponse) ProtoMessage() {}
+
+func (x *ListInstanceJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInstanceJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListInstanceJobsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListInstanceJobsResponse) GetJobs() []*InstanceJob {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+// Request message for RunInstanceJob method.
+type RunInstanceJobRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the job.
+	// Format: instance/jobs/{job}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunInstanceJobRequest) Reset() {
+	*x = RunInstanceJobRequest{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunInstanceJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunInstanceJobRequest) ProtoMessage() {}
+
+func (x *RunInstanceJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunInstanceJobRequest.ProtoReflect.Descriptor instead.
+func (*RunInstanceJobRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RunInstanceJobRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Request message for UpdateInstanceJob method.
+type UpdateInstanceJobRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The job to update, only paused can be updated.
+	Job *InstanceJob `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	// The list of fields to update.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateInstanceJobRequest) Reset() {
+	*x = UpdateInstanceJobRequest{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateInstanceJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateInstanceJobRequest) ProtoMessage() {}
+
+func (x *UpdateInstanceJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateInstanceJobRequest.ProtoReflect.Descriptor instead.
+func (*UpdateInstanceJobRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateInstanceJobRequest) GetJob() *InstanceJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+func (x *UpdateInstanceJobRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// Request message for SendTestEmail method.
+type SendTestEmailRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The address to send the test email to.
+	// If empty, the email of the current user is used.
+	Email         string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendTestEmailRequest) Reset() {
+	*x = SendTestEmailRequest{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendTestEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendTestEmailRequest) ProtoMessage() {}
+
+func (x *SendTestEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendTestEmailRequest.ProtoReflect.Descriptor instead.
+func (*SendTestEmailRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SendTestEmailRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
 // General instance settings configuration.
 type InstanceSetting_GeneralSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -479,13 +912,19 @@ type InstanceSetting_GeneralSetting struct {
 	DisallowChangeUsername bool `protobuf:"varint,8,opt,name=disallow_change_username,json=disallowChangeUsername,proto3" json:"disallow_change_username,omitempty"`
 	// disallow_change_nickname disallows changing nickname.
 	DisallowChangeNickname bool `protobuf:"varint,9,opt,name=disallow_change_nickname,json=disallowChangeNickname,proto3" json:"disallow_change_nickname,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+	// require_admin_two_factor requires admins to sign in with two-factor authentication.
+	// Admins without two-factor authentication cannot sign in with a password,
+	// so it can only be turned on once all the active admins enabled two-factor authentication.
+	RequireAdminTwoFactor bool `protobuf:"varint,10,opt,name=require_admin_two_factor,json=requireAdminTwoFactor,proto3" json:"require_admin_two_factor,omitempty"`
+	// sign_in_limit throttles failed password sign-in attempts.
+	SignInLimit   *InstanceSetting_GeneralSetting_SignInLimit `protobuf:"bytes,11,opt,name=sign_in_limit,json=signInLimit,proto3" json:"sign_in_limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *InstanceSetting_GeneralSetting) Reset() {
 	*x = InstanceSetting_GeneralSetting{}
-	mi := &file_api_v1_instance_service_proto_msgTypes[5]
+	mi := &file_api_v1_instance_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -497,7 +936,7 @@ func (x *InstanceSetting_GeneralSetting) String() string {
 func (*InstanceSetting_GeneralSetting) ProtoMessage() {}
 
 func (x *InstanceSetting_GeneralSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_instance_service_proto_msgTypes[5]
+	mi := &file_api_v1_instance_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -569,6 +1008,20 @@ func (x *InstanceSetting_GeneralSetting) GetDisallowChangeNickname() bool {
 	return false
 }
 
+func (x *InstanceSetting_GeneralSetting) GetRequireAdminTwoFactor() bool {
+	if x != nil {
+		return x.RequireAdminTwoFactor
+	}
+	return false
+}
+
+func (x *InstanceSetting_GeneralSetting) GetSignInLimit() *InstanceSetting_GeneralSetting_SignInLimit {
+	if x != nil {
+		return x.SignInLimit
+	}
+	return nil
+}
+
 // Storage configuration settings for instance attachments.
 type InstanceSetting_StorageSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -587,7 +1040,7 @@ type InstanceSetting_StorageSetting struct {
 
 func (x *InstanceSetting_StorageSetting) Reset() {
 	*x = InstanceSetting_StorageSetting{}
-	mi := &file_api_v1_instance_service_proto_msgTypes[6]
+	mi := &file_api_v1_instance_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -599,7 +1052,7 @@ func (x *InstanceSetting_StorageSetting) String() string {
 func (*InstanceSetting_StorageSetting) ProtoMessage() {}
 
 func (x *InstanceSetting_StorageSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_instance_service_proto_msgTypes[6]
+	mi := &file_api_v1_instance_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -662,7 +1115,7 @@ type InstanceSetting_MemoRelatedSetting struct {
 
 func (x *InstanceSetting_MemoRelatedSetting) Reset() {
 	*x = InstanceSetting_MemoRelatedSetting{}
-	mi := &file_api_v1_instance_service_proto_msgTypes[7]
+	mi := &file_api_v1_instance_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -674,7 +1127,7 @@ func (x *InstanceSetting_MemoRelatedSetting) String() string {
 func (*InstanceSetting_MemoRelatedSetting) ProtoMessage() {}
 
 func (x *InstanceSetting_MemoRelatedSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_instance_service_proto_msgTypes[7]
+	mi := &file_api_v1_instance_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -725,6 +1178,198 @@ func (x *InstanceSetting_MemoRelatedSetting) GetReactions() []string {
 	return nil
 }
 
+// Embedding provider settings used by semantic search.
+type InstanceSetting_EmbeddingSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// provider is the embedding provider.
+	Provider InstanceSetting_EmbeddingSetting_Provider `protobuf:"varint,1,opt,name=provider,proto3,enum=memos.api.v1.InstanceSetting_EmbeddingSetting_Provider" json:"provider,omitempty"`
+	// endpoint is the base URL of the provider API, e.g. http://localhost:11434/v1.
+	// Empty uses the default endpoint of the provider.
+	Endpoint string `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// api_key is the key used to authenticate with the provider.
+	ApiKey string `protobuf:"bytes,3,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	// model is the embedding model, empty uses the default model of the provider.
+	Model string `protobuf:"bytes,4,opt,name=model,proto3" json:"model,omitempty"`
+	// dimension is the dimension of the embedding vectors, 0 uses the model default.
+	Dimension     int32 `protobuf:"varint,5,opt,name=dimension,proto3" json:"dimension,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstanceSetting_EmbeddingSetting) Reset() {
+	*x = InstanceSetting_EmbeddingSetting{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceSetting_EmbeddingSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceSetting_EmbeddingSetting) ProtoMessage() {}
+
+func (x *InstanceSetting_EmbeddingSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceSetting_EmbeddingSetting.ProtoReflect.Descriptor instead.
+func (*InstanceSetting_EmbeddingSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{2, 3}
+}
+
+func (x *InstanceSetting_EmbeddingSetting) GetProvider() InstanceSetting_EmbeddingSetting_Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return InstanceSetting_EmbeddingSetting_PROVIDER_UNSPECIFIED
+}
+
+func (x *InstanceSetting_EmbeddingSetting) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *InstanceSetting_EmbeddingSetting) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+func (x *InstanceSetting_EmbeddingSetting) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *InstanceSetting_EmbeddingSetting) GetDimension() int32 {
+	if x != nil {
+		return x.Dimension
+	}
+	return 0
+}
+
+// SMTP server settings used to send email notifications.
+type InstanceSetting_SmtpSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// host is the host of the SMTP server, empty disables emails.
+	Host string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	// port is the port of the SMTP server, e.g. 587 for STARTTLS or 465 for SSL.
+	Port int32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	// username is the username used to authenticate with the SMTP server.
+	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	// password is the password used to authenticate with the SMTP server.
+	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	// from_email is the address emails are sent from.
+	FromEmail string `protobuf:"bytes,5,opt,name=from_email,json=fromEmail,proto3" json:"from_email,omitempty"`
+	// from_name is the display name emails are sent from.
+	FromName string `protobuf:"bytes,6,opt,name=from_name,json=fromName,proto3" json:"from_name,omitempty"`
+	// use_tls enables STARTTLS.
+	UseTls bool `protobuf:"varint,7,opt,name=use_tls,json=useTls,proto3" json:"use_tls,omitempty"`
+	// use_ssl enables implicit SSL/TLS.
+	UseSsl        bool `protobuf:"varint,8,opt,name=use_ssl,json=useSsl,proto3" json:"use_ssl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstanceSetting_SmtpSetting) Reset() {
+	*x = InstanceSetting_SmtpSetting{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceSetting_SmtpSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceSetting_SmtpSetting) ProtoMessage() {}
+
+func (x *InstanceSetting_SmtpSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceSetting_SmtpSetting.ProtoReflect.Descriptor instead.
+func (*InstanceSetting_SmtpSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{2, 4}
+}
+
+func (x *InstanceSetting_SmtpSetting) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *InstanceSetting_SmtpSetting) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *InstanceSetting_SmtpSetting) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *InstanceSetting_SmtpSetting) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *InstanceSetting_SmtpSetting) GetFromEmail() string {
+	if x != nil {
+		return x.FromEmail
+	}
+	return ""
+}
+
+func (x *InstanceSetting_SmtpSetting) GetFromName() string {
+	if x != nil {
+		return x.FromName
+	}
+	return ""
+}
+
+func (x *InstanceSetting_SmtpSetting) GetUseTls() bool {
+	if x != nil {
+		return x.UseTls
+	}
+	return false
+}
+
+func (x *InstanceSetting_SmtpSetting) GetUseSsl() bool {
+	if x != nil {
+		return x.UseSsl
+	}
+	return false
+}
+
 // Custom profile configuration for instance branding.
 type InstanceSetting_GeneralSetting_CustomProfile struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -737,7 +1382,7 @@ type InstanceSetting_GeneralSetting_CustomProfile struct {
 
 func (x *InstanceSetting_GeneralSetting_CustomProfile) Reset() {
 	*x = InstanceSetting_GeneralSetting_CustomProfile{}
-	mi := &file_api_v1_instance_service_proto_msgTypes[8]
+	mi := &file_api_v1_instance_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -749,7 +1394,7 @@ func (x *InstanceSetting_GeneralSetting_CustomProfile) String() string {
 func (*InstanceSetting_GeneralSetting_CustomProfile) ProtoMessage() {}
 
 func (x *InstanceSetting_GeneralSetting_CustomProfile) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_instance_service_proto_msgTypes[8]
+	mi := &file_api_v1_instance_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -786,6 +1431,83 @@ func (x *InstanceSetting_GeneralSetting_CustomProfile) GetLogoUrl() string {
 	return ""
 }
 
+// Lockout thresholds of failed password sign-in attempts.
+type InstanceSetting_GeneralSetting_SignInLimit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// max_attempts_per_username is the number of failed attempts for a username which locks it out.
+	// 0 uses the default of 5, a negative value disables the limit.
+	MaxAttemptsPerUsername int32 `protobuf:"varint,1,opt,name=max_attempts_per_username,json=maxAttemptsPerUsername,proto3" json:"max_attempts_per_username,omitempty"`
+	// max_attempts_per_ip is the number of failed attempts from a client IP which locks it out.
+	// 0 uses the default of 20, a negative value disables the limit.
+	MaxAttemptsPerIp int32 `protobuf:"varint,2,opt,name=max_attempts_per_ip,json=maxAttemptsPerIp,proto3" json:"max_attempts_per_ip,omitempty"`
+	// window_seconds is the period over which failed attempts are counted.
+	// 0 uses the default of 15 minutes.
+	WindowSeconds int32 `protobuf:"varint,3,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	// lockout_seconds is how long a username or client IP is locked out.
+	// 0 uses the default of 15 minutes.
+	LockoutSeconds int32 `protobuf:"varint,4,opt,name=lockout_seconds,json=lockoutSeconds,proto3" json:"lockout_seconds,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InstanceSetting_GeneralSetting_SignInLimit) Reset() {
+	*x = InstanceSetting_GeneralSetting_SignInLimit{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceSetting_GeneralSetting_SignInLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceSetting_GeneralSetting_SignInLimit) ProtoMessage() {}
+
+func (x *InstanceSetting_GeneralSetting_SignInLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceSetting_GeneralSetting_SignInLimit.ProtoReflect.Descriptor instead.
+func (*InstanceSetting_GeneralSetting_SignInLimit) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{2, 0, 1}
+}
+
+func (x *InstanceSetting_GeneralSetting_SignInLimit) GetMaxAttemptsPerUsername() int32 {
+	if x != nil {
+		return x.MaxAttemptsPerUsername
+	}
+	return 0
+}
+
+func (x *InstanceSetting_GeneralSetting_SignInLimit) GetMaxAttemptsPerIp() int32 {
+	if x != nil {
+		return x.MaxAttemptsPerIp
+	}
+	return 0
+}
+
+func (x *InstanceSetting_GeneralSetting_SignInLimit) GetWindowSeconds() int32 {
+	if x != nil {
+		return x.WindowSeconds
+	}
+	return 0
+}
+
+func (x *InstanceSetting_GeneralSetting_SignInLimit) GetLockoutSeconds() int32 {
+	if x != nil {
+		return x.LockoutSeconds
+	}
+	return 0
+}
+
 // S3 configuration for cloud storage backend.
 // Reference: https://developers.cloudflare.com/r2/examples/aws/aws-sdk-go/
 type InstanceSetting_StorageSetting_S3Config struct {
@@ -802,7 +1524,7 @@ type InstanceSetting_StorageSetting_S3Config struct {
 
 func (x *InstanceSetting_StorageSetting_S3Config) Reset() {
 	*x = InstanceSetting_StorageSetting_S3Config{}
-	mi := &file_api_v1_instance_service_proto_msgTypes[9]
+	mi := &file_api_v1_instance_service_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -814,7 +1536,7 @@ func (x *InstanceSetting_StorageSetting_S3Config) String() string {
 func (*InstanceSetting_StorageSetting_S3Config) ProtoMessage() {}
 
 func (x *InstanceSetting_StorageSetting_S3Config) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_instance_service_proto_msgTypes[9]
+	mi := &file_api_v1_instance_service_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -876,18 +1598,20 @@ var File_api_v1_instance_service_proto protoreflect.FileDescriptor
 
 const file_api_v1_instance_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1dapi/v1/instance_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a google/protobuf/field_mask.proto\"\x84\x01\n" +
+	"\x1dapi/v1/instance_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x84\x01\n" +
 	"\x0fInstanceProfile\x12\x18\n" +
 	"\aversion\x18\x02 \x01(\tR\aversion\x12\x12\n" +
 	"\x04demo\x18\x03 \x01(\bR\x04demo\x12!\n" +
 	"\finstance_url\x18\x06 \x01(\tR\vinstanceUrl\x12 \n" +
 	"\vinitialized\x18\a \x01(\bR\vinitialized\"\x1b\n" +
-	"\x19GetInstanceProfileRequest\"\x99\x0f\n" +
+	"\x19GetInstanceProfileRequest\"\xc3\x17\n" +
 	"\x0fInstanceSetting\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12W\n" +
 	"\x0fgeneral_setting\x18\x02 \x01(\v2,.memos.api.v1.InstanceSetting.GeneralSettingH\x00R\x0egeneralSetting\x12W\n" +
 	"\x0fstorage_setting\x18\x03 \x01(\v2,.memos.api.v1.InstanceSetting.StorageSettingH\x00R\x0estorageSetting\x12d\n" +
-	"\x14memo_related_setting\x18\x04 \x01(\v20.memos.api.v1.InstanceSetting.MemoRelatedSettingH\x00R\x12memoRelatedSetting\x1a\xca\x04\n" +
+	"\x14memo_related_setting\x18\x04 \x01(\v20.memos.api.v1.InstanceSetting.MemoRelatedSettingH\x00R\x12memoRelatedSetting\x12]\n" +
+	"\x11embedding_setting\x18\x05 \x01(\v2..memos.api.v1.InstanceSetting.EmbeddingSettingH\x00R\x10embeddingSetting\x12N\n" +
+	"\fsmtp_setting\x18\x06 \x01(\v2).memos.api.v1.InstanceSetting.SmtpSettingH\x00R\vsmtpSetting\x1a\xab\a\n" +
 	"\x0eGeneralSetting\x12<\n" +
 	"\x1adisallow_user_registration\x18\x02 \x01(\bR\x18disallowUserRegistration\x124\n" +
 	"\x16disallow_password_auth\x18\x03 \x01(\bR\x14disallowPasswordAuth\x12+\n" +
@@ -896,11 +1620,19 @@ const file_api_v1_instance_service_proto_rawDesc = "" +
 	"\x0ecustom_profile\x18\x06 \x01(\v2:.memos.api.v1.InstanceSetting.GeneralSetting.CustomProfileR\rcustomProfile\x121\n" +
 	"\x15week_start_day_offset\x18\a \x01(\x05R\x12weekStartDayOffset\x128\n" +
 	"\x18disallow_change_username\x18\b \x01(\bR\x16disallowChangeUsername\x128\n" +
-	"\x18disallow_change_nickname\x18\t \x01(\bR\x16disallowChangeNickname\x1ab\n" +
+	"\x18disallow_change_nickname\x18\t \x01(\bR\x16disallowChangeNickname\x127\n" +
+	"\x18require_admin_two_factor\x18\n" +
+	" \x01(\bR\x15requireAdminTwoFactor\x12\\\n" +
+	"\rsign_in_limit\x18\v \x01(\v28.memos.api.v1.InstanceSetting.GeneralSetting.SignInLimitR\vsignInLimit\x1ab\n" +
 	"\rCustomProfile\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x19\n" +
-	"\blogo_url\x18\x03 \x01(\tR\alogoUrl\x1a\xbc\x04\n" +
+	"\blogo_url\x18\x03 \x01(\tR\alogoUrl\x1a\xc7\x01\n" +
+	"\vSignInLimit\x129\n" +
+	"\x19max_attempts_per_username\x18\x01 \x01(\x05R\x16maxAttemptsPerUsername\x12-\n" +
+	"\x13max_attempts_per_ip\x18\x02 \x01(\x05R\x10maxAttemptsPerIp\x12%\n" +
+	"\x0ewindow_seconds\x18\x03 \x01(\x05R\rwindowSeconds\x12'\n" +
+	"\x0flockout_seconds\x18\x04 \x01(\x05R\x0elockoutSeconds\x1a\xbc\x04\n" +
 	"\x0eStorageSetting\x12[\n" +
 	"\fstorage_type\x18\x01 \x01(\x0e28.memos.api.v1.InstanceSetting.StorageSetting.StorageTypeR\vstorageType\x12+\n" +
 	"\x11filepath_template\x18\x02 \x01(\tR\x10filepathTemplate\x12/\n" +
@@ -923,12 +1655,36 @@ const file_api_v1_instance_service_proto_rawDesc = "" +
 	"\x18display_with_update_time\x18\x02 \x01(\bR\x15displayWithUpdateTime\x120\n" +
 	"\x14content_length_limit\x18\x03 \x01(\x05R\x12contentLengthLimit\x127\n" +
 	"\x18enable_double_click_edit\x18\x04 \x01(\bR\x15enableDoubleClickEdit\x12\x1c\n" +
-	"\treactions\x18\a \x03(\tR\treactions\"F\n" +
+	"\treactions\x18\a \x03(\tR\treactions\x1a\xa0\x02\n" +
+	"\x10EmbeddingSetting\x12S\n" +
+	"\bprovider\x18\x01 \x01(\x0e27.memos.api.v1.InstanceSetting.EmbeddingSetting.ProviderR\bprovider\x12\x1a\n" +
+	"\bendpoint\x18\x02 \x01(\tR\bendpoint\x12\x17\n" +
+	"\aapi_key\x18\x03 \x01(\tR\x06apiKey\x12\x14\n" +
+	"\x05model\x18\x04 \x01(\tR\x05model\x12\x1c\n" +
+	"\tdimension\x18\x05 \x01(\x05R\tdimension\"N\n" +
+	"\bProvider\x12\x18\n" +
+	"\x14PROVIDER_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06OPENAI\x10\x01\x12\x0f\n" +
+	"\vHUGGINGFACE\x10\x02\x12\v\n" +
+	"\aHASHING\x10\x03\x1a\xdb\x01\n" +
+	"\vSmtpSetting\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x12\x12\n" +
+	"\x04port\x18\x02 \x01(\x05R\x04port\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x04 \x01(\tR\bpassword\x12\x1d\n" +
+	"\n" +
+	"from_email\x18\x05 \x01(\tR\tfromEmail\x12\x1b\n" +
+	"\tfrom_name\x18\x06 \x01(\tR\bfromName\x12\x17\n" +
+	"\ause_tls\x18\a \x01(\bR\x06useTls\x12\x17\n" +
+	"\ause_ssl\x18\b \x01(\bR\x06useSsl\"_\n" +
 	"\x03Key\x12\x13\n" +
 	"\x0fKEY_UNSPECIFIED\x10\x00\x12\v\n" +
 	"\aGENERAL\x10\x01\x12\v\n" +
 	"\aSTORAGE\x10\x02\x12\x10\n" +
-	"\fMEMO_RELATED\x10\x03:a\xeaA^\n" +
+	"\fMEMO_RELATED\x10\x03\x12\r\n" +
+	"\tEMBEDDING\x10\x04\x12\b\n" +
+	"\x04SMTP\x10\x05:a\xeaA^\n" +
 	"\x1cmemos.api.v1/InstanceSetting\x12\x1binstance/settings/{setting}*\x10instanceSettings2\x0finstanceSettingB\a\n" +
 	"\x05value\"U\n" +
 	"\x19GetInstanceSettingRequest\x128\n" +
@@ -937,11 +1693,37 @@ const file_api_v1_instance_service_proto_rawDesc = "" +
 	"\x1cUpdateInstanceSettingRequest\x12<\n" +
 	"\asetting\x18\x01 \x01(\v2\x1d.memos.api.v1.InstanceSettingB\x03\xe0A\x02R\asetting\x12@\n" +
 	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x01R\n" +
-	"updateMask2\xdb\x03\n" +
+	"updateMask\"\x83\x03\n" +
+	"\vInstanceJob\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12%\n" +
+	"\vdescription\x18\x02 \x01(\tB\x03\xe0A\x03R\vdescription\x12\x1f\n" +
+	"\bschedule\x18\x03 \x01(\tB\x03\xe0A\x03R\bschedule\x12\x16\n" +
+	"\x06paused\x18\x04 \x01(\bR\x06paused\x12C\n" +
+	"\rlast_run_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\vlastRunTime\x12\"\n" +
+	"\n" +
+	"last_error\x18\x06 \x01(\tB\x03\xe0A\x03R\tlastError\x12C\n" +
+	"\rnext_run_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\vnextRunTime:M\xeaAJ\n" +
+	"\x18memos.api.v1/InstanceJob\x12\x13instance/jobs/{job}*\finstanceJobs2\vinstanceJob\"\x19\n" +
+	"\x17ListInstanceJobsRequest\"I\n" +
+	"\x18ListInstanceJobsResponse\x12-\n" +
+	"\x04jobs\x18\x01 \x03(\v2\x19.memos.api.v1.InstanceJobR\x04jobs\"M\n" +
+	"\x15RunInstanceJobRequest\x124\n" +
+	"\x04name\x18\x01 \x01(\tB \xe0A\x02\xfaA\x1a\n" +
+	"\x18memos.api.v1/InstanceJobR\x04name\"\x8e\x01\n" +
+	"\x18UpdateInstanceJobRequest\x120\n" +
+	"\x03job\x18\x01 \x01(\v2\x19.memos.api.v1.InstanceJobB\x03\xe0A\x02R\x03job\x12@\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x02R\n" +
+	"updateMask\"1\n" +
+	"\x14SendTestEmailRequest\x12\x19\n" +
+	"\x05email\x18\x01 \x01(\tB\x03\xe0A\x01R\x05email2\xfb\a\n" +
 	"\x0fInstanceService\x12~\n" +
 	"\x12GetInstanceProfile\x12'.memos.api.v1.GetInstanceProfileRequest\x1a\x1d.memos.api.v1.InstanceProfile\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/instance/profile\x12\x8f\x01\n" +
 	"\x12GetInstanceSetting\x12'.memos.api.v1.GetInstanceSettingRequest\x1a\x1d.memos.api.v1.InstanceSetting\"1\xdaA\x04name\x82\xd3\xe4\x93\x02$\x12\"/api/v1/{name=instance/settings/*}\x12\xb5\x01\n" +
-	"\x15UpdateInstanceSetting\x12*.memos.api.v1.UpdateInstanceSettingRequest\x1a\x1d.memos.api.v1.InstanceSetting\"Q\xdaA\x13setting,update_mask\x82\xd3\xe4\x93\x025:\asetting2*/api/v1/{setting.name=instance/settings/*}B\xac\x01\n" +
+	"\x15UpdateInstanceSetting\x12*.memos.api.v1.UpdateInstanceSettingRequest\x1a\x1d.memos.api.v1.InstanceSetting\"Q\xdaA\x13setting,update_mask\x82\xd3\xe4\x93\x025:\asetting2*/api/v1/{setting.name=instance/settings/*}\x12\x80\x01\n" +
+	"\x10ListInstanceJobs\x12%.memos.api.v1.ListInstanceJobsRequest\x1a&.memos.api.v1.ListInstanceJobsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/instance/jobs\x12\x86\x01\n" +
+	"\x0eRunInstanceJob\x12#.memos.api.v1.RunInstanceJobRequest\x1a\x19.memos.api.v1.InstanceJob\"4\xdaA\x04name\x82\xd3\xe4\x93\x02':\x01*\"\"/api/v1/{name=instance/jobs/*}:run\x12\x99\x01\n" +
+	"\x11UpdateInstanceJob\x12&.memos.api.v1.UpdateInstanceJobRequest\x1a\x19.memos.api.v1.InstanceJob\"A\xdaA\x0fjob,update_mask\x82\xd3\xe4\x93\x02):\x03job2\"/api/v1/{job.name=instance/jobs/*}\x12v\n" +
+	"\rSendTestEmail\x12\".memos.api.v1.SendTestEmailRequest\x1a\x16.google.protobuf.Empty\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/instance:sendTestEmailB\xac\x01\n" +
 	"\x10com.memos.api.v1B\x14InstanceServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
 
 var (
@@ -956,43 +1738,72 @@ func file_api_v1_instance_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_instance_service_proto_rawDescData
 }
 
-var file_api_v1_instance_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_api_v1_instance_service_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_api_v1_instance_service_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_api_v1_instance_service_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_api_v1_instance_service_proto_goTypes = []any{
 	(InstanceSetting_Key)(0),                             // 0: memos.api.v1.InstanceSetting.Key
 	(InstanceSetting_StorageSetting_StorageType)(0),      // 1: memos.api.v1.InstanceSetting.StorageSetting.StorageType
-	(*InstanceProfile)(nil),                              // 2: memos.api.v1.InstanceProfile
-	(*GetInstanceProfileRequest)(nil),                    // 3: memos.api.v1.GetInstanceProfileRequest
-	(*InstanceSetting)(nil),                              // 4: memos.api.v1.InstanceSetting
-	(*GetInstanceSettingRequest)(nil),                    // 5: memos.api.v1.GetInstanceSettingRequest
-	(*UpdateInstanceSettingRequest)(nil),                 // 6: memos.api.v1.UpdateInstanceSettingRequest
-	(*InstanceSetting_GeneralSetting)(nil),               // 7: memos.api.v1.InstanceSetting.GeneralSetting
-	(*InstanceSetting_StorageSetting)(nil),               // 8: memos.api.v1.InstanceSetting.StorageSetting
-	(*InstanceSetting_MemoRelatedSetting)(nil),           // 9: memos.api.v1.InstanceSetting.MemoRelatedSetting
-	(*InstanceSetting_GeneralSetting_CustomProfile)(nil), // 10: memos.api.v1.InstanceSetting.GeneralSetting.CustomProfile
-	(*InstanceSetting_StorageSetting_S3Config)(nil),      // 11: memos.api.v1.InstanceSetting.StorageSetting.S3Config
-	(*fieldmaskpb.FieldMask)(nil),                        // 12: google.protobuf.FieldMask
+	(InstanceSetting_EmbeddingSetting_Provider)(0),       // 2: memos.api.v1.InstanceSetting.EmbeddingSetting.Provider
+	(*InstanceProfile)(nil),                              // 3: memos.api.v1.InstanceProfile
+	(*GetInstanceProfileRequest)(nil),                    // 4: memos.api.v1.GetInstanceProfileRequest
+	(*InstanceSetting)(nil),                              // 5: memos.api.v1.InstanceSetting
+	(*GetInstanceSettingRequest)(nil),                    // 6: memos.api.v1.GetInstanceSettingRequest
+	(*UpdateInstanceSettingRequest)(nil),                 // 7: memos.api.v1.UpdateInstanceSettingRequest
+	(*InstanceJob)(nil),                                  // 8: memos.api.v1.InstanceJob
+	(*ListInstanceJobsRequest)(nil),                      // 9: memos.api.v1.ListInstanceJobsRequest
+	(*ListInstanceJobsResponse)(nil),                     // 10: memos.api.v1.ListInstanceJobsResponse
+	(*RunInstanceJobRequest)(nil),                        // 11: memos.api.v1.RunInstanceJobRequest
+	(*UpdateInstanceJobRequest)(nil),                     // 12: memos.api.v1.UpdateInstanceJobRequest
+	(*SendTestEmailRequest)(nil),                         // 13: memos.api.v1.SendTestEmailRequest
+	(*InstanceSetting_GeneralSetting)(nil),               // 14: memos.api.v1.InstanceSetting.GeneralSetting
+	(*InstanceSetting_StorageSetting)(nil),               // 15: memos.api.v1.InstanceSetting.StorageSetting
+	(*InstanceSetting_MemoRelatedSetting)(nil),           // 16: memos.api.v1.InstanceSetting.MemoRelatedSetting
+	(*InstanceSetting_EmbeddingSetting)(nil),             // 17: memos.api.v1.InstanceSetting.EmbeddingSetting
+	(*InstanceSetting_SmtpSetting)(nil),                  // 18: memos.api.v1.InstanceSetting.SmtpSetting
+	(*InstanceSetting_GeneralSetting_CustomProfile)(nil), // 19: memos.api.v1.InstanceSetting.GeneralSetting.CustomProfile
+	(*InstanceSetting_GeneralSetting_SignInLimit)(nil),   // 20: memos.api.v1.InstanceSetting.GeneralSetting.SignInLimit
+	(*InstanceSetting_StorageSetting_S3Config)(nil),      // 21: memos.api.v1.InstanceSetting.StorageSetting.S3Config
+	(*fieldmaskpb.FieldMask)(nil),                        // 22: google.protobuf.FieldMask
+	(*timestamppb.Timestamp)(nil),                        // 23: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                                // 24: google.protobuf.Empty
 }
 var file_api_v1_instance_service_proto_depIdxs = []int32{
-	7,  // 0: memos.api.v1.InstanceSetting.general_setting:type_name -> memos.api.v1.InstanceSetting.GeneralSetting
-	8,  // 1: memos.api.v1.InstanceSetting.storage_setting:type_name -> memos.api.v1.InstanceSetting.StorageSetting
-	9,  // 2: memos.api.v1.InstanceSetting.memo_related_setting:type_name -> memos.api.v1.InstanceSetting.MemoRelatedSetting
-	4,  // 3: memos.api.v1.UpdateInstanceSettingRequest.setting:type_name -> memos.api.v1.InstanceSetting
-	12, // 4: memos.api.v1.UpdateInstanceSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
-	10, // 5: memos.api.v1.InstanceSetting.GeneralSetting.custom_profile:type_name -> memos.api.v1.InstanceSetting.GeneralSetting.CustomProfile
-	1,  // 6: memos.api.v1.InstanceSetting.StorageSetting.storage_type:type_name -> memos.api.v1.InstanceSetting.StorageSetting.StorageType
-	11, // 7: memos.api.v1.InstanceSetting.StorageSetting.s3_config:type_name -> memos.api.v1.InstanceSetting.StorageSetting.S3Config
-	3,  // 8: memos.api.v1.InstanceService.GetInstanceProfile:input_type -> memos.api.v1.GetInstanceProfileRequest
-	5,  // 9: memos.api.v1.InstanceService.GetInstanceSetting:input_type -> memos.api.v1.GetInstanceSettingRequest
-	6,  // 10: memos.api.v1.InstanceService.UpdateInstanceSetting:input_type -> memos.api.v1.UpdateInstanceSettingRequest
-	2,  // 11: memos.api.v1.InstanceService.GetInstanceProfile:output_type -> memos.api.v1.InstanceProfile
-	4,  // 12: memos.api.v1.InstanceService.GetInstanceSetting:output_type -> memos.api.v1.InstanceSetting
-	4,  // 13: memos.api.v1.InstanceService.UpdateInstanceSetting:output_type -> memos.api.v1.InstanceSetting
-	11, // [11:14] is the sub-list for method output_type
-	8,  // [8:11] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	14, // 0: memos.api.v1.InstanceSetting.general_setting:type_name -> memos.api.v1.InstanceSetting.GeneralSetting
+	15, // 1: memos.api.v1.InstanceSetting.storage_setting:type_name -> memos.api.v1.InstanceSetting.StorageSetting
+	16, // 2: memos.api.v1.InstanceSetting.memo_related_setting:type_name -> memos.api.v1.InstanceSetting.MemoRelatedSetting
+	17, // 3: memos.api.v1.InstanceSetting.embedding_setting:type_name -> memos.api.v1.InstanceSetting.EmbeddingSetting
+	18, // 4: memos.api.v1.InstanceSetting.smtp_setting:type_name -> memos.api.v1.InstanceSetting.SmtpSetting
+	5,  // 5: memos.api.v1.UpdateInstanceSettingRequest.setting:type_name -> memos.api.v1.InstanceSetting
+	22, // 6: memos.api.v1.UpdateInstanceSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
+	23, // 7: memos.api.v1.InstanceJob.last_run_time:type_name -> google.protobuf.Timestamp
+	23, // 8: memos.api.v1.InstanceJob.next_run_time:type_name -> google.protobuf.Timestamp
+	8,  // 9: memos.api.v1.ListInstanceJobsResponse.jobs:type_name -> memos.api.v1.InstanceJob
+	8,  // 10: memos.api.v1.UpdateInstanceJobRequest.job:type_name -> memos.api.v1.InstanceJob
+	22, // 11: memos.api.v1.UpdateInstanceJobRequest.update_mask:type_name -> google.protobuf.FieldMask
+	19, // 12: memos.api.v1.InstanceSetting.GeneralSetting.custom_profile:type_name -> memos.api.v1.InstanceSetting.GeneralSetting.CustomProfile
+	20, // 13: memos.api.v1.InstanceSetting.GeneralSetting.sign_in_limit:type_name -> memos.api.v1.InstanceSetting.GeneralSetting.SignInLimit
+	1,  // 14: memos.api.v1.InstanceSetting.StorageSetting.storage_type:type_name -> memos.api.v1.InstanceSetting.StorageSetting.StorageType
+	21, // 15: memos.api.v1.InstanceSetting.StorageSetting.s3_config:type_name -> memos.api.v1.InstanceSetting.StorageSetting.S3Config
+	2,  // 16: memos.api.v1.InstanceSetting.EmbeddingSetting.provider:type_name -> memos.api.v1.InstanceSetting.EmbeddingSetting.Provider
+	4,  // 17: memos.api.v1.InstanceService.GetInstanceProfile:input_type -> memos.api.v1.GetInstanceProfileRequest
+	6,  // 18: memos.api.v1.InstanceService.GetInstanceSetting:input_type -> memos.api.v1.GetInstanceSettingRequest
+	7,  // 19: memos.api.v1.InstanceService.UpdateInstanceSetting:input_type -> memos.api.v1.UpdateInstanceSettingRequest
+	9,  // 20: memos.api.v1.InstanceService.ListInstanceJobs:input_type -> memos.api.v1.ListInstanceJobsRequest
+	11, // 21: memos.api.v1.InstanceService.RunInstanceJob:input_type -> memos.api.v1.RunInstanceJobRequest
+	12, // 22: memos.api.v1.InstanceService.UpdateInstanceJob:input_type -> memos.api.v1.UpdateInstanceJobRequest
+	13, // 23: memos.api.v1.InstanceService.SendTestEmail:input_type -> memos.api.v1.SendTestEmailRequest
+	3,  // 24: memos.api.v1.InstanceService.GetInstanceProfile:output_type -> memos.api.v1.InstanceProfile
+	5,  // 25: memos.api.v1.InstanceService.GetInstanceSetting:output_type -> memos.api.v1.InstanceSetting
+	5,  // 26: memos.api.v1.InstanceService.UpdateInstanceSetting:output_type -> memos.api.v1.InstanceSetting
+	10, // 27: memos.api.v1.InstanceService.ListInstanceJobs:output_type -> memos.api.v1.ListInstanceJobsResponse
+	8,  // 28: memos.api.v1.InstanceService.RunInstanceJob:output_type -> memos.api.v1.InstanceJob
+	8,  // 29: memos.api.v1.InstanceService.UpdateInstanceJob:output_type -> memos.api.v1.InstanceJob
+	24, // 30: memos.api.v1.InstanceService.SendTestEmail:output_type -> google.protobuf.Empty
+	24, // [24:31] is the sub-list for method output_type
+	17, // [17:24] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_instance_service_proto_init() }
@@ -1004,14 +1815,16 @@ func file_api_v1_instance_service_proto_init() {
 		(*InstanceSetting_GeneralSetting_)(nil),
 		(*InstanceSetting_StorageSetting_)(nil),
 		(*InstanceSetting_MemoRelatedSetting_)(nil),
+		(*InstanceSetting_EmbeddingSetting_)(nil),
+		(*InstanceSetting_SmtpSetting_)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_instance_service_proto_rawDesc), len(file_api_v1_instance_service_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   10,
+			NumEnums:      3,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -11,6 +11,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -22,6 +23,10 @@ const (
 	InstanceService_GetInstanceProfile_FullMethodName    = "/memos.api.v1.InstanceService/GetInstanceProfile"
 	InstanceService_GetInstanceSetting_FullMethodName    = "/memos.api.v1.InstanceService/GetInstanceSetting"
 	InstanceService_UpdateInstanceSetting_FullMethodName = "/memos.api.v1.InstanceService/UpdateInstanceSetting"
+	InstanceService_ListInstanceJobs_FullMethodName      = "/memos.api.v1.InstanceService/ListInstanceJobs"
+	InstanceService_RunInstanceJob_FullMethodName        = "/memos.api.v1.InstanceService/RunInstanceJob"
+	InstanceService_UpdateInstanceJob_FullMethodName     = "/memos.api.v1.InstanceService/UpdateInstanceJob"
+	InstanceService_SendTestEmail_FullMethodName         = "/memos.api.v1.InstanceService/SendTestEmail"
 )
 
 // InstanceServiceClient is the client API for InstanceService service.
@@ -34,6 +39,14 @@ type InstanceServiceClient interface {
 	GetInstanceSetting(ctx context.Context, in *GetInstanceSettingRequest, opts ...grpc.CallOption) (*InstanceSetting, error)
 	// Updates an instance setting.
 	UpdateInstanceSetting(ctx context.Context, in *UpdateInstanceSettingRequest, opts ...grpc.CallOption) (*InstanceSetting, error)
+	// Lists the background jobs of the instance.
+	ListInstanceJobs(ctx context.Context, in *ListInstanceJobsRequest, opts ...grpc.CallOption) (*ListInstanceJobsResponse, error)
+	// Runs a background job now, even if it is paused.
+	RunInstanceJob(ctx context.Context, in *RunInstanceJobRequest, opts ...grpc.CallOption) (*InstanceJob, error)
+	// Updates a background job, pausing or resuming its scheduled runs.
+	UpdateInstanceJob(ctx context.Context, in *UpdateInstanceJobRequest, opts ...grpc.CallOption) (*InstanceJob, error)
+	// Sends a test email with the SMTP setting of the instance.
+	SendTestEmail(ctx context.Context, in *SendTestEmailRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type instanceServiceClient struct {
@@ -74,6 +87,46 @@ func (c *instanceServiceClient) UpdateInstanceSetting(ctx context.Context, in *U
 	return out, nil
 }
 
+func (c *instanceServiceClient) ListInstanceJobs(ctx context.Context, in *ListInstanceJobsRequest, opts ...grpc.CallOption) (*ListInstanceJobsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInstanceJobsResponse)
+	err := c.cc.Invoke(ctx, InstanceService_ListInstanceJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) RunInstanceJob(ctx context.Context, in *RunInstanceJobRequest, opts ...grpc.CallOption) (*InstanceJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InstanceJob)
+	err := c.cc.Invoke(ctx, InstanceService_RunInstanceJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) UpdateInstanceJob(ctx context.Context, in *UpdateInstanceJobRequest, opts ...grpc.CallOption) (*InstanceJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InstanceJob)
+	err := c.cc.Invoke(ctx, InstanceService_UpdateInstanceJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instanceServiceClient) SendTestEmail(ctx context.Context, in *SendTestEmailRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, InstanceService_SendTestEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InstanceServiceServer is the server API for InstanceService service.
 // All implementations must embed UnimplementedInstanceServiceServer
 // for forward compatibility.
@@ -84,6 +137,14 @@ type InstanceServiceServer interface {
 	GetInstanceSetting(context.Context, *GetInstanceSettingRequest) (*InstanceSetting, error)
 	// Updates an instance setting.
 	UpdateInstanceSetting(context.Context, *UpdateInstanceSettingRequest) (*InstanceSetting, error)
+	// Lists the background jobs of the instance.
+	ListInstanceJobs(context.Context, *ListInstanceJobsRequest) (*ListInstanceJobsResponse, error)
+	// Runs a background job now, even if it is paused.
+	RunInstanceJob(context.Context, *RunInstanceJobRequest) (*InstanceJob, error)
+	// Updates a background job, pausing or resuming its scheduled runs.
+	UpdateInstanceJob(context.Context, *UpdateInstanceJobRequest) (*InstanceJob, error)
+	// Sends a test email with the SMTP setting of the instance.
+	SendTestEmail(context.Context, *SendTestEmailRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedInstanceServiceServer()
 }
 
@@ -103,6 +164,18 @@ func (UnimplementedInstanceServiceServer) GetInstanceSetting(context.Context, *G
 func (UnimplementedInstanceServiceServer) UpdateInstanceSetting(context.Context, *UpdateInstanceSettingRequest) (*InstanceSetting, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateInstanceSetting not implemented")
 }
+func (UnimplementedInstanceServiceServer) ListInstanceJobs(context.Context, *ListInstanceJobsRequest) (*ListInstanceJobsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListInstanceJobs not implemented")
+}
+func (UnimplementedInstanceServiceServer) RunInstanceJob(context.Context, *RunInstanceJobRequest) (*InstanceJob, error) {
+	return nil, status.Error(codes.Unimplemented, "method RunInstanceJob not implemented")
+}
+func (UnimplementedInstanceServiceServer) UpdateInstanceJob(context.Context, *UpdateInstanceJobRequest) (*InstanceJob, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateInstanceJob not implemented")
+}
+func (UnimplementedInstanceServiceServer) SendTestEmail(context.Context, *SendTestEmailRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SendTestEmail not implemented")
+}
 func (UnimplementedInstanceServiceServer) mustEmbedUnimplementedInstanceServiceServer() {}
 func (UnimplementedInstanceServiceServer) testEmbeddedByValue()                         {}
 
@@ -178,6 +251,78 @@ func _InstanceService_UpdateInstanceSetting_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InstanceService_ListInstanceJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInstanceJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).ListInstanceJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstanceService_ListInstanceJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).ListInstanceJobs(ctx, req.(*ListInstanceJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_RunInstanceJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunInstanceJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).RunInstanceJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstanceService_RunInstanceJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).RunInstanceJob(ctx, req.(*RunInstanceJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_UpdateInstanceJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateInstanceJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).UpdateInstanceJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstanceService_UpdateInstanceJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).UpdateInstanceJob(ctx, req.(*UpdateInstanceJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstanceService_SendTestEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendTestEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).SendTestEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstanceService_SendTestEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).SendTestEmail(ctx, req.(*SendTestEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // InstanceService_ServiceDesc is the grpc.ServiceDesc for InstanceService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -197,6 +342,22 @@ var InstanceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateInstanceSetting",
 			Handler:    _InstanceService_UpdateInstanceSetting_Handler,
 		},
+		{
+			MethodName: "ListInstanceJobs",
+			Handler:    _InstanceService_ListInstanceJobs_Handler,
+		},
+		{
+			MethodName: "RunInstanceJob",
+			Handler:    _InstanceService_RunInstanceJob_Handler,
+		},
+		{
+			MethodName: "UpdateInstanceJob",
+			Handler:    _InstanceService_UpdateInstanceJob_Handler,
+		},
+		{
+			MethodName: "SendTestEmail",
+			Handler:    _InstanceService_SendTestEmail_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/instance_service.proto",
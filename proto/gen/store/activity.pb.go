@@ -9,6 +9,7 @@ package store
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -73,16 +74,279 @@ func (x *ActivityMemoCommentPayload) GetRelatedMemoId() int32 {
 	return 0
 }
 
+type ActivityTaskDuePayload struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	MemoId int32                  `protobuf:"varint,1,opt,name=memo_id,json=memoId,proto3" json:"memo_id,omitempty"`
+	// The text of the task.
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	DueTime       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=due_time,json=dueTime,proto3" json:"due_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityTaskDuePayload) Reset() {
+	*x = ActivityTaskDuePayload{}
+	mi := &file_store_activity_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityTaskDuePayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityTaskDuePayload) ProtoMessage() {}
+
+func (x *ActivityTaskDuePayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_activity_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityTaskDuePayload.ProtoReflect.Descriptor instead.
+func (*ActivityTaskDuePayload) Descriptor() ([]byte, []int) {
+	return file_store_activity_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ActivityTaskDuePayload) GetMemoId() int32 {
+	if x != nil {
+		return x.MemoId
+	}
+	return 0
+}
+
+func (x *ActivityTaskDuePayload) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ActivityTaskDuePayload) GetDueTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueTime
+	}
+	return nil
+}
+
+type ActivityMemoMentionPayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemoId        int32                  `protobuf:"varint,1,opt,name=memo_id,json=memoId,proto3" json:"memo_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityMemoMentionPayload) Reset() {
+	*x = ActivityMemoMentionPayload{}
+	mi := &file_store_activity_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityMemoMentionPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityMemoMentionPayload) ProtoMessage() {}
+
+func (x *ActivityMemoMentionPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_activity_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityMemoMentionPayload.ProtoReflect.Descriptor instead.
+func (*ActivityMemoMentionPayload) Descriptor() ([]byte, []int) {
+	return file_store_activity_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ActivityMemoMentionPayload) GetMemoId() int32 {
+	if x != nil {
+		return x.MemoId
+	}
+	return 0
+}
+
+type ActivitySignInFailedPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The username the sign-in was attempted with.
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// The IP address of the client.
+	IpAddress string `protobuf:"bytes,2,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	// The user agent of the client.
+	UserAgent string `protobuf:"bytes,3,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	// Why the sign-in failed.
+	Reason        string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivitySignInFailedPayload) Reset() {
+	*x = ActivitySignInFailedPayload{}
+	mi := &file_store_activity_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivitySignInFailedPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivitySignInFailedPayload) ProtoMessage() {}
+
+func (x *ActivitySignInFailedPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_activity_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivitySignInFailedPayload.ProtoReflect.Descriptor instead.
+func (*ActivitySignInFailedPayload) Descriptor() ([]byte, []int) {
+	return file_store_activity_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ActivitySignInFailedPayload) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ActivitySignInFailedPayload) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *ActivitySignInFailedPayload) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *ActivitySignInFailedPayload) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// ActivityAuditPayload is the payload of the audit activities, the creator is the user who caused the event.
+type ActivityAuditPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the resource the event is about.
+	// e.g. users/1, users/1/personalAccessTokens/{id}, instance/settings/GENERAL or identity-providers/1.
+	Resource string `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	// The IP address of the client.
+	IpAddress string `protobuf:"bytes,2,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	// The user agent of the client.
+	UserAgent string `protobuf:"bytes,3,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	// The value before and after the change, e.g. the roles of a role change.
+	OldValue      string `protobuf:"bytes,4,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	NewValue      string `protobuf:"bytes,5,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityAuditPayload) Reset() {
+	*x = ActivityAuditPayload{}
+	mi := &file_store_activity_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityAuditPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityAuditPayload) ProtoMessage() {}
+
+func (x *ActivityAuditPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_activity_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityAuditPayload.ProtoReflect.Descriptor instead.
+func (*ActivityAuditPayload) Descriptor() ([]byte, []int) {
+	return file_store_activity_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ActivityAuditPayload) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *ActivityAuditPayload) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *ActivityAuditPayload) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *ActivityAuditPayload) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *ActivityAuditPayload) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
 type ActivityPayload struct {
-	state         protoimpl.MessageState      `protogen:"open.v1"`
-	MemoComment   *ActivityMemoCommentPayload `protobuf:"bytes,1,opt,name=memo_comment,json=memoComment,proto3" json:"memo_comment,omitempty"`
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	MemoComment   *ActivityMemoCommentPayload  `protobuf:"bytes,1,opt,name=memo_comment,json=memoComment,proto3" json:"memo_comment,omitempty"`
+	SignInFailed  *ActivitySignInFailedPayload `protobuf:"bytes,2,opt,name=sign_in_failed,json=signInFailed,proto3" json:"sign_in_failed,omitempty"`
+	Audit         *ActivityAuditPayload        `protobuf:"bytes,3,opt,name=audit,proto3" json:"audit,omitempty"`
+	TaskDue       *ActivityTaskDuePayload      `protobuf:"bytes,4,opt,name=task_due,json=taskDue,proto3" json:"task_due,omitempty"`
+	MemoMention   *ActivityMemoMentionPayload  `protobuf:"bytes,5,opt,name=memo_mention,json=memoMention,proto3" json:"memo_mention,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ActivityPayload) Reset() {
 	*x = ActivityPayload{}
-	mi := &file_store_activity_proto_msgTypes[1]
+	mi := &file_store_activity_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -94,7 +358,7 @@ func (x *ActivityPayload) String() string {
 func (*ActivityPayload) ProtoMessage() {}
 
 func (x *ActivityPayload) ProtoReflect() protoreflect.Message {
-	mi := &file_store_activity_proto_msgTypes[1]
+	mi := &file_store_activity_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -107,7 +371,7 @@ func (x *ActivityPayload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ActivityPayload.ProtoReflect.Descriptor instead.
 func (*ActivityPayload) Descriptor() ([]byte, []int) {
-	return file_store_activity_proto_rawDescGZIP(), []int{1}
+	return file_store_activity_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ActivityPayload) GetMemoComment() *ActivityMemoCommentPayload {
@@ -117,16 +381,69 @@ func (x *ActivityPayload) GetMemoComment() *ActivityMemoCommentPayload {
 	return nil
 }
 
+func (x *ActivityPayload) GetSignInFailed() *ActivitySignInFailedPayload {
+	if x != nil {
+		return x.SignInFailed
+	}
+	return nil
+}
+
+func (x *ActivityPayload) GetAudit() *ActivityAuditPayload {
+	if x != nil {
+		return x.Audit
+	}
+	return nil
+}
+
+func (x *ActivityPayload) GetTaskDue() *ActivityTaskDuePayload {
+	if x != nil {
+		return x.TaskDue
+	}
+	return nil
+}
+
+func (x *ActivityPayload) GetMemoMention() *ActivityMemoMentionPayload {
+	if x != nil {
+		return x.MemoMention
+	}
+	return nil
+}
+
 var File_store_activity_proto protoreflect.FileDescriptor
 
 const file_store_activity_proto_rawDesc = "" +
 	"\n" +
-	"\x14store/activity.proto\x12\vmemos.store\"]\n" +
+	"\x14store/activity.proto\x12\vmemos.store\x1a\x1fgoogle/protobuf/timestamp.proto\"]\n" +
 	"\x1aActivityMemoCommentPayload\x12\x17\n" +
 	"\amemo_id\x18\x01 \x01(\x05R\x06memoId\x12&\n" +
-	"\x0frelated_memo_id\x18\x02 \x01(\x05R\rrelatedMemoId\"]\n" +
+	"\x0frelated_memo_id\x18\x02 \x01(\x05R\rrelatedMemoId\"\x82\x01\n" +
+	"\x16ActivityTaskDuePayload\x12\x17\n" +
+	"\amemo_id\x18\x01 \x01(\x05R\x06memoId\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x125\n" +
+	"\bdue_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\adueTime\"5\n" +
+	"\x1aActivityMemoMentionPayload\x12\x17\n" +
+	"\amemo_id\x18\x01 \x01(\x05R\x06memoId\"\x8f\x01\n" +
+	"\x1bActivitySignInFailedPayload\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x02 \x01(\tR\tipAddress\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x03 \x01(\tR\tuserAgent\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\xaa\x01\n" +
+	"\x14ActivityAuditPayload\x12\x1a\n" +
+	"\bresource\x18\x01 \x01(\tR\bresource\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x02 \x01(\tR\tipAddress\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x03 \x01(\tR\tuserAgent\x12\x1b\n" +
+	"\told_value\x18\x04 \x01(\tR\boldValue\x12\x1b\n" +
+	"\tnew_value\x18\x05 \x01(\tR\bnewValue\"\xf2\x02\n" +
 	"\x0fActivityPayload\x12J\n" +
-	"\fmemo_comment\x18\x01 \x01(\v2'.memos.store.ActivityMemoCommentPayloadR\vmemoCommentB\x98\x01\n" +
+	"\fmemo_comment\x18\x01 \x01(\v2'.memos.store.ActivityMemoCommentPayloadR\vmemoComment\x12N\n" +
+	"\x0esign_in_failed\x18\x02 \x01(\v2(.memos.store.ActivitySignInFailedPayloadR\fsignInFailed\x127\n" +
+	"\x05audit\x18\x03 \x01(\v2!.memos.store.ActivityAuditPayloadR\x05audit\x12>\n" +
+	"\btask_due\x18\x04 \x01(\v2#.memos.store.ActivityTaskDuePayloadR\ataskDue\x12J\n" +
+	"\fmemo_mention\x18\x05 \x01(\v2'.memos.store.ActivityMemoMentionPayloadR\vmemoMentionB\x98\x01\n" +
 	"\x0fcom.memos.storeB\rActivityProtoP\x01Z)github.com/usememos/memos/proto/gen/store\xa2\x02\x03MSX\xaa\x02\vMemos.Store\xca\x02\vMemos\\Store\xe2\x02\x17Memos\\Store\\GPBMetadata\xea\x02\fMemos::Storeb\x06proto3"
 
 var (
@@ -141,18 +458,28 @@ func file_store_activity_proto_rawDescGZIP() []byte {
 	return file_store_activity_proto_rawDescData
 }
 
-var file_store_activity_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_store_activity_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_store_activity_proto_goTypes = []any{
-	(*ActivityMemoCommentPayload)(nil), // 0: memos.store.ActivityMemoCommentPayload
-	(*ActivityPayload)(nil),            // 1: memos.store.ActivityPayload
+	(*ActivityMemoCommentPayload)(nil),  // 0: memos.store.ActivityMemoCommentPayload
+	(*ActivityTaskDuePayload)(nil),      // 1: memos.store.ActivityTaskDuePayload
+	(*ActivityMemoMentionPayload)(nil),  // 2: memos.store.ActivityMemoMentionPayload
+	(*ActivitySignInFailedPayload)(nil), // 3: memos.store.ActivitySignInFailedPayload
+	(*ActivityAuditPayload)(nil),        // 4: memos.store.ActivityAuditPayload
+	(*ActivityPayload)(nil),             // 5: memos.store.ActivityPayload
+	(*timestamppb.Timestamp)(nil),       // 6: google.protobuf.Timestamp
 }
 var file_store_activity_proto_depIdxs = []int32{
-	0, // 0: memos.store.ActivityPayload.memo_comment:type_name -> memos.store.ActivityMemoCommentPayload
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	6, // 0: memos.store.ActivityTaskDuePayload.due_time:type_name -> google.protobuf.Timestamp
+	0, // 1: memos.store.ActivityPayload.memo_comment:type_name -> memos.store.ActivityMemoCommentPayload
+	3, // 2: memos.store.ActivityPayload.sign_in_failed:type_name -> memos.store.ActivitySignInFailedPayload
+	4, // 3: memos.store.ActivityPayload.audit:type_name -> memos.store.ActivityAuditPayload
+	1, // 4: memos.store.ActivityPayload.task_due:type_name -> memos.store.ActivityTaskDuePayload
+	2, // 5: memos.store.ActivityPayload.memo_mention:type_name -> memos.store.ActivityMemoMentionPayload
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_store_activity_proto_init() }
@@ -166,7 +493,7 @@ func file_store_activity_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_activity_proto_rawDesc), len(file_store_activity_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
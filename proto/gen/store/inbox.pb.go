@@ -27,6 +27,10 @@ const (
 	InboxMessage_TYPE_UNSPECIFIED InboxMessage_Type = 0
 	// Memo comment notification.
 	InboxMessage_MEMO_COMMENT InboxMessage_Type = 1
+	// Task due notification.
+	InboxMessage_TASK_DUE InboxMessage_Type = 2
+	// Memo mention notification.
+	InboxMessage_MEMO_MENTION InboxMessage_Type = 3
 )
 
 // Enum value maps for InboxMessage_Type.
@@ -34,10 +38,14 @@ var (
 	InboxMessage_Type_name = map[int32]string{
 		0: "TYPE_UNSPECIFIED",
 		1: "MEMO_COMMENT",
+		2: "TASK_DUE",
+		3: "MEMO_MENTION",
 	}
 	InboxMessage_Type_value = map[string]int32{
 		"TYPE_UNSPECIFIED": 0,
 		"MEMO_COMMENT":     1,
+		"TASK_DUE":         2,
+		"MEMO_MENTION":     3,
 	}
 )
 
@@ -126,14 +134,16 @@ var File_store_inbox_proto protoreflect.FileDescriptor
 
 const file_store_inbox_proto_rawDesc = "" +
 	"\n" +
-	"\x11store/inbox.proto\x12\vmemos.store\"\xa8\x01\n" +
+	"\x11store/inbox.proto\x12\vmemos.store\"\xc8\x01\n" +
 	"\fInboxMessage\x122\n" +
 	"\x04type\x18\x01 \x01(\x0e2\x1e.memos.store.InboxMessage.TypeR\x04type\x12$\n" +
 	"\vactivity_id\x18\x02 \x01(\x05H\x00R\n" +
-	"activityId\x88\x01\x01\".\n" +
+	"activityId\x88\x01\x01\"N\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x10\n" +
-	"\fMEMO_COMMENT\x10\x01B\x0e\n" +
+	"\fMEMO_COMMENT\x10\x01\x12\f\n" +
+	"\bTASK_DUE\x10\x02\x12\x10\n" +
+	"\fMEMO_MENTION\x10\x03B\x0e\n" +
 	"\f_activity_idB\x95\x01\n" +
 	"\x0fcom.memos.storeB\n" +
 	"InboxProtoP\x01Z)github.com/usememos/memos/proto/gen/store\xa2\x02\x03MSX\xaa\x02\vMemos.Store\xca\x02\vMemos\\Store\xe2\x02\x17Memos\\Store\\GPBMetadata\xea\x02\fMemos::Storeb\x06proto3"
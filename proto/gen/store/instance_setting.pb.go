@@ -33,6 +33,10 @@ const (
 	InstanceSettingKey_STORAGE InstanceSettingKey = 3
 	// MEMO_RELATED is the key for memo related settings.
 	InstanceSettingKey_MEMO_RELATED InstanceSettingKey = 4
+	// EMBEDDING is the key for embedding provider settings.
+	InstanceSettingKey_EMBEDDING InstanceSettingKey = 5
+	// SMTP is the key for the SMTP server used to send emails.
+	InstanceSettingKey_SMTP InstanceSettingKey = 6
 )
 
 // Enum value maps for InstanceSettingKey.
@@ -43,6 +47,8 @@ var (
 		2: "GENERAL",
 		3: "STORAGE",
 		4: "MEMO_RELATED",
+		5: "EMBEDDING",
+		6: "SMTP",
 	}
 	InstanceSettingKey_value = map[string]int32{
 		"INSTANCE_SETTING_KEY_UNSPECIFIED": 0,
@@ -50,6 +56,8 @@ var (
 		"GENERAL":                          2,
 		"STORAGE":                          3,
 		"MEMO_RELATED":                     4,
+		"EMBEDDING":                        5,
+		"SMTP":                             6,
 	}
 )
 
@@ -132,7 +140,62 @@ func (x InstanceStorageSetting_StorageType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use InstanceStorageSetting_StorageType.Descriptor instead.
 func (InstanceStorageSetting_StorageType) EnumDescriptor() ([]byte, []int) {
-	return file_store_instance_setting_proto_rawDescGZIP(), []int{4, 0}
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{5, 0}
+}
+
+type InstanceEmbeddingSetting_Provider int32
+
+const (
+	InstanceEmbeddingSetting_PROVIDER_UNSPECIFIED InstanceEmbeddingSetting_Provider = 0
+	// OPENAI is an OpenAI compatible /v1/embeddings endpoint, e.g. a local Ollama or llama.cpp server.
+	InstanceEmbeddingSetting_OPENAI InstanceEmbeddingSetting_Provider = 1
+	// HUGGINGFACE is the HuggingFace inference API.
+	InstanceEmbeddingSetting_HUGGINGFACE InstanceEmbeddingSetting_Provider = 2
+	// HASHING is a deterministic local provider hashing words into vectors, mainly for testing.
+	InstanceEmbeddingSetting_HASHING InstanceEmbeddingSetting_Provider = 3
+)
+
+// Enum value maps for InstanceEmbeddingSetting_Provider.
+var (
+	InstanceEmbeddingSetting_Provider_name = map[int32]string{
+		0: "PROVIDER_UNSPECIFIED",
+		1: "OPENAI",
+		2: "HUGGINGFACE",
+		3: "HASHING",
+	}
+	InstanceEmbeddingSetting_Provider_value = map[string]int32{
+		"PROVIDER_UNSPECIFIED": 0,
+		"OPENAI":               1,
+		"HUGGINGFACE":          2,
+		"HASHING":              3,
+	}
+)
+
+func (x InstanceEmbeddingSetting_Provider) Enum() *InstanceEmbeddingSetting_Provider {
+	p := new(InstanceEmbeddingSetting_Provider)
+	*p = x
+	return p
+}
+
+func (x InstanceEmbeddingSetting_Provider) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InstanceEmbeddingSetting_Provider) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_instance_setting_proto_enumTypes[2].Descriptor()
+}
+
+func (InstanceEmbeddingSetting_Provider) Type() protoreflect.EnumType {
+	return &file_store_instance_setting_proto_enumTypes[2]
+}
+
+func (x InstanceEmbeddingSetting_Provider) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InstanceEmbeddingSetting_Provider.Descriptor instead.
+func (InstanceEmbeddingSetting_Provider) EnumDescriptor() ([]byte, []int) {
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{8, 0}
 }
 
 type InstanceSetting struct {
@@ -144,6 +207,8 @@ type InstanceSetting struct {
 	//	*InstanceSetting_GeneralSetting
 	//	*InstanceSetting_StorageSetting
 	//	*InstanceSetting_MemoRelatedSetting
+	//	*InstanceSetting_EmbeddingSetting
+	//	*InstanceSetting_SmtpSetting
 	Value         isInstanceSetting_Value `protobuf_oneof:"value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -229,6 +294,24 @@ func (x *InstanceSetting) GetMemoRelatedSetting() *InstanceMemoRelatedSetting {
 	return nil
 }
 
+func (x *InstanceSetting) GetEmbeddingSetting() *InstanceEmbeddingSetting {
+	if x != nil {
+		if x, ok := x.Value.(*InstanceSetting_EmbeddingSetting); ok {
+			return x.EmbeddingSetting
+		}
+	}
+	return nil
+}
+
+func (x *InstanceSetting) GetSmtpSetting() *InstanceSmtpSetting {
+	if x != nil {
+		if x, ok := x.Value.(*InstanceSetting_SmtpSetting); ok {
+			return x.SmtpSetting
+		}
+	}
+	return nil
+}
+
 type isInstanceSetting_Value interface {
 	isInstanceSetting_Value()
 }
@@ -249,6 +332,14 @@ type InstanceSetting_MemoRelatedSetting struct {
 	MemoRelatedSetting *InstanceMemoRelatedSetting `protobuf:"bytes,5,opt,name=memo_related_setting,json=memoRelatedSetting,proto3,oneof"`
 }
 
+type InstanceSetting_EmbeddingSetting struct {
+	EmbeddingSetting *InstanceEmbeddingSetting `protobuf:"bytes,6,opt,name=embedding_setting,json=embeddingSetting,proto3,oneof"`
+}
+
+type InstanceSetting_SmtpSetting struct {
+	SmtpSetting *InstanceSmtpSetting `protobuf:"bytes,7,opt,name=smtp_setting,json=smtpSetting,proto3,oneof"`
+}
+
 func (*InstanceSetting_BasicSetting) isInstanceSetting_Value() {}
 
 func (*InstanceSetting_GeneralSetting) isInstanceSetting_Value() {}
@@ -257,6 +348,10 @@ func (*InstanceSetting_StorageSetting) isInstanceSetting_Value() {}
 
 func (*InstanceSetting_MemoRelatedSetting) isInstanceSetting_Value() {}
 
+func (*InstanceSetting_EmbeddingSetting) isInstanceSetting_Value() {}
+
+func (*InstanceSetting_SmtpSetting) isInstanceSetting_Value() {}
+
 type InstanceBasicSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The secret key for instance. Mainly used for session management.
@@ -331,8 +426,13 @@ type InstanceGeneralSetting struct {
 	DisallowChangeUsername bool `protobuf:"varint,8,opt,name=disallow_change_username,json=disallowChangeUsername,proto3" json:"disallow_change_username,omitempty"`
 	// disallow_change_nickname disallows changing nickname.
 	DisallowChangeNickname bool `protobuf:"varint,9,opt,name=disallow_change_nickname,json=disallowChangeNickname,proto3" json:"disallow_change_nickname,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+	// require_admin_two_factor requires admins to sign in with two-factor authentication.
+	// Admins without two-factor authentication cannot sign in with a password.
+	RequireAdminTwoFactor bool `protobuf:"varint,10,opt,name=require_admin_two_factor,json=requireAdminTwoFactor,proto3" json:"require_admin_two_factor,omitempty"`
+	// sign_in_limit throttles failed password sign-in attempts.
+	SignInLimit   *InstanceSignInLimit `protobuf:"bytes,11,opt,name=sign_in_limit,json=signInLimit,proto3" json:"sign_in_limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *InstanceGeneralSetting) Reset() {
@@ -421,6 +521,96 @@ func (x *InstanceGeneralSetting) GetDisallowChangeNickname() bool {
 	return false
 }
 
+func (x *InstanceGeneralSetting) GetRequireAdminTwoFactor() bool {
+	if x != nil {
+		return x.RequireAdminTwoFactor
+	}
+	return false
+}
+
+func (x *InstanceGeneralSetting) GetSignInLimit() *InstanceSignInLimit {
+	if x != nil {
+		return x.SignInLimit
+	}
+	return nil
+}
+
+type InstanceSignInLimit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// max_attempts_per_username is the number of failed attempts for a username which locks it out.
+	// 0 uses the default of 5, a negative value disables the limit.
+	MaxAttemptsPerUsername int32 `protobuf:"varint,1,opt,name=max_attempts_per_username,json=maxAttemptsPerUsername,proto3" json:"max_attempts_per_username,omitempty"`
+	// max_attempts_per_ip is the number of failed attempts from a client IP which locks it out.
+	// 0 uses the default of 20, a negative value disables the limit.
+	MaxAttemptsPerIp int32 `protobuf:"varint,2,opt,name=max_attempts_per_ip,json=maxAttemptsPerIp,proto3" json:"max_attempts_per_ip,omitempty"`
+	// window_seconds is the period over which failed attempts are counted.
+	// 0 uses the default of 15 minutes.
+	WindowSeconds int32 `protobuf:"varint,3,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	// lockout_seconds is how long a username or client IP is locked out.
+	// 0 uses the default of 15 minutes.
+	LockoutSeconds int32 `protobuf:"varint,4,opt,name=lockout_seconds,json=lockoutSeconds,proto3" json:"lockout_seconds,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InstanceSignInLimit) Reset() {
+	*x = InstanceSignInLimit{}
+	mi := &file_store_instance_setting_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceSignInLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceSignInLimit) ProtoMessage() {}
+
+func (x *InstanceSignInLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_store_instance_setting_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceSignInLimit.ProtoReflect.Descriptor instead.
+func (*InstanceSignInLimit) Descriptor() ([]byte, []int) {
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *InstanceSignInLimit) GetMaxAttemptsPerUsername() int32 {
+	if x != nil {
+		return x.MaxAttemptsPerUsername
+	}
+	return 0
+}
+
+func (x *InstanceSignInLimit) GetMaxAttemptsPerIp() int32 {
+	if x != nil {
+		return x.MaxAttemptsPerIp
+	}
+	return 0
+}
+
+func (x *InstanceSignInLimit) GetWindowSeconds() int32 {
+	if x != nil {
+		return x.WindowSeconds
+	}
+	return 0
+}
+
+func (x *InstanceSignInLimit) GetLockoutSeconds() int32 {
+	if x != nil {
+		return x.LockoutSeconds
+	}
+	return 0
+}
+
 type InstanceCustomProfile struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
@@ -432,7 +622,7 @@ type InstanceCustomProfile struct {
 
 func (x *InstanceCustomProfile) Reset() {
 	*x = InstanceCustomProfile{}
-	mi := &file_store_instance_setting_proto_msgTypes[3]
+	mi := &file_store_instance_setting_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -444,7 +634,7 @@ func (x *InstanceCustomProfile) String() string {
 func (*InstanceCustomProfile) ProtoMessage() {}
 
 func (x *InstanceCustomProfile) ProtoReflect() protoreflect.Message {
-	mi := &file_store_instance_setting_proto_msgTypes[3]
+	mi := &file_store_instance_setting_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -457,7 +647,7 @@ func (x *InstanceCustomProfile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InstanceCustomProfile.ProtoReflect.Descriptor instead.
 func (*InstanceCustomProfile) Descriptor() ([]byte, []int) {
-	return file_store_instance_setting_proto_rawDescGZIP(), []int{3}
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *InstanceCustomProfile) GetTitle() string {
@@ -498,7 +688,7 @@ type InstanceStorageSetting struct {
 
 func (x *InstanceStorageSetting) Reset() {
 	*x = InstanceStorageSetting{}
-	mi := &file_store_instance_setting_proto_msgTypes[4]
+	mi := &file_store_instance_setting_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -510,7 +700,7 @@ func (x *InstanceStorageSetting) String() string {
 func (*InstanceStorageSetting) ProtoMessage() {}
 
 func (x *InstanceStorageSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_store_instance_setting_proto_msgTypes[4]
+	mi := &file_store_instance_setting_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -523,7 +713,7 @@ func (x *InstanceStorageSetting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InstanceStorageSetting.ProtoReflect.Descriptor instead.
 func (*InstanceStorageSetting) Descriptor() ([]byte, []int) {
-	return file_store_instance_setting_proto_rawDescGZIP(), []int{4}
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *InstanceStorageSetting) GetStorageType() InstanceStorageSetting_StorageType {
@@ -569,7 +759,7 @@ type StorageS3Config struct {
 
 func (x *StorageS3Config) Reset() {
 	*x = StorageS3Config{}
-	mi := &file_store_instance_setting_proto_msgTypes[5]
+	mi := &file_store_instance_setting_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -581,7 +771,7 @@ func (x *StorageS3Config) String() string {
 func (*StorageS3Config) ProtoMessage() {}
 
 func (x *StorageS3Config) ProtoReflect() protoreflect.Message {
-	mi := &file_store_instance_setting_proto_msgTypes[5]
+	mi := &file_store_instance_setting_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -594,7 +784,7 @@ func (x *StorageS3Config) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StorageS3Config.ProtoReflect.Descriptor instead.
 func (*StorageS3Config) Descriptor() ([]byte, []int) {
-	return file_store_instance_setting_proto_rawDescGZIP(), []int{5}
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *StorageS3Config) GetAccessKeyId() string {
@@ -657,7 +847,7 @@ type InstanceMemoRelatedSetting struct {
 
 func (x *InstanceMemoRelatedSetting) Reset() {
 	*x = InstanceMemoRelatedSetting{}
-	mi := &file_store_instance_setting_proto_msgTypes[6]
+	mi := &file_store_instance_setting_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -669,7 +859,7 @@ func (x *InstanceMemoRelatedSetting) String() string {
 func (*InstanceMemoRelatedSetting) ProtoMessage() {}
 
 func (x *InstanceMemoRelatedSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_store_instance_setting_proto_msgTypes[6]
+	mi := &file_store_instance_setting_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -682,7 +872,7 @@ func (x *InstanceMemoRelatedSetting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InstanceMemoRelatedSetting.ProtoReflect.Descriptor instead.
 func (*InstanceMemoRelatedSetting) Descriptor() ([]byte, []int) {
-	return file_store_instance_setting_proto_rawDescGZIP(), []int{6}
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *InstanceMemoRelatedSetting) GetDisallowPublicVisibility() bool {
@@ -720,22 +910,214 @@ func (x *InstanceMemoRelatedSetting) GetReactions() []string {
 	return nil
 }
 
+type InstanceEmbeddingSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// provider is the embedding provider.
+	Provider InstanceEmbeddingSetting_Provider `protobuf:"varint,1,opt,name=provider,proto3,enum=memos.store.InstanceEmbeddingSetting_Provider" json:"provider,omitempty"`
+	// endpoint is the base URL of the provider API, e.g. http://localhost:11434/v1.
+	// Empty uses the default endpoint of the provider.
+	Endpoint string `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// api_key is the key used to authenticate with the provider.
+	ApiKey string `protobuf:"bytes,3,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	// model is the embedding model, empty uses the default model of the provider.
+	Model string `protobuf:"bytes,4,opt,name=model,proto3" json:"model,omitempty"`
+	// dimension is the dimension of the embedding vectors, 0 uses the model default.
+	Dimension     int32 `protobuf:"varint,5,opt,name=dimension,proto3" json:"dimension,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstanceEmbeddingSetting) Reset() {
+	*x = InstanceEmbeddingSetting{}
+	mi := &file_store_instance_setting_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceEmbeddingSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceEmbeddingSetting) ProtoMessage() {}
+
+func (x *InstanceEmbeddingSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_instance_setting_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceEmbeddingSetting.ProtoReflect.Descriptor instead.
+func (*InstanceEmbeddingSetting) Descriptor() ([]byte, []int) {
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *InstanceEmbeddingSetting) GetProvider() InstanceEmbeddingSetting_Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return InstanceEmbeddingSetting_PROVIDER_UNSPECIFIED
+}
+
+func (x *InstanceEmbeddingSetting) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *InstanceEmbeddingSetting) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+func (x *InstanceEmbeddingSetting) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *InstanceEmbeddingSetting) GetDimension() int32 {
+	if x != nil {
+		return x.Dimension
+	}
+	return 0
+}
+
+type InstanceSmtpSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// host is the host of the SMTP server, empty disables emails.
+	Host string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	// port is the port of the SMTP server, e.g. 587 for STARTTLS or 465 for SSL.
+	Port int32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	// username is the username used to authenticate with the SMTP server.
+	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	// password is the password used to authenticate with the SMTP server.
+	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	// from_email is the address emails are sent from.
+	FromEmail string `protobuf:"bytes,5,opt,name=from_email,json=fromEmail,proto3" json:"from_email,omitempty"`
+	// from_name is the display name emails are sent from.
+	FromName string `protobuf:"bytes,6,opt,name=from_name,json=fromName,proto3" json:"from_name,omitempty"`
+	// use_tls enables STARTTLS.
+	UseTls bool `protobuf:"varint,7,opt,name=use_tls,json=useTls,proto3" json:"use_tls,omitempty"`
+	// use_ssl enables implicit SSL/TLS.
+	UseSsl        bool `protobuf:"varint,8,opt,name=use_ssl,json=useSsl,proto3" json:"use_ssl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstanceSmtpSetting) Reset() {
+	*x = InstanceSmtpSetting{}
+	mi := &file_store_instance_setting_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceSmtpSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceSmtpSetting) ProtoMessage() {}
+
+func (x *InstanceSmtpSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_instance_setting_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceSmtpSetting.ProtoReflect.Descriptor instead.
+func (*InstanceSmtpSetting) Descriptor() ([]byte, []int) {
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *InstanceSmtpSetting) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *InstanceSmtpSetting) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *InstanceSmtpSetting) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *InstanceSmtpSetting) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *InstanceSmtpSetting) GetFromEmail() string {
+	if x != nil {
+		return x.FromEmail
+	}
+	return ""
+}
+
+func (x *InstanceSmtpSetting) GetFromName() string {
+	if x != nil {
+		return x.FromName
+	}
+	return ""
+}
+
+func (x *InstanceSmtpSetting) GetUseTls() bool {
+	if x != nil {
+		return x.UseTls
+	}
+	return false
+}
+
+func (x *InstanceSmtpSetting) GetUseSsl() bool {
+	if x != nil {
+		return x.UseSsl
+	}
+	return false
+}
+
 var File_store_instance_setting_proto protoreflect.FileDescriptor
 
 const file_store_instance_setting_proto_rawDesc = "" +
 	"\n" +
-	"\x1cstore/instance_setting.proto\x12\vmemos.store\"\x94\x03\n" +
+	"\x1cstore/instance_setting.proto\x12\vmemos.store\"\xb1\x04\n" +
 	"\x0fInstanceSetting\x121\n" +
 	"\x03key\x18\x01 \x01(\x0e2\x1f.memos.store.InstanceSettingKeyR\x03key\x12H\n" +
 	"\rbasic_setting\x18\x02 \x01(\v2!.memos.store.InstanceBasicSettingH\x00R\fbasicSetting\x12N\n" +
 	"\x0fgeneral_setting\x18\x03 \x01(\v2#.memos.store.InstanceGeneralSettingH\x00R\x0egeneralSetting\x12N\n" +
 	"\x0fstorage_setting\x18\x04 \x01(\v2#.memos.store.InstanceStorageSettingH\x00R\x0estorageSetting\x12[\n" +
-	"\x14memo_related_setting\x18\x05 \x01(\v2'.memos.store.InstanceMemoRelatedSettingH\x00R\x12memoRelatedSettingB\a\n" +
+	"\x14memo_related_setting\x18\x05 \x01(\v2'.memos.store.InstanceMemoRelatedSettingH\x00R\x12memoRelatedSetting\x12T\n" +
+	"\x11embedding_setting\x18\x06 \x01(\v2%.memos.store.InstanceEmbeddingSettingH\x00R\x10embeddingSetting\x12E\n" +
+	"\fsmtp_setting\x18\a \x01(\v2 .memos.store.InstanceSmtpSettingH\x00R\vsmtpSettingB\a\n" +
 	"\x05value\"\\\n" +
 	"\x14InstanceBasicSetting\x12\x1d\n" +
 	"\n" +
 	"secret_key\x18\x01 \x01(\tR\tsecretKey\x12%\n" +
-	"\x0eschema_version\x18\x02 \x01(\tR\rschemaVersion\"\xd6\x03\n" +
+	"\x0eschema_version\x18\x02 \x01(\tR\rschemaVersion\"\xd5\x04\n" +
 	"\x16InstanceGeneralSetting\x12<\n" +
 	"\x1adisallow_user_registration\x18\x02 \x01(\bR\x18disallowUserRegistration\x124\n" +
 	"\x16disallow_password_auth\x18\x03 \x01(\bR\x14disallowPasswordAuth\x12+\n" +
@@ -744,7 +1126,15 @@ const file_store_instance_setting_proto_rawDesc = "" +
 	"\x0ecustom_profile\x18\x06 \x01(\v2\".memos.store.InstanceCustomProfileR\rcustomProfile\x121\n" +
 	"\x15week_start_day_offset\x18\a \x01(\x05R\x12weekStartDayOffset\x128\n" +
 	"\x18disallow_change_username\x18\b \x01(\bR\x16disallowChangeUsername\x128\n" +
-	"\x18disallow_change_nickname\x18\t \x01(\bR\x16disallowChangeNickname\"j\n" +
+	"\x18disallow_change_nickname\x18\t \x01(\bR\x16disallowChangeNickname\x127\n" +
+	"\x18require_admin_two_factor\x18\n" +
+	" \x01(\bR\x15requireAdminTwoFactor\x12D\n" +
+	"\rsign_in_limit\x18\v \x01(\v2 .memos.store.InstanceSignInLimitR\vsignInLimit\"\xcf\x01\n" +
+	"\x13InstanceSignInLimit\x129\n" +
+	"\x19max_attempts_per_username\x18\x01 \x01(\x05R\x16maxAttemptsPerUsername\x12-\n" +
+	"\x13max_attempts_per_ip\x18\x02 \x01(\x05R\x10maxAttemptsPerIp\x12%\n" +
+	"\x0ewindow_seconds\x18\x03 \x01(\x05R\rwindowSeconds\x12'\n" +
+	"\x0flockout_seconds\x18\x04 \x01(\x05R\x0elockoutSeconds\"j\n" +
 	"\x15InstanceCustomProfile\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x19\n" +
@@ -771,13 +1161,37 @@ const file_store_instance_setting_proto_rawDesc = "" +
 	"\x18display_with_update_time\x18\x02 \x01(\bR\x15displayWithUpdateTime\x120\n" +
 	"\x14content_length_limit\x18\x03 \x01(\x05R\x12contentLengthLimit\x127\n" +
 	"\x18enable_double_click_edit\x18\x04 \x01(\bR\x15enableDoubleClickEdit\x12\x1c\n" +
-	"\treactions\x18\a \x03(\tR\treactions*q\n" +
+	"\treactions\x18\a \x03(\tR\treactions\"\x9f\x02\n" +
+	"\x18InstanceEmbeddingSetting\x12J\n" +
+	"\bprovider\x18\x01 \x01(\x0e2..memos.store.InstanceEmbeddingSetting.ProviderR\bprovider\x12\x1a\n" +
+	"\bendpoint\x18\x02 \x01(\tR\bendpoint\x12\x17\n" +
+	"\aapi_key\x18\x03 \x01(\tR\x06apiKey\x12\x14\n" +
+	"\x05model\x18\x04 \x01(\tR\x05model\x12\x1c\n" +
+	"\tdimension\x18\x05 \x01(\x05R\tdimension\"N\n" +
+	"\bProvider\x12\x18\n" +
+	"\x14PROVIDER_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06OPENAI\x10\x01\x12\x0f\n" +
+	"\vHUGGINGFACE\x10\x02\x12\v\n" +
+	"\aHASHING\x10\x03\"\xe3\x01\n" +
+	"\x13InstanceSmtpSetting\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x12\x12\n" +
+	"\x04port\x18\x02 \x01(\x05R\x04port\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x04 \x01(\tR\bpassword\x12\x1d\n" +
+	"\n" +
+	"from_email\x18\x05 \x01(\tR\tfromEmail\x12\x1b\n" +
+	"\tfrom_name\x18\x06 \x01(\tR\bfromName\x12\x17\n" +
+	"\ause_tls\x18\a \x01(\bR\x06useTls\x12\x17\n" +
+	"\ause_ssl\x18\b \x01(\bR\x06useSsl*\x8a\x01\n" +
 	"\x12InstanceSettingKey\x12$\n" +
 	" INSTANCE_SETTING_KEY_UNSPECIFIED\x10\x00\x12\t\n" +
 	"\x05BASIC\x10\x01\x12\v\n" +
 	"\aGENERAL\x10\x02\x12\v\n" +
 	"\aSTORAGE\x10\x03\x12\x10\n" +
-	"\fMEMO_RELATED\x10\x04B\x9f\x01\n" +
+	"\fMEMO_RELATED\x10\x04\x12\r\n" +
+	"\tEMBEDDING\x10\x05\x12\b\n" +
+	"\x04SMTP\x10\x06B\x9f\x01\n" +
 	"\x0fcom.memos.storeB\x14InstanceSettingProtoP\x01Z)github.com/usememos/memos/proto/gen/store\xa2\x02\x03MSX\xaa\x02\vMemos.Store\xca\x02\vMemos\\Store\xe2\x02\x17Memos\\Store\\GPBMetadata\xea\x02\fMemos::Storeb\x06proto3"
 
 var (
@@ -792,33 +1206,41 @@ func file_store_instance_setting_proto_rawDescGZIP() []byte {
 	return file_store_instance_setting_proto_rawDescData
 }
 
-var file_store_instance_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_store_instance_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_store_instance_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_store_instance_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_store_instance_setting_proto_goTypes = []any{
 	(InstanceSettingKey)(0),                 // 0: memos.store.InstanceSettingKey
 	(InstanceStorageSetting_StorageType)(0), // 1: memos.store.InstanceStorageSetting.StorageType
-	(*InstanceSetting)(nil),                 // 2: memos.store.InstanceSetting
-	(*InstanceBasicSetting)(nil),            // 3: memos.store.InstanceBasicSetting
-	(*InstanceGeneralSetting)(nil),          // 4: memos.store.InstanceGeneralSetting
-	(*InstanceCustomProfile)(nil),           // 5: memos.store.InstanceCustomProfile
-	(*InstanceStorageSetting)(nil),          // 6: memos.store.InstanceStorageSetting
-	(*StorageS3Config)(nil),                 // 7: memos.store.StorageS3Config
-	(*InstanceMemoRelatedSetting)(nil),      // 8: memos.store.InstanceMemoRelatedSetting
+	(InstanceEmbeddingSetting_Provider)(0),  // 2: memos.store.InstanceEmbeddingSetting.Provider
+	(*InstanceSetting)(nil),                 // 3: memos.store.InstanceSetting
+	(*InstanceBasicSetting)(nil),            // 4: memos.store.InstanceBasicSetting
+	(*InstanceGeneralSetting)(nil),          // 5: memos.store.InstanceGeneralSetting
+	(*InstanceSignInLimit)(nil),             // 6: memos.store.InstanceSignInLimit
+	(*InstanceCustomProfile)(nil),           // 7: memos.store.InstanceCustomProfile
+	(*InstanceStorageSetting)(nil),          // 8: memos.store.InstanceStorageSetting
+	(*StorageS3Config)(nil),                 // 9: memos.store.StorageS3Config
+	(*InstanceMemoRelatedSetting)(nil),      // 10: memos.store.InstanceMemoRelatedSetting
+	(*InstanceEmbeddingSetting)(nil),        // 11: memos.store.InstanceEmbeddingSetting
+	(*InstanceSmtpSetting)(nil),             // 12: memos.store.InstanceSmtpSetting
 }
 var file_store_instance_setting_proto_depIdxs = []int32{
-	0, // 0: memos.store.InstanceSetting.key:type_name -> memos.store.InstanceSettingKey
-	3, // 1: memos.store.InstanceSetting.basic_setting:type_name -> memos.store.InstanceBasicSetting
-	4, // 2: memos.store.InstanceSetting.general_setting:type_name -> memos.store.InstanceGeneralSetting
-	6, // 3: memos.store.InstanceSetting.storage_setting:type_name -> memos.store.InstanceStorageSetting
-	8, // 4: memos.store.InstanceSetting.memo_related_setting:type_name -> memos.store.InstanceMemoRelatedSetting
-	5, // 5: memos.store.InstanceGeneralSetting.custom_profile:type_name -> memos.store.InstanceCustomProfile
-	1, // 6: memos.store.InstanceStorageSetting.storage_type:type_name -> memos.store.InstanceStorageSetting.StorageType
-	7, // 7: memos.store.InstanceStorageSetting.s3_config:type_name -> memos.store.StorageS3Config
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	0,  // 0: memos.store.InstanceSetting.key:type_name -> memos.store.InstanceSettingKey
+	4,  // 1: memos.store.InstanceSetting.basic_setting:type_name -> memos.store.InstanceBasicSetting
+	5,  // 2: memos.store.InstanceSetting.general_setting:type_name -> memos.store.InstanceGeneralSetting
+	8,  // 3: memos.store.InstanceSetting.storage_setting:type_name -> memos.store.InstanceStorageSetting
+	10, // 4: memos.store.InstanceSetting.memo_related_setting:type_name -> memos.store.InstanceMemoRelatedSetting
+	11, // 5: memos.store.InstanceSetting.embedding_setting:type_name -> memos.store.InstanceEmbeddingSetting
+	12, // 6: memos.store.InstanceSetting.smtp_setting:type_name -> memos.store.InstanceSmtpSetting
+	7,  // 7: memos.store.InstanceGeneralSetting.custom_profile:type_name -> memos.store.InstanceCustomProfile
+	6,  // 8: memos.store.InstanceGeneralSetting.sign_in_limit:type_name -> memos.store.InstanceSignInLimit
+	1,  // 9: memos.store.InstanceStorageSetting.storage_type:type_name -> memos.store.InstanceStorageSetting.StorageType
+	9,  // 10: memos.store.InstanceStorageSetting.s3_config:type_name -> memos.store.StorageS3Config
+	2,  // 11: memos.store.InstanceEmbeddingSetting.provider:type_name -> memos.store.InstanceEmbeddingSetting.Provider
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_store_instance_setting_proto_init() }
@@ -831,14 +1253,16 @@ func file_store_instance_setting_proto_init() {
 		(*InstanceSetting_GeneralSetting)(nil),
 		(*InstanceSetting_StorageSetting)(nil),
 		(*InstanceSetting_MemoRelatedSetting)(nil),
+		(*InstanceSetting_EmbeddingSetting)(nil),
+		(*InstanceSetting_SmtpSetting)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_instance_setting_proto_rawDesc), len(file_store_instance_setting_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   7,
+			NumEnums:      3,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
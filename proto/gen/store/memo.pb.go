@@ -9,6 +9,7 @@ package store
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -22,10 +23,16 @@ const (
 )
 
 type MemoPayload struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Property      *MemoPayload_Property  `protobuf:"bytes,1,opt,name=property,proto3" json:"property,omitempty"`
-	Location      *MemoPayload_Location  `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
-	Tags          []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Property *MemoPayload_Property  `protobuf:"bytes,1,opt,name=property,proto3" json:"property,omitempty"`
+	Location *MemoPayload_Location  `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	Tags     []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	// The publication schedule of a draft memo.
+	Schedule *MemoPayload_Schedule `protobuf:"bytes,4,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// The tasks with a due marker, e.g. "- [ ] ship it @2026-11-01 09:00".
+	DueTasks []*MemoPayload_DueTask `protobuf:"bytes,5,rep,name=due_tasks,json=dueTasks,proto3" json:"due_tasks,omitempty"`
+	// The IDs of the users mentioned with @username.
+	Mentions      []int32 `protobuf:"varint,6,rep,packed,name=mentions,proto3" json:"mentions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -81,6 +88,27 @@ func (x *MemoPayload) GetTags() []string {
 	return nil
 }
 
+func (x *MemoPayload) GetSchedule() *MemoPayload_Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *MemoPayload) GetDueTasks() []*MemoPayload_DueTask {
+	if x != nil {
+		return x.DueTasks
+	}
+	return nil
+}
+
+func (x *MemoPayload) GetMentions() []int32 {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
 // The calculated properties from the memo content.
 type MemoPayload_Property struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
@@ -88,8 +116,12 @@ type MemoPayload_Property struct {
 	HasTaskList        bool                   `protobuf:"varint,2,opt,name=has_task_list,json=hasTaskList,proto3" json:"has_task_list,omitempty"`
 	HasCode            bool                   `protobuf:"varint,3,opt,name=has_code,json=hasCode,proto3" json:"has_code,omitempty"`
 	HasIncompleteTasks bool                   `protobuf:"varint,4,opt,name=has_incomplete_tasks,json=hasIncompleteTasks,proto3" json:"has_incomplete_tasks,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// The earliest due time of the incomplete tasks, in unix seconds.
+	DueTs int64 `protobuf:"varint,5,opt,name=due_ts,json=dueTs,proto3" json:"due_ts,omitempty"`
+	// The earliest due time of the incomplete tasks not reminded of yet, in unix seconds, 0 if none.
+	RemindTs      int64 `protobuf:"varint,6,opt,name=remind_ts,json=remindTs,proto3" json:"remind_ts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MemoPayload_Property) Reset() {
@@ -150,6 +182,145 @@ func (x *MemoPayload_Property) GetHasIncompleteTasks() bool {
 	return false
 }
 
+func (x *MemoPayload_Property) GetDueTs() int64 {
+	if x != nil {
+		return x.DueTs
+	}
+	return 0
+}
+
+func (x *MemoPayload_Property) GetRemindTs() int64 {
+	if x != nil {
+		return x.RemindTs
+	}
+	return 0
+}
+
+type MemoPayload_DueTask struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The text of the task, without the checkbox and the due marker.
+	Content   string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Completed bool   `protobuf:"varint,2,opt,name=completed,proto3" json:"completed,omitempty"`
+	// The due time, evaluated in the creator's timezone.
+	DueTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=due_time,json=dueTime,proto3" json:"due_time,omitempty"`
+	// Whether the creator was reminded of the task coming due.
+	Reminded      bool `protobuf:"varint,4,opt,name=reminded,proto3" json:"reminded,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoPayload_DueTask) Reset() {
+	*x = MemoPayload_DueTask{}
+	mi := &file_store_memo_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoPayload_DueTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoPayload_DueTask) ProtoMessage() {}
+
+func (x *MemoPayload_DueTask) ProtoReflect() protoreflect.Message {
+	mi := &file_store_memo_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoPayload_DueTask.ProtoReflect.Descriptor instead.
+func (*MemoPayload_DueTask) Descriptor() ([]byte, []int) {
+	return file_store_memo_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *MemoPayload_DueTask) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *MemoPayload_DueTask) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+func (x *MemoPayload_DueTask) GetDueTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueTime
+	}
+	return nil
+}
+
+func (x *MemoPayload_DueTask) GetReminded() bool {
+	if x != nil {
+		return x.Reminded
+	}
+	return false
+}
+
+type MemoPayload_Schedule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The time the memo is published at, or the time of the next copy of a recurring memo.
+	PublishTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=publish_time,json=publishTime,proto3" json:"publish_time,omitempty"`
+	// The cron expression of a recurring memo, evaluated in the creator's timezone.
+	Recurrence    string `protobuf:"bytes,2,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoPayload_Schedule) Reset() {
+	*x = MemoPayload_Schedule{}
+	mi := &file_store_memo_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoPayload_Schedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoPayload_Schedule) ProtoMessage() {}
+
+func (x *MemoPayload_Schedule) ProtoReflect() protoreflect.Message {
+	mi := &file_store_memo_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoPayload_Schedule.ProtoReflect.Descriptor instead.
+func (*MemoPayload_Schedule) Descriptor() ([]byte, []int) {
+	return file_store_memo_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *MemoPayload_Schedule) GetPublishTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishTime
+	}
+	return nil
+}
+
+func (x *MemoPayload_Schedule) GetRecurrence() string {
+	if x != nil {
+		return x.Recurrence
+	}
+	return ""
+}
+
 type MemoPayload_Location struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Placeholder   string                 `protobuf:"bytes,1,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
@@ -161,7 +332,7 @@ type MemoPayload_Location struct {
 
 func (x *MemoPayload_Location) Reset() {
 	*x = MemoPayload_Location{}
-	mi := &file_store_memo_proto_msgTypes[2]
+	mi := &file_store_memo_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -173,7 +344,7 @@ func (x *MemoPayload_Location) String() string {
 func (*MemoPayload_Location) ProtoMessage() {}
 
 func (x *MemoPayload_Location) ProtoReflect() protoreflect.Message {
-	mi := &file_store_memo_proto_msgTypes[2]
+	mi := &file_store_memo_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -186,7 +357,7 @@ func (x *MemoPayload_Location) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MemoPayload_Location.ProtoReflect.Descriptor instead.
 func (*MemoPayload_Location) Descriptor() ([]byte, []int) {
-	return file_store_memo_proto_rawDescGZIP(), []int{0, 1}
+	return file_store_memo_proto_rawDescGZIP(), []int{0, 3}
 }
 
 func (x *MemoPayload_Location) GetPlaceholder() string {
@@ -214,16 +385,31 @@ var File_store_memo_proto protoreflect.FileDescriptor
 
 const file_store_memo_proto_rawDesc = "" +
 	"\n" +
-	"\x10store/memo.proto\x12\vmemos.store\"\xa0\x03\n" +
+	"\x10store/memo.proto\x12\vmemos.store\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf0\x06\n" +
 	"\vMemoPayload\x12=\n" +
 	"\bproperty\x18\x01 \x01(\v2!.memos.store.MemoPayload.PropertyR\bproperty\x12=\n" +
 	"\blocation\x18\x02 \x01(\v2!.memos.store.MemoPayload.LocationR\blocation\x12\x12\n" +
-	"\x04tags\x18\x03 \x03(\tR\x04tags\x1a\x96\x01\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\x12=\n" +
+	"\bschedule\x18\x04 \x01(\v2!.memos.store.MemoPayload.ScheduleR\bschedule\x12=\n" +
+	"\tdue_tasks\x18\x05 \x03(\v2 .memos.store.MemoPayload.DueTaskR\bdueTasks\x12\x1a\n" +
+	"\bmentions\x18\x06 \x03(\x05R\bmentions\x1a\xca\x01\n" +
 	"\bProperty\x12\x19\n" +
 	"\bhas_link\x18\x01 \x01(\bR\ahasLink\x12\"\n" +
 	"\rhas_task_list\x18\x02 \x01(\bR\vhasTaskList\x12\x19\n" +
 	"\bhas_code\x18\x03 \x01(\bR\ahasCode\x120\n" +
-	"\x14has_incomplete_tasks\x18\x04 \x01(\bR\x12hasIncompleteTasks\x1af\n" +
+	"\x14has_incomplete_tasks\x18\x04 \x01(\bR\x12hasIncompleteTasks\x12\x15\n" +
+	"\x06due_ts\x18\x05 \x01(\x03R\x05dueTs\x12\x1b\n" +
+	"\tremind_ts\x18\x06 \x01(\x03R\bremindTs\x1a\x94\x01\n" +
+	"\aDueTask\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\tR\acontent\x12\x1c\n" +
+	"\tcompleted\x18\x02 \x01(\bR\tcompleted\x125\n" +
+	"\bdue_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\adueTime\x12\x1a\n" +
+	"\breminded\x18\x04 \x01(\bR\breminded\x1ai\n" +
+	"\bSchedule\x12=\n" +
+	"\fpublish_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vpublishTime\x12\x1e\n" +
+	"\n" +
+	"recurrence\x18\x02 \x01(\tR\n" +
+	"recurrence\x1af\n" +
 	"\bLocation\x12 \n" +
 	"\vplaceholder\x18\x01 \x01(\tR\vplaceholder\x12\x1a\n" +
 	"\blatitude\x18\x02 \x01(\x01R\blatitude\x12\x1c\n" +
@@ -242,20 +428,27 @@ func file_store_memo_proto_rawDescGZIP() []byte {
 	return file_store_memo_proto_rawDescData
 }
 
-var file_store_memo_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_store_memo_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_store_memo_proto_goTypes = []any{
-	(*MemoPayload)(nil),          // 0: memos.store.MemoPayload
-	(*MemoPayload_Property)(nil), // 1: memos.store.MemoPayload.Property
-	(*MemoPayload_Location)(nil), // 2: memos.store.MemoPayload.Location
+	(*MemoPayload)(nil),           // 0: memos.store.MemoPayload
+	(*MemoPayload_Property)(nil),  // 1: memos.store.MemoPayload.Property
+	(*MemoPayload_DueTask)(nil),   // 2: memos.store.MemoPayload.DueTask
+	(*MemoPayload_Schedule)(nil),  // 3: memos.store.MemoPayload.Schedule
+	(*MemoPayload_Location)(nil),  // 4: memos.store.MemoPayload.Location
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
 }
 var file_store_memo_proto_depIdxs = []int32{
 	1, // 0: memos.store.MemoPayload.property:type_name -> memos.store.MemoPayload.Property
-	2, // 1: memos.store.MemoPayload.location:type_name -> memos.store.MemoPayload.Location
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	4, // 1: memos.store.MemoPayload.location:type_name -> memos.store.MemoPayload.Location
+	3, // 2: memos.store.MemoPayload.schedule:type_name -> memos.store.MemoPayload.Schedule
+	2, // 3: memos.store.MemoPayload.due_tasks:type_name -> memos.store.MemoPayload.DueTask
+	5, // 4: memos.store.MemoPayload.DueTask.due_time:type_name -> google.protobuf.Timestamp
+	5, // 5: memos.store.MemoPayload.Schedule.publish_time:type_name -> google.protobuf.Timestamp
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_store_memo_proto_init() }
@@ -269,7 +462,7 @@ func file_store_memo_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_memo_proto_rawDesc), len(file_store_memo_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
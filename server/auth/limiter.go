@@ -0,0 +1,107 @@
+package auth
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/usememos/memos/store/cache"
+)
+
+// LockoutPolicy configures when repeated failed attempts lock a key out.
+type LockoutPolicy struct {
+	// MaxAttempts is the number of failed attempts within the window which locks the key out.
+	// Zero or negative disables the lockout.
+	MaxAttempts int
+	// Window is the period over which failed attempts are counted.
+	Window time.Duration
+	// Lockout is how long the key is locked out once the threshold is reached.
+	Lockout time.Duration
+}
+
+// AttemptLimiter throttles failed attempts, e.g. sign-ins per username and per client IP.
+// The in-memory default only counts the attempts seen by one server, other implementations can share them.
+type AttemptLimiter interface {
+	// Check returns how long the key stays locked out, zero if it may attempt.
+	Check(ctx context.Context, key string) time.Duration
+	// RecordFailure records a failed attempt of the key.
+	// It returns how long the key is locked out by this failure, zero if the threshold is not reached.
+	RecordFailure(ctx context.Context, key string, policy LockoutPolicy) time.Duration
+	// Reset forgets the failed attempts of the key.
+	Reset(ctx context.Context, key string)
+}
+
+// attempts is the state of a key of the memory limiter.
+type attempts struct {
+	failures    int
+	windowStart time.Time
+	lockedUntil time.Time
+}
+
+// MemoryAttemptLimiter is an AttemptLimiter keeping the attempts in a cache.
+type MemoryAttemptLimiter struct {
+	// mutex serializes the read-modify-write of the cached attempts.
+	mutex sync.Mutex
+	cache cache.Interface
+	// now returns the current time, replaced in tests.
+	now func() time.Time
+}
+
+// NewMemoryAttemptLimiter creates an AttemptLimiter keeping the attempts in the cache.
+func NewMemoryAttemptLimiter(c cache.Interface) *MemoryAttemptLimiter {
+	return &MemoryAttemptLimiter{
+		cache: c,
+		now:   time.Now,
+	}
+}
+
+func (l *MemoryAttemptLimiter) Check(ctx context.Context, key string) time.Duration {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	state, ok := l.get(ctx, key)
+	if !ok {
+		return 0
+	}
+	return max(state.lockedUntil.Sub(l.now()), 0)
+}
+
+func (l *MemoryAttemptLimiter) RecordFailure(ctx context.Context, key string, policy LockoutPolicy) time.Duration {
+	if policy.MaxAttempts <= 0 {
+		return 0
+	}
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	now := l.now()
+	state, ok := l.get(ctx, key)
+	if !ok || now.Sub(state.windowStart) >= policy.Window {
+		state = attempts{windowStart: now}
+	}
+	state.failures++
+	if state.failures >= policy.MaxAttempts {
+		state.lockedUntil = now.Add(policy.Lockout)
+		// The attempts are counted again from zero once the lockout expires.
+		state.failures = 0
+		state.windowStart = state.lockedUntil
+	}
+	ttl := max(state.windowStart.Add(policy.Window).Sub(now), state.lockedUntil.Sub(now))
+	l.cache.SetWithTTL(ctx, key, state, ttl)
+	return max(state.lockedUntil.Sub(now), 0)
+}
+
+func (l *MemoryAttemptLimiter) Reset(ctx context.Context, key string) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.cache.Delete(ctx, key)
+}
+
+func (l *MemoryAttemptLimiter) get(ctx context.Context, key string) (attempts, bool) {
+	value, ok := l.cache.Get(ctx, key)
+	if !ok {
+		return attempts{}, false
+	}
+	state, ok := value.(attempts)
+	return state, ok
+}
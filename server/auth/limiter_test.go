@@ -0,0 +1,72 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/usememos/memos/store/cache"
+)
+
+func TestMemoryAttemptLimiter(t *testing.T) {
+	ctx := context.Background()
+	policy := LockoutPolicy{
+		MaxAttempts: 3,
+		Window:      10 * time.Minute,
+		Lockout:     15 * time.Minute,
+	}
+	newLimiter := func() (*MemoryAttemptLimiter, *time.Time) {
+		now := time.Unix(1_800_000_000, 0)
+		limiter := NewMemoryAttemptLimiter(cache.NewDefault())
+		limiter.now = func() time.Time { return now }
+		return limiter, &now
+	}
+
+	t.Run("locks out after max attempts", func(t *testing.T) {
+		limiter, now := newLimiter()
+		assert.Zero(t, limiter.RecordFailure(ctx, "key", policy))
+		assert.Zero(t, limiter.RecordFailure(ctx, "key", policy))
+		assert.Zero(t, limiter.Check(ctx, "key"))
+		assert.Equal(t, 15*time.Minute, limiter.RecordFailure(ctx, "key", policy))
+		assert.Equal(t, 15*time.Minute, limiter.Check(ctx, "key"))
+		assert.Zero(t, limiter.Check(ctx, "other"))
+
+		*now = now.Add(10 * time.Minute)
+		assert.Equal(t, 5*time.Minute, limiter.Check(ctx, "key"))
+		*now = now.Add(5 * time.Minute)
+		assert.Zero(t, limiter.Check(ctx, "key"))
+
+		// Attempts are counted from zero after the lockout.
+		assert.Zero(t, limiter.RecordFailure(ctx, "key", policy))
+		assert.Zero(t, limiter.Check(ctx, "key"))
+	})
+
+	t.Run("attempts expire with the window", func(t *testing.T) {
+		limiter, now := newLimiter()
+		limiter.RecordFailure(ctx, "key", policy)
+		limiter.RecordFailure(ctx, "key", policy)
+		*now = now.Add(11 * time.Minute)
+		assert.Zero(t, limiter.RecordFailure(ctx, "key", policy))
+		assert.Zero(t, limiter.RecordFailure(ctx, "key", policy))
+		assert.Zero(t, limiter.Check(ctx, "key"))
+	})
+
+	t.Run("reset forgets attempts", func(t *testing.T) {
+		limiter, _ := newLimiter()
+		limiter.RecordFailure(ctx, "key", policy)
+		limiter.RecordFailure(ctx, "key", policy)
+		limiter.Reset(ctx, "key")
+		assert.Zero(t, limiter.RecordFailure(ctx, "key", policy))
+		assert.Zero(t, limiter.RecordFailure(ctx, "key", policy))
+	})
+
+	t.Run("disabled policy", func(t *testing.T) {
+		limiter, _ := newLimiter()
+		for range 10 {
+			assert.Zero(t, limiter.RecordFailure(ctx, "key", LockoutPolicy{}))
+		}
+		assert.Zero(t, limiter.Check(ctx, "key"))
+	})
+}
@@ -50,6 +50,12 @@ const (
 
 	// PersonalAccessTokenPrefix is the prefix for PAT tokens.
 	PersonalAccessTokenPrefix = "memos_pat_"
+
+	// TwoFactorChallengeAudienceName is the audience claim for two-factor sign-in challenges.
+	TwoFactorChallengeAudienceName = "user.two-factor-challenge"
+
+	// TwoFactorChallengeDuration is the lifetime of two-factor sign-in challenges (5 minutes).
+	TwoFactorChallengeDuration = 5 * time.Minute
 )
 
 // ClaimsMessage represents the claims structure in a JWT token.
@@ -84,6 +90,13 @@ type RefreshTokenClaims struct {
 	jwt.RegisteredClaims
 }
 
+// TwoFactorChallengeClaims contains claims for two-factor sign-in challenges.
+// A challenge proves the password was verified, and is exchanged for tokens with a second factor.
+type TwoFactorChallengeClaims struct {
+	Type string `json:"type"` // "two_factor"
+	jwt.RegisteredClaims
+}
+
 // GenerateAccessToken generates a JWT access token for a user.
 //
 // Parameters:
@@ -186,6 +199,34 @@ func GenerateRefreshToken(userID int32, tokenID string, secret []byte) (string,
 	return tokenString, expiresAt, nil
 }
 
+// GenerateTwoFactorChallenge generates a short-lived two-factor sign-in challenge.
+// The challenge ID is stored in the "jti" claim so that attempts can be tracked per challenge.
+func GenerateTwoFactorChallenge(userID int32, challengeID string, secret []byte) (string, time.Time, error) {
+	expiresAt := time.Now().Add(TwoFactorChallengeDuration)
+
+	claims := &TwoFactorChallengeClaims{
+		Type: "two_factor",
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    Issuer,
+			Audience:  jwt.ClaimStrings{TwoFactorChallengeAudienceName},
+			Subject:   fmt.Sprint(userID),
+			ID:        challengeID,
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	token.Header["kid"] = KeyID
+
+	tokenString, err := token.SignedString(secret)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	return tokenString, expiresAt, nil
+}
+
 // GeneratePersonalAccessToken generates a random PAT string.
 func GeneratePersonalAccessToken() string {
 	randomStr, err := util.RandomString(32)
@@ -247,3 +288,19 @@ func ParseRefreshToken(tokenString string, secret []byte) (*RefreshTokenClaims,
 	}
 	return claims, nil
 }
+
+// ParseTwoFactorChallenge parses and validates a two-factor sign-in challenge.
+func ParseTwoFactorChallenge(tokenString string, secret []byte) (*TwoFactorChallengeClaims, error) {
+	claims := &TwoFactorChallengeClaims{}
+	_, err := jwt.ParseWithClaims(tokenString, claims, verifyJWTKeyFunc(secret),
+		jwt.WithIssuer(Issuer),
+		jwt.WithAudience(TwoFactorChallengeAudienceName),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Type != "two_factor" {
+		return nil, errors.New("invalid token type: expected two-factor challenge")
+	}
+	return claims, nil
+}
@@ -147,6 +147,48 @@ func TestParseRefreshToken(t *testing.T) {
 	})
 }
 
+func TestParseTwoFactorChallenge(t *testing.T) {
+	secret := []byte("test-secret")
+
+	t.Run("parses valid challenge", func(t *testing.T) {
+		token, expiresAt, err := GenerateTwoFactorChallenge(1, "challenge-id-123", secret)
+		require.NoError(t, err)
+		assert.True(t, expiresAt.After(time.Now()))
+		assert.True(t, expiresAt.Before(time.Now().Add(TwoFactorChallengeDuration+time.Minute)))
+
+		claims, err := ParseTwoFactorChallenge(token, secret)
+		require.NoError(t, err)
+		assert.Equal(t, "1", claims.Subject)
+		assert.Equal(t, "challenge-id-123", claims.ID)
+		assert.Equal(t, "two_factor", claims.Type)
+	})
+
+	t.Run("fails with wrong secret", func(t *testing.T) {
+		token, _, err := GenerateTwoFactorChallenge(1, "challenge-id-123", secret)
+		require.NoError(t, err)
+
+		_, err = ParseTwoFactorChallenge(token, []byte("wrong-secret"))
+		assert.Error(t, err)
+	})
+
+	t.Run("is not accepted as access token", func(t *testing.T) {
+		token, _, err := GenerateTwoFactorChallenge(1, "challenge-id-123", secret)
+		require.NoError(t, err)
+
+		_, err = ParseAccessTokenV2(token, secret)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid audience")
+	})
+
+	t.Run("fails with access token", func(t *testing.T) {
+		accessToken, _, err := GenerateAccessTokenV2(1, "testuser", "USER", "ACTIVE", secret)
+		require.NoError(t, err)
+
+		_, err = ParseTwoFactorChallenge(accessToken, secret)
+		assert.Error(t, err)
+	})
+}
+
 func TestGeneratePersonalAccessToken(t *testing.T) {
 	t.Run("generates token with correct prefix", func(t *testing.T) {
 		token := GeneratePersonalAccessToken()
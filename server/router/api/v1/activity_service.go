@@ -1,36 +1,47 @@
 package v1
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"log/slog"
 	"time"
 
+	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/usememos/memos/plugin/filter"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
 )
 
+// defaultActivityPageSize is the page size of ListActivities and ListAuditEvents if unspecified.
+const defaultActivityPageSize = 100
+
+// maxAuditEventExportSize is the maximum number of audit events of an export, which is built in memory.
+const maxAuditEventExportSize = 10000
+
 func (s *APIV1Service) ListActivities(ctx context.Context, request *v1pb.ListActivitiesRequest) (*v1pb.ListActivitiesResponse, error) {
-	// Set default page size if not specified
-	pageSize := request.PageSize
-	if pageSize <= 0 || pageSize > 1000 {
-		pageSize = 100
+	currentUser, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
 	}
 
-	// TODO: Implement pagination with page_token and use pageSize for limiting
-	// For now, we'll fetch all activities and the pageSize will be used in future pagination implementation
-	_ = pageSize // Acknowledge pageSize variable to avoid linter warning
-
-	activities, err := s.Store.ListActivities(ctx, &store.FindActivity{})
+	activityFind := &store.FindActivity{}
+	applyActivityVisibility(activityFind, currentUser)
+	activities, nextPageToken, err := s.listActivityPage(ctx, activityFind, request.PageSize, request.PageToken, request.Filter)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list activities: %v", err)
+		return nil, err
 	}
 
-	var activityMessages []*v1pb.Activity
+	activityMessages := []*v1pb.Activity{}
 	for _, activity := range activities {
 		activityMessage, err := s.convertActivityFromStore(ctx, activity)
 		if err != nil {
@@ -40,8 +51,8 @@ func (s *APIV1Service) ListActivities(ctx context.Context, request *v1pb.ListAct
 	}
 
 	return &v1pb.ListActivitiesResponse{
-		Activities: activityMessages,
-		// TODO: Implement next_page_token for pagination
+		Activities:    activityMessages,
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
@@ -56,6 +67,27 @@ func (s *APIV1Service) GetActivity(ctx context.Context, request *v1pb.GetActivit
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get activity: %v", err)
 	}
+	if activity == nil {
+		return nil, status.Errorf(codes.NotFound, "activity not found")
+	}
+	currentUser, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if !isSuperUser(currentUser) {
+		activityFind := &store.FindActivity{ID: &activityID}
+		applyActivityVisibility(activityFind, currentUser)
+		visibleActivity, err := s.Store.GetActivity(ctx, activityFind)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get activity: %v", err)
+		}
+		if visibleActivity == nil {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		}
+	}
 
 	activityMessage, err := s.convertActivityFromStore(ctx, activity)
 	if err != nil {
@@ -64,6 +96,181 @@ func (s *APIV1Service) GetActivity(ctx context.Context, request *v1pb.GetActivit
 	return activityMessage, nil
 }
 
+func (s *APIV1Service) ListAuditEvents(ctx context.Context, request *v1pb.ListAuditEventsRequest) (*v1pb.ListAuditEventsResponse, error) {
+	if err := s.checkAuditEventsPermission(ctx); err != nil {
+		return nil, err
+	}
+
+	activities, nextPageToken, err := s.listActivityPage(ctx, &store.FindActivity{TypeList: store.AuditActivityTypes}, request.PageSize, request.PageToken, request.Filter)
+	if err != nil {
+		return nil, err
+	}
+	auditEvents := []*v1pb.Activity{}
+	for _, activity := range activities {
+		auditEvent, err := s.convertActivityFromStore(ctx, activity)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert activity from store: %v", err)
+		}
+		auditEvents = append(auditEvents, auditEvent)
+	}
+	return &v1pb.ListAuditEventsResponse{
+		AuditEvents:   auditEvents,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *APIV1Service) ExportAuditEvents(ctx context.Context, request *v1pb.ExportAuditEventsRequest) (*v1pb.ExportAuditEventsResponse, error) {
+	if err := s.checkAuditEventsPermission(ctx); err != nil {
+		return nil, err
+	}
+
+	var content bytes.Buffer
+	pageToken := request.PageToken
+	for exported := 0; ; {
+		activities, nextPageToken, err := s.listActivityPage(ctx, &store.FindActivity{TypeList: store.AuditActivityTypes}, MaxPageSize, pageToken, request.Filter)
+		if err != nil {
+			return nil, err
+		}
+		exported += len(activities)
+		for _, activity := range activities {
+			auditEvent, err := s.convertActivityFromStore(ctx, activity)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to convert activity from store: %v", err)
+			}
+			line, err := protojson.Marshal(auditEvent)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to marshal audit event: %v", err)
+			}
+			content.Write(line)
+			content.WriteByte('\n')
+		}
+		pageToken = nextPageToken
+		if pageToken == "" || exported >= maxAuditEventExportSize {
+			break
+		}
+	}
+	return &v1pb.ExportAuditEventsResponse{
+		Content:       content.Bytes(),
+		NextPageToken: pageToken,
+	}, nil
+}
+
+func (s *APIV1Service) checkAuditEventsPermission(ctx context.Context) error {
+	currentUser, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if !isSuperUser(currentUser) {
+		return status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return nil
+}
+
+// listActivityPage lists a page of the activities matching the find and the filter, from the newest.
+func (s *APIV1Service) listActivityPage(ctx context.Context, activityFind *store.FindActivity, pageSize int32, pageToken string, filterStr string) ([]*store.Activity, string, error) {
+	if filterStr != "" {
+		if err := s.validateActivityFilter(ctx, filterStr); err != nil {
+			return nil, "", status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+		}
+		activityFind.Filters = append(activityFind.Filters, filterStr)
+	}
+
+	limit := int(pageSize)
+	if pageToken != "" {
+		var token v1pb.PageToken
+		if err := unmarshalPageToken(pageToken, &token); err != nil {
+			return nil, "", status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		if token.Cursor == nil {
+			return nil, "", status.Errorf(codes.InvalidArgument, "invalid page token")
+		}
+		limit = int(token.Limit)
+		activityFind.Cursor = &store.ActivityCursor{
+			CreatedTs: token.Cursor.CreatedTs,
+			ID:        token.Cursor.Id,
+		}
+	}
+	if limit <= 0 {
+		limit = defaultActivityPageSize
+	}
+	limit = min(limit, MaxPageSize)
+	limitPlusOne := limit + 1
+	activityFind.Limit = &limitPlusOne
+	activities, err := s.Store.ListActivities(ctx, activityFind)
+	if err != nil {
+		return nil, "", status.Errorf(codes.Internal, "failed to list activities: %v", err)
+	}
+
+	nextPageToken := ""
+	if len(activities) == limitPlusOne {
+		activities = activities[:limit]
+		last := activities[limit-1]
+		nextPageToken, err = marshalPageToken(&v1pb.PageToken{
+			Limit: int32(limit),
+			Cursor: &v1pb.PageToken_Cursor{
+				CreatedTs: last.CreatedTs,
+				Id:        last.ID,
+			},
+		})
+		if err != nil {
+			return nil, "", status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
+		}
+	}
+	return activities, nextPageToken, nil
+}
+
+// recordAuditEvent records an audit event caused by the user, with the client of the request.
+// Failing to record it does not fail the request.
+func (s *APIV1Service) recordAuditEvent(ctx context.Context, creatorID int32, activityType store.ActivityType, payload *storepb.ActivityAuditPayload) {
+	clientInfo := s.extractClientInfo(ctx)
+	payload.IpAddress = clientInfo.IpAddress
+	payload.UserAgent = clientInfo.UserAgent
+	if _, err := s.Store.CreateActivity(ctx, &store.Activity{
+		CreatorID: creatorID,
+		Type:      activityType,
+		Level:     store.ActivityLevelInfo,
+		Payload:   &storepb.ActivityPayload{Audit: payload},
+	}); err != nil {
+		slog.Warn("failed to create audit activity", slog.String("type", activityType.String()), slog.Any("err", err))
+	}
+}
+
+// applyActivityVisibility limits the activities to those the user can view.
+// Admins view all activities, other users view the activities they are party to.
+// Audit events, failed sign-ins included, reveal client addresses, so only admins can view them.
+func applyActivityVisibility(activityFind *store.FindActivity, user *store.User) {
+	if isSuperUser(user) {
+		return
+	}
+	activityFind.PartyID = &user.ID
+	activityFind.ExcludeTypes = append(activityFind.ExcludeTypes, store.AuditActivityTypes...)
+}
+
+func (s *APIV1Service) validateActivityFilter(ctx context.Context, filterStr string) error {
+	engine, err := filter.DefaultActivityEngine()
+	if err != nil {
+		return err
+	}
+
+	var dialect filter.DialectName
+	switch s.Profile.Driver {
+	case "mysql":
+		dialect = filter.DialectMySQL
+	case "postgres":
+		dialect = filter.DialectPostgres
+	default:
+		dialect = filter.DialectSQLite
+	}
+
+	if _, err := engine.CompileToStatement(ctx, filterStr, filter.RenderOptions{Dialect: dialect}); err != nil {
+		return errors.Wrap(err, "failed to compile filter")
+	}
+	return nil
+}
+
 // convertActivityFromStore converts a storage-layer activity to an API activity.
 // This handles the mapping between internal activity representation and the public API,
 // including proper type and level conversions.
@@ -73,27 +280,28 @@ func (s *APIV1Service) convertActivityFromStore(ctx context.Context, activity *s
 		return nil, status.Errorf(codes.Internal, "failed to convert activity payload from store: %v", err)
 	}
 
-	// Convert store activity type to proto enum
-	var activityType v1pb.Activity_Type
-	switch activity.Type {
-	case store.ActivityTypeMemoComment:
-		activityType = v1pb.Activity_MEMO_COMMENT
-	default:
-		activityType = v1pb.Activity_TYPE_UNSPECIFIED
-	}
+	// Store activity types are named after the proto enum values, unknown types are unspecified.
+	activityType := v1pb.Activity_Type(v1pb.Activity_Type_value[activity.Type.String()])
 
 	// Convert store activity level to proto enum
 	var activityLevel v1pb.Activity_Level
 	switch activity.Level {
 	case store.ActivityLevelInfo:
 		activityLevel = v1pb.Activity_INFO
+	case store.ActivityLevelWarn:
+		activityLevel = v1pb.Activity_WARN
 	default:
 		activityLevel = v1pb.Activity_LEVEL_UNSPECIFIED
 	}
 
+	// Failed sign-ins with unknown usernames have no creator.
+	creator := ""
+	if activity.CreatorID != 0 {
+		creator = fmt.Sprintf("%s%d", UserNamePrefix, activity.CreatorID)
+	}
 	return &v1pb.Activity{
 		Name:       fmt.Sprintf("%s%d", ActivityNamePrefix, activity.ID),
-		Creator:    fmt.Sprintf("%s%d", UserNamePrefix, activity.CreatorID),
+		Creator:    creator,
 		Type:       activityType,
 		Level:      activityLevel,
 		CreateTime: timestamppb.New(time.Unix(activity.CreatedTs, 0)),
@@ -114,9 +322,6 @@ func (s *APIV1Service) convertActivityPayloadFromStore(ctx context.Context, payl
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
 		}
-		if memo == nil {
-			return nil, status.Errorf(codes.NotFound, "memo does not exist")
-		}
 
 		// Fetch the related memo (the one being commented on)
 		relatedMemo, err := s.Store.GetMemo(ctx, &store.FindMemo{
@@ -127,10 +332,75 @@ func (s *APIV1Service) convertActivityPayloadFromStore(ctx context.Context, payl
 			return nil, status.Errorf(codes.Internal, "failed to get related memo: %v", err)
 		}
 
+		memoComment := &v1pb.ActivityMemoCommentPayload{}
+		// The activity outlives the memos, which are left out once deleted.
+		if memo != nil {
+			memoComment.Memo = fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID)
+		}
+		if relatedMemo != nil {
+			memoComment.RelatedMemo = fmt.Sprintf("%s%s", MemoNamePrefix, relatedMemo.UID)
+		}
 		v2Payload.Payload = &v1pb.ActivityPayload_MemoComment{
-			MemoComment: &v1pb.ActivityMemoCommentPayload{
-				Memo:        fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
-				RelatedMemo: fmt.Sprintf("%s%s", MemoNamePrefix, relatedMemo.UID),
+			MemoComment: memoComment,
+		}
+	}
+	if payload.TaskDue != nil {
+		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
+			ID:             &payload.TaskDue.MemoId,
+			ExcludeContent: true,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+		}
+
+		taskDue := &v1pb.ActivityTaskDuePayload{
+			Content: payload.TaskDue.Content,
+			DueTime: payload.TaskDue.DueTime,
+		}
+		// The activity outlives the memo, which is left out once deleted.
+		if memo != nil {
+			taskDue.Memo = fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID)
+		}
+		v2Payload.Payload = &v1pb.ActivityPayload_TaskDue{
+			TaskDue: taskDue,
+		}
+	}
+	if payload.MemoMention != nil {
+		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
+			ID:             &payload.MemoMention.MemoId,
+			ExcludeContent: true,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+		}
+
+		memoMention := &v1pb.ActivityMemoMentionPayload{}
+		// The activity outlives the memo, which is left out once deleted.
+		if memo != nil {
+			memoMention.Memo = fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID)
+		}
+		v2Payload.Payload = &v1pb.ActivityPayload_MemoMention{
+			MemoMention: memoMention,
+		}
+	}
+	if payload.SignInFailed != nil {
+		v2Payload.Payload = &v1pb.ActivityPayload_SignInFailed{
+			SignInFailed: &v1pb.ActivitySignInFailedPayload{
+				Username:  payload.SignInFailed.Username,
+				IpAddress: payload.SignInFailed.IpAddress,
+				UserAgent: payload.SignInFailed.UserAgent,
+				Reason:    payload.SignInFailed.Reason,
+			},
+		}
+	}
+	if payload.Audit != nil {
+		v2Payload.Payload = &v1pb.ActivityPayload_Audit{
+			Audit: &v1pb.ActivityAuditPayload{
+				Resource:  payload.Audit.Resource,
+				IpAddress: payload.Audit.IpAddress,
+				UserAgent: payload.Audit.UserAgent,
+				OldValue:  payload.Audit.OldValue,
+				NewValue:  payload.Audit.NewValue,
 			},
 		}
 	}
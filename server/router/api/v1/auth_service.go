@@ -18,7 +18,9 @@ import (
 
 	"github.com/usememos/memos/internal/util"
 	"github.com/usememos/memos/plugin/idp"
+	"github.com/usememos/memos/plugin/idp/ldap"
 	"github.com/usememos/memos/plugin/idp/oauth2"
+	"github.com/usememos/memos/plugin/idp/oidc"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/server/auth"
@@ -27,6 +29,15 @@ import (
 
 const (
 	unmatchedUsernameAndPasswordError = "unmatched username and password"
+
+	// defaultSignInMaxAttemptsPerUsername is the number of failed attempts which locks out a username by default.
+	defaultSignInMaxAttemptsPerUsername = 5
+	// defaultSignInMaxAttemptsPerIP is the number of failed attempts which locks out a client IP by default.
+	defaultSignInMaxAttemptsPerIP = 20
+	// defaultSignInLimitWindow is the default period over which failed sign-in attempts are counted.
+	defaultSignInLimitWindow = 15 * time.Minute
+	// defaultSignInLockout is how long a username or client IP is locked out by default.
+	defaultSignInLockout = 15 * time.Minute
 )
 
 // GetCurrentUser returns the authenticated user's information.
@@ -55,41 +66,71 @@ func (s *APIV1Service) GetCurrentUser(ctx context.Context, _ *v1pb.GetCurrentUse
 // SignIn authenticates a user with credentials and returns tokens.
 // On success, returns an access token and sets a refresh token cookie.
 //
-// Supports two authentication methods:
-// 1. Password-based authentication (username + password).
-// 2. SSO authentication (OAuth2 authorization code).
+// Supports three authentication methods:
+// 1. Password-based authentication (username + password), with the local or a directory (LDAP) password.
+// 2. SSO authentication (OAuth2 or OpenID Connect authorization code).
+// 3. Two-factor authentication, the second step of a password sign-in.
+//
+// If the user enabled two-factor authentication, a successful password sign-in
+// returns a short-lived challenge instead of tokens, to be sent back with a code.
 //
 // Authentication: Not required (public endpoint).
 // Returns: User info, access token, and token expiry.
 func (s *APIV1Service) SignIn(ctx context.Context, request *v1pb.SignInRequest) (*v1pb.SignInResponse, error) {
 	var existingUser *store.User
+	requireTwoFactor := false
+	// limitedUsername is the username whose failed attempts are forgotten once signed in.
+	limitedUsername := ""
 
 	// Authentication Method 1: Password-based authentication
 	if passwordCredentials := request.GetPasswordCredentials(); passwordCredentials != nil {
+		// Locked out usernames and clients are rejected before comparing passwords.
+		if err := s.checkSignInLimit(ctx, passwordCredentials.Username); err != nil {
+			return nil, err
+		}
 		user, err := s.Store.GetUser(ctx, &store.FindUser{
 			Username: &passwordCredentials.Username,
 		})
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get user, error: %v", err)
 		}
-		if user == nil {
-			return nil, status.Errorf(codes.InvalidArgument, unmatchedUsernameAndPasswordError)
-		}
 		// Compare the stored hashed password, with the hashed version of the password that was received.
-		if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(passwordCredentials.Password)); err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, unmatchedUsernameAndPasswordError)
-		}
+		passwordMatched := user != nil && bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(passwordCredentials.Password)) == nil
 		instanceGeneralSetting, err := s.Store.GetInstanceGeneralSetting(ctx)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get instance general setting, error: %v", err)
 		}
-		// Check if the password auth in is allowed.
-		if instanceGeneralSetting.DisallowPasswordAuth && user.Role == store.RoleUser {
+		if !passwordMatched {
+			// Otherwise the password may be the one of a directory user.
+			ldapUser, err := s.signInWithLDAP(ctx, passwordCredentials.Username, passwordCredentials.Password)
+			if err != nil {
+				return nil, err
+			}
+			if ldapUser == nil {
+				if user == nil {
+					s.recordSignInFailure(ctx, 0, passwordCredentials.Username, "unknown username")
+				} else {
+					s.recordSignInFailure(ctx, user.ID, user.Username, "invalid password")
+				}
+				return nil, status.Errorf(codes.InvalidArgument, unmatchedUsernameAndPasswordError)
+			}
+			user = ldapUser
+		} else if instanceGeneralSetting.DisallowPasswordAuth && user.Role == store.RoleUser {
+			// Check if the password auth in is allowed. Directory passwords are allowed like other identity providers.
 			return nil, status.Errorf(codes.PermissionDenied, "password signin is not allowed")
 		}
+		twoFactorSetting, err := s.Store.GetUserTwoFactorSetting(ctx, user.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get two-factor setting, error: %v", err)
+		}
+		if !twoFactorSetting.Enabled && user.Role == store.RoleAdmin && instanceGeneralSetting.RequireAdminTwoFactor {
+			return nil, status.Errorf(codes.PermissionDenied, "two-factor authentication is required for admins")
+		}
+		requireTwoFactor = twoFactorSetting.Enabled
 		existingUser = user
+		limitedUsername = user.Username
 	} else if ssoCredentials := request.GetSsoCredentials(); ssoCredentials != nil {
-		// Authentication Method 2: SSO (OAuth2) authentication
+		// Authentication Method 2: SSO (OAuth2 or OpenID Connect) authentication
 		identityProvider, err := s.Store.GetIdentityProvider(ctx, &store.FindIdentityProvider{
 			ID: &ssoCredentials.IdpId,
 		})
@@ -101,6 +142,8 @@ func (s *APIV1Service) SignIn(ctx context.Context, request *v1pb.SignInRequest)
 		}
 
 		var userInfo *idp.IdentityProviderUserInfo
+		// ssoRole is the role mapped from the identity provider, nil if the provider does not manage roles.
+		var ssoRole *store.Role
 		if identityProvider.Type == storepb.IdentityProvider_OAUTH2 {
 			oauth2IdentityProvider, err := oauth2.NewIdentityProvider(identityProvider.Config.GetOauth2Config())
 			if err != nil {
@@ -115,59 +158,46 @@ func (s *APIV1Service) SignIn(ctx context.Context, request *v1pb.SignInRequest)
 			if err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to get user info, error: %v", err)
 			}
-		}
-
-		identifierFilter := identityProvider.IdentifierFilter
-		if identifierFilter != "" {
-			identifierFilterRegex, err := regexp.Compile(identifierFilter)
+		} else if identityProvider.Type == storepb.IdentityProvider_OIDC {
+			oidcConfig := identityProvider.Config.GetOidcConfig()
+			oidcIdentityProvider, err := oidc.NewIdentityProvider(oidcConfig)
 			if err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to compile identifier filter regex, error: %v", err)
-			}
-			if !identifierFilterRegex.MatchString(userInfo.Identifier) {
-				return nil, status.Errorf(codes.PermissionDenied, "identifier %s is not allowed", userInfo.Identifier)
+				return nil, status.Errorf(codes.Internal, "failed to create oidc identity provider, error: %v", err)
 			}
-		}
-
-		user, err := s.Store.GetUser(ctx, &store.FindUser{
-			Username: &userInfo.Identifier,
-		})
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to get user, error: %v", err)
-		}
-		if user == nil {
-			// Check if the user is allowed to sign up.
-			instanceGeneralSetting, err := s.Store.GetInstanceGeneralSetting(ctx)
+			idToken, err := oidcIdentityProvider.ExchangeToken(ctx, ssoCredentials.RedirectUri, ssoCredentials.Code, ssoCredentials.CodeVerifier)
 			if err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to get instance general setting, error: %v", err)
-			}
-			if instanceGeneralSetting.DisallowUserRegistration {
-				return nil, status.Errorf(codes.PermissionDenied, "user registration is not allowed")
-			}
-
-			// Create a new user with the user info from the identity provider.
-			userCreate := &store.User{
-				Username: userInfo.Identifier,
-				// The new signup user should be normal user by default.
-				Role:      store.RoleUser,
-				Nickname:  userInfo.DisplayName,
-				Email:     userInfo.Email,
-				AvatarURL: userInfo.AvatarURL,
+				return nil, status.Errorf(codes.Internal, "failed to exchange token, error: %v", err)
 			}
-			password, err := util.RandomString(20)
+			userInfo, err = oidcIdentityProvider.UserInfo(ctx, idToken, ssoCredentials.Nonce)
 			if err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to generate random password, error: %v", err)
+				return nil, status.Errorf(codes.Unauthenticated, "failed to verify id token, error: %v", err)
 			}
-			passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
-			if err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to generate password hash, error: %v", err)
-			}
-			userCreate.PasswordHash = string(passwordHash)
-			user, err = s.Store.CreateUser(ctx, userCreate)
-			if err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to create user, error: %v", err)
+			// The role follows the groups of the user if the provider is configured with a groups claim.
+			if oidcConfig.GroupsClaim != "" {
+				role := store.RoleUser
+				if oidcIdentityProvider.IsAdmin(userInfo) {
+					role = store.RoleAdmin
+				}
+				ssoRole = &role
 			}
 		}
+		if userInfo == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported identity provider type %s", identityProvider.Type)
+		}
+
+		user, err := s.findOrCreateIdentityProviderUser(ctx, identityProvider, userInfo, ssoRole)
+		if err != nil {
+			return nil, err
+		}
 		existingUser = user
+	} else if twoFactorCredentials := request.GetTwoFactorCredentials(); twoFactorCredentials != nil {
+		// Authentication Method 3: Second factor of a password sign-in
+		user, err := s.verifyTwoFactorChallenge(ctx, twoFactorCredentials)
+		if err != nil {
+			return nil, err
+		}
+		existingUser = user
+		limitedUsername = user.Username
 	}
 
 	if existingUser == nil {
@@ -177,10 +207,20 @@ func (s *APIV1Service) SignIn(ctx context.Context, request *v1pb.SignInRequest)
 		return nil, status.Errorf(codes.PermissionDenied, "user has been archived with username %s", existingUser.Username)
 	}
 
+	if requireTwoFactor {
+		return s.createTwoFactorChallenge(existingUser)
+	}
+	if limitedUsername != "" {
+		s.SignInLimiter.Reset(ctx, signInUsernameLimitKey(limitedUsername))
+	}
+
 	accessToken, accessExpiresAt, err := s.doSignIn(ctx, existingUser)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to sign in: %v", err)
 	}
+	s.recordAuditEvent(ctx, existingUser.ID, store.ActivityTypeSignIn, &storepb.ActivityAuditPayload{
+		Resource: fmt.Sprintf("%s%d", UserNamePrefix, existingUser.ID),
+	})
 
 	return &v1pb.SignInResponse{
 		User:                 convertUserFromStore(existingUser),
@@ -189,6 +229,198 @@ func (s *APIV1Service) SignIn(ctx context.Context, request *v1pb.SignInRequest)
 	}, nil
 }
 
+// findOrCreateIdentityProviderUser returns the user with the identifier of the identity provider,
+// creating it if the user registration is allowed. The identifier must match the identifier filter of the provider.
+// If role is not nil, the role of the user is synchronized with it.
+func (s *APIV1Service) findOrCreateIdentityProviderUser(ctx context.Context, identityProvider *storepb.IdentityProvider, userInfo *idp.IdentityProviderUserInfo, role *store.Role) (*store.User, error) {
+	identifierFilter := identityProvider.IdentifierFilter
+	if identifierFilter != "" {
+		identifierFilterRegex, err := regexp.Compile(identifierFilter)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to compile identifier filter regex, error: %v", err)
+		}
+		if !identifierFilterRegex.MatchString(userInfo.Identifier) {
+			return nil, status.Errorf(codes.PermissionDenied, "identifier %s is not allowed", userInfo.Identifier)
+		}
+	}
+
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		Username: &userInfo.Identifier,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user, error: %v", err)
+	}
+	if user == nil {
+		// Check if the user is allowed to sign up.
+		instanceGeneralSetting, err := s.Store.GetInstanceGeneralSetting(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get instance general setting, error: %v", err)
+		}
+		if instanceGeneralSetting.DisallowUserRegistration {
+			return nil, status.Errorf(codes.PermissionDenied, "user registration is not allowed")
+		}
+
+		// Create a new user with the user info from the identity provider.
+		userCreate := &store.User{
+			Username: userInfo.Identifier,
+			// The new signup user should be normal user by default.
+			Role:      store.RoleUser,
+			Nickname:  userInfo.DisplayName,
+			Email:     userInfo.Email,
+			AvatarURL: userInfo.AvatarURL,
+		}
+		password, err := util.RandomString(20)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to generate random password, error: %v", err)
+		}
+		passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to generate password hash, error: %v", err)
+		}
+		userCreate.PasswordHash = string(passwordHash)
+		if role != nil {
+			userCreate.Role = *role
+		}
+		user, err = s.Store.CreateUser(ctx, userCreate)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create user, error: %v", err)
+		}
+		s.recordAuditEvent(ctx, user.ID, store.ActivityTypeUserCreated, &storepb.ActivityAuditPayload{
+			Resource: fmt.Sprintf("%s%d", UserNamePrefix, user.ID),
+			NewValue: user.Role.String(),
+		})
+	} else if role != nil && user.Role != *role {
+		oldRole := user.Role
+		user, err = s.Store.UpdateUser(ctx, &store.UpdateUser{
+			ID:   user.ID,
+			Role: role,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to update user role, error: %v", err)
+		}
+		s.recordAuditEvent(ctx, user.ID, store.ActivityTypeUserRoleChanged, &storepb.ActivityAuditPayload{
+			Resource: fmt.Sprintf("%s%d", UserNamePrefix, user.ID),
+			OldValue: oldRole.String(),
+			NewValue: user.Role.String(),
+		})
+	}
+	return user, nil
+}
+
+// signInWithLDAP authenticates the username and password with the LDAP identity providers, in order.
+// It returns the user of the first directory accepting the password, created just in time if needed,
+// or nil if no directory accepts it.
+func (s *APIV1Service) signInWithLDAP(ctx context.Context, username, password string) (*store.User, error) {
+	identityProviders, err := s.Store.ListIdentityProviders(ctx, &store.FindIdentityProvider{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list identity providers, error: %v", err)
+	}
+	for _, identityProvider := range identityProviders {
+		if identityProvider.Type != storepb.IdentityProvider_LDAP {
+			continue
+		}
+		ldapIdentityProvider, err := ldap.NewIdentityProvider(identityProvider.Config.GetLdapConfig())
+		if err != nil {
+			slog.Warn("invalid ldap identity provider", slog.String("name", identityProvider.Name), slog.Any("err", err))
+			continue
+		}
+		userInfo, err := ldapIdentityProvider.Authenticate(username, password)
+		if err != nil {
+			// An unreachable directory must not prevent signing in with the others.
+			if !errors.Is(err, ldap.ErrInvalidCredentials) {
+				slog.Warn("failed to authenticate with ldap identity provider", slog.String("name", identityProvider.Name), slog.Any("err", err))
+			}
+			continue
+		}
+		return s.findOrCreateIdentityProviderUser(ctx, identityProvider, userInfo, nil)
+	}
+	return nil, nil
+}
+
+// checkSignInLimit rejects sign-in attempts for a username, or from a client IP, which are locked out.
+func (s *APIV1Service) checkSignInLimit(ctx context.Context, username string) error {
+	keys := []string{signInUsernameLimitKey(username)}
+	if ipAddress := s.extractClientInfo(ctx).IpAddress; ipAddress != "" {
+		keys = append(keys, signInIPLimitKey(ipAddress))
+	}
+	for _, key := range keys {
+		if lockout := s.SignInLimiter.Check(ctx, key); lockout > 0 {
+			return status.Errorf(codes.ResourceExhausted, "too many failed sign-in attempts, try again in %s", lockout.Round(time.Second))
+		}
+	}
+	return nil
+}
+
+// recordSignInFailure counts a failed sign-in attempt against the username and client IP,
+// and records it as an activity. The user ID is 0 if no user has the username.
+func (s *APIV1Service) recordSignInFailure(ctx context.Context, userID int32, username string, reason string) {
+	clientInfo := s.extractClientInfo(ctx)
+	instanceGeneralSetting, err := s.Store.GetInstanceGeneralSetting(ctx)
+	if err != nil {
+		slog.Warn("failed to get instance general setting", slog.Any("err", err))
+		instanceGeneralSetting = &storepb.InstanceGeneralSetting{}
+	}
+	usernamePolicy, ipPolicy := getSignInLockoutPolicies(instanceGeneralSetting.GetSignInLimit())
+	s.SignInLimiter.RecordFailure(ctx, signInUsernameLimitKey(username), usernamePolicy)
+	if clientInfo.IpAddress != "" {
+		s.SignInLimiter.RecordFailure(ctx, signInIPLimitKey(clientInfo.IpAddress), ipPolicy)
+	}
+
+	if _, err := s.Store.CreateActivity(ctx, &store.Activity{
+		CreatorID: userID,
+		Type:      store.ActivityTypeSignInFailed,
+		Level:     store.ActivityLevelWarn,
+		Payload: &storepb.ActivityPayload{
+			SignInFailed: &storepb.ActivitySignInFailedPayload{
+				Username:  username,
+				IpAddress: clientInfo.IpAddress,
+				UserAgent: clientInfo.UserAgent,
+				Reason:    reason,
+			},
+		},
+	}); err != nil {
+		slog.Warn("failed to create sign-in failed activity", slog.Any("err", err))
+	}
+}
+
+// getSignInLockoutPolicies returns the lockout policies of usernames and client IPs, filling in the defaults.
+func getSignInLockoutPolicies(limit *storepb.InstanceSignInLimit) (auth.LockoutPolicy, auth.LockoutPolicy) {
+	window := defaultSignInLimitWindow
+	if limit.GetWindowSeconds() > 0 {
+		window = time.Duration(limit.GetWindowSeconds()) * time.Second
+	}
+	lockout := defaultSignInLockout
+	if limit.GetLockoutSeconds() > 0 {
+		lockout = time.Duration(limit.GetLockoutSeconds()) * time.Second
+	}
+	maxAttempts := func(value int32, defaultValue int) int {
+		if value == 0 {
+			return defaultValue
+		}
+		// Negative values disable the limit.
+		return int(value)
+	}
+	usernamePolicy := auth.LockoutPolicy{
+		MaxAttempts: maxAttempts(limit.GetMaxAttemptsPerUsername(), defaultSignInMaxAttemptsPerUsername),
+		Window:      window,
+		Lockout:     lockout,
+	}
+	ipPolicy := auth.LockoutPolicy{
+		MaxAttempts: maxAttempts(limit.GetMaxAttemptsPerIp(), defaultSignInMaxAttemptsPerIP),
+		Window:      window,
+		Lockout:     lockout,
+	}
+	return usernamePolicy, ipPolicy
+}
+
+func signInUsernameLimitKey(username string) string {
+	return "sign-in:username:" + username
+}
+
+func signInIPLimitKey(ipAddress string) string {
+	return "sign-in:ip:" + ipAddress
+}
+
 // doSignIn performs the actual sign-in operation by creating a session and setting the cookie.
 //
 // This function:
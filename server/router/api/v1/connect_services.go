@@ -39,6 +39,38 @@ func (s *ConnectServiceHandler) UpdateInstanceSetting(ctx context.Context, req *
 	return connect.NewResponse(resp), nil
 }
 
+func (s *ConnectServiceHandler) ListInstanceJobs(ctx context.Context, req *connect.Request[v1pb.ListInstanceJobsRequest]) (*connect.Response[v1pb.ListInstanceJobsResponse], error) {
+	resp, err := s.APIV1Service.ListInstanceJobs(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) RunInstanceJob(ctx context.Context, req *connect.Request[v1pb.RunInstanceJobRequest]) (*connect.Response[v1pb.InstanceJob], error) {
+	resp, err := s.APIV1Service.RunInstanceJob(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) UpdateInstanceJob(ctx context.Context, req *connect.Request[v1pb.UpdateInstanceJobRequest]) (*connect.Response[v1pb.InstanceJob], error) {
+	resp, err := s.APIV1Service.UpdateInstanceJob(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) SendTestEmail(ctx context.Context, req *connect.Request[v1pb.SendTestEmailRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.SendTestEmail(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
 // AuthService
 //
 // Auth service methods need special handling for response headers (cookies).
@@ -175,6 +207,46 @@ func (s *ConnectServiceHandler) DeletePersonalAccessToken(ctx context.Context, r
 	return connect.NewResponse(resp), nil
 }
 
+func (s *ConnectServiceHandler) GetTwoFactorStatus(ctx context.Context, req *connect.Request[v1pb.GetTwoFactorStatusRequest]) (*connect.Response[v1pb.TwoFactorStatus], error) {
+	resp, err := s.APIV1Service.GetTwoFactorStatus(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) SetupTwoFactor(ctx context.Context, req *connect.Request[v1pb.SetupTwoFactorRequest]) (*connect.Response[v1pb.SetupTwoFactorResponse], error) {
+	resp, err := s.APIV1Service.SetupTwoFactor(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) EnableTwoFactor(ctx context.Context, req *connect.Request[v1pb.EnableTwoFactorRequest]) (*connect.Response[v1pb.EnableTwoFactorResponse], error) {
+	resp, err := s.APIV1Service.EnableTwoFactor(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) DisableTwoFactor(ctx context.Context, req *connect.Request[v1pb.DisableTwoFactorRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.DisableTwoFactor(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) RegenerateRecoveryCodes(ctx context.Context, req *connect.Request[v1pb.RegenerateRecoveryCodesRequest]) (*connect.Response[v1pb.RegenerateRecoveryCodesResponse], error) {
+	resp, err := s.APIV1Service.RegenerateRecoveryCodes(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
 func (s *ConnectServiceHandler) ListUserWebhooks(ctx context.Context, req *connect.Request[v1pb.ListUserWebhooksRequest]) (*connect.Response[v1pb.ListUserWebhooksResponse], error) {
 	resp, err := s.APIV1Service.ListUserWebhooks(ctx, req.Msg)
 	if err != nil {
@@ -207,6 +279,14 @@ func (s *ConnectServiceHandler) DeleteUserWebhook(ctx context.Context, req *conn
 	return connect.NewResponse(resp), nil
 }
 
+func (s *ConnectServiceHandler) ListWebhookDeliveries(ctx context.Context, req *connect.Request[v1pb.ListWebhookDeliveriesRequest]) (*connect.Response[v1pb.ListWebhookDeliveriesResponse], error) {
+	resp, err := s.APIV1Service.ListWebhookDeliveries(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
 func (s *ConnectServiceHandler) ListUserNotifications(ctx context.Context, req *connect.Request[v1pb.ListUserNotificationsRequest]) (*connect.Response[v1pb.ListUserNotificationsResponse], error) {
 	resp, err := s.APIV1Service.ListUserNotifications(ctx, req.Msg)
 	if err != nil {
@@ -345,6 +425,38 @@ func (s *ConnectServiceHandler) DeleteMemoReaction(ctx context.Context, req *con
 	return connect.NewResponse(resp), nil
 }
 
+func (s *ConnectServiceHandler) SearchMemos(ctx context.Context, req *connect.Request[v1pb.SearchMemosRequest]) (*connect.Response[v1pb.SearchMemosResponse], error) {
+	resp, err := s.APIV1Service.SearchMemos(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) ListMemoRevisions(ctx context.Context, req *connect.Request[v1pb.ListMemoRevisionsRequest]) (*connect.Response[v1pb.ListMemoRevisionsResponse], error) {
+	resp, err := s.APIV1Service.ListMemoRevisions(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) GetMemoRevision(ctx context.Context, req *connect.Request[v1pb.GetMemoRevisionRequest]) (*connect.Response[v1pb.MemoRevision], error) {
+	resp, err := s.APIV1Service.GetMemoRevision(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) RestoreMemoRevision(ctx context.Context, req *connect.Request[v1pb.RestoreMemoRevisionRequest]) (*connect.Response[v1pb.Memo], error) {
+	resp, err := s.APIV1Service.RestoreMemoRevision(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
 // AttachmentService
 
 func (s *ConnectServiceHandler) CreateAttachment(ctx context.Context, req *connect.Request[v1pb.CreateAttachmentRequest]) (*connect.Response[v1pb.Attachment], error) {
@@ -429,6 +541,40 @@ func (s *ConnectServiceHandler) DeleteShortcut(ctx context.Context, req *connect
 	return connect.NewResponse(resp), nil
 }
 
+// TagService
+
+func (s *ConnectServiceHandler) ListTags(ctx context.Context, req *connect.Request[v1pb.ListTagsRequest]) (*connect.Response[v1pb.ListTagsResponse], error) {
+	resp, err := s.APIV1Service.ListTags(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) RenameTag(ctx context.Context, req *connect.Request[v1pb.RenameTagRequest]) (*connect.Response[v1pb.RenameTagResponse], error) {
+	resp, err := s.APIV1Service.RenameTag(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) MergeTags(ctx context.Context, req *connect.Request[v1pb.MergeTagsRequest]) (*connect.Response[v1pb.MergeTagsResponse], error) {
+	resp, err := s.APIV1Service.MergeTags(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) DeleteTag(ctx context.Context, req *connect.Request[v1pb.DeleteTagRequest]) (*connect.Response[v1pb.DeleteTagResponse], error) {
+	resp, err := s.APIV1Service.DeleteTag(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
 // ActivityService
 
 func (s *ConnectServiceHandler) ListActivities(ctx context.Context, req *connect.Request[v1pb.ListActivitiesRequest]) (*connect.Response[v1pb.ListActivitiesResponse], error) {
@@ -447,6 +593,22 @@ func (s *ConnectServiceHandler) GetActivity(ctx context.Context, req *connect.Re
 	return connect.NewResponse(resp), nil
 }
 
+func (s *ConnectServiceHandler) ListAuditEvents(ctx context.Context, req *connect.Request[v1pb.ListAuditEventsRequest]) (*connect.Response[v1pb.ListAuditEventsResponse], error) {
+	resp, err := s.APIV1Service.ListAuditEvents(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) ExportAuditEvents(ctx context.Context, req *connect.Request[v1pb.ExportAuditEventsRequest]) (*connect.Response[v1pb.ExportAuditEventsResponse], error) {
+	resp, err := s.APIV1Service.ExportAuditEvents(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
 // IdentityProviderService
 
 func (s *ConnectServiceHandler) ListIdentityProviders(ctx context.Context, req *connect.Request[v1pb.ListIdentityProvidersRequest]) (*connect.Response[v1pb.ListIdentityProvidersResponse], error) {
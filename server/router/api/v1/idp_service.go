@@ -8,6 +8,8 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	"github.com/usememos/memos/plugin/idp/ldap"
+	"github.com/usememos/memos/plugin/idp/oidc"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
@@ -25,11 +27,26 @@ func (s *APIV1Service) CreateIdentityProvider(ctx context.Context, request *v1pb
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
-	identityProvider, err := s.Store.CreateIdentityProvider(ctx, convertIdentityProviderToStore(request.IdentityProvider))
+	identityProviderCreate := convertIdentityProviderToStore(request.IdentityProvider)
+	if identityProviderCreate.Type == storepb.IdentityProvider_OIDC {
+		if err := discoverOIDCIdentityProvider(ctx, identityProviderCreate.Config.GetOidcConfig()); err != nil {
+			return nil, err
+		}
+	} else if identityProviderCreate.Type == storepb.IdentityProvider_LDAP {
+		if _, err := ldap.NewIdentityProvider(identityProviderCreate.Config.GetLdapConfig()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid ldap config: %v", err)
+		}
+	}
+	identityProvider, err := s.Store.CreateIdentityProvider(ctx, identityProviderCreate)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create identity provider, error: %+v", err)
 	}
-	return convertIdentityProviderFromStore(identityProvider), nil
+	identityProviderMessage := convertIdentityProviderFromStore(identityProvider)
+	s.recordAuditEvent(ctx, currentUser.ID, store.ActivityTypeIdentityProviderCreated, &storepb.ActivityAuditPayload{
+		Resource: identityProviderMessage.Name,
+		NewValue: identityProvider.Name,
+	})
+	return identityProviderMessage, nil
 }
 
 func (s *APIV1Service) ListIdentityProviders(ctx context.Context, _ *v1pb.ListIdentityProvidersRequest) (*v1pb.ListIdentityProvidersResponse, error) {
@@ -114,6 +131,15 @@ func (s *APIV1Service) UpdateIdentityProvider(ctx context.Context, request *v1pb
 			update.IdentifierFilter = &request.IdentityProvider.IdentifierFilter
 		case "config":
 			update.Config = convertIdentityProviderConfigToStore(request.IdentityProvider.Type, request.IdentityProvider.Config)
+			if update.Type == storepb.IdentityProvider_OIDC {
+				if err := discoverOIDCIdentityProvider(ctx, update.Config.GetOidcConfig()); err != nil {
+					return nil, err
+				}
+			} else if update.Type == storepb.IdentityProvider_LDAP {
+				if _, err := ldap.NewIdentityProvider(update.Config.GetLdapConfig()); err != nil {
+					return nil, status.Errorf(codes.InvalidArgument, "invalid ldap config: %v", err)
+				}
+			}
 		default:
 			// Ignore unsupported fields
 		}
@@ -123,7 +149,12 @@ func (s *APIV1Service) UpdateIdentityProvider(ctx context.Context, request *v1pb
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update identity provider, error: %+v", err)
 	}
-	return convertIdentityProviderFromStore(identityProvider), nil
+	identityProviderMessage := convertIdentityProviderFromStore(identityProvider)
+	s.recordAuditEvent(ctx, currentUser.ID, store.ActivityTypeIdentityProviderUpdated, &storepb.ActivityAuditPayload{
+		Resource: identityProviderMessage.Name,
+		NewValue: identityProvider.Name,
+	})
+	return identityProviderMessage, nil
 }
 
 func (s *APIV1Service) DeleteIdentityProvider(ctx context.Context, request *v1pb.DeleteIdentityProviderRequest) (*emptypb.Empty, error) {
@@ -155,9 +186,31 @@ func (s *APIV1Service) DeleteIdentityProvider(ctx context.Context, request *v1pb
 	if err := s.Store.DeleteIdentityProvider(ctx, &store.DeleteIdentityProvider{ID: id}); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete identity provider, error: %+v", err)
 	}
+	s.recordAuditEvent(ctx, currentUser.ID, store.ActivityTypeIdentityProviderDeleted, &storepb.ActivityAuditPayload{
+		Resource: fmt.Sprintf("%s%d", IdentityProviderNamePrefix, id),
+		OldValue: identityProvider.Name,
+	})
 	return &emptypb.Empty{}, nil
 }
 
+// discoverOIDCIdentityProvider validates the OpenID Connect configuration against the metadata of its issuer,
+// and keeps the discovered authorization endpoint for clients to start the sign-in.
+func discoverOIDCIdentityProvider(ctx context.Context, config *storepb.OIDCConfig) error {
+	if config == nil {
+		return status.Errorf(codes.InvalidArgument, "oidc config is required")
+	}
+	oidcIdentityProvider, err := oidc.NewIdentityProvider(config)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid oidc config: %v", err)
+	}
+	discovery, err := oidcIdentityProvider.Discover(ctx)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "failed to discover oidc issuer: %v", err)
+	}
+	config.AuthorizationEndpoint = discovery.AuthorizationEndpoint
+	return nil
+}
+
 func convertIdentityProviderFromStore(identityProvider *storepb.IdentityProvider) *v1pb.IdentityProvider {
 	temp := &v1pb.IdentityProvider{
 		Name:             fmt.Sprintf("%s%d", IdentityProviderNamePrefix, identityProvider.Id),
@@ -185,6 +238,38 @@ func convertIdentityProviderFromStore(identityProvider *storepb.IdentityProvider
 				},
 			},
 		}
+	} else if identityProvider.Type == storepb.IdentityProvider_OIDC {
+		oidcConfig := identityProvider.Config.GetOidcConfig()
+		temp.Config = &v1pb.IdentityProviderConfig{
+			Config: &v1pb.IdentityProviderConfig_OidcConfig{
+				OidcConfig: &v1pb.OIDCConfig{
+					IssuerUrl:             oidcConfig.IssuerUrl,
+					ClientId:              oidcConfig.ClientId,
+					ClientSecret:          oidcConfig.ClientSecret,
+					Scopes:                oidcConfig.Scopes,
+					FieldMapping:          convertFieldMappingFromStore(oidcConfig.FieldMapping),
+					AuthorizationEndpoint: oidcConfig.AuthorizationEndpoint,
+					GroupsClaim:           oidcConfig.GroupsClaim,
+					AdminGroups:           oidcConfig.AdminGroups,
+				},
+			},
+		}
+	} else if identityProvider.Type == storepb.IdentityProvider_LDAP {
+		ldapConfig := identityProvider.Config.GetLdapConfig()
+		temp.Config = &v1pb.IdentityProviderConfig{
+			Config: &v1pb.IdentityProviderConfig_LdapConfig{
+				LdapConfig: &v1pb.LDAPConfig{
+					ServerUrl:          ldapConfig.ServerUrl,
+					StartTls:           ldapConfig.StartTls,
+					InsecureSkipVerify: ldapConfig.InsecureSkipVerify,
+					BindDn:             ldapConfig.BindDn,
+					BindPassword:       ldapConfig.BindPassword,
+					SearchBase:         ldapConfig.SearchBase,
+					UserFilter:         ldapConfig.UserFilter,
+					FieldMapping:       convertFieldMappingFromStore(ldapConfig.FieldMapping),
+				},
+			},
+		}
 	}
 	return temp
 }
@@ -223,14 +308,80 @@ func convertIdentityProviderConfigToStore(identityProviderType v1pb.IdentityProv
 				},
 			},
 		}
+	} else if identityProviderType == v1pb.IdentityProvider_OIDC {
+		oidcConfig := config.GetOidcConfig()
+		if oidcConfig == nil {
+			return nil
+		}
+		return &storepb.IdentityProviderConfig{
+			Config: &storepb.IdentityProviderConfig_OidcConfig{
+				OidcConfig: &storepb.OIDCConfig{
+					IssuerUrl:    oidcConfig.IssuerUrl,
+					ClientId:     oidcConfig.ClientId,
+					ClientSecret: oidcConfig.ClientSecret,
+					Scopes:       oidcConfig.Scopes,
+					FieldMapping: convertFieldMappingToStore(oidcConfig.FieldMapping),
+					GroupsClaim:  oidcConfig.GroupsClaim,
+					AdminGroups:  oidcConfig.AdminGroups,
+				},
+			},
+		}
+	} else if identityProviderType == v1pb.IdentityProvider_LDAP {
+		ldapConfig := config.GetLdapConfig()
+		if ldapConfig == nil {
+			return nil
+		}
+		return &storepb.IdentityProviderConfig{
+			Config: &storepb.IdentityProviderConfig_LdapConfig{
+				LdapConfig: &storepb.LDAPConfig{
+					ServerUrl:          ldapConfig.ServerUrl,
+					StartTls:           ldapConfig.StartTls,
+					InsecureSkipVerify: ldapConfig.InsecureSkipVerify,
+					BindDn:             ldapConfig.BindDn,
+					BindPassword:       ldapConfig.BindPassword,
+					SearchBase:         ldapConfig.SearchBase,
+					UserFilter:         ldapConfig.UserFilter,
+					FieldMapping:       convertFieldMappingToStore(ldapConfig.FieldMapping),
+				},
+			},
+		}
 	}
 	return nil
 }
 
+func convertFieldMappingFromStore(fieldMapping *storepb.FieldMapping) *v1pb.FieldMapping {
+	if fieldMapping == nil {
+		return nil
+	}
+	return &v1pb.FieldMapping{
+		Identifier:  fieldMapping.Identifier,
+		DisplayName: fieldMapping.DisplayName,
+		Email:       fieldMapping.Email,
+		AvatarUrl:   fieldMapping.AvatarUrl,
+	}
+}
+
+func convertFieldMappingToStore(fieldMapping *v1pb.FieldMapping) *storepb.FieldMapping {
+	if fieldMapping == nil {
+		return nil
+	}
+	return &storepb.FieldMapping{
+		Identifier:  fieldMapping.Identifier,
+		DisplayName: fieldMapping.DisplayName,
+		Email:       fieldMapping.Email,
+		AvatarUrl:   fieldMapping.AvatarUrl,
+	}
+}
+
 func redactIdentityProviderResponse(identityProvider *v1pb.IdentityProvider, userRole store.Role) *v1pb.IdentityProvider {
 	if userRole != store.RoleAdmin {
 		if identityProvider.Type == v1pb.IdentityProvider_OAUTH2 {
 			identityProvider.Config.GetOauth2Config().ClientSecret = ""
+		} else if identityProvider.Type == v1pb.IdentityProvider_OIDC {
+			identityProvider.Config.GetOidcConfig().ClientSecret = ""
+		} else if identityProvider.Type == v1pb.IdentityProvider_LDAP {
+			// The directory is only used by the server, clients need none of its configuration.
+			identityProvider.Config = nil
 		}
 	}
 
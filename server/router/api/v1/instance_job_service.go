@@ -0,0 +1,191 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/plugin/scheduler"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) ListInstanceJobs(ctx context.Context, _ *v1pb.ListInstanceJobsRequest) (*v1pb.ListInstanceJobsResponse, error) {
+	if err := s.checkInstanceJobsPermission(ctx); err != nil {
+		return nil, err
+	}
+
+	jobStates, err := s.Store.ListJobs(ctx, &store.FindJob{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list job states: %v", err)
+	}
+	jobStateMap := make(map[string]*store.Job, len(jobStates))
+	for _, jobState := range jobStates {
+		jobStateMap[jobState.Name] = jobState
+	}
+
+	response := &v1pb.ListInstanceJobsResponse{}
+	for _, job := range s.Scheduler.Jobs() {
+		response.Jobs = append(response.Jobs, s.convertInstanceJobFromStore(job, jobStateMap[job.Name]))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) RunInstanceJob(ctx context.Context, request *v1pb.RunInstanceJobRequest) (*v1pb.InstanceJob, error) {
+	if err := s.checkInstanceJobsPermission(ctx); err != nil {
+		return nil, err
+	}
+
+	job, err := s.getScheduledJob(request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Scheduler.Trigger(job.Name); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to trigger job: %v", err)
+	}
+
+	jobState, err := s.Store.GetJob(ctx, &store.FindJob{Name: &job.Name})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get job state: %v", err)
+	}
+	return s.convertInstanceJobFromStore(job, jobState), nil
+}
+
+func (s *APIV1Service) UpdateInstanceJob(ctx context.Context, request *v1pb.UpdateInstanceJobRequest) (*v1pb.InstanceJob, error) {
+	if err := s.checkInstanceJobsPermission(ctx); err != nil {
+		return nil, err
+	}
+	if request.Job == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "job is required")
+	}
+	if request.UpdateMask == nil || !slices.Equal(request.UpdateMask.Paths, []string{"paused"}) {
+		return nil, status.Errorf(codes.InvalidArgument, "only paused can be updated")
+	}
+
+	job, err := s.getScheduledJob(request.Job.Name)
+	if err != nil {
+		return nil, err
+	}
+	jobState, err := s.Store.UpsertJob(ctx, &store.UpsertJob{
+		Name:   job.Name,
+		Paused: &request.Job.Paused,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update job state: %v", err)
+	}
+	return s.convertInstanceJobFromStore(job, jobState), nil
+}
+
+// jobStateSaveInterval is how often the state of the jobs is saved while it does not change,
+// so that jobs running every few seconds do not write it on every run.
+const jobStateSaveInterval = 10 * time.Minute
+
+// JobStateMiddleware persists the last run, error and next run of the jobs run by the scheduler.
+// Scheduled runs of paused jobs are skipped, manual runs are not.
+// The state of scheduled runs is only saved when the error changes or once per jobStateSaveInterval.
+func (s *APIV1Service) JobStateMiddleware() scheduler.Middleware {
+	return func(next scheduler.JobHandler) scheduler.JobHandler {
+		return func(ctx context.Context) error {
+			name := scheduler.GetJobName(ctx)
+			jobState, err := s.Store.GetJob(ctx, &store.FindJob{Name: &name})
+			if err != nil {
+				return errors.Wrap(err, "failed to get job state")
+			}
+
+			// The state is saved even if the run was canceled by a shutdown.
+			saveCtx := context.WithoutCancel(ctx)
+			upsert := &store.UpsertJob{Name: name}
+			if s.Scheduler != nil {
+				if nextRun, err := s.Scheduler.NextRun(name); err == nil {
+					nextRunTs := nextRun.Unix()
+					upsert.NextRunTs = &nextRunTs
+				}
+			}
+			interval := int64(jobStateSaveInterval.Seconds())
+			if jobState != nil && jobState.Paused && !scheduler.IsTriggered(ctx) {
+				if upsert.NextRunTs != nil && *upsert.NextRunTs-jobState.NextRunTs >= interval {
+					if _, err := s.Store.UpsertJob(saveCtx, upsert); err != nil {
+						slog.Warn("Failed to save job state", "job", name, "error", err)
+					}
+				}
+				return nil
+			}
+
+			lastRunTs := time.Now().Unix()
+			runErr := next(ctx)
+			lastError := ""
+			if runErr != nil {
+				lastError = runErr.Error()
+				slog.Warn("Job failed", "job", name, "error", runErr)
+			}
+			if jobState != nil && !scheduler.IsTriggered(ctx) && jobState.LastError == lastError && lastRunTs-jobState.LastRunTs < interval {
+				return runErr
+			}
+			upsert.LastRunTs = &lastRunTs
+			upsert.LastError = &lastError
+			if _, err := s.Store.UpsertJob(saveCtx, upsert); err != nil {
+				slog.Warn("Failed to save job state", "job", name, "error", err)
+			}
+			return runErr
+		}
+	}
+}
+
+// checkInstanceJobsPermission only allows admins to manage the jobs, once the scheduler is running.
+func (s *APIV1Service) checkInstanceJobsPermission(ctx context.Context) error {
+	user, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if user.Role != store.RoleAdmin {
+		return status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	if s.Scheduler == nil {
+		return status.Errorf(codes.Unavailable, "background jobs are not running")
+	}
+	return nil
+}
+
+func (s *APIV1Service) getScheduledJob(name string) (*scheduler.Job, error) {
+	jobName, err := ExtractInstanceJobNameFromName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	for _, job := range s.Scheduler.Jobs() {
+		if job.Name == jobName {
+			return job, nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "job %q not found", jobName)
+}
+
+// convertInstanceJobFromStore converts the job with its state, which is nil until the job first runs or is paused.
+func (s *APIV1Service) convertInstanceJobFromStore(job *scheduler.Job, jobState *store.Job) *v1pb.InstanceJob {
+	instanceJob := &v1pb.InstanceJob{
+		Name:        InstanceJobNamePrefix + job.Name,
+		Description: job.Description,
+		Schedule:    job.Schedule,
+	}
+	if jobState != nil {
+		instanceJob.Paused = jobState.Paused
+		instanceJob.LastError = jobState.LastError
+		if jobState.LastRunTs > 0 {
+			instanceJob.LastRunTime = timestamppb.New(time.Unix(jobState.LastRunTs, 0))
+		}
+	}
+	if !instanceJob.Paused {
+		if nextRun, err := s.Scheduler.NextRun(job.Name); err == nil {
+			instanceJob.NextRunTime = timestamppb.New(nextRun)
+		}
+	}
+	return instanceJob
+}
@@ -47,6 +47,10 @@ func (s *APIV1Service) GetInstanceSetting(ctx context.Context, request *v1pb.Get
 		_, err = s.Store.GetInstanceMemoRelatedSetting(ctx)
 	case storepb.InstanceSettingKey_STORAGE:
 		_, err = s.Store.GetInstanceStorageSetting(ctx)
+	case storepb.InstanceSettingKey_EMBEDDING:
+		_, err = s.Store.GetInstanceEmbeddingSetting(ctx)
+	case storepb.InstanceSettingKey_SMTP:
+		_, err = s.Store.GetInstanceSMTPSetting(ctx)
 	default:
 		return nil, status.Errorf(codes.InvalidArgument, "unsupported instance setting key: %v", instanceSettingKey)
 	}
@@ -64,8 +68,8 @@ func (s *APIV1Service) GetInstanceSetting(ctx context.Context, request *v1pb.Get
 		return nil, status.Errorf(codes.NotFound, "instance setting not found")
 	}
 
-	// For storage setting, only admin can get it.
-	if instanceSetting.Key == storepb.InstanceSettingKey_STORAGE {
+	// For storage, embedding and SMTP settings, only admin can get them as they contain credentials.
+	if instanceSetting.Key == storepb.InstanceSettingKey_STORAGE || instanceSetting.Key == storepb.InstanceSettingKey_EMBEDDING || instanceSetting.Key == storepb.InstanceSettingKey_SMTP {
 		user, err := s.fetchCurrentUser(ctx)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
@@ -97,12 +101,51 @@ func (s *APIV1Service) UpdateInstanceSetting(ctx context.Context, request *v1pb.
 	_ = request.UpdateMask
 
 	updateSetting := convertInstanceSettingToStore(request.Setting)
+	// Requiring two-factor authentication would lock out the admins without it, who could no longer sign in to enable it.
+	if updateSetting.GetGeneralSetting().GetRequireAdminTwoFactor() {
+		instanceGeneralSetting, err := s.Store.GetInstanceGeneralSetting(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get instance general setting: %v", err)
+		}
+		if !instanceGeneralSetting.RequireAdminTwoFactor {
+			if err := s.checkAdminsTwoFactorEnabled(ctx); err != nil {
+				return nil, err
+			}
+		}
+	}
 	instanceSetting, err := s.Store.UpsertInstanceSetting(ctx, updateSetting)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to upsert instance setting: %v", err)
 	}
 
-	return convertInstanceSettingFromStore(instanceSetting), nil
+	instanceSettingMessage := convertInstanceSettingFromStore(instanceSetting)
+	// Setting values may hold secrets, such as storage credentials, so they are not recorded.
+	s.recordAuditEvent(ctx, user.ID, store.ActivityTypeInstanceSettingUpdated, &storepb.ActivityAuditPayload{
+		Resource: instanceSettingMessage.Name,
+	})
+	return instanceSettingMessage, nil
+}
+
+// checkAdminsTwoFactorEnabled checks that all the active admins enabled two-factor authentication.
+func (s *APIV1Service) checkAdminsTwoFactorEnabled(ctx context.Context) error {
+	role := store.RoleAdmin
+	admins, err := s.Store.ListUsers(ctx, &store.FindUser{Role: &role})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list admins: %v", err)
+	}
+	for _, admin := range admins {
+		if admin.RowStatus == store.Archived {
+			continue
+		}
+		twoFactorSetting, err := s.Store.GetUserTwoFactorSetting(ctx, admin.ID)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get two-factor setting: %v", err)
+		}
+		if !twoFactorSetting.Enabled {
+			return status.Errorf(codes.FailedPrecondition, "admin %q must enable two-factor authentication before it is required for admins", admin.Username)
+		}
+	}
+	return nil
 }
 
 func convertInstanceSettingFromStore(setting *storepb.InstanceSetting) *v1pb.InstanceSetting {
@@ -122,6 +165,14 @@ func convertInstanceSettingFromStore(setting *storepb.InstanceSetting) *v1pb.Ins
 		instanceSetting.Value = &v1pb.InstanceSetting_MemoRelatedSetting_{
 			MemoRelatedSetting: convertInstanceMemoRelatedSettingFromStore(setting.GetMemoRelatedSetting()),
 		}
+	case *storepb.InstanceSetting_EmbeddingSetting:
+		instanceSetting.Value = &v1pb.InstanceSetting_EmbeddingSetting_{
+			EmbeddingSetting: convertInstanceEmbeddingSettingFromStore(setting.GetEmbeddingSetting()),
+		}
+	case *storepb.InstanceSetting_SmtpSetting:
+		instanceSetting.Value = &v1pb.InstanceSetting_SmtpSetting_{
+			SmtpSetting: convertInstanceSMTPSettingFromStore(setting.GetSmtpSetting()),
+		}
 	}
 	return instanceSetting
 }
@@ -147,6 +198,14 @@ func convertInstanceSettingToStore(setting *v1pb.InstanceSetting) *storepb.Insta
 		instanceSetting.Value = &storepb.InstanceSetting_MemoRelatedSetting{
 			MemoRelatedSetting: convertInstanceMemoRelatedSettingToStore(setting.GetMemoRelatedSetting()),
 		}
+	case storepb.InstanceSettingKey_EMBEDDING:
+		instanceSetting.Value = &storepb.InstanceSetting_EmbeddingSetting{
+			EmbeddingSetting: convertInstanceEmbeddingSettingToStore(setting.GetEmbeddingSetting()),
+		}
+	case storepb.InstanceSettingKey_SMTP:
+		instanceSetting.Value = &storepb.InstanceSetting_SmtpSetting{
+			SmtpSetting: convertInstanceSMTPSettingToStore(setting.GetSmtpSetting()),
+		}
 	default:
 		// Keep the default GeneralSetting value
 	}
@@ -166,6 +225,7 @@ func convertInstanceGeneralSettingFromStore(setting *storepb.InstanceGeneralSett
 		WeekStartDayOffset:       setting.WeekStartDayOffset,
 		DisallowChangeUsername:   setting.DisallowChangeUsername,
 		DisallowChangeNickname:   setting.DisallowChangeNickname,
+		RequireAdminTwoFactor:    setting.RequireAdminTwoFactor,
 	}
 	if setting.CustomProfile != nil {
 		generalSetting.CustomProfile = &v1pb.InstanceSetting_GeneralSetting_CustomProfile{
@@ -174,6 +234,14 @@ func convertInstanceGeneralSettingFromStore(setting *storepb.InstanceGeneralSett
 			LogoUrl:     setting.CustomProfile.LogoUrl,
 		}
 	}
+	if setting.SignInLimit != nil {
+		generalSetting.SignInLimit = &v1pb.InstanceSetting_GeneralSetting_SignInLimit{
+			MaxAttemptsPerUsername: setting.SignInLimit.MaxAttemptsPerUsername,
+			MaxAttemptsPerIp:       setting.SignInLimit.MaxAttemptsPerIp,
+			WindowSeconds:          setting.SignInLimit.WindowSeconds,
+			LockoutSeconds:         setting.SignInLimit.LockoutSeconds,
+		}
+	}
 	return generalSetting
 }
 
@@ -189,6 +257,7 @@ func convertInstanceGeneralSettingToStore(setting *v1pb.InstanceSetting_GeneralS
 		WeekStartDayOffset:       setting.WeekStartDayOffset,
 		DisallowChangeUsername:   setting.DisallowChangeUsername,
 		DisallowChangeNickname:   setting.DisallowChangeNickname,
+		RequireAdminTwoFactor:    setting.RequireAdminTwoFactor,
 	}
 	if setting.CustomProfile != nil {
 		generalSetting.CustomProfile = &storepb.InstanceCustomProfile{
@@ -197,6 +266,14 @@ func convertInstanceGeneralSettingToStore(setting *v1pb.InstanceSetting_GeneralS
 			LogoUrl:     setting.CustomProfile.LogoUrl,
 		}
 	}
+	if setting.SignInLimit != nil {
+		generalSetting.SignInLimit = &storepb.InstanceSignInLimit{
+			MaxAttemptsPerUsername: setting.SignInLimit.MaxAttemptsPerUsername,
+			MaxAttemptsPerIp:       setting.SignInLimit.MaxAttemptsPerIp,
+			WindowSeconds:          setting.SignInLimit.WindowSeconds,
+			LockoutSeconds:         setting.SignInLimit.LockoutSeconds,
+		}
+	}
 	return generalSetting
 }
 
@@ -270,6 +347,64 @@ func convertInstanceMemoRelatedSettingToStore(setting *v1pb.InstanceSetting_Memo
 	}
 }
 
+func convertInstanceEmbeddingSettingFromStore(setting *storepb.InstanceEmbeddingSetting) *v1pb.InstanceSetting_EmbeddingSetting {
+	if setting == nil {
+		return nil
+	}
+	return &v1pb.InstanceSetting_EmbeddingSetting{
+		Provider:  v1pb.InstanceSetting_EmbeddingSetting_Provider(setting.Provider),
+		Endpoint:  setting.Endpoint,
+		ApiKey:    setting.ApiKey,
+		Model:     setting.Model,
+		Dimension: setting.Dimension,
+	}
+}
+
+func convertInstanceEmbeddingSettingToStore(setting *v1pb.InstanceSetting_EmbeddingSetting) *storepb.InstanceEmbeddingSetting {
+	if setting == nil {
+		return nil
+	}
+	return &storepb.InstanceEmbeddingSetting{
+		Provider:  storepb.InstanceEmbeddingSetting_Provider(setting.Provider),
+		Endpoint:  setting.Endpoint,
+		ApiKey:    setting.ApiKey,
+		Model:     setting.Model,
+		Dimension: setting.Dimension,
+	}
+}
+
+func convertInstanceSMTPSettingFromStore(setting *storepb.InstanceSmtpSetting) *v1pb.InstanceSetting_SmtpSetting {
+	if setting == nil {
+		return nil
+	}
+	return &v1pb.InstanceSetting_SmtpSetting{
+		Host:      setting.Host,
+		Port:      setting.Port,
+		Username:  setting.Username,
+		Password:  setting.Password,
+		FromEmail: setting.FromEmail,
+		FromName:  setting.FromName,
+		UseTls:    setting.UseTls,
+		UseSsl:    setting.UseSsl,
+	}
+}
+
+func convertInstanceSMTPSettingToStore(setting *v1pb.InstanceSetting_SmtpSetting) *storepb.InstanceSmtpSetting {
+	if setting == nil {
+		return nil
+	}
+	return &storepb.InstanceSmtpSetting{
+		Host:      setting.Host,
+		Port:      setting.Port,
+		Username:  setting.Username,
+		Password:  setting.Password,
+		FromEmail: setting.FromEmail,
+		FromName:  setting.FromName,
+		UseTls:    setting.UseTls,
+		UseSsl:    setting.UseSsl,
+	}
+}
+
 var (
 	ownerCache      *v1pb.User
 	ownerCacheMutex sync.RWMutex
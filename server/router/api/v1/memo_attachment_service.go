@@ -2,6 +2,7 @@ package v1
 
 import (
 	"context"
+	"log/slog"
 	"slices"
 	"time"
 
@@ -14,6 +15,19 @@ import (
 )
 
 func (s *APIV1Service) SetMemoAttachments(ctx context.Context, request *v1pb.SetMemoAttachmentsRequest) (*emptypb.Empty, error) {
+	memo, err := s.setMemoAttachments(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.DispatchMemoAttachmentsUpdatedWebhook(ctx, memo); err != nil {
+		slog.Warn("Failed to dispatch memo attachments updated webhook", slog.Any("err", err))
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// setMemoAttachments replaces the attachments of the memo and returns the memo.
+// It doesn't dispatch webhooks, so that it can be used while creating a memo.
+func (s *APIV1Service) setMemoAttachments(ctx context.Context, request *v1pb.SetMemoAttachmentsRequest) (*store.Memo, error) {
 	user, err := s.fetchCurrentUser(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
@@ -85,8 +99,7 @@ func (s *APIV1Service) SetMemoAttachments(ctx context.Context, request *v1pb.Set
 			return nil, status.Errorf(codes.Internal, "failed to update attachment: %v", err)
 		}
 	}
-
-	return &emptypb.Empty{}, nil
+	return memo, nil
 }
 
 func (s *APIV1Service) ListMemoAttachments(ctx context.Context, request *v1pb.ListMemoAttachmentsRequest) (*v1pb.ListMemoAttachmentsResponse, error) {
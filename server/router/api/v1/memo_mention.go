@@ -0,0 +1,88 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// notifyMemoMentions notifies the users mentioned in the memo with a MEMO_MENTION activity, an inbox message and an email if they enabled it.
+// Users who cannot see the memo are not notified, nor the users in notified, who already were.
+func (s *APIV1Service) notifyMemoMentions(ctx context.Context, memo *store.Memo, notified []int32) error {
+	if memo.RowStatus != store.Normal {
+		return nil
+	}
+	receivers := []*store.User{}
+	for _, userID := range getNotifiedMemoMentions(memo) {
+		if userID == memo.CreatorID || slices.Contains(notified, userID) {
+			continue
+		}
+		user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+		if err != nil {
+			return errors.Wrap(err, "failed to get mentioned user")
+		}
+		if user == nil || user.RowStatus == store.Archived {
+			continue
+		}
+		receivers = append(receivers, user)
+	}
+	if len(receivers) == 0 {
+		return nil
+	}
+
+	activity, err := s.Store.CreateActivity(ctx, &store.Activity{
+		CreatorID: memo.CreatorID,
+		Type:      store.ActivityTypeMemoMention,
+		Level:     store.ActivityLevelInfo,
+		Payload: &storepb.ActivityPayload{
+			MemoMention: &storepb.ActivityMemoMentionPayload{
+				MemoId: memo.ID,
+			},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create activity")
+	}
+	creator, err := s.Store.GetUser(ctx, &store.FindUser{ID: &memo.CreatorID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get memo creator")
+	}
+	for _, receiver := range receivers {
+		if _, err := s.Store.CreateInbox(ctx, &store.Inbox{
+			SenderID:   memo.CreatorID,
+			ReceiverID: receiver.ID,
+			Status:     store.UNREAD,
+			Message: &storepb.InboxMessage{
+				Type:       storepb.InboxMessage_MEMO_MENTION,
+				ActivityId: &activity.ID,
+			},
+		}); err != nil {
+			return errors.Wrap(err, "failed to create inbox")
+		}
+		if creator != nil {
+			s.notifyMemoMentionByEmail(ctx, memo, creator, receiver.ID)
+		}
+	}
+	return nil
+}
+
+// notifyMemoMentionByEmail emails a user mentioned in the memo.
+func (s *APIV1Service) notifyMemoMentionByEmail(ctx context.Context, memo *store.Memo, creator *store.User, receiverID int32) {
+	subject := fmt.Sprintf("%s mentioned you in a memo", getUserDisplayName(creator))
+	s.sendNotificationEmail(ctx, receiverID, (*storepb.NotificationUserSetting).GetEmailMemoMention, subject,
+		s.buildNotificationEmailBody(subject, memo.Content, fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID)))
+}
+
+// getNotifiedMemoMentions returns the users mentioned in the memo who are notified of it.
+// Drafts and private memos are not notified of, as the mentioned users cannot see them.
+func getNotifiedMemoMentions(memo *store.Memo) []int32 {
+	if memo.RowStatus == store.Draft || memo.Visibility == store.Private {
+		return nil
+	}
+	return memo.Payload.GetMentions()
+}
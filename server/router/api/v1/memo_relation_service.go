@@ -3,6 +3,7 @@ package v1
 import (
 	"context"
 	"fmt"
+	"log/slog"
 
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
@@ -14,6 +15,19 @@ import (
 )
 
 func (s *APIV1Service) SetMemoRelations(ctx context.Context, request *v1pb.SetMemoRelationsRequest) (*emptypb.Empty, error) {
+	memo, err := s.setMemoRelations(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.DispatchMemoRelationsUpdatedWebhook(ctx, memo); err != nil {
+		slog.Warn("Failed to dispatch memo relations updated webhook", slog.Any("err", err))
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// setMemoRelations replaces the relations of the memo and returns the memo.
+// It doesn't dispatch webhooks, so that it can be used while creating a memo.
+func (s *APIV1Service) setMemoRelations(ctx context.Context, request *v1pb.SetMemoRelationsRequest) (*store.Memo, error) {
 	user, err := s.fetchCurrentUser(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
@@ -70,8 +84,7 @@ func (s *APIV1Service) SetMemoRelations(ctx context.Context, request *v1pb.SetMe
 			return nil, status.Errorf(codes.Internal, "failed to upsert memo relation")
 		}
 	}
-
-	return &emptypb.Empty{}, nil
+	return memo, nil
 }
 
 func (s *APIV1Service) ListMemoRelations(ctx context.Context, request *v1pb.ListMemoRelationsRequest) (*v1pb.ListMemoRelationsResponse, error) {
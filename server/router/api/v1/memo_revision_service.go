@@ -0,0 +1,219 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/plugin/diff"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) ListMemoRevisions(ctx context.Context, request *v1pb.ListMemoRevisionsRequest) (*v1pb.ListMemoRevisionsResponse, error) {
+	memoUID, err := ExtractMemoUIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.getMemoForRevisions(ctx, memoUID)
+	if err != nil {
+		return nil, err
+	}
+
+	var limit, offset int
+	if request.PageToken != "" {
+		var pageToken v1pb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		limit = int(pageToken.Limit)
+		offset = int(pageToken.Offset)
+	} else {
+		limit = int(request.PageSize)
+	}
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	// The extra revision tells whether there is a next page, and is the previous revision of the last one.
+	limitPlusOne := limit + 1
+	revisions, err := s.Store.ListMemoRevisions(ctx, &store.FindMemoRevision{
+		MemoID: &memo.ID,
+		Limit:  &limitPlusOne,
+		Offset: &offset,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo revisions: %v", err)
+	}
+
+	response := &v1pb.ListMemoRevisionsResponse{
+		Revisions: []*v1pb.MemoRevision{},
+	}
+	for i, revision := range revisions {
+		if i == limit {
+			response.NextPageToken, err = getPageToken(limit, offset+limit)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
+			}
+			break
+		}
+		var previous *store.MemoRevision
+		if i+1 < len(revisions) {
+			previous = revisions[i+1]
+		}
+		response.Revisions = append(response.Revisions, convertMemoRevisionFromStore(memo, revision, previous))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) GetMemoRevision(ctx context.Context, request *v1pb.GetMemoRevisionRequest) (*v1pb.MemoRevision, error) {
+	memoUID, revisionID, err := ExtractMemoRevisionIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo revision name: %v", err)
+	}
+	memo, err := s.getMemoForRevisions(ctx, memoUID)
+	if err != nil {
+		return nil, err
+	}
+
+	revision, err := s.Store.GetMemoRevision(ctx, &store.FindMemoRevision{
+		ID:     &revisionID,
+		MemoID: &memo.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo revision: %v", err)
+	}
+	if revision == nil {
+		return nil, status.Errorf(codes.NotFound, "memo revision not found")
+	}
+	limit := 1
+	previous, err := s.Store.GetMemoRevision(ctx, &store.FindMemoRevision{
+		MemoID:   &memo.ID,
+		BeforeID: &revision.ID,
+		Limit:    &limit,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get previous memo revision: %v", err)
+	}
+	return convertMemoRevisionFromStore(memo, revision, previous), nil
+}
+
+func (s *APIV1Service) RestoreMemoRevision(ctx context.Context, request *v1pb.RestoreMemoRevisionRequest) (*v1pb.Memo, error) {
+	revision, err := s.GetMemoRevision(ctx, &v1pb.GetMemoRevisionRequest{Name: request.Name})
+	if err != nil {
+		return nil, err
+	}
+	memoUID, _, err := ExtractMemoRevisionIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo revision name: %v", err)
+	}
+
+	// Restoring is an update of its own, so it is recorded as a new revision.
+	return s.UpdateMemo(ctx, &v1pb.UpdateMemoRequest{
+		Memo: &v1pb.Memo{
+			Name:       fmt.Sprintf("%s%s", MemoNamePrefix, memoUID),
+			Content:    revision.Content,
+			Visibility: revision.Visibility,
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content", "visibility"}},
+	})
+}
+
+// getMemoForRevisions returns the memo whose revisions are requested.
+// Revisions may hold content the memo no longer shows, so only the creator and admins can access them.
+func (s *APIV1Service) getMemoForRevisions(ctx context.Context, memoUID string) (*store.Memo, error) {
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo")
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	user, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if memo.CreatorID != user.ID && !isSuperUser(user) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return memo, nil
+}
+
+// recordMemoRevision records the current content and visibility of the memo as a revision made by the user.
+// The state before the change is recorded first for memos created before revisions were kept.
+func (s *APIV1Service) recordMemoRevision(ctx context.Context, memo *store.Memo, before *store.Memo, creatorID int32) error {
+	if before != nil {
+		limit := 1
+		revisions, err := s.Store.ListMemoRevisions(ctx, &store.FindMemoRevision{
+			MemoID: &memo.ID,
+			Limit:  &limit,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to list memo revisions")
+		}
+		if len(revisions) == 0 {
+			if _, err := s.Store.CreateMemoRevision(ctx, &store.MemoRevision{
+				MemoID:     before.ID,
+				CreatedTs:  before.UpdatedTs,
+				CreatorID:  before.CreatorID,
+				Content:    before.Content,
+				Visibility: before.Visibility,
+			}); err != nil {
+				return errors.Wrap(err, "failed to create initial memo revision")
+			}
+		}
+	}
+	if _, err := s.Store.CreateMemoRevision(ctx, &store.MemoRevision{
+		MemoID:     memo.ID,
+		CreatorID:  creatorID,
+		Content:    memo.Content,
+		Visibility: memo.Visibility,
+	}); err != nil {
+		return errors.Wrap(err, "failed to create memo revision")
+	}
+	return nil
+}
+
+func convertMemoRevisionFromStore(memo *store.Memo, revision *store.MemoRevision, previous *store.MemoRevision) *v1pb.MemoRevision {
+	previousContent := ""
+	if previous != nil {
+		previousContent = previous.Content
+	}
+	lines := diff.Lines(previousContent, revision.Content)
+	diffLines := make([]*v1pb.MemoRevision_DiffLine, 0, len(lines))
+	for _, line := range lines {
+		diffLines = append(diffLines, &v1pb.MemoRevision_DiffLine{
+			Operation: convertDiffOperationFromPlugin(line.Operation),
+			Text:      line.Text,
+		})
+	}
+	return &v1pb.MemoRevision{
+		Name:       fmt.Sprintf("%s%s/%s%d", MemoNamePrefix, memo.UID, RevisionNamePrefix, revision.ID),
+		Creator:    fmt.Sprintf("%s%d", UserNamePrefix, revision.CreatorID),
+		CreateTime: timestamppb.New(time.Unix(revision.CreatedTs, 0)),
+		Content:    revision.Content,
+		Visibility: convertVisibilityFromStore(revision.Visibility),
+		Diff:       diffLines,
+	}
+}
+
+func convertDiffOperationFromPlugin(operation diff.Operation) v1pb.MemoRevision_DiffLine_Operation {
+	switch operation {
+	case diff.Equal:
+		return v1pb.MemoRevision_DiffLine_EQUAL
+	case diff.Insert:
+		return v1pb.MemoRevision_DiffLine_INSERT
+	case diff.Delete:
+		return v1pb.MemoRevision_DiffLine_DELETE
+	default:
+		return v1pb.MemoRevision_DiffLine_OPERATION_UNSPECIFIED
+	}
+}
@@ -0,0 +1,163 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/lithammer/shortuuid/v4"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/plugin/scheduler"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// applyMemoSchedule sets the publication schedule and the state of the memo.
+// Memos scheduled in the future and recurring memos are drafts, other memos are published right away.
+func (s *APIV1Service) applyMemoSchedule(ctx context.Context, memo *store.Memo, scheduleTime *timestamppb.Timestamp, recurrence string) error {
+	if memo.Payload == nil {
+		memo.Payload = &storepb.MemoPayload{}
+	}
+	now := time.Now()
+	if recurrence != "" {
+		cron, err := scheduler.ParseCronExpression(recurrence)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid recurrence: %v", err)
+		}
+		location, err := s.Store.GetUserLocation(ctx, memo.CreatorID)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get user timezone: %v", err)
+		}
+		// The schedule time is the start of the recurrence, the first copy is published at the next occurrence from then.
+		from := now
+		if scheduleTime != nil && scheduleTime.AsTime().After(now) {
+			from = scheduleTime.AsTime().Add(-time.Second)
+		}
+		publishTime := cron.Next(from.In(location))
+		if publishTime.IsZero() {
+			return status.Errorf(codes.InvalidArgument, "recurrence %q never occurs", recurrence)
+		}
+		memo.Payload.Schedule = &storepb.MemoPayload_Schedule{
+			PublishTime: timestamppb.New(publishTime),
+			Recurrence:  recurrence,
+		}
+		memo.RowStatus = store.Draft
+		return nil
+	}
+	if scheduleTime != nil && scheduleTime.AsTime().After(now) {
+		memo.Payload.Schedule = &storepb.MemoPayload_Schedule{
+			PublishTime: scheduleTime,
+		}
+		memo.RowStatus = store.Draft
+		return nil
+	}
+	memo.Payload.Schedule = nil
+	memo.RowStatus = store.Normal
+	return nil
+}
+
+// PublishScheduledMemos publishes the drafts whose schedule time has passed.
+// One-off drafts become normal memos, recurring drafts publish a copy and move on to their next occurrence.
+// Drafts which fail to be published, or are updated while being published, are retried on the next run.
+// Failures are reported in the returned error.
+func (s *APIV1Service) PublishScheduledMemos(ctx context.Context) error {
+	rowStatus := store.Draft
+	drafts, err := s.Store.ListMemos(ctx, &store.FindMemo{RowStatus: &rowStatus})
+	if err != nil {
+		return errors.Wrap(err, "failed to list draft memos")
+	}
+
+	now := time.Now()
+	failed := 0
+	for _, draft := range drafts {
+		schedule := draft.Payload.GetSchedule()
+		if schedule == nil || schedule.PublishTime.AsTime().After(now) {
+			continue
+		}
+		if err := s.publishScheduledMemo(ctx, draft, now); err != nil {
+			slog.Error("failed to publish scheduled memo", "err", err, "memoID", draft.ID)
+			failed++
+		}
+	}
+	if failed > 0 {
+		return errors.Errorf("failed to publish %d scheduled memos", failed)
+	}
+	return nil
+}
+
+func (s *APIV1Service) publishScheduledMemo(ctx context.Context, draft *store.Memo, now time.Time) error {
+	schedule := draft.Payload.Schedule
+	payload := proto.Clone(draft.Payload).(*storepb.MemoPayload)
+	payload.Schedule = nil
+	publishedTs := schedule.PublishTime.AsTime().Unix()
+
+	// The draft was read before, so it is only written if it was not updated since, e.g. rescheduled by its creator.
+	var memo *store.Memo
+	if schedule.Recurrence == "" {
+		rowStatus := store.Normal
+		updatedTs := now.Unix()
+		if err := s.Store.UpdateMemo(ctx, &store.UpdateMemo{
+			ID:              draft.ID,
+			CreatedTs:       &publishedTs,
+			UpdatedTs:       &updatedTs,
+			RowStatus:       &rowStatus,
+			Payload:         payload,
+			ExpectedVersion: &draft.Version,
+		}); err != nil {
+			if errors.Is(err, store.ErrMemoModified) {
+				return nil
+			}
+			return errors.Wrap(err, "failed to publish memo")
+		}
+		published, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &draft.ID})
+		if err != nil {
+			return errors.Wrap(err, "failed to get memo")
+		}
+		memo = published
+	} else {
+		// The next occurrence is saved before the copy is created, so that a failure skips a copy rather than publishing it twice.
+		// Occurrences missed while the server was down are skipped.
+		if err := s.applyMemoSchedule(ctx, draft, nil, schedule.Recurrence); err != nil {
+			return errors.Wrap(err, "failed to schedule the next occurrence")
+		}
+		if err := s.Store.UpdateMemo(ctx, &store.UpdateMemo{
+			ID:              draft.ID,
+			Payload:         draft.Payload,
+			ExpectedVersion: &draft.Version,
+		}); err != nil {
+			if errors.Is(err, store.ErrMemoModified) {
+				return nil
+			}
+			return errors.Wrap(err, "failed to update recurring memo")
+		}
+
+		created, err := s.Store.CreateMemo(ctx, &store.Memo{
+			UID:        shortuuid.New(),
+			CreatorID:  draft.CreatorID,
+			CreatedTs:  publishedTs,
+			Content:    draft.Content,
+			Visibility: draft.Visibility,
+			Payload:    payload,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to create memo")
+		}
+		if err := s.recordMemoRevision(ctx, created, nil, created.CreatorID); err != nil {
+			return errors.Wrap(err, "failed to record memo revision")
+		}
+		memo = created
+	}
+
+	if err := s.notifyMemoMentions(ctx, memo, nil); err != nil {
+		slog.Warn("Failed to notify memo mentions", slog.Any("err", err))
+	}
+	if err := s.dispatchStoreMemoRelatedWebhook(ctx, memo, webhookActivityMemoCreated, nil); err != nil {
+		slog.Warn("Failed to dispatch memo created webhook", slog.Any("err", err))
+	}
+	return nil
+}
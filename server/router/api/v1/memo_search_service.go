@@ -0,0 +1,271 @@
+package v1
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"html"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/embedding"
+	"github.com/usememos/memos/plugin/filter"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// DefaultSearchPageSize is the default number of semantic search results.
+	DefaultSearchPageSize = 10
+	// MaxSearchPageSize is the maximum number of semantic search results.
+	MaxSearchPageSize = 100
+	// searchCandidateBatchSize is the number of best scored memos checked for visibility at once.
+	searchCandidateBatchSize = 100
+	// searchSnippetLength is the maximum number of characters of a search snippet.
+	searchSnippetLength = 200
+	// searchSnippetContext is the number of characters kept before the first match of a search snippet.
+	searchSnippetContext = 40
+)
+
+func (s *APIV1Service) SearchMemos(ctx context.Context, request *v1pb.SearchMemosRequest) (*v1pb.SearchMemosResponse, error) {
+	query := strings.TrimSpace(request.Query)
+	if query == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "query is required")
+	}
+	provider, err := s.getEmbeddingProvider(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get embedding provider: %v", err)
+	}
+	if provider == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "semantic search is not configured")
+	}
+	if request.Filter != "" {
+		if err := s.validateFilter(ctx, request.Filter); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+		}
+	}
+	pageSize := int(request.PageSize)
+	if pageSize <= 0 {
+		pageSize = DefaultSearchPageSize
+	}
+	if pageSize > MaxSearchPageSize {
+		pageSize = MaxSearchPageSize
+	}
+
+	currentUser, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+
+	queryEmbedding, err := provider.Embed(ctx, query)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate query embedding: %v", err)
+	}
+	model := provider.Model()
+	memoEmbeddings, err := s.Store.ListMemoEmbeddings(ctx, &store.FindMemoEmbedding{
+		Model: &model,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo embeddings: %v", err)
+	}
+
+	type candidate struct {
+		memoID int32
+		score  float64
+	}
+	candidates := make([]candidate, 0, len(memoEmbeddings))
+	for _, memoEmbedding := range memoEmbeddings {
+		candidates = append(candidates, candidate{
+			memoID: memoEmbedding.MemoID,
+			score:  embedding.CosineSimilarity(queryEmbedding, memoEmbedding.Embedding),
+		})
+	}
+	slices.SortFunc(candidates, func(a, b candidate) int {
+		return cmp.Compare(b.score, a.score)
+	})
+
+	// Walk the candidates from the best score, keeping the memos the ListMemos visibility rules allow.
+	memos := []*store.Memo{}
+	scores := []float64{}
+	for start := 0; start < len(candidates) && len(memos) < pageSize; start += searchCandidateBatchSize {
+		batch := candidates[start:min(start+searchCandidateBatchSize, len(candidates))]
+		memoIDs := make([]int32, 0, len(batch))
+		for _, c := range batch {
+			memoIDs = append(memoIDs, c.memoID)
+		}
+		state := store.Normal
+		memoFind := &store.FindMemo{
+			IDList:          memoIDs,
+			RowStatus:       &state,
+			ExcludeComments: true,
+		}
+		if request.Filter != "" {
+			memoFind.Filters = append(memoFind.Filters, request.Filter)
+		}
+		applyMemoVisibility(memoFind, currentUser)
+		list, err := s.Store.ListMemos(ctx, memoFind)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+		}
+		memoMap := make(map[int32]*store.Memo, len(list))
+		for _, memo := range list {
+			memoMap[memo.ID] = memo
+		}
+		for _, c := range batch {
+			memo, ok := memoMap[c.memoID]
+			if !ok {
+				continue
+			}
+			memos = append(memos, memo)
+			scores = append(scores, c.score)
+			if len(memos) == pageSize {
+				break
+			}
+		}
+	}
+
+	response := &v1pb.SearchMemosResponse{
+		Results: []*v1pb.SearchMemosResponse_Result{},
+	}
+	if len(memos) == 0 {
+		return response, nil
+	}
+
+	contentIDs := make([]string, 0, len(memos))
+	memoIDs := make([]int32, 0, len(memos))
+	for _, memo := range memos {
+		contentIDs = append(contentIDs, fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID))
+		memoIDs = append(memoIDs, memo.ID)
+	}
+	reactions, err := s.Store.ListReactions(ctx, &store.FindReaction{ContentIDList: contentIDs})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list reactions")
+	}
+	reactionMap := make(map[string][]*store.Reaction)
+	for _, reaction := range reactions {
+		reactionMap[reaction.ContentID] = append(reactionMap[reaction.ContentID], reaction)
+	}
+	attachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{MemoIDList: memoIDs})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list attachments")
+	}
+	attachmentMap := make(map[int32][]*store.Attachment)
+	for _, attachment := range attachments {
+		attachmentMap[*attachment.MemoID] = append(attachmentMap[*attachment.MemoID], attachment)
+	}
+
+	for i, memo := range memos {
+		memoName := fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID)
+		memoMessage, err := s.convertMemoFromStore(ctx, memo, reactionMap[memoName], attachmentMap[memo.ID])
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to convert memo")
+		}
+		response.Results = append(response.Results, &v1pb.SearchMemosResponse_Result{
+			Memo:  memoMessage,
+			Score: scores[i],
+		})
+	}
+	return response, nil
+}
+
+// getEmbeddingProvider returns the provider configured by the instance embedding setting, nil if none is configured.
+func (s *APIV1Service) getEmbeddingProvider(ctx context.Context) (embedding.Provider, error) {
+	instanceEmbeddingSetting, err := s.Store.GetInstanceEmbeddingSetting(ctx)
+	if err != nil {
+		return nil, err
+	}
+	provider, err := embedding.NewProvider(instanceEmbeddingSetting)
+	if err != nil || provider == nil {
+		return nil, err
+	}
+	if s.embeddingCache != nil {
+		provider = embedding.NewCachedProvider(provider, s.embeddingCache)
+	}
+	return provider, nil
+}
+
+// getFilterSearchTerms returns the terms of the search() calls in the filter.
+func getFilterSearchTerms(ctx context.Context, filterStr string) ([]string, error) {
+	engine, err := filter.DefaultEngine()
+	if err != nil {
+		return nil, err
+	}
+	program, err := engine.Compile(ctx, filterStr)
+	if err != nil {
+		return nil, err
+	}
+	return program.SearchTerms(), nil
+}
+
+// buildSearchSnippet returns the HTML escaped excerpt of the content around the first search term,
+// with the words matching the terms wrapped in <mark> tags.
+// Words are split like filter.SearchTerms does, so that the highlights agree with the full-text index.
+func buildSearchSnippet(content string, terms []string) string {
+	text := []rune(strings.Join(strings.Fields(content), " "))
+
+	type match struct {
+		start, end int
+	}
+	matches := []match{}
+	for i := 0; i < len(text); {
+		if !filter.IsSearchTermRune(text[i]) {
+			i++
+			continue
+		}
+		j := i
+		for j < len(text) && filter.IsSearchTermRune(text[j]) {
+			j++
+		}
+		if slices.Contains(terms, strings.ToLower(string(text[i:j]))) {
+			matches = append(matches, match{start: i, end: j})
+		}
+		i = j
+	}
+
+	start := 0
+	if len(matches) > 0 && matches[0].start > searchSnippetContext {
+		start = matches[0].start - searchSnippetContext
+		// Avoid starting the snippet in the middle of a word.
+		if space := slices.Index(text[start:matches[0].start], ' '); space >= 0 {
+			start += space + 1
+		}
+	}
+	end := min(len(text), start+searchSnippetLength)
+	if end < len(text) {
+		// Avoid ending the snippet in the middle of a word.
+		for i := end - 1; i > start; i-- {
+			if text[i] == ' ' {
+				end = i
+				break
+			}
+		}
+	}
+
+	var builder strings.Builder
+	if start > 0 {
+		builder.WriteString("…")
+	}
+	cursor := start
+	for _, m := range matches {
+		if m.start < start {
+			continue
+		}
+		if m.end > end {
+			break
+		}
+		builder.WriteString(html.EscapeString(string(text[cursor:m.start])))
+		builder.WriteString("<mark>")
+		builder.WriteString(html.EscapeString(string(text[m.start:m.end])))
+		builder.WriteString("</mark>")
+		cursor = m.end
+	}
+	builder.WriteString(html.EscapeString(string(text[cursor:end])))
+	if end < len(text) {
+		builder.WriteString("…")
+	}
+	return builder.String()
+}
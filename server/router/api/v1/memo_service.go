@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"slices"
 	"strings"
 	"time"
 
@@ -84,12 +85,19 @@ func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoR
 	if len(create.Content) > contentLengthLimit {
 		return nil, status.Errorf(codes.InvalidArgument, "content too long (max %d characters)", contentLengthLimit)
 	}
-	if err := memopayload.RebuildMemoPayload(create, s.MarkdownService); err != nil {
+	location, err := s.Store.GetUserLocation(ctx, create.CreatorID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user timezone: %v", err)
+	}
+	if err := memopayload.RebuildMemoPayload(ctx, s.Store, create, s.MarkdownService, location); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to rebuild memo payload: %v", err)
 	}
 	if request.Memo.Location != nil {
 		create.Payload.Location = convertLocationToStore(request.Memo.Location)
 	}
+	if err := s.applyMemoSchedule(ctx, create, request.Memo.ScheduleTime, request.Memo.Recurrence); err != nil {
+		return nil, err
+	}
 
 	memo, err := s.Store.CreateMemo(ctx, create)
 	if err != nil {
@@ -102,11 +110,14 @@ func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoR
 		}
 		return nil, err
 	}
+	if err := s.recordMemoRevision(ctx, memo, nil, user.ID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record memo revision: %v", err)
+	}
 
 	attachments := []*store.Attachment{}
 
 	if len(request.Memo.Attachments) > 0 {
-		_, err := s.SetMemoAttachments(ctx, &v1pb.SetMemoAttachmentsRequest{
+		_, err := s.setMemoAttachments(ctx, &v1pb.SetMemoAttachmentsRequest{
 			Name:        fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
 			Attachments: request.Memo.Attachments,
 		})
@@ -123,7 +134,7 @@ func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoR
 		attachments = a
 	}
 	if len(request.Memo.Relations) > 0 {
-		_, err := s.SetMemoRelations(ctx, &v1pb.SetMemoRelationsRequest{
+		_, err := s.setMemoRelations(ctx, &v1pb.SetMemoRelationsRequest{
 			Name:      fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
 			Relations: request.Memo.Relations,
 		})
@@ -136,9 +147,14 @@ func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoR
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to convert memo")
 	}
-	// Try to dispatch webhook when memo is created.
-	if err := s.DispatchMemoCreatedWebhook(ctx, memoMessage); err != nil {
-		slog.Warn("Failed to dispatch memo created webhook", slog.Any("err", err))
+	if err := s.notifyMemoMentions(ctx, memo, nil); err != nil {
+		slog.Warn("Failed to notify memo mentions", slog.Any("err", err))
+	}
+	// Try to dispatch webhook when memo is created, drafts dispatch it once they are published.
+	if memo.RowStatus != store.Draft {
+		if err := s.DispatchMemoCreatedWebhook(ctx, memoMessage); err != nil {
+			slog.Warn("Failed to dispatch memo created webhook", slog.Any("err", err))
+		}
 	}
 
 	return memoMessage, nil
@@ -152,6 +168,9 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 	if request.State == v1pb.State_ARCHIVED {
 		state := store.Archived
 		memoFind.RowStatus = &state
+	} else if request.State == v1pb.State_DRAFT {
+		state := store.Draft
+		memoFind.RowStatus = &state
 	} else {
 		state := store.Normal
 		memoFind.RowStatus = &state
@@ -167,27 +186,34 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 		memoFind.OrderByTimeAsc = false
 	}
 
+	searchTerms := []string{}
 	if request.Filter != "" {
 		if err := s.validateFilter(ctx, request.Filter); err != nil {
 			return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
 		}
 		memoFind.Filters = append(memoFind.Filters, request.Filter)
+		terms, err := getFilterSearchTerms(ctx, request.Filter)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+		}
+		searchTerms = terms
+	}
+	if memoFind.OrderByRelevance && len(searchTerms) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid order_by: relevance requires a search() filter")
 	}
 
 	currentUser, err := s.fetchCurrentUser(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get user")
 	}
-	if currentUser == nil {
-		memoFind.VisibilityList = []store.Visibility{store.Public}
-	} else {
-		if memoFind.CreatorID == nil {
-			filter := fmt.Sprintf(`creator_id == %d || visibility in ["PUBLIC", "PROTECTED"]`, currentUser.ID)
-			memoFind.Filters = append(memoFind.Filters, filter)
-		} else if *memoFind.CreatorID != currentUser.ID {
-			memoFind.VisibilityList = []store.Visibility{store.Public, store.Protected}
+	// Drafts are only listed to their creator.
+	if request.State == v1pb.State_DRAFT {
+		if currentUser == nil {
+			return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
 		}
+		memoFind.CreatorID = &currentUser.ID
 	}
+	applyMemoVisibility(memoFind, currentUser)
 
 	instanceMemoRelatedSetting, err := s.Store.GetInstanceMemoRelatedSetting(ctx)
 	if err != nil {
@@ -205,6 +231,15 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 		}
 		limit = int(pageToken.Limit)
 		offset = int(pageToken.Offset)
+		// Relevance scores are no sort key, so memos ordered by relevance are paginated by offset.
+		if cursor := pageToken.Cursor; cursor != nil && !memoFind.OrderByRelevance {
+			memoFind.Cursor = &store.MemoCursor{
+				Pinned:    cursor.Pinned,
+				CreatedTs: cursor.CreatedTs,
+				UpdatedTs: cursor.UpdatedTs,
+				ID:        cursor.Id,
+			}
+		}
 	} else {
 		limit = int(request.PageSize)
 	}
@@ -213,7 +248,9 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 	}
 	limitPlusOne := limit + 1
 	memoFind.Limit = &limitPlusOne
-	memoFind.Offset = &offset
+	if memoFind.Cursor == nil {
+		memoFind.Offset = &offset
+	}
 	memos, err := s.Store.ListMemos(ctx, memoFind)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
@@ -223,7 +260,7 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 	nextPageToken := ""
 	if len(memos) == limitPlusOne {
 		memos = memos[:limit]
-		nextPageToken, err = getPageToken(limit, offset+limit)
+		nextPageToken, err = getMemoPageToken(limit, offset+limit, memos[limit-1], memoFind.OrderByRelevance)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
 		}
@@ -275,6 +312,9 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to convert memo")
 		}
+		if len(searchTerms) > 0 {
+			memoMessage.SearchSnippet = buildSearchSnippet(memo.Content, searchTerms)
+		}
 
 		memoMessages = append(memoMessages, memoMessage)
 	}
@@ -286,6 +326,21 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 	return response, nil
 }
 
+// applyMemoVisibility restricts the memo query to the memos visible to the user.
+// The user is nil for anonymous requests.
+func applyMemoVisibility(memoFind *store.FindMemo, currentUser *store.User) {
+	if currentUser == nil {
+		memoFind.VisibilityList = []store.Visibility{store.Public}
+	} else {
+		if memoFind.CreatorID == nil {
+			filter := fmt.Sprintf(`creator_id == %d || visibility in ["PUBLIC", "PROTECTED"]`, currentUser.ID)
+			memoFind.Filters = append(memoFind.Filters, filter)
+		} else if *memoFind.CreatorID != currentUser.ID {
+			memoFind.VisibilityList = []store.Visibility{store.Public, store.Protected}
+		}
+	}
+}
+
 func (s *APIV1Service) GetMemo(ctx context.Context, request *v1pb.GetMemoRequest) (*v1pb.Memo, error) {
 	memoUID, err := ExtractMemoUIDFromName(request.Name)
 	if err != nil {
@@ -300,7 +355,7 @@ func (s *APIV1Service) GetMemo(ctx context.Context, request *v1pb.GetMemoRequest
 	if memo == nil {
 		return nil, status.Errorf(codes.NotFound, "memo not found")
 	}
-	if memo.Visibility != store.Public {
+	if memo.Visibility != store.Public || memo.RowStatus == store.Draft {
 		user, err := s.fetchCurrentUser(ctx)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get user")
@@ -308,7 +363,8 @@ func (s *APIV1Service) GetMemo(ctx context.Context, request *v1pb.GetMemoRequest
 		if user == nil {
 			return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
 		}
-		if memo.Visibility == store.Private && memo.CreatorID != user.ID {
+		// Drafts are private to their creator until they are published.
+		if (memo.Visibility == store.Private || memo.RowStatus == store.Draft) && memo.CreatorID != user.ID {
 			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 		}
 	}
@@ -362,7 +418,15 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 	if memo.CreatorID != user.ID && !isSuperUser(user) {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
+	// The update is aborted if the memo has been modified since the client read it, so that it can retry on the latest version.
+	if request.Memo.Etag != "" && request.Memo.Etag != getMemoEtag(memo) {
+		return nil, status.Errorf(codes.Aborted, "etag mismatch: the memo has been modified")
+	}
 
+	// Keep the state before the update to record revisions of content and visibility changes.
+	before := *memo
+	// The payload is rebuilt in place, so the users already notified of their mentions are kept aside.
+	notifiedMentions := getNotifiedMemoMentions(memo)
 	update := &store.UpdateMemo{
 		ID: memo.ID,
 	}
@@ -376,7 +440,11 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 				return nil, status.Errorf(codes.InvalidArgument, "content too long (max %d characters)", contentLengthLimit)
 			}
 			memo.Content = request.Memo.Content
-			if err := memopayload.RebuildMemoPayload(memo, s.MarkdownService); err != nil {
+			location, err := s.Store.GetUserLocation(ctx, memo.CreatorID)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get user timezone: %v", err)
+			}
+			if err := memopayload.RebuildMemoPayload(ctx, s.Store, memo, s.MarkdownService, location); err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to rebuild memo payload: %v", err)
 			}
 			update.Content = &memo.Content
@@ -394,6 +462,9 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 		} else if path == "pinned" {
 			update.Pinned = &request.Memo.Pinned
 		} else if path == "state" {
+			if memo.RowStatus == store.Draft {
+				return nil, status.Errorf(codes.FailedPrecondition, "drafts are published by their schedule")
+			}
 			rowStatus := convertStateToStore(request.Memo.State)
 			update.RowStatus = &rowStatus
 		} else if path == "create_time" {
@@ -420,28 +491,60 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 			payload := memo.Payload
 			payload.Location = convertLocationToStore(request.Memo.Location)
 			update.Payload = payload
-		} else if path == "attachments" {
-			_, err := s.SetMemoAttachments(ctx, &v1pb.SetMemoAttachmentsRequest{
-				Name:        request.Memo.Name,
-				Attachments: request.Memo.Attachments,
-			})
-			if err != nil {
-				return nil, errors.Wrap(err, "failed to set memo attachments")
-			}
-		} else if path == "relations" {
-			_, err := s.SetMemoRelations(ctx, &v1pb.SetMemoRelationsRequest{
-				Name:      request.Memo.Name,
-				Relations: request.Memo.Relations,
-			})
-			if err != nil {
-				return nil, errors.Wrap(err, "failed to set memo relations")
-			}
+		}
+	}
+	if slices.Contains(request.UpdateMask.Paths, "schedule_time") || slices.Contains(request.UpdateMask.Paths, "recurrence") {
+		if memo.RowStatus != store.Draft {
+			return nil, status.Errorf(codes.FailedPrecondition, "only drafts can be scheduled")
+		}
+		scheduleTime, recurrence := memo.Payload.GetSchedule().GetPublishTime(), memo.Payload.GetSchedule().GetRecurrence()
+		if slices.Contains(request.UpdateMask.Paths, "schedule_time") {
+			scheduleTime = request.Memo.ScheduleTime
+		}
+		if slices.Contains(request.UpdateMask.Paths, "recurrence") {
+			recurrence = request.Memo.Recurrence
+		}
+		if err := s.applyMemoSchedule(ctx, memo, scheduleTime, recurrence); err != nil {
+			return nil, err
+		}
+		update.Payload = memo.Payload
+		update.RowStatus = &memo.RowStatus
+		// A draft without schedule is published right away.
+		if memo.RowStatus == store.Normal {
+			createdTs := time.Now().Unix()
+			update.CreatedTs = &createdTs
 		}
 	}
 
+	// The update only applies to the version of the memo the etag was read from, so that concurrent updates cannot both succeed.
+	if request.Memo.Etag != "" {
+		update.ExpectedVersion = &memo.Version
+	}
 	if err = s.Store.UpdateMemo(ctx, update); err != nil {
+		if errors.Is(err, store.ErrMemoModified) {
+			return nil, status.Errorf(codes.Aborted, "etag mismatch: the memo has been modified")
+		}
 		return nil, status.Errorf(codes.Internal, "failed to update memo")
 	}
+	// Attachments and relations are set once the memo update succeeded, as they cannot be rolled back.
+	if slices.Contains(request.UpdateMask.Paths, "attachments") {
+		_, err := s.SetMemoAttachments(ctx, &v1pb.SetMemoAttachmentsRequest{
+			Name:        request.Memo.Name,
+			Attachments: request.Memo.Attachments,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to set memo attachments")
+		}
+	}
+	if slices.Contains(request.UpdateMask.Paths, "relations") {
+		_, err := s.SetMemoRelations(ctx, &v1pb.SetMemoRelationsRequest{
+			Name:      request.Memo.Name,
+			Relations: request.Memo.Relations,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to set memo relations")
+		}
+	}
 
 	memo, err = s.Store.GetMemo(ctx, &store.FindMemo{
 		ID: &memo.ID,
@@ -449,6 +552,15 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get memo")
 	}
+	if memo.Content != before.Content || memo.Visibility != before.Visibility {
+		if err := s.recordMemoRevision(ctx, memo, &before, user.ID); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to record memo revision: %v", err)
+		}
+	}
+	// Only the users newly mentioned, or who can see the memo since the update, are notified.
+	if err := s.notifyMemoMentions(ctx, memo, notifiedMentions); err != nil {
+		slog.Warn("Failed to notify memo mentions", slog.Any("err", err))
+	}
 	reactions, err := s.Store.ListReactions(ctx, &store.FindReaction{
 		ContentID: &request.Memo.Name,
 	})
@@ -466,9 +578,15 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to convert memo")
 	}
-	// Try to dispatch webhook when memo is updated.
-	if err := s.DispatchMemoUpdatedWebhook(ctx, memoMessage); err != nil {
-		slog.Warn("Failed to dispatch memo updated webhook", slog.Any("err", err))
+	// Try to dispatch webhook when memo is updated, or created if it is a published draft.
+	if before.RowStatus == store.Draft && memo.RowStatus == store.Normal {
+		if err := s.DispatchMemoCreatedWebhook(ctx, memoMessage); err != nil {
+			slog.Warn("Failed to dispatch memo created webhook", slog.Any("err", err))
+		}
+	} else if memo.RowStatus != store.Draft {
+		if err := s.DispatchMemoUpdatedWebhook(ctx, memoMessage); err != nil {
+			slog.Warn("Failed to dispatch memo updated webhook", slog.Any("err", err))
+		}
 	}
 
 	return memoMessage, nil
@@ -500,6 +618,18 @@ func (s *APIV1Service) DeleteMemo(ctx context.Context, request *v1pb.DeleteMemoR
 	if memo.CreatorID != user.ID && !isSuperUser(user) {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
+	if request.Etag != "" && request.Etag != getMemoEtag(memo) {
+		return nil, status.Errorf(codes.Aborted, "etag mismatch: the memo has been modified")
+	}
+	if request.Etag != "" {
+		// Claim the version of the memo the etag was read from, so that a concurrent update cannot slip in before the deletion.
+		if err := s.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, ExpectedVersion: &memo.Version}); err != nil {
+			if errors.Is(err, store.ErrMemoModified) {
+				return nil, status.Errorf(codes.Aborted, "etag mismatch: the memo has been modified")
+			}
+			return nil, status.Errorf(codes.Internal, "failed to update memo")
+		}
+	}
 
 	reactions, err := s.Store.ListReactions(ctx, &store.FindReaction{
 		ContentID: &request.Name,
@@ -515,7 +645,7 @@ func (s *APIV1Service) DeleteMemo(ctx context.Context, request *v1pb.DeleteMemoR
 		return nil, status.Errorf(codes.Internal, "failed to list attachments")
 	}
 
-	if memoMessage, err := s.convertMemoFromStore(ctx, memo, reactions, attachments); err == nil {
+	if memoMessage, err := s.convertMemoFromStore(ctx, memo, reactions, attachments); err == nil && memo.RowStatus != store.Draft {
 		// Try to dispatch webhook when memo is deleted.
 		if err := s.DispatchMemoDeletedWebhook(ctx, memoMessage); err != nil {
 			slog.Warn("Failed to dispatch memo deleted webhook", slog.Any("err", err))
@@ -551,6 +681,15 @@ func (s *APIV1Service) CreateMemoComment(ctx context.Context, request *v1pb.Crea
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get memo")
 	}
+	if relatedMemo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	if relatedMemo.RowStatus == store.Draft {
+		return nil, status.Errorf(codes.FailedPrecondition, "drafts cannot be commented on")
+	}
+	if request.Comment.GetScheduleTime() != nil || request.Comment.GetRecurrence() != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "comments cannot be scheduled")
+	}
 
 	// Create the memo comment first.
 	memoComment, err := s.CreateMemo(ctx, &v1pb.CreateMemoRequest{
@@ -582,6 +721,12 @@ func (s *APIV1Service) CreateMemoComment(ctx context.Context, request *v1pb.Crea
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid memo creator")
 	}
+	// Private comments of other users are not visible to the memo creator.
+	if memoComment.Visibility != v1pb.Visibility_PRIVATE || creatorID == relatedMemo.CreatorID {
+		if err := s.DispatchMemoCommentCreatedWebhook(ctx, relatedMemo, memoComment); err != nil {
+			slog.Warn("Failed to dispatch memo comment created webhook", slog.Any("err", err))
+		}
+	}
 	if memoComment.Visibility != v1pb.Visibility_PRIVATE && creatorID != relatedMemo.CreatorID {
 		activity, err := s.Store.CreateActivity(ctx, &store.Activity{
 			CreatorID: creatorID,
@@ -608,6 +753,7 @@ func (s *APIV1Service) CreateMemoComment(ctx context.Context, request *v1pb.Crea
 		}); err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to create inbox")
 		}
+		s.notifyMemoCommentByEmail(ctx, relatedMemo, memoComment, creatorID)
 	}
 
 	return memoComment, nil
@@ -715,22 +861,120 @@ func (s *APIV1Service) getContentLengthLimit(ctx context.Context) (int, error) {
 	return int(instanceMemoRelatedSetting.ContentLengthLimit), nil
 }
 
+// Activity types of the events dispatched to webhooks.
+const (
+	webhookActivityMemoCreated            = "memos.memo.created"
+	webhookActivityMemoUpdated            = "memos.memo.updated"
+	webhookActivityMemoDeleted            = "memos.memo.deleted"
+	webhookActivityMemoCommentCreated     = "memos.memo.comment.created"
+	webhookActivityMemoRelationsUpdated   = "memos.memo.relations.updated"
+	webhookActivityMemoAttachmentsUpdated = "memos.memo.attachments.updated"
+	webhookActivityMemoTaskDue            = "memos.memo.task.due"
+	webhookActivityReactionCreated        = "memos.reaction.created"
+	webhookActivityReactionDeleted        = "memos.reaction.deleted"
+)
+
+// webhookActivityTypes lists the activity types a webhook can subscribe to.
+var webhookActivityTypes = []string{
+	webhookActivityMemoCreated,
+	webhookActivityMemoUpdated,
+	webhookActivityMemoDeleted,
+	webhookActivityMemoCommentCreated,
+	webhookActivityMemoRelationsUpdated,
+	webhookActivityMemoAttachmentsUpdated,
+	webhookActivityMemoTaskDue,
+	webhookActivityReactionCreated,
+	webhookActivityReactionDeleted,
+}
+
+// webhookSnippetLength is the maximum length of the content snippet in chat formatted webhooks.
+const webhookSnippetLength = 280
+
 // DispatchMemoCreatedWebhook dispatches webhook when memo is created.
 func (s *APIV1Service) DispatchMemoCreatedWebhook(ctx context.Context, memo *v1pb.Memo) error {
-	return s.dispatchMemoRelatedWebhook(ctx, memo, "memos.memo.created")
+	return s.dispatchMemoRelatedWebhook(ctx, memo, webhookActivityMemoCreated)
 }
 
 // DispatchMemoUpdatedWebhook dispatches webhook when memo is updated.
 func (s *APIV1Service) DispatchMemoUpdatedWebhook(ctx context.Context, memo *v1pb.Memo) error {
-	return s.dispatchMemoRelatedWebhook(ctx, memo, "memos.memo.updated")
+	return s.dispatchMemoRelatedWebhook(ctx, memo, webhookActivityMemoUpdated)
 }
 
 // DispatchMemoDeletedWebhook dispatches webhook when memo is deleted.
+// It must be called before the memo is removed so that webhook filters can still be evaluated.
 func (s *APIV1Service) DispatchMemoDeletedWebhook(ctx context.Context, memo *v1pb.Memo) error {
-	return s.dispatchMemoRelatedWebhook(ctx, memo, "memos.memo.deleted")
+	return s.dispatchMemoRelatedWebhook(ctx, memo, webhookActivityMemoDeleted)
+}
+
+// DispatchMemoCommentCreatedWebhook dispatches webhook to the memo creator when a comment is added to the memo.
+func (s *APIV1Service) DispatchMemoCommentCreatedWebhook(ctx context.Context, memo *store.Memo, comment *v1pb.Memo) error {
+	return s.dispatchStoreMemoRelatedWebhook(ctx, memo, webhookActivityMemoCommentCreated, func(payload *webhook.WebhookRequestPayload) {
+		payload.Comment = comment
+	})
+}
+
+// DispatchMemoRelationsUpdatedWebhook dispatches webhook when the relations of a memo are set.
+func (s *APIV1Service) DispatchMemoRelationsUpdatedWebhook(ctx context.Context, memo *store.Memo) error {
+	return s.dispatchStoreMemoRelatedWebhook(ctx, memo, webhookActivityMemoRelationsUpdated, func(payload *webhook.WebhookRequestPayload) {
+		payload.Relations = payload.Memo.Relations
+	})
+}
+
+// DispatchMemoAttachmentsUpdatedWebhook dispatches webhook when the attachments of a memo are set.
+func (s *APIV1Service) DispatchMemoAttachmentsUpdatedWebhook(ctx context.Context, memo *store.Memo) error {
+	return s.dispatchStoreMemoRelatedWebhook(ctx, memo, webhookActivityMemoAttachmentsUpdated, func(payload *webhook.WebhookRequestPayload) {
+		payload.Attachments = payload.Memo.Attachments
+	})
+}
+
+// DispatchReactionWebhook dispatches webhook to the memo creator when a reaction on the memo is created or deleted.
+func (s *APIV1Service) DispatchReactionWebhook(ctx context.Context, reaction *v1pb.Reaction, activityType string) error {
+	memoUID, err := ExtractMemoUIDFromName(reaction.ContentId)
+	if err != nil {
+		return errors.Wrap(err, "invalid reaction content id")
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get memo")
+	}
+	if memo == nil {
+		return errors.Errorf("memo not found: %s", reaction.ContentId)
+	}
+	return s.dispatchStoreMemoRelatedWebhook(ctx, memo, activityType, func(payload *webhook.WebhookRequestPayload) {
+		payload.Reaction = reaction
+	})
+}
+
+// dispatchStoreMemoRelatedWebhook converts the stored memo and dispatches an event about it.
+// The fill callback adds the event specific resources to the payload.
+func (s *APIV1Service) dispatchStoreMemoRelatedWebhook(ctx context.Context, memo *store.Memo, activityType string, fill func(payload *webhook.WebhookRequestPayload)) error {
+	contentID := fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID)
+	reactions, err := s.Store.ListReactions(ctx, &store.FindReaction{
+		ContentID: &contentID,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list reactions")
+	}
+	attachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{
+		MemoID: &memo.ID,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list attachments")
+	}
+	memoMessage, err := s.convertMemoFromStore(ctx, memo, reactions, attachments)
+	if err != nil {
+		return errors.Wrap(err, "failed to convert memo")
+	}
+	return s.dispatchWebhook(ctx, memoMessage, activityType, fill)
 }
 
 func (s *APIV1Service) dispatchMemoRelatedWebhook(ctx context.Context, memo *v1pb.Memo, activityType string) error {
+	return s.dispatchWebhook(ctx, memo, activityType, nil)
+}
+
+// dispatchWebhook enqueues an event about the memo for the webhooks of the memo creator
+// that are subscribed to the activity type and whose filter matches the memo.
+func (s *APIV1Service) dispatchWebhook(ctx context.Context, memo *v1pb.Memo, activityType string, fill func(payload *webhook.WebhookRequestPayload)) error {
 	creatorID, err := ExtractUserIDFromName(memo.Creator)
 	if err != nil {
 		return status.Errorf(codes.InvalidArgument, "invalid memo creator")
@@ -740,19 +984,122 @@ func (s *APIV1Service) dispatchMemoRelatedWebhook(ctx context.Context, memo *v1p
 		return err
 	}
 	for _, hook := range webhooks {
+		if !isWebhookSubscribed(hook, activityType) {
+			continue
+		}
+		if hook.Filter != "" {
+			matched, err := s.memoMatchesWebhookFilter(ctx, memo, hook.Filter)
+			if err != nil {
+				slog.Warn("Failed to evaluate webhook filter", slog.String("webhook", hook.Id), slog.Any("err", err))
+				continue
+			}
+			if !matched {
+				continue
+			}
+		}
+
 		payload, err := convertMemoToWebhookPayload(memo)
 		if err != nil {
 			return errors.Wrap(err, "failed to convert memo to webhook payload")
 		}
 		payload.ActivityType = activityType
 		payload.URL = hook.Url
+		if fill != nil {
+			fill(payload)
+		}
 
-		// Use asynchronous webhook dispatch
-		webhook.PostAsync(payload)
+		request, err := webhook.NewFormattedRequest(payload, convertWebhookFormatFromStore(hook.Format), hook.Template, s.buildWebhookMessage(payload))
+		if err != nil {
+			// The request of this webhook cannot be built, e.g. its template is broken, which must not hold back the other webhooks.
+			slog.Warn("Failed to build webhook request", slog.String("webhook", hook.Id), slog.Any("err", err))
+			if _, err := s.Store.CreateWebhookDelivery(ctx, &store.WebhookDelivery{
+				CreatorID:    creatorID,
+				WebhookID:    hook.Id,
+				URL:          hook.Url,
+				ActivityType: activityType,
+				Status:       store.WebhookDeliveryFailed,
+				LastError:    err.Error(),
+			}); err != nil {
+				return errors.Wrap(err, "failed to record webhook delivery")
+			}
+			continue
+		}
+		// Enqueue the delivery, the webhook delivery runner sends it and retries on failure.
+		if _, err := s.Store.CreateWebhookDelivery(ctx, &store.WebhookDelivery{
+			CreatorID:     creatorID,
+			WebhookID:     hook.Id,
+			URL:           request.URL,
+			ActivityType:  activityType,
+			Payload:       string(request.Body),
+			Status:        store.WebhookDeliveryPending,
+			NextAttemptTs: time.Now().Unix(),
+		}); err != nil {
+			return errors.Wrap(err, "failed to enqueue webhook delivery")
+		}
 	}
 	return nil
 }
 
+// buildWebhookMessage builds the human readable summary of the event used by the chat formats.
+func (s *APIV1Service) buildWebhookMessage(payload *webhook.WebhookRequestPayload) *webhook.Message {
+	message := &webhook.Message{}
+	if payload.Memo == nil {
+		return message
+	}
+	content := payload.Memo.Content
+	if payload.Comment != nil {
+		content = payload.Comment.Content
+	}
+	if payload.Task != nil {
+		content = payload.Task.Content
+	}
+	snippet, err := s.MarkdownService.GenerateSnippet([]byte(content), webhookSnippetLength)
+	if err != nil {
+		slog.Warn("Failed to generate webhook snippet", slog.Any("err", err))
+	}
+	message.Snippet = snippet
+	message.URL = s.getMemoLink(payload.Memo.Name)
+	return message
+}
+
+// convertWebhookFormatFromStore converts the stored webhook format to the webhook plugin format.
+func convertWebhookFormatFromStore(format storepb.WebhooksUserSetting_Webhook_Format) webhook.Format {
+	switch format {
+	case storepb.WebhooksUserSetting_Webhook_SLACK:
+		return webhook.FormatSlack
+	case storepb.WebhooksUserSetting_Webhook_DISCORD:
+		return webhook.FormatDiscord
+	case storepb.WebhooksUserSetting_Webhook_MATRIX:
+		return webhook.FormatMatrix
+	case storepb.WebhooksUserSetting_Webhook_TEMPLATE:
+		return webhook.FormatTemplate
+	default:
+		return webhook.FormatJSON
+	}
+}
+
+// isWebhookSubscribed reports whether the webhook receives events of the activity type.
+func isWebhookSubscribed(hook *storepb.WebhooksUserSetting_Webhook, activityType string) bool {
+	return len(hook.EventTypes) == 0 || slices.Contains(hook.EventTypes, activityType)
+}
+
+// memoMatchesWebhookFilter evaluates the webhook filter against the stored memo.
+func (s *APIV1Service) memoMatchesWebhookFilter(ctx context.Context, memo *v1pb.Memo, filter string) (bool, error) {
+	memoUID, err := ExtractMemoUIDFromName(memo.Name)
+	if err != nil {
+		return false, errors.Wrap(err, "invalid memo name")
+	}
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		UID:            &memoUID,
+		ExcludeContent: true,
+		Filters:        []string{filter},
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(memos) > 0, nil
+}
+
 func convertMemoToWebhookPayload(memo *v1pb.Memo) (*webhook.WebhookRequestPayload, error) {
 	creatorID, err := ExtractUserIDFromName(memo.Creator)
 	if err != nil {
@@ -773,6 +1120,24 @@ func (s *APIV1Service) getMemoContentSnippet(content string) (string, error) {
 	return snippet, nil
 }
 
+// getMemoPageToken returns the token of the page after the last memo.
+// The token holds the whole sort key of the memo, so it stays valid whatever the order is,
+// and memos created between page loads neither shift nor repeat the following pages.
+func getMemoPageToken(limit, offset int, last *store.Memo, orderByRelevance bool) (string, error) {
+	if orderByRelevance {
+		return getPageToken(limit, offset)
+	}
+	return marshalPageToken(&v1pb.PageToken{
+		Limit: int32(limit),
+		Cursor: &v1pb.PageToken_Cursor{
+			Pinned:    last.Pinned,
+			CreatedTs: last.CreatedTs,
+			UpdatedTs: last.UpdatedTs,
+			Id:        last.ID,
+		},
+	})
+}
+
 // parseMemoOrderBy parses the order_by field and sets the appropriate ordering in memoFind.
 // Follows AIP-132: supports comma-separated list of fields with optional "desc" suffix.
 // Example: "pinned desc, display_time desc" or "create_time asc".
@@ -815,8 +1180,11 @@ func (*APIV1Service) parseMemoOrderBy(orderBy string, memoFind *store.FindMemo)
 		case "update_time":
 			memoFind.OrderByUpdatedTs = true
 			memoFind.OrderByTimeAsc = fieldDirection == "asc"
+		case "relevance":
+			memoFind.OrderByRelevance = true
+			// Note: relevance is always DESC (most relevant first) regardless of direction specified.
 		default:
-			return errors.Errorf("unsupported order field: %s, supported fields are: pinned, display_time, create_time, update_time, name", fieldName)
+			return errors.Errorf("unsupported order field: %s, supported fields are: pinned, display_time, create_time, update_time, name, relevance", fieldName)
 		}
 	}
 
@@ -34,11 +34,19 @@ func (s *APIV1Service) convertMemoFromStore(ctx context.Context, memo *store.Mem
 		Content:     memo.Content,
 		Visibility:  convertVisibilityFromStore(memo.Visibility),
 		Pinned:      memo.Pinned,
+		Etag:        getMemoEtag(memo),
 	}
 	if memo.Payload != nil {
 		memoMessage.Tags = memo.Payload.Tags
 		memoMessage.Property = convertMemoPropertyFromStore(memo.Payload.Property)
 		memoMessage.Location = convertLocationFromStore(memo.Payload.Location)
+		for _, userID := range memo.Payload.Mentions {
+			memoMessage.Mentions = append(memoMessage.Mentions, fmt.Sprintf("%s%d", UserNamePrefix, userID))
+		}
+		if schedule := memo.Payload.Schedule; schedule != nil {
+			memoMessage.ScheduleTime = schedule.PublishTime
+			memoMessage.Recurrence = schedule.Recurrence
+		}
 	}
 
 	if memo.ParentUID != nil {
@@ -75,16 +83,25 @@ func (s *APIV1Service) convertMemoFromStore(ctx context.Context, memo *store.Mem
 	return memoMessage, nil
 }
 
+// getMemoEtag returns the etag of the memo, which changes with every update of the memo.
+func getMemoEtag(memo *store.Memo) string {
+	return fmt.Sprintf("%d-%d", memo.ID, memo.Version)
+}
+
 func convertMemoPropertyFromStore(property *storepb.MemoPayload_Property) *v1pb.Memo_Property {
 	if property == nil {
 		return nil
 	}
-	return &v1pb.Memo_Property{
+	memoProperty := &v1pb.Memo_Property{
 		HasLink:            property.HasLink,
 		HasTaskList:        property.HasTaskList,
 		HasCode:            property.HasCode,
 		HasIncompleteTasks: property.HasIncompleteTasks,
 	}
+	if property.DueTs != 0 {
+		memoProperty.DueTime = timestamppb.New(time.Unix(property.DueTs, 0))
+	}
+	return memoProperty
 }
 
 func convertLocationFromStore(location *storepb.MemoPayload_Location) *v1pb.Location {
@@ -0,0 +1,127 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/webhook"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/store"
+)
+
+// taskReminderWindow is how long after coming due a task is still reminded of.
+// Tasks which came due long before, e.g. when the due markers of existing memos are first extracted, are not.
+const taskReminderWindow = 24 * time.Hour
+
+// taskReminderBatchSize is the number of memos processed per run, the rest are processed on the next runs.
+const taskReminderBatchSize = 100
+
+// RemindDueTasks notifies the creators of the incomplete tasks which came due since the last run.
+// Each task is reminded of once, with an inbox notification, a webhook event and an email if enabled.
+// Memos which fail to be processed, or are updated while being processed, are retried on the next run.
+// Failures are reported in the returned error.
+func (s *APIV1Service) RemindDueTasks(ctx context.Context) error {
+	now := time.Now()
+	rowStatus := store.Normal
+	remindBefore := now.Unix()
+	limit := taskReminderBatchSize
+	// Only the memos with tasks not reminded of yet are listed, so that reminded memos are not processed again.
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		RowStatus:    &rowStatus,
+		RemindBefore: &remindBefore,
+		Limit:        &limit,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list memos with due tasks")
+	}
+
+	failed := 0
+	for _, memo := range memos {
+		if err := s.remindMemoDueTasks(ctx, memo, now); err != nil {
+			slog.Error("failed to remind due tasks", "err", err, "memoID", memo.ID)
+			failed++
+		}
+	}
+	if failed > 0 {
+		return errors.Errorf("failed to remind the due tasks of %d memos", failed)
+	}
+	return nil
+}
+
+func (s *APIV1Service) remindMemoDueTasks(ctx context.Context, memo *store.Memo, now time.Time) error {
+	updated := false
+	dueTasks := []*storepb.MemoPayload_DueTask{}
+	for _, dueTask := range memo.Payload.GetDueTasks() {
+		dueTime := dueTask.DueTime.AsTime()
+		if dueTask.Completed || dueTask.Reminded || dueTime.After(now) {
+			continue
+		}
+		dueTask.Reminded = true
+		updated = true
+		if now.Sub(dueTime) <= taskReminderWindow {
+			dueTasks = append(dueTasks, dueTask)
+		}
+	}
+	if !updated {
+		return nil
+	}
+	memopayload.SetRemindTs(memo.Payload)
+
+	// The tasks are marked as reminded first, so that a failure skips a reminder rather than sending it twice.
+	// The payload was read before, so it is only written if the memo was not updated since.
+	if err := s.Store.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:              memo.ID,
+		Payload:         memo.Payload,
+		ExpectedVersion: &memo.Version,
+	}); err != nil {
+		if errors.Is(err, store.ErrMemoModified) {
+			return nil
+		}
+		return errors.Wrap(err, "failed to update memo")
+	}
+
+	for _, dueTask := range dueTasks {
+		activity, err := s.Store.CreateActivity(ctx, &store.Activity{
+			CreatorID: memo.CreatorID,
+			Type:      store.ActivityTypeTaskDue,
+			Level:     store.ActivityLevelInfo,
+			Payload: &storepb.ActivityPayload{
+				TaskDue: &storepb.ActivityTaskDuePayload{
+					MemoId:  memo.ID,
+					Content: dueTask.Content,
+					DueTime: dueTask.DueTime,
+				},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to create activity")
+		}
+		if _, err := s.Store.CreateInbox(ctx, &store.Inbox{
+			SenderID:   memo.CreatorID,
+			ReceiverID: memo.CreatorID,
+			Status:     store.UNREAD,
+			Message: &storepb.InboxMessage{
+				Type:       storepb.InboxMessage_TASK_DUE,
+				ActivityId: &activity.ID,
+			},
+		}); err != nil {
+			return errors.Wrap(err, "failed to create inbox")
+		}
+		s.notifyTaskDueByEmail(ctx, memo, dueTask)
+
+		task := &webhook.Task{
+			Content: dueTask.Content,
+			DueTime: dueTask.DueTime.AsTime(),
+		}
+		if err := s.dispatchStoreMemoRelatedWebhook(ctx, memo, webhookActivityMemoTaskDue, func(payload *webhook.WebhookRequestPayload) {
+			payload.Task = task
+		}); err != nil {
+			slog.Warn("Failed to dispatch task due webhook", slog.Any("err", err))
+		}
+	}
+	return nil
+}
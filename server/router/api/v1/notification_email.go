@@ -0,0 +1,322 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/usememos/memos/plugin/email"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// emailDigestWindow is the period of the unread notifications summarised by the daily digest.
+const emailDigestWindow = 24 * time.Hour
+
+// SendTestEmail sends a test email with the SMTP setting of the instance.
+func (s *APIV1Service) SendTestEmail(ctx context.Context, request *v1pb.SendTestEmailRequest) (*emptypb.Empty, error) {
+	user, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if user.Role != store.RoleAdmin {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	to := request.Email
+	if to == "" {
+		to = user.Email
+	}
+	if to == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "email is required")
+	}
+	config, err := s.getEmailConfig(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get smtp setting: %v", err)
+	}
+	if config == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "smtp is not configured")
+	}
+	if err := s.EmailSender(config, &email.Message{
+		To:      []string{to},
+		Subject: "Memos test email",
+		Body:    "This is a test email sent from your Memos instance. Email notifications are working.",
+	}); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to send test email: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// getEmailConfig returns the email configuration from the SMTP setting of the instance.
+// It returns nil if no SMTP server is configured.
+func (s *APIV1Service) getEmailConfig(ctx context.Context) (*email.Config, error) {
+	smtpSetting, err := s.Store.GetInstanceSMTPSetting(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if smtpSetting.GetHost() == "" {
+		return nil, nil
+	}
+	return &email.Config{
+		SMTPHost:     smtpSetting.Host,
+		SMTPPort:     int(smtpSetting.Port),
+		SMTPUsername: smtpSetting.Username,
+		SMTPPassword: smtpSetting.Password,
+		FromEmail:    smtpSetting.FromEmail,
+		FromName:     smtpSetting.FromName,
+		UseTLS:       smtpSetting.UseTls,
+		UseSSL:       smtpSetting.UseSsl,
+	}, nil
+}
+
+// sendNotificationEmail emails the receiver of an inbox notification if they enabled it in their notification preferences.
+// Nothing is sent when the instance has no SMTP server or the receiver has no email.
+// The email is sent in the background and failures are only logged, notifications never fail because of emails.
+func (s *APIV1Service) sendNotificationEmail(ctx context.Context, receiverID int32, enabled func(*storepb.NotificationUserSetting) bool, subject, body string) {
+	config, message, err := s.prepareNotificationEmail(ctx, receiverID, enabled, subject, body)
+	if err != nil {
+		slog.Warn("Failed to prepare notification email", slog.Int("receiverID", int(receiverID)), slog.Any("err", err))
+		return
+	}
+	if message == nil {
+		return
+	}
+	go func() {
+		if err := s.EmailSender(config, message); err != nil {
+			slog.Warn("Failed to send notification email", slog.Int("receiverID", int(receiverID)), slog.Any("err", err))
+		}
+	}()
+}
+
+// prepareNotificationEmail returns the email to send to the receiver, a nil message if none should be sent.
+func (s *APIV1Service) prepareNotificationEmail(ctx context.Context, receiverID int32, enabled func(*storepb.NotificationUserSetting) bool, subject, body string) (*email.Config, *email.Message, error) {
+	config, err := s.getEmailConfig(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to get smtp setting")
+	}
+	if config == nil {
+		return nil, nil, nil
+	}
+	notificationSetting, err := s.Store.GetUserNotificationSetting(ctx, receiverID)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to get notification setting")
+	}
+	if !enabled(notificationSetting) {
+		return nil, nil, nil
+	}
+	receiver, err := s.Store.GetUser(ctx, &store.FindUser{ID: &receiverID})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to get receiver")
+	}
+	if receiver == nil || receiver.Email == "" {
+		return nil, nil, nil
+	}
+	return config, &email.Message{
+		To:      []string{receiver.Email},
+		Subject: subject,
+		Body:    body,
+	}, nil
+}
+
+// notifyMemoCommentByEmail emails the creator of the memo about a new comment.
+func (s *APIV1Service) notifyMemoCommentByEmail(ctx context.Context, relatedMemo *store.Memo, comment *v1pb.Memo, commenterID int32) {
+	commenter, err := s.Store.GetUser(ctx, &store.FindUser{ID: &commenterID})
+	if err != nil || commenter == nil {
+		slog.Warn("Failed to get commenter", slog.Int("userID", int(commenterID)), slog.Any("err", err))
+		return
+	}
+	subject := fmt.Sprintf("%s commented on your memo", getUserDisplayName(commenter))
+	s.sendNotificationEmail(ctx, relatedMemo.CreatorID, (*storepb.NotificationUserSetting).GetEmailMemoComment, subject,
+		s.buildNotificationEmailBody(subject, comment.Content, fmt.Sprintf("%s%s", MemoNamePrefix, relatedMemo.UID)))
+}
+
+// notifyTaskDueByEmail emails the creator of the memo about a task which came due.
+func (s *APIV1Service) notifyTaskDueByEmail(ctx context.Context, memo *store.Memo, dueTask *storepb.MemoPayload_DueTask) {
+	subject := fmt.Sprintf("Task due: %s", dueTask.Content)
+	s.sendNotificationEmail(ctx, memo.CreatorID, (*storepb.NotificationUserSetting).GetEmailTaskDue, subject,
+		s.buildNotificationEmailBody(subject, memo.Content, fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID)))
+}
+
+// buildNotificationEmailBody builds the plain text body of a notification email about a memo.
+func (s *APIV1Service) buildNotificationEmailBody(summary, content, memoName string) string {
+	var sb strings.Builder
+	sb.WriteString(summary)
+	sb.WriteString(".\n")
+	snippet, err := s.getMemoContentSnippet(content)
+	if err != nil {
+		slog.Warn("Failed to generate email snippet", slog.Any("err", err))
+	}
+	if snippet != "" {
+		sb.WriteString("\n")
+		sb.WriteString(snippet)
+		sb.WriteString("\n")
+	}
+	if link := s.getMemoLink(memoName); link != "" {
+		sb.WriteString("\n")
+		sb.WriteString(link)
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+// getMemoLink returns the URL of the memo, empty if the instance URL is unknown.
+func (s *APIV1Service) getMemoLink(memoName string) string {
+	if s.Profile.InstanceURL == "" {
+		return ""
+	}
+	return strings.TrimSuffix(s.Profile.InstanceURL, "/") + "/" + memoName
+}
+
+// SendEmailDigests emails the users who enabled the daily digest a summary of their notifications of the last day which are still unread.
+// It is run once a day at 08:00 UTC, whatever the timezones of the users are.
+// Users who fail to be processed are reported in the returned error.
+func (s *APIV1Service) SendEmailDigests(ctx context.Context) error {
+	config, err := s.getEmailConfig(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get smtp setting")
+	}
+	if config == nil {
+		return nil
+	}
+	userSettings, err := s.Store.ListUserSettings(ctx, &store.FindUserSetting{
+		Key: storepb.UserSetting_NOTIFICATION,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list notification settings")
+	}
+
+	since := time.Now().Add(-emailDigestWindow).Unix()
+	failed := 0
+	for _, userSetting := range userSettings {
+		if !userSetting.GetNotification().GetEmailDigest() {
+			continue
+		}
+		if err := s.sendEmailDigest(ctx, config, userSetting.UserId, since); err != nil {
+			slog.Error("failed to send email digest", "err", err, "userID", userSetting.UserId)
+			failed++
+		}
+	}
+	if failed > 0 {
+		return errors.Errorf("failed to send the email digest of %d users", failed)
+	}
+	return nil
+}
+
+func (s *APIV1Service) sendEmailDigest(ctx context.Context, config *email.Config, userID int32, since int64) error {
+	user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get user")
+	}
+	if user == nil || user.RowStatus == store.Archived || user.Email == "" {
+		return nil
+	}
+	unread := store.UNREAD
+	inboxes, err := s.Store.ListInboxes(ctx, &store.FindInbox{
+		ReceiverID:      &userID,
+		Status:          &unread,
+		MessageTypeList: []storepb.InboxMessage_Type{storepb.InboxMessage_MEMO_COMMENT, storepb.InboxMessage_TASK_DUE, storepb.InboxMessage_MEMO_MENTION},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list inboxes")
+	}
+
+	lines := []string{}
+	for _, inbox := range inboxes {
+		if inbox.CreatedTs < since {
+			continue
+		}
+		line, err := s.describeInboxMessage(ctx, inbox)
+		if err != nil {
+			return errors.Wrapf(err, "failed to describe inbox %d", inbox.ID)
+		}
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	if len(lines) == 0 {
+		return nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("You have %d unread notifications from the last day.\n", len(lines)))
+	for _, line := range lines {
+		sb.WriteString("\n- ")
+		sb.WriteString(line)
+	}
+	sb.WriteString("\n")
+	return s.EmailSender(config, &email.Message{
+		To:      []string{user.Email},
+		Subject: "Your Memos daily digest",
+		Body:    sb.String(),
+	})
+}
+
+// describeInboxMessage returns a one line summary of the inbox notification, empty if its activity is gone.
+func (s *APIV1Service) describeInboxMessage(ctx context.Context, inbox *store.Inbox) (string, error) {
+	if inbox.Message.ActivityId == nil {
+		return "", nil
+	}
+	activity, err := s.Store.GetActivity(ctx, &store.FindActivity{ID: inbox.Message.ActivityId})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get activity")
+	}
+	if activity == nil {
+		return "", nil
+	}
+
+	var summary string
+	var memoID int32
+	switch inbox.Message.Type {
+	case storepb.InboxMessage_MEMO_COMMENT, storepb.InboxMessage_MEMO_MENTION:
+		sender, err := s.Store.GetUser(ctx, &store.FindUser{ID: &inbox.SenderID})
+		if err != nil {
+			return "", errors.Wrap(err, "failed to get sender")
+		}
+		if sender == nil {
+			return "", nil
+		}
+		if inbox.Message.Type == storepb.InboxMessage_MEMO_COMMENT {
+			summary = fmt.Sprintf("%s commented on your memo", getUserDisplayName(sender))
+			memoID = activity.Payload.GetMemoComment().GetRelatedMemoId()
+		} else {
+			summary = fmt.Sprintf("%s mentioned you in a memo", getUserDisplayName(sender))
+			memoID = activity.Payload.GetMemoMention().GetMemoId()
+		}
+	case storepb.InboxMessage_TASK_DUE:
+		summary = fmt.Sprintf("Task due: %s", activity.Payload.GetTaskDue().GetContent())
+		memoID = activity.Payload.GetTaskDue().GetMemoId()
+	default:
+		return "", nil
+	}
+
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &memoID})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get memo")
+	}
+	if memo == nil {
+		return "", nil
+	}
+	if link := s.getMemoLink(fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID)); link != "" {
+		summary = fmt.Sprintf("%s: %s", summary, link)
+	}
+	return summary, nil
+}
+
+// getUserDisplayName returns the nickname of the user, or the username if they have none.
+func getUserDisplayName(user *store.User) string {
+	if user.Nickname != "" {
+		return user.Nickname
+	}
+	return user.Username
+}
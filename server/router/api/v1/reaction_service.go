@@ -3,6 +3,7 @@ package v1
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"time"
 
 	"google.golang.org/grpc/codes"
@@ -50,6 +51,9 @@ func (s *APIV1Service) UpsertMemoReaction(ctx context.Context, request *v1pb.Ups
 	}
 
 	reactionMessage := convertReactionFromStore(reaction)
+	if err := s.DispatchReactionWebhook(ctx, reactionMessage, webhookActivityReactionCreated); err != nil {
+		slog.Warn("Failed to dispatch reaction created webhook", slog.Any("err", err))
+	}
 
 	return reactionMessage, nil
 }
@@ -89,6 +93,9 @@ func (s *APIV1Service) DeleteMemoReaction(ctx context.Context, request *v1pb.Del
 	}); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete reaction")
 	}
+	if err := s.DispatchReactionWebhook(ctx, convertReactionFromStore(reaction), webhookActivityReactionDeleted); err != nil {
+		slog.Warn("Failed to dispatch reaction deleted webhook", slog.Any("err", err))
+	}
 
 	return &emptypb.Empty{}, nil
 }
@@ -11,6 +11,7 @@ import (
 
 const (
 	InstanceSettingNamePrefix  = "instance/settings/"
+	InstanceJobNamePrefix      = "instance/jobs/"
 	UserNamePrefix             = "users/"
 	MemoNamePrefix             = "memos/"
 	AttachmentNamePrefix       = "attachments/"
@@ -19,6 +20,7 @@ const (
 	IdentityProviderNamePrefix = "identity-providers/"
 	ActivityNamePrefix         = "activities/"
 	WebhookNamePrefix          = "webhooks/"
+	RevisionNamePrefix         = "revisions/"
 )
 
 // GetNameParentTokens returns the tokens from a resource name.
@@ -60,6 +62,15 @@ func ExtractInstanceSettingKeyFromName(name string) (string, error) {
 	return settingKey, nil
 }
 
+// ExtractInstanceJobNameFromName returns the job name from a resource name.
+func ExtractInstanceJobNameFromName(name string) (string, error) {
+	jobName := strings.TrimPrefix(name, InstanceJobNamePrefix)
+	if jobName == name || jobName == "" || strings.Contains(jobName, "/") {
+		return "", errors.Errorf("invalid instance job name %q", name)
+	}
+	return jobName, nil
+}
+
 // ExtractUserIDFromName returns the uid from a resource name.
 func ExtractUserIDFromName(name string) (int32, error) {
 	tokens, err := GetNameParentTokens(name, UserNamePrefix)
@@ -120,6 +131,21 @@ func ExtractMemoReactionIDFromName(name string) (string, int32, error) {
 	return memoUID, reactionID, nil
 }
 
+// ExtractMemoRevisionIDFromName returns the memo UID and revision ID from a resource name.
+// e.g., "memos/abc/revisions/123" -> ("abc", 123).
+func ExtractMemoRevisionIDFromName(name string) (string, int32, error) {
+	tokens, err := GetNameParentTokens(name, MemoNamePrefix, RevisionNamePrefix)
+	if err != nil {
+		return "", 0, err
+	}
+	memoUID := tokens[0]
+	revisionID, err := util.ConvertStringToInt32(tokens[1])
+	if err != nil {
+		return "", 0, errors.Errorf("invalid revision ID %q", tokens[1])
+	}
+	return memoUID, revisionID, nil
+}
+
 // ExtractInboxIDFromName returns the inbox ID from a resource name.
 func ExtractInboxIDFromName(name string) (int32, error) {
 	tokens, err := GetNameParentTokens(name, InboxNamePrefix)
@@ -0,0 +1,275 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) ListTags(ctx context.Context, request *v1pb.ListTagsRequest) (*v1pb.ListTagsResponse, error) {
+	userID, err := s.getTagOwnerID(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{CreatorID: &userID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+
+	tagCount := map[string]int32{}
+	for _, memo := range memos {
+		for _, tag := range memo.Payload.GetTags() {
+			tagCount[tag]++
+		}
+	}
+	response := &v1pb.ListTagsResponse{
+		Tags: []*v1pb.Tag{},
+	}
+	for tag, count := range tagCount {
+		response.Tags = append(response.Tags, &v1pb.Tag{
+			Tag:       tag,
+			MemoCount: count,
+		})
+	}
+	slices.SortFunc(response.Tags, func(a, b *v1pb.Tag) int {
+		return strings.Compare(a.Tag, b.Tag)
+	})
+	return response, nil
+}
+
+func (s *APIV1Service) RenameTag(ctx context.Context, request *v1pb.RenameTagRequest) (*v1pb.RenameTagResponse, error) {
+	userID, err := s.getTagOwnerID(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validateTag(request.OldTag); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid old tag: %v", err)
+	}
+	if err := s.validateTag(request.NewTag); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid new tag: %v", err)
+	}
+	if request.OldTag == request.NewTag {
+		return nil, status.Errorf(codes.InvalidArgument, "new tag must differ from old tag")
+	}
+
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{CreatorID: &userID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+	if !hasTag(memos, request.OldTag) {
+		return nil, status.Errorf(codes.NotFound, "tag %q not found", request.OldTag)
+	}
+	// Renaming to a tag used apart from the renamed ones is a merge.
+	for _, memo := range memos {
+		for _, tag := range memo.Payload.GetTags() {
+			if isTagOrNested(tag, request.NewTag) && !isTagOrNested(tag, request.OldTag) {
+				return nil, status.Errorf(codes.AlreadyExists, "tag %q already exists", request.NewTag)
+			}
+		}
+	}
+
+	memoNames, err := s.rewriteMemoTags(ctx, memos, []string{request.OldTag}, request.ValidateOnly, func(content []byte) (string, error) {
+		return s.MarkdownService.RenameTag(content, request.OldTag, request.NewTag)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1pb.RenameTagResponse{Memos: memoNames}, nil
+}
+
+func (s *APIV1Service) MergeTags(ctx context.Context, request *v1pb.MergeTagsRequest) (*v1pb.MergeTagsResponse, error) {
+	userID, err := s.getTagOwnerID(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+	if len(request.SourceTags) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "source tags are required")
+	}
+	for _, tag := range request.SourceTags {
+		if err := s.validateTag(tag); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid source tag: %v", err)
+		}
+		if strings.EqualFold(tag, request.TargetTag) {
+			return nil, status.Errorf(codes.InvalidArgument, "source tag %q is the target tag", tag)
+		}
+	}
+	if err := s.validateTag(request.TargetTag); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid target tag: %v", err)
+	}
+
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{CreatorID: &userID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+	for _, tag := range request.SourceTags {
+		if !hasTag(memos, tag) {
+			return nil, status.Errorf(codes.NotFound, "tag %q not found", tag)
+		}
+	}
+
+	memoNames, err := s.rewriteMemoTags(ctx, memos, request.SourceTags, request.ValidateOnly, func(content []byte) (string, error) {
+		for _, tag := range request.SourceTags {
+			renamed, err := s.MarkdownService.RenameTag(content, tag, request.TargetTag)
+			if err != nil {
+				return "", err
+			}
+			content = []byte(renamed)
+		}
+		return string(content), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1pb.MergeTagsResponse{Memos: memoNames}, nil
+}
+
+func (s *APIV1Service) DeleteTag(ctx context.Context, request *v1pb.DeleteTagRequest) (*v1pb.DeleteTagResponse, error) {
+	userID, err := s.getTagOwnerID(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validateTag(request.Tag); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tag: %v", err)
+	}
+
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{CreatorID: &userID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+	if !hasTag(memos, request.Tag) {
+		return nil, status.Errorf(codes.NotFound, "tag %q not found", request.Tag)
+	}
+
+	memoNames, err := s.rewriteMemoTags(ctx, memos, []string{request.Tag}, request.ValidateOnly, func(content []byte) (string, error) {
+		return s.MarkdownService.RemoveTag(content, request.Tag)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1pb.DeleteTagResponse{Memos: memoNames}, nil
+}
+
+// getTagOwnerID returns the ID of the user whose tags are managed, who must be the current user.
+func (s *APIV1Service) getTagOwnerID(ctx context.Context, parent string) (int32, error) {
+	userID, err := ExtractUserIDFromName(parent)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	currentUser, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return 0, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if currentUser.ID != userID {
+		return 0, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return userID, nil
+}
+
+// validateTag checks that the tag, without the # prefix, is parsed back as a single tag.
+func (s *APIV1Service) validateTag(tag string) error {
+	if tag == "" {
+		return errors.New("tag is required")
+	}
+	tags, err := s.MarkdownService.ExtractTags([]byte("#" + tag))
+	if err != nil {
+		return err
+	}
+	if len(tags) != 1 || tags[0] != strings.ToLower(tag) {
+		return errors.Errorf("%q is not a valid tag", tag)
+	}
+	return nil
+}
+
+// rewriteMemoTags rewrites the content of the memos using any of the tags, or the tags nested under them.
+// The memos are updated in a single transaction, unless validateOnly is set.
+// The memos are owned by the current user, and are only updated if none of them was updated since they were listed.
+// It returns the names of the memos whose content changes.
+func (s *APIV1Service) rewriteMemoTags(ctx context.Context, memos []*store.Memo, tags []string, validateOnly bool, rewrite func(content []byte) (string, error)) ([]string, error) {
+	currentUser, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	location, err := s.Store.GetUserLocation(ctx, currentUser.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user timezone: %v", err)
+	}
+
+	memoNames := []string{}
+	updates := []*store.UpdateMemo{}
+	updatedMemos, previousMemos := []*store.Memo{}, []store.Memo{}
+	for _, memo := range memos {
+		if !slices.ContainsFunc(tags, func(tag string) bool {
+			return hasTag([]*store.Memo{memo}, tag)
+		}) {
+			continue
+		}
+		content, err := rewrite([]byte(memo.Content))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to rewrite memo %d: %v", memo.ID, err)
+		}
+		if content == memo.Content {
+			continue
+		}
+
+		before := *memo
+		memo.Content = content
+		if err := memopayload.RebuildMemoPayload(ctx, s.Store, memo, s.MarkdownService, location); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to rebuild payload of memo %d: %v", memo.ID, err)
+		}
+		memoNames = append(memoNames, fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID))
+		updates = append(updates, &store.UpdateMemo{
+			ID:              memo.ID,
+			Content:         &memo.Content,
+			Payload:         memo.Payload,
+			ExpectedVersion: &memo.Version,
+		})
+		updatedMemos = append(updatedMemos, memo)
+		previousMemos = append(previousMemos, before)
+	}
+	if validateOnly || len(updates) == 0 {
+		return memoNames, nil
+	}
+
+	if err := s.Store.UpdateMemos(ctx, updates); err != nil {
+		if errors.Is(err, store.ErrMemoModified) {
+			return nil, status.Errorf(codes.Aborted, "a memo has been modified, please retry")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update memos: %v", err)
+	}
+	for i, memo := range updatedMemos {
+		if err := s.recordMemoRevision(ctx, memo, &previousMemos[i], currentUser.ID); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to record memo revision: %v", err)
+		}
+	}
+	return memoNames, nil
+}
+
+// hasTag reports whether any of the memos uses the tag or a tag nested under it.
+func hasTag(memos []*store.Memo, tag string) bool {
+	for _, memo := range memos {
+		for _, memoTag := range memo.Payload.GetTags() {
+			if isTagOrNested(memoTag, tag) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isTagOrNested reports whether the lowercase memo tag is the tag or nested under it.
+func isTagOrNested(memoTag, tag string) bool {
+	tag = strings.ToLower(tag)
+	return memoTag == tag || strings.HasPrefix(memoTag, tag+"/")
+}
@@ -0,0 +1,284 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestListActivities(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	admin := createUserWithPassword(ctx, t, ts, "admin", store.RoleAdmin)
+	alice := createUserWithPassword(ctx, t, ts, "alice", store.RoleUser)
+	bob := createUserWithPassword(ctx, t, ts, "bob", store.RoleUser)
+	carol := createUserWithPassword(ctx, t, ts, "carol", store.RoleUser)
+	aliceCtx := ts.CreateUserContext(ctx, alice.ID)
+	bobCtx := ts.CreateUserContext(ctx, bob.ID)
+
+	// Bob comments the memo of alice, alice is notified in the inbox.
+	memo, err := ts.Service.CreateMemo(aliceCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "Hello", Visibility: v1pb.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+	for i := 0; i < 5; i++ {
+		_, err = ts.Service.CreateMemoComment(bobCtx, &v1pb.CreateMemoCommentRequest{
+			Name:    memo.Name,
+			Comment: &v1pb.Memo{Content: fmt.Sprintf("Comment %d", i), Visibility: v1pb.Visibility_PUBLIC},
+		})
+		require.NoError(t, err)
+	}
+	err = signInWithWrongPassword(ctx, ts, "alice")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	listAll := func(userID int32, filter string) []*v1pb.Activity {
+		activities := []*v1pb.Activity{}
+		pageToken := ""
+		for {
+			response, err := ts.Service.ListActivities(ts.CreateUserContext(ctx, userID), &v1pb.ListActivitiesRequest{
+				PageSize:  2,
+				PageToken: pageToken,
+				Filter:    filter,
+			})
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(response.Activities), 2)
+			activities = append(activities, response.Activities...)
+			if response.NextPageToken == "" {
+				return activities
+			}
+			pageToken = response.NextPageToken
+		}
+	}
+
+	t.Run("admins view all activities", func(t *testing.T) {
+		activities := listAll(admin.ID, "")
+		require.Len(t, activities, 6)
+		// The newest activity comes first.
+		require.Equal(t, v1pb.Activity_SIGN_IN_FAILED, activities[0].Type)
+		names := map[string]bool{}
+		for _, activity := range activities {
+			names[activity.Name] = true
+		}
+		require.Len(t, names, 6)
+	})
+
+	t.Run("users view the activities they are party to", func(t *testing.T) {
+		// Failed sign-ins are hidden from the user whose account was targeted.
+		activities := listAll(alice.ID, "")
+		require.Len(t, activities, 5)
+		for _, activity := range activities {
+			require.Equal(t, v1pb.Activity_MEMO_COMMENT, activity.Type)
+		}
+		require.Len(t, listAll(bob.ID, ""), 5)
+		require.Empty(t, listAll(carol.ID, ""))
+
+		_, err := ts.Service.GetActivity(ts.CreateUserContext(ctx, carol.ID), &v1pb.GetActivityRequest{Name: activities[0].Name})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		activity, err := ts.Service.GetActivity(aliceCtx, &v1pb.GetActivityRequest{Name: activities[0].Name})
+		require.NoError(t, err)
+		require.Equal(t, activities[0].Name, activity.Name)
+	})
+
+	t.Run("filters activities", func(t *testing.T) {
+		require.Len(t, listAll(admin.ID, `activity_type == "SIGN_IN_FAILED"`), 1)
+		require.Len(t, listAll(admin.ID, `level == "INFO" && create_time > now() - 3600`), 5)
+		require.Len(t, listAll(admin.ID, fmt.Sprintf("creator_id == %d", bob.ID)), 5)
+		require.Empty(t, listAll(alice.ID, `activity_type == "SIGN_IN_FAILED"`))
+
+		_, err := ts.Service.ListActivities(aliceCtx, &v1pb.ListActivitiesRequest{Filter: `unknown == 1`})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = ts.Service.ListActivities(aliceCtx, &v1pb.ListActivitiesRequest{PageToken: "invalid"})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("activities outlive the deleted comments", func(t *testing.T) {
+		comment, err := ts.Service.CreateMemoComment(bobCtx, &v1pb.CreateMemoCommentRequest{
+			Name:    memo.Name,
+			Comment: &v1pb.Memo{Content: "Deleted comment", Visibility: v1pb.Visibility_PUBLIC},
+		})
+		require.NoError(t, err)
+		_, err = ts.Service.DeleteMemo(bobCtx, &v1pb.DeleteMemoRequest{Name: comment.Name})
+		require.NoError(t, err)
+
+		activities := listAll(alice.ID, "")
+		require.Len(t, activities, 6)
+		require.Empty(t, activities[0].Payload.GetMemoComment().Memo)
+		require.Equal(t, memo.Name, activities[0].Payload.GetMemoComment().RelatedMemo)
+	})
+}
+
+func TestListAuditEvents(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	admin := createUserWithPassword(ctx, t, ts, "admin", store.RoleAdmin)
+	alice := createUserWithPassword(ctx, t, ts, "alice", store.RoleUser)
+	adminCtx := ts.CreateUserContext(ctx, admin.ID)
+	aliceCtx := ts.CreateUserContext(ctx, alice.ID)
+	aliceName := fmt.Sprintf("users/%d", alice.ID)
+
+	_, err := signInWithPassword(ctx, ts, "alice")
+	require.NoError(t, err)
+	token, err := ts.Service.CreatePersonalAccessToken(aliceCtx, &v1pb.CreatePersonalAccessTokenRequest{
+		Parent:      aliceName,
+		Description: "CLI",
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.DeletePersonalAccessToken(aliceCtx, &v1pb.DeletePersonalAccessTokenRequest{Name: token.PersonalAccessToken.Name})
+	require.NoError(t, err)
+	_, err = ts.Service.UpdateUser(adminCtx, &v1pb.UpdateUserRequest{
+		User:       &v1pb.User{Name: aliceName, Role: v1pb.User_ADMIN},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"role"}},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.UpdateUser(adminCtx, &v1pb.UpdateUserRequest{
+		User:       &v1pb.User{Name: aliceName, State: v1pb.State_ARCHIVED},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"state"}},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.UpdateInstanceSetting(adminCtx, &v1pb.UpdateInstanceSettingRequest{
+		Setting: &v1pb.InstanceSetting{
+			Name: "instance/settings/GENERAL",
+			Value: &v1pb.InstanceSetting_GeneralSetting_{
+				GeneralSetting: &v1pb.InstanceSetting_GeneralSetting{DisallowUserRegistration: true},
+			},
+		},
+	})
+	require.NoError(t, err)
+	identityProvider, err := ts.Service.CreateIdentityProvider(adminCtx, &v1pb.CreateIdentityProviderRequest{
+		IdentityProvider: &v1pb.IdentityProvider{
+			Title: "SSO",
+			Type:  v1pb.IdentityProvider_OAUTH2,
+			Config: &v1pb.IdentityProviderConfig{
+				Config: &v1pb.IdentityProviderConfig_Oauth2Config{
+					Oauth2Config: &v1pb.OAuth2Config{
+						ClientId:     "client",
+						AuthUrl:      "https://example.com/auth",
+						TokenUrl:     "https://example.com/token",
+						UserInfoUrl:  "https://example.com/user",
+						FieldMapping: &v1pb.FieldMapping{Identifier: "id"},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.DeleteIdentityProvider(adminCtx, &v1pb.DeleteIdentityProviderRequest{Name: identityProvider.Name})
+	require.NoError(t, err)
+	err = signInWithWrongPassword(ctx, ts, "admin")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	listAll := func(filter string) []*v1pb.Activity {
+		auditEvents := []*v1pb.Activity{}
+		pageToken := ""
+		for {
+			response, err := ts.Service.ListAuditEvents(adminCtx, &v1pb.ListAuditEventsRequest{
+				PageSize:  3,
+				PageToken: pageToken,
+				Filter:    filter,
+			})
+			require.NoError(t, err)
+			auditEvents = append(auditEvents, response.AuditEvents...)
+			if response.NextPageToken == "" {
+				return auditEvents
+			}
+			pageToken = response.NextPageToken
+		}
+	}
+
+	t.Run("lists the audit events from the newest", func(t *testing.T) {
+		types := []v1pb.Activity_Type{}
+		for _, auditEvent := range listAll("") {
+			types = append(types, auditEvent.Type)
+		}
+		require.Equal(t, []v1pb.Activity_Type{
+			v1pb.Activity_SIGN_IN_FAILED,
+			v1pb.Activity_IDENTITY_PROVIDER_DELETED,
+			v1pb.Activity_IDENTITY_PROVIDER_CREATED,
+			v1pb.Activity_INSTANCE_SETTING_UPDATED,
+			v1pb.Activity_USER_ARCHIVED,
+			v1pb.Activity_USER_ROLE_CHANGED,
+			v1pb.Activity_PERSONAL_ACCESS_TOKEN_DELETED,
+			v1pb.Activity_PERSONAL_ACCESS_TOKEN_CREATED,
+			v1pb.Activity_SIGN_IN,
+		}, types)
+	})
+
+	t.Run("filters audit events", func(t *testing.T) {
+		auditEvents := listAll(`activity_type == "USER_ROLE_CHANGED"`)
+		require.Len(t, auditEvents, 1)
+		require.Equal(t, fmt.Sprintf("users/%d", admin.ID), auditEvents[0].Creator)
+		payload := auditEvents[0].Payload.GetAudit()
+		require.Equal(t, aliceName, payload.Resource)
+		require.Equal(t, "USER", payload.OldValue)
+		require.Equal(t, "ADMIN", payload.NewValue)
+
+		auditEvents = listAll(fmt.Sprintf("creator_id == %d", alice.ID))
+		require.Len(t, auditEvents, 3)
+		require.Equal(t, token.PersonalAccessToken.Name, auditEvents[1].Payload.GetAudit().Resource)
+		require.Equal(t, "CLI", auditEvents[1].Payload.GetAudit().NewValue)
+
+		auditEvents = listAll(`activity_type in ["USER_ROLE_CHANGED", "PERSONAL_ACCESS_TOKEN_CREATED"] && create_time <= now()`)
+		require.NotEmpty(t, auditEvents)
+		for _, auditEvent := range auditEvents {
+			require.Contains(t, []v1pb.Activity_Type{v1pb.Activity_USER_ROLE_CHANGED, v1pb.Activity_PERSONAL_ACCESS_TOKEN_CREATED}, auditEvent.Type)
+		}
+
+		_, err := ts.Service.ListAuditEvents(adminCtx, &v1pb.ListAuditEventsRequest{Filter: `unknown == 1`})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = ts.Service.ListAuditEvents(adminCtx, &v1pb.ListAuditEventsRequest{Filter: `level < "WARN"`})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("exports audit events as JSON lines", func(t *testing.T) {
+		response, err := ts.Service.ExportAuditEvents(adminCtx, &v1pb.ExportAuditEventsRequest{Filter: `level == "INFO"`})
+		require.NoError(t, err)
+		lines := strings.Split(strings.TrimSuffix(string(response.Content), "\n"), "\n")
+		require.Len(t, lines, 8)
+		auditEvent := &v1pb.Activity{}
+		require.NoError(t, protojson.Unmarshal([]byte(lines[0]), auditEvent))
+		require.Equal(t, v1pb.Activity_IDENTITY_PROVIDER_DELETED, auditEvent.Type)
+		require.Equal(t, identityProvider.Name, auditEvent.Payload.GetAudit().Resource)
+		require.Equal(t, "SSO", auditEvent.Payload.GetAudit().OldValue)
+		require.Empty(t, response.NextPageToken)
+
+		// Exports continue from a page token.
+		page, err := ts.Service.ListAuditEvents(adminCtx, &v1pb.ListAuditEventsRequest{Filter: `level == "INFO"`, PageSize: 3})
+		require.NoError(t, err)
+		response, err = ts.Service.ExportAuditEvents(adminCtx, &v1pb.ExportAuditEventsRequest{Filter: `level == "INFO"`, PageToken: page.NextPageToken})
+		require.NoError(t, err)
+		require.Equal(t, strings.Join(lines[3:], "\n")+"\n", string(response.Content))
+		require.Empty(t, response.NextPageToken)
+	})
+
+	t.Run("only admins view audit events", func(t *testing.T) {
+		bob := createUserWithPassword(ctx, t, ts, "bob", store.RoleUser)
+		bobCtx := ts.CreateUserContext(ctx, bob.ID)
+		_, err := ts.Service.ListAuditEvents(bobCtx, &v1pb.ListAuditEventsRequest{})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = ts.Service.ExportAuditEvents(bobCtx, &v1pb.ExportAuditEventsRequest{})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = ts.Service.ListAuditEvents(ctx, &v1pb.ListAuditEventsRequest{})
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+		// Audit events are hidden from the activities of the users they are about.
+		_, err = ts.Service.CreatePersonalAccessToken(bobCtx, &v1pb.CreatePersonalAccessTokenRequest{Parent: fmt.Sprintf("users/%d", bob.ID)})
+		require.NoError(t, err)
+		response, err := ts.Service.ListActivities(bobCtx, &v1pb.ListActivitiesRequest{})
+		require.NoError(t, err)
+		require.Empty(t, response.Activities)
+	})
+}
@@ -0,0 +1,165 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/idp/ldap/ldaptest"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func newLDAPServer(t *testing.T) *ldaptest.Server {
+	server, err := ldaptest.NewServer(
+		&ldaptest.Entry{
+			DN:       "cn=memos,ou=services,dc=example,dc=com",
+			Password: "service-password",
+		},
+		&ldaptest.Entry{
+			DN:       "uid=alice,ou=people,dc=example,dc=com",
+			Password: "alice-password",
+			Attributes: map[string][]string{
+				"uid":  {"alice"},
+				"cn":   {"Alice Liddell"},
+				"mail": {"alice@example.com"},
+			},
+		},
+		&ldaptest.Entry{
+			DN:         "uid=contractor-bob,ou=people,dc=example,dc=com",
+			Password:   "bob-password",
+			Attributes: map[string][]string{"uid": {"contractor-bob"}},
+		},
+	)
+	require.NoError(t, err)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func createLDAPIdentityProvider(ctx context.Context, ts *TestService, adminID int32, serverURL, identifierFilter string) (*v1pb.IdentityProvider, error) {
+	return ts.Service.CreateIdentityProvider(ts.CreateUserContext(ctx, adminID), &v1pb.CreateIdentityProviderRequest{
+		IdentityProvider: &v1pb.IdentityProvider{
+			Title:            "Directory",
+			Type:             v1pb.IdentityProvider_LDAP,
+			IdentifierFilter: identifierFilter,
+			Config: &v1pb.IdentityProviderConfig{
+				Config: &v1pb.IdentityProviderConfig_LdapConfig{
+					LdapConfig: &v1pb.LDAPConfig{
+						ServerUrl:    serverURL,
+						BindDn:       "cn=memos,ou=services,dc=example,dc=com",
+						BindPassword: "service-password",
+						SearchBase:   "ou=people,dc=example,dc=com",
+						FieldMapping: &v1pb.FieldMapping{
+							Identifier:  "uid",
+							DisplayName: "cn",
+							Email:       "mail",
+						},
+					},
+				},
+			},
+		},
+	})
+}
+
+func signInWithDirectoryPassword(ctx context.Context, ts *TestService, username, password string) (*v1pb.SignInResponse, error) {
+	return ts.Service.SignIn(apiv1.WithHeaderCarrier(ctx), &v1pb.SignInRequest{
+		Credentials: &v1pb.SignInRequest_PasswordCredentials_{
+			PasswordCredentials: &v1pb.SignInRequest_PasswordCredentials{
+				Username: username,
+				Password: password,
+			},
+		},
+	})
+}
+
+func TestLDAPIdentityProvider(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("validates and hides the configuration", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+		admin := createUserWithPassword(ctx, t, ts, "admin", store.RoleAdmin)
+		regular := createUserWithPassword(ctx, t, ts, "bob", store.RoleUser)
+		server := newLDAPServer(t)
+
+		identityProvider, err := createLDAPIdentityProvider(ctx, ts, admin.ID, server.URL, "")
+		require.NoError(t, err)
+		require.Equal(t, v1pb.IdentityProvider_LDAP, identityProvider.Type)
+		require.Equal(t, "service-password", identityProvider.Config.GetLdapConfig().BindPassword)
+
+		identityProvider, err = ts.Service.GetIdentityProvider(ts.CreateUserContext(ctx, regular.ID), &v1pb.GetIdentityProviderRequest{
+			Name: identityProvider.Name,
+		})
+		require.NoError(t, err)
+		require.Nil(t, identityProvider.Config)
+
+		_, err = createLDAPIdentityProvider(ctx, ts, admin.ID, "", "")
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("signs in with the directory password", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+		admin := createUserWithPassword(ctx, t, ts, "admin", store.RoleAdmin)
+		_, err := createLDAPIdentityProvider(ctx, ts, admin.ID, newLDAPServer(t).URL, "")
+		require.NoError(t, err)
+
+		// The user is created on the first sign-in.
+		response, err := signInWithDirectoryPassword(ctx, ts, "alice", "alice-password")
+		require.NoError(t, err)
+		require.NotEmpty(t, response.AccessToken)
+		require.Equal(t, "alice", response.User.Username)
+		require.Equal(t, "Alice Liddell", response.User.DisplayName)
+		require.Equal(t, "alice@example.com", response.User.Email)
+		require.Equal(t, v1pb.User_USER, response.User.Role)
+
+		response, err = signInWithDirectoryPassword(ctx, ts, "alice", "alice-password")
+		require.NoError(t, err)
+		require.Equal(t, "alice", response.User.Username)
+
+		_, err = signInWithDirectoryPassword(ctx, ts, "alice", "wrong-password")
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = signInWithDirectoryPassword(ctx, ts, "unknown", "alice-password")
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		// Local users keep signing in with their local password.
+		_, err = signInWithPassword(ctx, ts, "admin")
+		require.NoError(t, err)
+	})
+
+	t.Run("respects the registration setting and identifier filter", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+		admin := createUserWithPassword(ctx, t, ts, "admin", store.RoleAdmin)
+		server := newLDAPServer(t)
+		_, err := createLDAPIdentityProvider(ctx, ts, admin.ID, server.URL, "^[a-z]+$")
+		require.NoError(t, err)
+
+		_, err = signInWithDirectoryPassword(ctx, ts, "contractor-bob", "bob-password")
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		_, err = ts.Service.UpdateInstanceSetting(ts.CreateUserContext(ctx, admin.ID), &v1pb.UpdateInstanceSettingRequest{
+			Setting: &v1pb.InstanceSetting{
+				Name: "instance/settings/GENERAL",
+				Value: &v1pb.InstanceSetting_GeneralSetting_{
+					GeneralSetting: &v1pb.InstanceSetting_GeneralSetting{
+						DisallowUserRegistration: true,
+					},
+				},
+			},
+		})
+		require.NoError(t, err)
+		_, err = signInWithDirectoryPassword(ctx, ts, "alice", "alice-password")
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		// Existing users still sign in with the directory password.
+		createUserWithPassword(ctx, t, ts, "alice", store.RoleUser)
+		response, err := signInWithDirectoryPassword(ctx, ts, "alice", "alice-password")
+		require.NoError(t, err)
+		require.Equal(t, "alice", response.User.Username)
+	})
+}
@@ -0,0 +1,160 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/idp/oidc/oidctest"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// oidcIssuer is an in-process OpenID provider issuing the ID tokens of the sign-ins.
+type oidcIssuer struct {
+	*oidctest.Server
+}
+
+func newOIDCIssuer(t *testing.T) *oidcIssuer {
+	server, err := oidctest.NewServer()
+	require.NoError(t, err)
+	t.Cleanup(server.Close)
+	return &oidcIssuer{Server: server}
+}
+
+// signIn signs in with an ID token of the user in the groups.
+// The token is issued for the nonce "nonce", the sign-in request sends the given nonce.
+func (i *oidcIssuer) signIn(ctx context.Context, ts *TestService, idpID int32, username, nonce string, groups ...string) (*v1pb.SignInResponse, error) {
+	idToken, err := i.Sign(jwt.SigningMethodRS256, oidctest.RSAKeyID, jwt.MapClaims{
+		"iss":                i.URL,
+		"aud":                "memos",
+		"sub":                "subject-" + username,
+		"exp":                time.Now().Add(5 * time.Minute).Unix(),
+		"nonce":              "nonce",
+		"preferred_username": username,
+		"name":               "OIDC " + username,
+		"groups":             groups,
+	})
+	if err != nil {
+		return nil, err
+	}
+	i.IDToken = idToken
+	return ts.Service.SignIn(apiv1.WithHeaderCarrier(ctx), &v1pb.SignInRequest{
+		Credentials: &v1pb.SignInRequest_SsoCredentials{
+			SsoCredentials: &v1pb.SignInRequest_SSOCredentials{
+				IdpId:        idpID,
+				Code:         "code",
+				RedirectUri:  "https://memos.example.com/auth/callback",
+				CodeVerifier: "verifier",
+				Nonce:        nonce,
+			},
+		},
+	})
+}
+
+func createOIDCIdentityProvider(ctx context.Context, ts *TestService, adminID int32, issuerURL string) (*v1pb.IdentityProvider, error) {
+	return ts.Service.CreateIdentityProvider(ts.CreateUserContext(ctx, adminID), &v1pb.CreateIdentityProviderRequest{
+		IdentityProvider: &v1pb.IdentityProvider{
+			Title: "Keycloak",
+			Type:  v1pb.IdentityProvider_OIDC,
+			Config: &v1pb.IdentityProviderConfig{
+				Config: &v1pb.IdentityProviderConfig_OidcConfig{
+					OidcConfig: &v1pb.OIDCConfig{
+						IssuerUrl:    issuerURL,
+						ClientId:     "memos",
+						ClientSecret: "secret",
+						FieldMapping: &v1pb.FieldMapping{
+							Identifier:  "preferred_username",
+							DisplayName: "name",
+						},
+						GroupsClaim: "groups",
+						AdminGroups: []string{"memos-admins"},
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestOIDCIdentityProvider(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("discovers the issuer when created", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+		admin := createUserWithPassword(ctx, t, ts, "admin", store.RoleAdmin)
+		regular := createUserWithPassword(ctx, t, ts, "bob", store.RoleUser)
+		issuer := newOIDCIssuer(t)
+
+		identityProvider, err := createOIDCIdentityProvider(ctx, ts, admin.ID, issuer.URL)
+		require.NoError(t, err)
+		require.Equal(t, v1pb.IdentityProvider_OIDC, identityProvider.Type)
+		require.Equal(t, issuer.URL+"/protocol/openid-connect/auth", identityProvider.Config.GetOidcConfig().AuthorizationEndpoint)
+		require.Equal(t, "secret", identityProvider.Config.GetOidcConfig().ClientSecret)
+
+		// The client secret is hidden from non-admins.
+		identityProvider, err = ts.Service.GetIdentityProvider(ts.CreateUserContext(ctx, regular.ID), &v1pb.GetIdentityProviderRequest{
+			Name: identityProvider.Name,
+		})
+		require.NoError(t, err)
+		require.Empty(t, identityProvider.Config.GetOidcConfig().ClientSecret)
+		require.Equal(t, []string{"memos-admins"}, identityProvider.Config.GetOidcConfig().AdminGroups)
+
+		_, err = createOIDCIdentityProvider(ctx, ts, admin.ID, issuer.URL+"/unknown")
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("signs in and maps groups to roles", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+		admin := createUserWithPassword(ctx, t, ts, "admin", store.RoleAdmin)
+		issuer := newOIDCIssuer(t)
+		identityProvider, err := createOIDCIdentityProvider(ctx, ts, admin.ID, issuer.URL)
+		require.NoError(t, err)
+		idpID, err := apiv1.ExtractIdentityProviderIDFromName(identityProvider.Name)
+		require.NoError(t, err)
+
+		response, err := issuer.signIn(ctx, ts, idpID, "alice", "nonce", "staff", "memos-admins")
+		require.NoError(t, err)
+		require.NotEmpty(t, response.AccessToken)
+		require.Equal(t, "alice", response.User.Username)
+		require.Equal(t, "OIDC alice", response.User.DisplayName)
+		require.Equal(t, v1pb.User_ADMIN, response.User.Role)
+
+		// Removing the user from the admin group demotes them on the next sign-in.
+		response, err = issuer.signIn(ctx, ts, idpID, "alice", "nonce", "staff")
+		require.NoError(t, err)
+		require.Equal(t, v1pb.User_USER, response.User.Role)
+		username := "alice"
+		user, err := ts.Store.GetUser(ctx, &store.FindUser{Username: &username})
+		require.NoError(t, err)
+		require.Equal(t, store.RoleUser, user.Role)
+	})
+
+	t.Run("rejects ID tokens of another sign-in", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+		admin := createUserWithPassword(ctx, t, ts, "admin", store.RoleAdmin)
+		issuer := newOIDCIssuer(t)
+		identityProvider, err := createOIDCIdentityProvider(ctx, ts, admin.ID, issuer.URL)
+		require.NoError(t, err)
+		idpID, err := apiv1.ExtractIdentityProviderIDFromName(identityProvider.Name)
+		require.NoError(t, err)
+
+		_, err = issuer.signIn(ctx, ts, idpID, "alice", "other-nonce")
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+		_, err = issuer.signIn(ctx, ts, idpID, "alice", "")
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+		username := "alice"
+		user, err := ts.Store.GetUser(ctx, &store.FindUser{Username: &username})
+		require.NoError(t, err)
+		require.Nil(t, user)
+	})
+}
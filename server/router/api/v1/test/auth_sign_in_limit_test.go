@@ -0,0 +1,170 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func withClientIP(ctx context.Context, ipAddress string) context.Context {
+	return metadata.NewIncomingContext(ctx, metadata.Pairs("x-forwarded-for", ipAddress, "user-agent", "test-agent"))
+}
+
+func signInWithWrongPassword(ctx context.Context, ts *TestService, username string) error {
+	_, err := ts.Service.SignIn(apiv1.WithHeaderCarrier(ctx), &v1pb.SignInRequest{
+		Credentials: &v1pb.SignInRequest_PasswordCredentials_{
+			PasswordCredentials: &v1pb.SignInRequest_PasswordCredentials{
+				Username: username,
+				Password: "wrong password",
+			},
+		},
+	})
+	return err
+}
+
+func updateSignInLimit(ctx context.Context, t *testing.T, ts *TestService, adminID int32, limit *v1pb.InstanceSetting_GeneralSetting_SignInLimit) {
+	_, err := ts.Service.UpdateInstanceSetting(ts.CreateUserContext(ctx, adminID), &v1pb.UpdateInstanceSettingRequest{
+		Setting: &v1pb.InstanceSetting{
+			Name: "instance/settings/GENERAL",
+			Value: &v1pb.InstanceSetting_GeneralSetting_{
+				GeneralSetting: &v1pb.InstanceSetting_GeneralSetting{
+					SignInLimit: limit,
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+}
+
+func TestSignInLimit(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("username is locked out after failed attempts", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+		admin := createUserWithPassword(ctx, t, ts, "admin", store.RoleAdmin)
+		createUserWithPassword(ctx, t, ts, "alice", store.RoleUser)
+		regular := createUserWithPassword(ctx, t, ts, "bob", store.RoleUser)
+		clientCtx := withClientIP(ctx, "203.0.113.1")
+
+		for range 5 {
+			err := signInWithWrongPassword(clientCtx, ts, "alice")
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+		}
+		// Even the correct password is rejected during the lockout, from any client.
+		_, err := signInWithPassword(clientCtx, ts, "alice")
+		require.Equal(t, codes.ResourceExhausted, status.Code(err))
+		_, err = signInWithPassword(withClientIP(ctx, "198.51.100.1"), ts, "alice")
+		require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+		// Other users of the client are below the client IP threshold.
+		response, err := signInWithPassword(clientCtx, ts, "bob")
+		require.NoError(t, err)
+		require.NotEmpty(t, response.AccessToken)
+
+		// Failed attempts are recorded as activities visible to admins only.
+		activities, err := ts.Service.ListActivities(ts.CreateUserContext(ctx, admin.ID), &v1pb.ListActivitiesRequest{
+			Filter: `activity_type == "SIGN_IN_FAILED"`,
+		})
+		require.NoError(t, err)
+		require.Len(t, activities.Activities, 5)
+		activity := activities.Activities[0]
+		require.Equal(t, v1pb.Activity_SIGN_IN_FAILED, activity.Type)
+		require.Equal(t, v1pb.Activity_WARN, activity.Level)
+		require.Equal(t, "alice", activity.Payload.GetSignInFailed().Username)
+		require.Equal(t, "203.0.113.1", activity.Payload.GetSignInFailed().IpAddress)
+		require.Equal(t, "test-agent", activity.Payload.GetSignInFailed().UserAgent)
+		require.Equal(t, "invalid password", activity.Payload.GetSignInFailed().Reason)
+
+		regularCtx := ts.CreateUserContext(ctx, regular.ID)
+		activities, err = ts.Service.ListActivities(regularCtx, &v1pb.ListActivitiesRequest{})
+		require.NoError(t, err)
+		require.Empty(t, activities.Activities)
+		_, err = ts.Service.GetActivity(regularCtx, &v1pb.GetActivityRequest{Name: activity.Name})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("client IP is locked out after failed attempts", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+		admin := createUserWithPassword(ctx, t, ts, "admin", store.RoleAdmin)
+		createUserWithPassword(ctx, t, ts, "bob", store.RoleUser)
+		updateSignInLimit(ctx, t, ts, admin.ID, &v1pb.InstanceSetting_GeneralSetting_SignInLimit{
+			MaxAttemptsPerUsername: -1,
+			MaxAttemptsPerIp:       3,
+		})
+		clientCtx := withClientIP(ctx, "203.0.113.1")
+
+		for _, username := range []string{"unknown1", "unknown2", "unknown3"} {
+			err := signInWithWrongPassword(clientCtx, ts, username)
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+		}
+		_, err := signInWithPassword(clientCtx, ts, "bob")
+		require.Equal(t, codes.ResourceExhausted, status.Code(err))
+		_, err = signInWithPassword(withClientIP(ctx, "198.51.100.1"), ts, "bob")
+		require.NoError(t, err)
+
+		activities, err := ts.Service.ListActivities(ts.CreateUserContext(ctx, admin.ID), &v1pb.ListActivitiesRequest{
+			Filter: `activity_type == "SIGN_IN_FAILED"`,
+		})
+		require.NoError(t, err)
+		require.Len(t, activities.Activities, 3)
+		require.Empty(t, activities.Activities[0].Creator)
+		require.Equal(t, "unknown username", activities.Activities[0].Payload.GetSignInFailed().Reason)
+	})
+
+	t.Run("successful sign-in resets the username attempts", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+		admin := createUserWithPassword(ctx, t, ts, "admin", store.RoleAdmin)
+		createUserWithPassword(ctx, t, ts, "alice", store.RoleUser)
+		updateSignInLimit(ctx, t, ts, admin.ID, &v1pb.InstanceSetting_GeneralSetting_SignInLimit{
+			MaxAttemptsPerUsername: 3,
+		})
+
+		for range 2 {
+			for range 2 {
+				err := signInWithWrongPassword(ctx, ts, "alice")
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+			}
+			_, err := signInWithPassword(ctx, ts, "alice")
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("failed two-factor codes count against the username", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+		admin := createUserWithPassword(ctx, t, ts, "admin", store.RoleAdmin)
+		user := createUserWithPassword(ctx, t, ts, "alice", store.RoleUser)
+		_, recoveryCodes := enableTwoFactor(ctx, t, ts, user)
+		updateSignInLimit(ctx, t, ts, admin.ID, &v1pb.InstanceSetting_GeneralSetting_SignInLimit{
+			MaxAttemptsPerUsername: 2,
+		})
+
+		for range 2 {
+			challenge, err := signInWithPassword(ctx, ts, "alice")
+			require.NoError(t, err)
+			_, err = signInWithCode(ctx, ts, challenge.TwoFactorChallenge, "000000")
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+		}
+		_, err := signInWithPassword(ctx, ts, "alice")
+		require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+		activities, err := ts.Service.ListActivities(ts.CreateUserContext(ctx, admin.ID), &v1pb.ListActivitiesRequest{
+			Filter: `activity_type == "SIGN_IN_FAILED"`,
+		})
+		require.NoError(t, err)
+		require.Len(t, activities.Activities, 2)
+		require.Equal(t, "invalid two-factor code", activities.Activities[0].Payload.GetSignInFailed().Reason)
+		require.NotEmpty(t, recoveryCodes)
+	})
+}
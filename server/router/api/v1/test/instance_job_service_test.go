@@ -0,0 +1,223 @@
+package test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/usememos/memos/plugin/scheduler"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// startTestScheduler starts a scheduler running the jobs with the middleware of the server.
+func startTestScheduler(t *testing.T, ts *TestService, jobs ...*scheduler.Job) {
+	s := scheduler.New(scheduler.WithMiddleware(ts.Service.JobStateMiddleware(), scheduler.Recovery(nil)))
+	for _, job := range jobs {
+		require.NoError(t, s.Register(job))
+	}
+	require.NoError(t, s.Start())
+	ts.Service.Scheduler = s
+	t.Cleanup(func() {
+		require.NoError(t, s.Stop(context.Background()))
+	})
+}
+
+func TestInstanceJobs(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("requires an admin and a running scheduler", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+		admin := createUserWithPassword(ctx, t, ts, "admin", store.RoleAdmin)
+		regular := createUserWithPassword(ctx, t, ts, "bob", store.RoleUser)
+
+		_, err := ts.Service.ListInstanceJobs(ts.CreateUserContext(ctx, admin.ID), &v1pb.ListInstanceJobsRequest{})
+		require.Equal(t, codes.Unavailable, status.Code(err))
+
+		startTestScheduler(t, ts)
+		_, err = ts.Service.ListInstanceJobs(ts.CreateUserContext(ctx, regular.ID), &v1pb.ListInstanceJobsRequest{})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = ts.Service.RunInstanceJob(ts.CreateUserContext(ctx, regular.ID), &v1pb.RunInstanceJobRequest{Name: "instance/jobs/report"})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("runs, records and pauses jobs", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+		admin := createUserWithPassword(ctx, t, ts, "admin", store.RoleAdmin)
+		adminCtx := ts.CreateUserContext(ctx, admin.ID)
+
+		var runs atomic.Int32
+		startTestScheduler(t, ts, &scheduler.Job{
+			Name:        "report",
+			Description: "Sends the yearly report.",
+			Schedule:    "0 0 1 1 *",
+			Handler: func(context.Context) error {
+				runs.Add(1)
+				return errors.New("mail server unreachable")
+			},
+		})
+
+		list, err := ts.Service.ListInstanceJobs(adminCtx, &v1pb.ListInstanceJobsRequest{})
+		require.NoError(t, err)
+		require.Len(t, list.Jobs, 1)
+		job := list.Jobs[0]
+		require.Equal(t, "instance/jobs/report", job.Name)
+		require.Equal(t, "Sends the yearly report.", job.Description)
+		require.Equal(t, "0 0 1 1 *", job.Schedule)
+		require.Nil(t, job.LastRunTime)
+		require.NotNil(t, job.NextRunTime)
+
+		_, err = ts.Service.RunInstanceJob(adminCtx, &v1pb.RunInstanceJobRequest{Name: "instance/jobs/unknown"})
+		require.Equal(t, codes.NotFound, status.Code(err))
+
+		// The run and its error are recorded.
+		_, err = ts.Service.RunInstanceJob(adminCtx, &v1pb.RunInstanceJobRequest{Name: job.Name})
+		require.NoError(t, err)
+		require.Eventually(t, func() bool {
+			list, err := ts.Service.ListInstanceJobs(adminCtx, &v1pb.ListInstanceJobsRequest{})
+			require.NoError(t, err)
+			return list.Jobs[0].LastRunTime != nil
+		}, 5*time.Second, 10*time.Millisecond)
+		list, err = ts.Service.ListInstanceJobs(adminCtx, &v1pb.ListInstanceJobsRequest{})
+		require.NoError(t, err)
+		require.Equal(t, "mail server unreachable", list.Jobs[0].LastError)
+		require.Equal(t, int32(1), runs.Load())
+
+		_, err = ts.Service.UpdateInstanceJob(adminCtx, &v1pb.UpdateInstanceJobRequest{
+			Job:        &v1pb.InstanceJob{Name: job.Name, Description: "Renamed."},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"description"}},
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		paused, err := ts.Service.UpdateInstanceJob(adminCtx, &v1pb.UpdateInstanceJobRequest{
+			Job:        &v1pb.InstanceJob{Name: job.Name, Paused: true},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"paused"}},
+		})
+		require.NoError(t, err)
+		require.True(t, paused.Paused)
+		require.Nil(t, paused.NextRunTime)
+		require.Equal(t, "mail server unreachable", paused.LastError)
+		jobName := "report"
+		jobState, err := ts.Store.GetJob(ctx, &store.FindJob{Name: &jobName})
+		require.NoError(t, err)
+		require.True(t, jobState.Paused)
+
+		// Paused jobs still run when triggered manually.
+		_, err = ts.Service.RunInstanceJob(adminCtx, &v1pb.RunInstanceJobRequest{Name: job.Name})
+		require.NoError(t, err)
+		require.Eventually(t, func() bool { return runs.Load() == 2 }, 5*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("records panicking runs as failed", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+		admin := createUserWithPassword(ctx, t, ts, "admin", store.RoleAdmin)
+		adminCtx := ts.CreateUserContext(ctx, admin.ID)
+
+		startTestScheduler(t, ts, &scheduler.Job{
+			Name:     "report",
+			Schedule: "0 0 1 1 *",
+			Handler: func(context.Context) error {
+				panic("nil report")
+			},
+		})
+
+		_, err := ts.Service.RunInstanceJob(adminCtx, &v1pb.RunInstanceJobRequest{Name: "instance/jobs/report"})
+		require.NoError(t, err)
+		require.Eventually(t, func() bool {
+			list, err := ts.Service.ListInstanceJobs(adminCtx, &v1pb.ListInstanceJobsRequest{})
+			require.NoError(t, err)
+			return list.Jobs[0].LastRunTime != nil
+		}, 5*time.Second, 10*time.Millisecond)
+		list, err := ts.Service.ListInstanceJobs(adminCtx, &v1pb.ListInstanceJobsRequest{})
+		require.NoError(t, err)
+		require.Contains(t, list.Jobs[0].LastError, "panicked: nil report")
+	})
+
+	t.Run("skips scheduled runs of paused jobs", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+
+		paused := true
+		_, err := ts.Store.UpsertJob(ctx, &store.UpsertJob{Name: "tick", Paused: &paused})
+		require.NoError(t, err)
+
+		var runs atomic.Int32
+		startTestScheduler(t, ts, &scheduler.Job{
+			Name:     "tick",
+			Schedule: "* * * * * *",
+			Handler: func(context.Context) error {
+				runs.Add(1)
+				return nil
+			},
+		})
+
+		// The skipped run records the next run only.
+		jobName := "tick"
+		require.Eventually(t, func() bool {
+			jobState, err := ts.Store.GetJob(ctx, &store.FindJob{Name: &jobName})
+			require.NoError(t, err)
+			return jobState.NextRunTs > 0
+		}, 5*time.Second, 10*time.Millisecond)
+		jobState, err := ts.Store.GetJob(ctx, &store.FindJob{Name: &jobName})
+		require.NoError(t, err)
+		require.Zero(t, jobState.LastRunTs)
+		require.Zero(t, runs.Load())
+	})
+
+	t.Run("saves the state of frequent runs when it changes", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+
+		var runs atomic.Int32
+		var failing atomic.Bool
+		startTestScheduler(t, ts, &scheduler.Job{
+			Name:     "tick",
+			Schedule: "* * * * * *",
+			Handler: func(context.Context) error {
+				runs.Add(1)
+				if failing.Load() {
+					return errors.New("disk full")
+				}
+				return nil
+			},
+		})
+
+		jobName := "tick"
+		var firstRunTs int64
+		require.Eventually(t, func() bool {
+			jobState, err := ts.Store.GetJob(ctx, &store.FindJob{Name: &jobName})
+			require.NoError(t, err)
+			if jobState == nil {
+				return false
+			}
+			firstRunTs = jobState.LastRunTs
+			return true
+		}, 5*time.Second, 10*time.Millisecond)
+		// The following runs end like the first one, so they are not saved.
+		runsBefore := runs.Load()
+		require.Eventually(t, func() bool { return runs.Load() >= runsBefore+2 }, 5*time.Second, 10*time.Millisecond)
+		jobState, err := ts.Store.GetJob(ctx, &store.FindJob{Name: &jobName})
+		require.NoError(t, err)
+		require.Equal(t, firstRunTs, jobState.LastRunTs)
+
+		failing.Store(true)
+		require.Eventually(t, func() bool {
+			jobState, err := ts.Store.GetJob(ctx, &store.FindJob{Name: &jobName})
+			require.NoError(t, err)
+			return jobState.LastError == "disk full"
+		}, 5*time.Second, 10*time.Millisecond)
+		jobState, err = ts.Store.GetJob(ctx, &store.FindJob{Name: &jobName})
+		require.NoError(t, err)
+		require.Greater(t, jobState.LastRunTs, firstRunTs)
+	})
+}
@@ -185,6 +185,50 @@ func TestGetInstanceSetting(t *testing.T) {
 		require.NotNil(t, memoRelatedSetting)
 	})
 
+	t.Run("GetInstanceSetting - embedding setting", func(t *testing.T) {
+		// Create test service for this specific test
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+
+		hostUser, err := ts.CreateHostUser(ctx, "testhost")
+		require.NoError(t, err)
+		hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+		regularUser, err := ts.CreateRegularUser(ctx, "testuser")
+		require.NoError(t, err)
+		regularCtx := ts.CreateUserContext(ctx, regularUser.ID)
+
+		// Update the embedding setting as admin
+		_, err = ts.Service.UpdateInstanceSetting(hostCtx, &v1pb.UpdateInstanceSettingRequest{
+			Setting: &v1pb.InstanceSetting{
+				Name: "instance/settings/EMBEDDING",
+				Value: &v1pb.InstanceSetting_EmbeddingSetting_{
+					EmbeddingSetting: &v1pb.InstanceSetting_EmbeddingSetting{
+						Provider: v1pb.InstanceSetting_EmbeddingSetting_OPENAI,
+						Endpoint: "http://localhost:11434/v1",
+						ApiKey:   "secret",
+						Model:    "nomic-embed-text",
+					},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		req := &v1pb.GetInstanceSettingRequest{
+			Name: "instance/settings/EMBEDDING",
+		}
+		resp, err := ts.Service.GetInstanceSetting(hostCtx, req)
+		require.NoError(t, err)
+		embeddingSetting := resp.GetEmbeddingSetting()
+		require.NotNil(t, embeddingSetting)
+		require.Equal(t, v1pb.InstanceSetting_EmbeddingSetting_OPENAI, embeddingSetting.Provider)
+		require.Equal(t, "nomic-embed-text", embeddingSetting.Model)
+
+		// The API key is only visible to admins
+		_, err = ts.Service.GetInstanceSetting(regularCtx, req)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "permission denied")
+	})
+
 	t.Run("GetInstanceSetting - invalid setting name", func(t *testing.T) {
 		// Create test service for this specific test
 		ts := NewTestService(t)
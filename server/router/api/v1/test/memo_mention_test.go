@@ -0,0 +1,118 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestMemoMentions(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	alice, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+	aliceCtx := ts.CreateUserContext(ctx, alice.ID)
+	bob, err := ts.CreateRegularUser(ctx, "bob")
+	require.NoError(t, err)
+	bobCtx := ts.CreateUserContext(ctx, bob.ID)
+	listNotifications := func(userCtx context.Context, userID int32) []*apiv1.UserNotification {
+		response, err := ts.Service.ListUserNotifications(userCtx, &apiv1.ListUserNotificationsRequest{
+			Parent: fmt.Sprintf("users/%d", userID),
+		})
+		require.NoError(t, err)
+		return response.Notifications
+	}
+
+	// Mentions of unknown users and in code are ignored.
+	memo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{Content: "Lunch with @alice and @nobody? `@bob`", Visibility: apiv1.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{fmt.Sprintf("users/%d", alice.ID)}, memo.Mentions)
+	notifications := listNotifications(aliceCtx, alice.ID)
+	require.Len(t, notifications, 1)
+	require.Equal(t, apiv1.UserNotification_MEMO_MENTION, notifications[0].Type)
+	require.Equal(t, fmt.Sprintf("users/%d", user.ID), notifications[0].Sender)
+	activity, err := ts.Service.GetActivity(aliceCtx, &apiv1.GetActivityRequest{
+		Name: fmt.Sprintf("activities/%d", notifications[0].GetActivityId()),
+	})
+	require.NoError(t, err)
+	require.Equal(t, apiv1.Activity_MEMO_MENTION, activity.Type)
+	require.Equal(t, memo.Name, activity.Payload.GetMemoMention().Memo)
+
+	// Editing the memo only notifies the users newly mentioned.
+	_, err = ts.Service.UpdateMemo(userCtx, &apiv1.UpdateMemoRequest{
+		Memo:       &apiv1.Memo{Name: memo.Name, Content: "Lunch with @alice and @bob"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	require.NoError(t, err)
+	require.Len(t, listNotifications(aliceCtx, alice.ID), 1)
+	require.Len(t, listNotifications(bobCtx, bob.ID), 1)
+
+	// Users mentioned in private memos cannot see them, so they are notified once the memo is shared.
+	private, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{Content: "Surprise party for @alice", Visibility: apiv1.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	require.Len(t, listNotifications(aliceCtx, alice.ID), 1)
+	_, err = ts.Service.UpdateMemo(userCtx, &apiv1.UpdateMemoRequest{
+		Memo:       &apiv1.Memo{Name: private.Name, Visibility: apiv1.Visibility_PROTECTED},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility"}},
+	})
+	require.NoError(t, err)
+	require.Len(t, listNotifications(aliceCtx, alice.ID), 2)
+
+	// Drafts notify the users they mention once they are published.
+	draft, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{
+			Content:      "Reminder for @bob",
+			Visibility:   apiv1.Visibility_PUBLIC,
+			ScheduleTime: timestamppb.New(time.Now().Add(time.Hour)),
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, apiv1.State_DRAFT, draft.State)
+	require.Len(t, listNotifications(bobCtx, bob.ID), 1)
+	makeMemoScheduleDue(ctx, t, ts, draft.Name)
+	require.NoError(t, ts.Service.PublishScheduledMemos(ctx))
+	require.Len(t, listNotifications(bobCtx, bob.ID), 2)
+
+	// Memos can be filtered by the users they mention.
+	list, err := ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{Filter: fmt.Sprintf("%d in mentions", alice.ID)})
+	require.NoError(t, err)
+	require.Len(t, list.Memos, 2)
+	list, err = ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{Filter: fmt.Sprintf("%d in mentions", bob.ID)})
+	require.NoError(t, err)
+	require.Len(t, list.Memos, 2)
+
+	// Mentions can still be listed once the memo is deleted.
+	_, err = ts.Service.DeleteMemo(userCtx, &apiv1.DeleteMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	activities, err := ts.Service.ListActivities(aliceCtx, &apiv1.ListActivitiesRequest{})
+	require.NoError(t, err)
+	require.Len(t, activities.Activities, 2)
+	memoNames := []string{}
+	for _, activity := range activities.Activities {
+		memoNames = append(memoNames, activity.Payload.GetMemoMention().Memo)
+	}
+	require.ElementsMatch(t, []string{"", private.Name}, memoNames)
+
+	// Mentions may follow punctuation, and usernames are matched case-insensitively.
+	punctuated, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{Content: "Notes for (@Alice), **@BOB** and \"@alice\"", Visibility: apiv1.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{fmt.Sprintf("users/%d", alice.ID), fmt.Sprintf("users/%d", bob.ID)}, punctuated.Mentions)
+}
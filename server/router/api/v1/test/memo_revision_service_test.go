@@ -0,0 +1,153 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoRevisions(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("edits are recorded with diffs and can be restored", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+
+		user, err := ts.CreateRegularUser(ctx, "user")
+		require.NoError(t, err)
+		userCtx := ts.CreateUserContext(ctx, user.ID)
+
+		memo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+			Memo: &apiv1.Memo{Content: "shopping\n- milk\n- eggs", Visibility: apiv1.Visibility_PROTECTED},
+		})
+		require.NoError(t, err)
+		_, err = ts.Service.UpdateMemo(userCtx, &apiv1.UpdateMemoRequest{
+			Memo:       &apiv1.Memo{Name: memo.Name, Content: "shopping\n- milk\n- bread"},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+		})
+		require.NoError(t, err)
+		// Changes of other fields are not recorded.
+		_, err = ts.Service.UpdateMemo(userCtx, &apiv1.UpdateMemoRequest{
+			Memo:       &apiv1.Memo{Name: memo.Name, Pinned: true},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"pinned"}},
+		})
+		require.NoError(t, err)
+
+		resp, err := ts.Service.ListMemoRevisions(userCtx, &apiv1.ListMemoRevisionsRequest{Name: memo.Name})
+		require.NoError(t, err)
+		require.Len(t, resp.Revisions, 2)
+		require.Empty(t, resp.NextPageToken)
+		latest, initial := resp.Revisions[0], resp.Revisions[1]
+		require.Equal(t, "shopping\n- milk\n- bread", latest.Content)
+		require.Equal(t, fmt.Sprintf("users/%d", user.ID), latest.Creator)
+		require.Equal(t, []*apiv1.MemoRevision_DiffLine{
+			{Operation: apiv1.MemoRevision_DiffLine_EQUAL, Text: "shopping"},
+			{Operation: apiv1.MemoRevision_DiffLine_EQUAL, Text: "- milk"},
+			{Operation: apiv1.MemoRevision_DiffLine_DELETE, Text: "- eggs"},
+			{Operation: apiv1.MemoRevision_DiffLine_INSERT, Text: "- bread"},
+		}, latest.Diff)
+		require.Equal(t, apiv1.Visibility_PROTECTED, initial.Visibility)
+		require.Len(t, initial.Diff, 3)
+		for _, line := range initial.Diff {
+			require.Equal(t, apiv1.MemoRevision_DiffLine_INSERT, line.Operation)
+		}
+
+		// Pages keep the diffs from the previous revision.
+		page, err := ts.Service.ListMemoRevisions(userCtx, &apiv1.ListMemoRevisionsRequest{Name: memo.Name, PageSize: 1})
+		require.NoError(t, err)
+		require.Len(t, page.Revisions, 1)
+		require.Equal(t, latest.Diff, page.Revisions[0].Diff)
+		require.NotEmpty(t, page.NextPageToken)
+		page, err = ts.Service.ListMemoRevisions(userCtx, &apiv1.ListMemoRevisionsRequest{Name: memo.Name, PageToken: page.NextPageToken})
+		require.NoError(t, err)
+		require.Len(t, page.Revisions, 1)
+		require.Equal(t, initial.Name, page.Revisions[0].Name)
+
+		revision, err := ts.Service.GetMemoRevision(userCtx, &apiv1.GetMemoRevisionRequest{Name: latest.Name})
+		require.NoError(t, err)
+		require.Equal(t, latest.Diff, revision.Diff)
+
+		restored, err := ts.Service.RestoreMemoRevision(userCtx, &apiv1.RestoreMemoRevisionRequest{Name: initial.Name})
+		require.NoError(t, err)
+		require.Equal(t, "shopping\n- milk\n- eggs", restored.Content)
+		require.True(t, restored.Pinned)
+		resp, err = ts.Service.ListMemoRevisions(userCtx, &apiv1.ListMemoRevisionsRequest{Name: memo.Name})
+		require.NoError(t, err)
+		require.Len(t, resp.Revisions, 3)
+		require.Equal(t, "shopping\n- milk\n- eggs", resp.Revisions[0].Content)
+	})
+
+	t.Run("memos without revisions keep their previous state", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+
+		user, err := ts.CreateRegularUser(ctx, "user")
+		require.NoError(t, err)
+		userCtx := ts.CreateUserContext(ctx, user.ID)
+		memo, err := ts.Store.CreateMemo(ctx, &store.Memo{
+			UID:        "legacy",
+			CreatorID:  user.ID,
+			Content:    "original",
+			Visibility: store.Private,
+		})
+		require.NoError(t, err)
+
+		_, err = ts.Service.UpdateMemo(userCtx, &apiv1.UpdateMemoRequest{
+			Memo:       &apiv1.Memo{Name: "memos/legacy", Visibility: apiv1.Visibility_PUBLIC},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility"}},
+		})
+		require.NoError(t, err)
+
+		resp, err := ts.Service.ListMemoRevisions(userCtx, &apiv1.ListMemoRevisionsRequest{Name: "memos/legacy"})
+		require.NoError(t, err)
+		require.Len(t, resp.Revisions, 2)
+		require.Equal(t, apiv1.Visibility_PUBLIC, resp.Revisions[0].Visibility)
+		require.Equal(t, apiv1.Visibility_PRIVATE, resp.Revisions[1].Visibility)
+		require.Equal(t, memo.UpdatedTs, resp.Revisions[1].CreateTime.AsTime().Unix())
+		require.Equal(t, []*apiv1.MemoRevision_DiffLine{
+			{Operation: apiv1.MemoRevision_DiffLine_EQUAL, Text: "original"},
+		}, resp.Revisions[0].Diff)
+	})
+
+	t.Run("only the creator and admins can access revisions", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+
+		user, err := ts.CreateRegularUser(ctx, "user")
+		require.NoError(t, err)
+		userCtx := ts.CreateUserContext(ctx, user.ID)
+		other, err := ts.CreateRegularUser(ctx, "other")
+		require.NoError(t, err)
+		otherCtx := ts.CreateUserContext(ctx, other.ID)
+		admin, err := ts.CreateHostUser(ctx, "admin")
+		require.NoError(t, err)
+		adminCtx := ts.CreateUserContext(ctx, admin.ID)
+
+		memo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+			Memo: &apiv1.Memo{Content: "public memo", Visibility: apiv1.Visibility_PUBLIC},
+		})
+		require.NoError(t, err)
+
+		_, err = ts.Service.ListMemoRevisions(otherCtx, &apiv1.ListMemoRevisionsRequest{Name: memo.Name})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "permission denied")
+		_, err = ts.Service.ListMemoRevisions(ctx, &apiv1.ListMemoRevisionsRequest{Name: memo.Name})
+		require.Error(t, err)
+
+		resp, err := ts.Service.ListMemoRevisions(adminCtx, &apiv1.ListMemoRevisionsRequest{Name: memo.Name})
+		require.NoError(t, err)
+		require.Len(t, resp.Revisions, 1)
+
+		_, err = ts.Service.RestoreMemoRevision(otherCtx, &apiv1.RestoreMemoRevisionRequest{Name: resp.Revisions[0].Name})
+		require.Error(t, err)
+		_, err = ts.Service.GetMemoRevision(userCtx, &apiv1.GetMemoRevisionRequest{Name: memo.Name + "/revisions/999"})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "not found")
+	})
+}
@@ -0,0 +1,185 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+	apiv1service "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// makeMemoScheduleDue moves the schedule time of the draft memo into the past.
+func makeMemoScheduleDue(ctx context.Context, t *testing.T, ts *TestService, name string) {
+	memoUID, err := apiv1service.ExtractMemoUIDFromName(name)
+	require.NoError(t, err)
+	memo, err := ts.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	require.NoError(t, err)
+	memo.Payload.Schedule.PublishTime = timestamppb.New(time.Now().Add(-time.Minute))
+	require.NoError(t, ts.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, Payload: memo.Payload}))
+}
+
+func TestScheduledMemo(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+	hook, err := ts.Service.CreateUserWebhook(userCtx, &apiv1.CreateUserWebhookRequest{
+		Parent:  fmt.Sprintf("users/%d", user.ID),
+		Webhook: &apiv1.UserWebhook{Url: "https://example.com/webhook"},
+	})
+	require.NoError(t, err)
+
+	scheduleTime := timestamppb.New(time.Now().Add(time.Hour).Truncate(time.Second))
+	memo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{
+			Content:      "Release notes #release",
+			Visibility:   apiv1.Visibility_PUBLIC,
+			ScheduleTime: scheduleTime,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, apiv1.State_DRAFT, memo.State)
+	require.Equal(t, scheduleTime.AsTime(), memo.ScheduleTime.AsTime())
+
+	// Drafts are only visible to their creator.
+	_, err = ts.Service.GetMemo(otherCtx, &apiv1.GetMemoRequest{Name: memo.Name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	list, err := ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{})
+	require.NoError(t, err)
+	require.Empty(t, list.Memos)
+	list, err = ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{State: apiv1.State_DRAFT})
+	require.NoError(t, err)
+	require.Len(t, list.Memos, 1)
+	list, err = ts.Service.ListMemos(otherCtx, &apiv1.ListMemosRequest{State: apiv1.State_DRAFT})
+	require.NoError(t, err)
+	require.Empty(t, list.Memos)
+	_, err = ts.Service.CreateMemoComment(otherCtx, &apiv1.CreateMemoCommentRequest{
+		Name:    memo.Name,
+		Comment: &apiv1.Memo{Content: "Nice", Visibility: apiv1.Visibility_PUBLIC},
+	})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// Nothing is published before the schedule time.
+	require.NoError(t, ts.Service.PublishScheduledMemos(ctx))
+	memo, err = ts.Service.GetMemo(userCtx, &apiv1.GetMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	require.Equal(t, apiv1.State_DRAFT, memo.State)
+	deliveries, err := ts.Service.ListWebhookDeliveries(userCtx, &apiv1.ListWebhookDeliveriesRequest{Parent: hook.Name})
+	require.NoError(t, err)
+	require.Empty(t, deliveries.Deliveries)
+
+	makeMemoScheduleDue(ctx, t, ts, memo.Name)
+	require.NoError(t, ts.Service.PublishScheduledMemos(ctx))
+	memo, err = ts.Service.GetMemo(otherCtx, &apiv1.GetMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	require.Equal(t, apiv1.State_NORMAL, memo.State)
+	require.Nil(t, memo.ScheduleTime)
+	require.Equal(t, []string{"release"}, memo.Tags)
+	deliveries, err = ts.Service.ListWebhookDeliveries(userCtx, &apiv1.ListWebhookDeliveriesRequest{Parent: hook.Name})
+	require.NoError(t, err)
+	require.Len(t, deliveries.Deliveries, 1)
+	require.Equal(t, "memos.memo.created", deliveries.Deliveries[0].ActivityType)
+
+	// Published memos cannot be scheduled again.
+	_, err = ts.Service.UpdateMemo(userCtx, &apiv1.UpdateMemoRequest{
+		Memo:       &apiv1.Memo{Name: memo.Name, ScheduleTime: scheduleTime},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"schedule_time"}},
+	})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestRecurringMemo(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	_, err = ts.Service.UpdateUserSetting(userCtx, &apiv1.UpdateUserSettingRequest{
+		Setting: &apiv1.UserSetting{
+			Name: fmt.Sprintf("users/%d/settings/GENERAL", user.ID),
+			Value: &apiv1.UserSetting_GeneralSetting_{
+				GeneralSetting: &apiv1.UserSetting_GeneralSetting{Timezone: "Mars/Olympus_Mons"},
+			},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"timezone"}},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ts.Service.UpdateUserSetting(userCtx, &apiv1.UpdateUserSettingRequest{
+		Setting: &apiv1.UserSetting{
+			Name: fmt.Sprintf("users/%d/settings/GENERAL", user.ID),
+			Value: &apiv1.UserSetting_GeneralSetting_{
+				GeneralSetting: &apiv1.UserSetting_GeneralSetting{Timezone: "Asia/Tokyo"},
+			},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"timezone"}},
+	})
+	require.NoError(t, err)
+
+	_, err = ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{Content: "Standup", Recurrence: "every weekday"},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Weekday standups at 09:00 in the timezone of the user.
+	template, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{
+			Content:    "## Standup\n- [ ] Yesterday\n- [ ] Today",
+			Visibility: apiv1.Visibility_PROTECTED,
+			Recurrence: "0 9 * * 1-5",
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, apiv1.State_DRAFT, template.State)
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+	publishTime := template.ScheduleTime.AsTime().In(tokyo)
+	require.Equal(t, 9, publishTime.Hour())
+	require.Zero(t, publishTime.Minute())
+	require.NotContains(t, []time.Weekday{time.Saturday, time.Sunday}, publishTime.Weekday())
+	require.True(t, publishTime.After(time.Now()))
+
+	// Each occurrence publishes a copy, the template moves on to the next occurrence.
+	makeMemoScheduleDue(ctx, t, ts, template.Name)
+	require.NoError(t, ts.Service.PublishScheduledMemos(ctx))
+	list, err := ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.Memos, 1)
+	require.NotEqual(t, template.Name, list.Memos[0].Name)
+	require.Equal(t, template.Content, list.Memos[0].Content)
+	require.Equal(t, apiv1.Visibility_PROTECTED, list.Memos[0].Visibility)
+	require.True(t, list.Memos[0].Property.HasIncompleteTasks)
+	require.Empty(t, list.Memos[0].Recurrence)
+	template, err = ts.Service.GetMemo(userCtx, &apiv1.GetMemoRequest{Name: template.Name})
+	require.NoError(t, err)
+	require.Equal(t, apiv1.State_DRAFT, template.State)
+	require.True(t, template.ScheduleTime.AsTime().After(time.Now()))
+
+	// Removing the recurrence of a draft without schedule time publishes it.
+	template, err = ts.Service.UpdateMemo(userCtx, &apiv1.UpdateMemoRequest{
+		Memo:       &apiv1.Memo{Name: template.Name},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"schedule_time", "recurrence"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, apiv1.State_NORMAL, template.State)
+	list, err = ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.Memos, 2)
+}
@@ -0,0 +1,84 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/memoembedding"
+)
+
+func TestSearchMemos(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("results are ranked and respect visibility", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+		_, err := ts.Store.UpsertInstanceSetting(ctx, &storepb.InstanceSetting{
+			Key: storepb.InstanceSettingKey_EMBEDDING,
+			Value: &storepb.InstanceSetting_EmbeddingSetting{
+				EmbeddingSetting: &storepb.InstanceEmbeddingSetting{Provider: storepb.InstanceEmbeddingSetting_HASHING},
+			},
+		})
+		require.NoError(t, err)
+
+		user, err := ts.CreateRegularUser(ctx, "user")
+		require.NoError(t, err)
+		userCtx := ts.CreateUserContext(ctx, user.ID)
+		other, err := ts.CreateRegularUser(ctx, "other")
+		require.NoError(t, err)
+		otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+		garden, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+			Memo: &apiv1.Memo{Content: "planting tomatoes in the garden", Visibility: apiv1.Visibility_PUBLIC},
+		})
+		require.NoError(t, err)
+		_, err = ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+			Memo: &apiv1.Memo{Content: "quarterly budget review", Visibility: apiv1.Visibility_PUBLIC},
+		})
+		require.NoError(t, err)
+		privateGarden, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+			Memo: &apiv1.Memo{Content: "garden tomatoes secret recipe", Visibility: apiv1.Visibility_PRIVATE},
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, memoembedding.NewRunner(ts.Store).RunOnce(ctx))
+
+		resp, err := ts.Service.SearchMemos(userCtx, &apiv1.SearchMemosRequest{Query: "garden tomatoes", PageSize: 2})
+		require.NoError(t, err)
+		require.Len(t, resp.Results, 2)
+		require.ElementsMatch(t, []string{garden.Name, privateGarden.Name}, []string{resp.Results[0].Memo.Name, resp.Results[1].Memo.Name})
+		require.GreaterOrEqual(t, resp.Results[0].Score, resp.Results[1].Score)
+
+		// Other users don't see private memos.
+		resp, err = ts.Service.SearchMemos(otherCtx, &apiv1.SearchMemosRequest{Query: "garden tomatoes"})
+		require.NoError(t, err)
+		require.Len(t, resp.Results, 2)
+		require.Equal(t, garden.Name, resp.Results[0].Memo.Name)
+		for _, result := range resp.Results {
+			require.NotEqual(t, privateGarden.Name, result.Memo.Name)
+		}
+
+		// Filters narrow the results.
+		resp, err = ts.Service.SearchMemos(userCtx, &apiv1.SearchMemosRequest{Query: "garden tomatoes", Filter: `visibility == "PRIVATE"`})
+		require.NoError(t, err)
+		require.Len(t, resp.Results, 1)
+		require.Equal(t, privateGarden.Name, resp.Results[0].Memo.Name)
+	})
+
+	t.Run("not configured", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+
+		user, err := ts.CreateRegularUser(ctx, "user")
+		require.NoError(t, err)
+		userCtx := ts.CreateUserContext(ctx, user.ID)
+
+		_, err = ts.Service.SearchMemos(userCtx, &apiv1.SearchMemosRequest{Query: "garden"})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "not configured")
+	})
+}
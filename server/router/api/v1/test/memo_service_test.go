@@ -4,10 +4,14 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
@@ -253,6 +257,71 @@ func TestListMemos(t *testing.T) {
 	require.Equal(t, "👍", userTwoReaction.ReactionType)
 }
 
+func TestListMemosPagination(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "test-user-pagination")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	createMemo := func(content string, createTime time.Time) *apiv1.Memo {
+		memo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+			Memo: &apiv1.Memo{
+				Content:    content,
+				Visibility: apiv1.Visibility_PRIVATE,
+				CreateTime: timestamppb.New(createTime),
+			},
+		})
+		require.NoError(t, err)
+		return memo
+	}
+	// Memos created in the same second are only told apart by the id.
+	baseTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	for i := 0; i < 5; i++ {
+		createMemo(fmt.Sprintf("memo %d", i), baseTime)
+	}
+
+	listAll := func(orderBy string) []string {
+		names := []string{}
+		pageToken := ""
+		for {
+			response, err := ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{PageSize: 2, PageToken: pageToken, OrderBy: orderBy})
+			require.NoError(t, err)
+			for _, memo := range response.Memos {
+				names = append(names, memo.Name)
+			}
+			// A memo created between page loads does not shift the following pages.
+			if pageToken == "" {
+				createMemo("new memo "+orderBy, time.Now())
+			}
+			if response.NextPageToken == "" {
+				return names
+			}
+			pageToken = response.NextPageToken
+		}
+	}
+
+	names := listAll("")
+	require.Len(t, names, 5)
+	require.NotContains(t, names[2:], names[0])
+	require.NotContains(t, names[2:], names[1])
+
+	// The oldest memos come first, so the new memos are on the last page.
+	names = listAll("display_time asc")
+	response, err := ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{PageSize: 10, OrderBy: "display_time asc"})
+	require.NoError(t, err)
+	require.Len(t, response.Memos, 7)
+	for i, memo := range response.Memos {
+		require.Equal(t, memo.Name, names[i])
+	}
+
+	_, err = ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{PageToken: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
 // TestCreateMemoWithCustomTimestamps tests that custom timestamps can be set when creating memos and comments.
 // This addresses issue #5483: https://github.com/usememos/memos/issues/5483
 func TestCreateMemoWithCustomTimestamps(t *testing.T) {
@@ -367,3 +436,112 @@ func TestCreateMemoWithCustomTimestamps(t *testing.T) {
 	require.NotNil(t, memoWithoutTimestamps.UpdateTime, "update_time should be auto-generated")
 	require.True(t, time.Now().Unix()-memoWithoutTimestamps.CreateTime.AsTime().Unix() < 5, "create_time should be recent (within 5 seconds)")
 }
+
+func TestListMemosFullTextSearch(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	strong, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{Content: "Kubernetes <notes>: kubernetes upgrades and kubernetes backups", Visibility: apiv1.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	weak, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{Content: strings.Repeat("filler words before the match ", 5) + "then kubernetes appears once in this long memo", Visibility: apiv1.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{Content: "Unrelated memo", Visibility: apiv1.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+
+	resp, err := ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{
+		Filter:  `search("kubernetes")`,
+		OrderBy: "relevance desc",
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Memos, 2)
+	require.Equal(t, strong.Name, resp.Memos[0].Name)
+	require.Equal(t, weak.Name, resp.Memos[1].Name)
+
+	// Matches are highlighted and the rest of the snippet is escaped.
+	require.Equal(t, "<mark>Kubernetes</mark> &lt;notes&gt;: <mark>kubernetes</mark> upgrades and <mark>kubernetes</mark> backups", resp.Memos[0].SearchSnippet)
+	require.True(t, strings.HasPrefix(resp.Memos[1].SearchSnippet, "…"))
+	require.Contains(t, resp.Memos[1].SearchSnippet, "then <mark>kubernetes</mark> appears")
+
+	// Snippets are only set for searches.
+	resp, err = ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Memos, 3)
+	for _, memo := range resp.Memos {
+		require.Empty(t, memo.SearchSnippet)
+	}
+
+	// Ordering by relevance requires a search.
+	_, err = ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{OrderBy: "relevance"})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "relevance requires a search() filter")
+}
+
+func TestMemoEtag(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "test-user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	memo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{Content: "daily log", Visibility: apiv1.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, memo.Etag)
+
+	fetched, err := ts.Service.GetMemo(userCtx, &apiv1.GetMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	require.Equal(t, memo.Etag, fetched.Etag)
+
+	// An update with the current etag succeeds and changes the etag.
+	updated, err := ts.Service.UpdateMemo(userCtx, &apiv1.UpdateMemoRequest{
+		Memo:       &apiv1.Memo{Name: memo.Name, Content: "daily log\n- from mobile", Etag: memo.Etag},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	require.NoError(t, err)
+	require.NotEqual(t, memo.Etag, updated.Etag)
+
+	// A stale etag aborts the update without changing the memo.
+	_, err = ts.Service.UpdateMemo(userCtx, &apiv1.UpdateMemoRequest{
+		Memo:       &apiv1.Memo{Name: memo.Name, Content: "daily log\n- from web", Etag: memo.Etag},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	require.Equal(t, codes.Aborted, status.Code(err))
+	fetched, err = ts.Service.GetMemo(userCtx, &apiv1.GetMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	require.Equal(t, "daily log\n- from mobile", fetched.Content)
+
+	// Updates without an etag are unconditional, and changes other than the content change the etag too.
+	pinned, err := ts.Service.UpdateMemo(userCtx, &apiv1.UpdateMemoRequest{
+		Memo:       &apiv1.Memo{Name: memo.Name, Pinned: true},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"pinned"}},
+	})
+	require.NoError(t, err)
+	require.NotEqual(t, updated.Etag, pinned.Etag)
+	_, err = ts.Service.UpdateMemo(userCtx, &apiv1.UpdateMemoRequest{
+		Memo:       &apiv1.Memo{Name: memo.Name, Visibility: apiv1.Visibility_PUBLIC, Etag: updated.Etag},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility"}},
+	})
+	require.Equal(t, codes.Aborted, status.Code(err))
+
+	_, err = ts.Service.DeleteMemo(userCtx, &apiv1.DeleteMemoRequest{Name: memo.Name, Etag: updated.Etag})
+	require.Equal(t, codes.Aborted, status.Code(err))
+	_, err = ts.Service.DeleteMemo(userCtx, &apiv1.DeleteMemoRequest{Name: memo.Name, Etag: pinned.Etag})
+	require.NoError(t, err)
+	_, err = ts.Service.GetMemo(userCtx, &apiv1.GetMemoRequest{Name: memo.Name})
+	require.Error(t, err)
+}
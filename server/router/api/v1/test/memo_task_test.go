@@ -0,0 +1,121 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestRemindDueTasks(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	_, err = ts.Service.UpdateUserSetting(userCtx, &apiv1.UpdateUserSettingRequest{
+		Setting: &apiv1.UserSetting{
+			Name: fmt.Sprintf("users/%d/settings/GENERAL", user.ID),
+			Value: &apiv1.UserSetting_GeneralSetting_{
+				GeneralSetting: &apiv1.UserSetting_GeneralSetting{Timezone: "Asia/Tokyo"},
+			},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"timezone"}},
+	})
+	require.NoError(t, err)
+	hook, err := ts.Service.CreateUserWebhook(userCtx, &apiv1.CreateUserWebhookRequest{
+		Parent:  fmt.Sprintf("users/%d", user.ID),
+		Webhook: &apiv1.UserWebhook{Url: "https://example.com/webhook"},
+	})
+	require.NoError(t, err)
+
+	// Due markers are evaluated in the timezone of the creator.
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+	now := time.Now().In(tokyo).Truncate(time.Minute)
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+	content := fmt.Sprintf("- [ ] ship it @%s\n- [ ] announce it @%s\n- [x] write it @%s\n- [ ] plan next @%s",
+		past.Format("2006-01-02 15:04"),
+		future.Format("2006-01-02 15:04"),
+		past.Format("2006-01-02 15:04"),
+		now.Add(-48*time.Hour).Format("2006-01-02 15:04"),
+	)
+	memo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{Content: content, Visibility: apiv1.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	require.Equal(t, now.Add(-48*time.Hour).Unix(), memo.Property.DueTime.AsTime().Unix())
+
+	list, err := ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{Filter: "due_time < now()"})
+	require.NoError(t, err)
+	require.Len(t, list.Memos, 1)
+	list, err = ts.Service.ListMemos(userCtx, &apiv1.ListMemosRequest{Filter: "due_time > now()"})
+	require.NoError(t, err)
+	require.Empty(t, list.Memos)
+
+	// Only the incomplete task which came due recently is reminded of.
+	require.NoError(t, ts.Service.RemindDueTasks(ctx))
+	notifications, err := ts.Service.ListUserNotifications(userCtx, &apiv1.ListUserNotificationsRequest{
+		Parent: fmt.Sprintf("users/%d", user.ID),
+	})
+	require.NoError(t, err)
+	require.Len(t, notifications.Notifications, 1)
+	require.Equal(t, apiv1.UserNotification_TASK_DUE, notifications.Notifications[0].Type)
+	activity, err := ts.Service.GetActivity(userCtx, &apiv1.GetActivityRequest{
+		Name: fmt.Sprintf("activities/%d", notifications.Notifications[0].GetActivityId()),
+	})
+	require.NoError(t, err)
+	require.Equal(t, apiv1.Activity_TASK_DUE, activity.Type)
+	require.Equal(t, memo.Name, activity.Payload.GetTaskDue().Memo)
+	require.Equal(t, "ship it", activity.Payload.GetTaskDue().Content)
+	require.Equal(t, past.Unix(), activity.Payload.GetTaskDue().DueTime.AsTime().Unix())
+	deliveries, err := ts.Service.ListWebhookDeliveries(userCtx, &apiv1.ListWebhookDeliveriesRequest{Parent: hook.Name})
+	require.NoError(t, err)
+	require.Len(t, deliveries.Deliveries, 2)
+	require.Equal(t, "memos.memo.task.due", deliveries.Deliveries[0].ActivityType)
+
+	// Reminded memos are no longer listed by the following runs, until their next task comes due.
+	remindBefore := time.Now().Unix()
+	due, err := ts.Store.ListMemos(ctx, &store.FindMemo{RemindBefore: &remindBefore})
+	require.NoError(t, err)
+	require.Empty(t, due)
+	remindBefore = future.Unix()
+	due, err = ts.Store.ListMemos(ctx, &store.FindMemo{RemindBefore: &remindBefore})
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+
+	// Tasks are reminded of once, even when the memo is edited.
+	_, err = ts.Service.UpdateMemo(userCtx, &apiv1.UpdateMemoRequest{
+		Memo:       &apiv1.Memo{Name: memo.Name, Content: content + "\n\nRelease checklist"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, ts.Service.RemindDueTasks(ctx))
+	notifications, err = ts.Service.ListUserNotifications(userCtx, &apiv1.ListUserNotificationsRequest{
+		Parent: fmt.Sprintf("users/%d", user.ID),
+	})
+	require.NoError(t, err)
+	require.Len(t, notifications.Notifications, 1)
+
+	// Activities about the memo can still be listed once it is deleted.
+	_, err = ts.Service.DeleteMemo(userCtx, &apiv1.DeleteMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	activities, err := ts.Service.ListActivities(userCtx, &apiv1.ListActivitiesRequest{})
+	require.NoError(t, err)
+	require.Len(t, activities.Activities, 1)
+	require.Empty(t, activities.Activities[0].Payload.GetTaskDue().Memo)
+	require.Equal(t, "ship it", activities.Activities[0].Payload.GetTaskDue().Content)
+	admin, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	_, err = ts.Service.ListActivities(ts.CreateUserContext(ctx, admin.ID), &apiv1.ListActivitiesRequest{})
+	require.NoError(t, err)
+}
@@ -0,0 +1,241 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/usememos/memos/plugin/email"
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+// emailRecorder records the emails sent by the service instead of sending them.
+type emailRecorder struct {
+	mutex    sync.Mutex
+	messages []*email.Message
+}
+
+func (r *emailRecorder) Send(_ *email.Config, message *email.Message) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.messages = append(r.messages, message)
+	return nil
+}
+
+func (r *emailRecorder) Messages() []*email.Message {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return append([]*email.Message{}, r.messages...)
+}
+
+func TestSMTPSetting(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+	recorder := &emailRecorder{}
+	ts.Service.EmailSender = recorder.Send
+
+	admin, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	adminCtx := ts.CreateUserContext(ctx, admin.ID)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	_, err = ts.Service.SendTestEmail(adminCtx, &apiv1.SendTestEmailRequest{})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = ts.Service.UpdateInstanceSetting(adminCtx, &apiv1.UpdateInstanceSettingRequest{
+		Setting: &apiv1.InstanceSetting{
+			Name: "instance/settings/SMTP",
+			Value: &apiv1.InstanceSetting_SmtpSetting_{
+				SmtpSetting: &apiv1.InstanceSetting_SmtpSetting{
+					Host:      "smtp.example.com",
+					Port:      587,
+					Username:  "memos",
+					Password:  "secret",
+					FromEmail: "memos@example.com",
+					UseTls:    true,
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	// The setting holds credentials, so only admins can read it.
+	setting, err := ts.Service.GetInstanceSetting(adminCtx, &apiv1.GetInstanceSettingRequest{Name: "instance/settings/SMTP"})
+	require.NoError(t, err)
+	require.Equal(t, "secret", setting.GetSmtpSetting().Password)
+	_, err = ts.Service.GetInstanceSetting(userCtx, &apiv1.GetInstanceSettingRequest{Name: "instance/settings/SMTP"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = ts.Service.SendTestEmail(userCtx, &apiv1.SendTestEmailRequest{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.SendTestEmail(adminCtx, &apiv1.SendTestEmailRequest{})
+	require.NoError(t, err)
+	_, err = ts.Service.SendTestEmail(adminCtx, &apiv1.SendTestEmailRequest{Email: "ops@example.com"})
+	require.NoError(t, err)
+	messages := recorder.Messages()
+	require.Len(t, messages, 2)
+	require.Equal(t, []string{admin.Email}, messages[0].To)
+	require.Equal(t, []string{"ops@example.com"}, messages[1].To)
+}
+
+func TestNotificationEmails(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+	recorder := &emailRecorder{}
+	ts.Service.EmailSender = recorder.Send
+
+	admin, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	adminCtx := ts.CreateUserContext(ctx, admin.ID)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	memo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{Content: "Trip plans", Visibility: apiv1.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+	comment := func(content string) {
+		_, err := ts.Service.CreateMemoComment(otherCtx, &apiv1.CreateMemoCommentRequest{
+			Name:    memo.Name,
+			Comment: &apiv1.Memo{Content: content, Visibility: apiv1.Visibility_PUBLIC},
+		})
+		require.NoError(t, err)
+	}
+
+	// Nothing is sent without an SMTP server, even with the preferences enabled.
+	setting, err := ts.Service.UpdateUserSetting(userCtx, &apiv1.UpdateUserSettingRequest{
+		Setting: &apiv1.UserSetting{
+			Name: fmt.Sprintf("users/%d/settings/NOTIFICATION", user.ID),
+			Value: &apiv1.UserSetting_NotificationSetting_{
+				NotificationSetting: &apiv1.UserSetting_NotificationSetting{EmailMemoComment: true, EmailDigest: true},
+			},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"email_memo_comment", "email_digest"}},
+	})
+	require.NoError(t, err)
+	require.True(t, setting.GetNotificationSetting().EmailMemoComment)
+	require.False(t, setting.GetNotificationSetting().EmailTaskDue)
+	comment("Count me in")
+	require.NoError(t, ts.Service.SendEmailDigests(ctx))
+
+	_, err = ts.Service.UpdateInstanceSetting(adminCtx, &apiv1.UpdateInstanceSettingRequest{
+		Setting: &apiv1.InstanceSetting{
+			Name: "instance/settings/SMTP",
+			Value: &apiv1.InstanceSetting_SmtpSetting_{
+				SmtpSetting: &apiv1.InstanceSetting_SmtpSetting{Host: "smtp.example.com", Port: 587, FromEmail: "memos@example.com"},
+			},
+		},
+	})
+	require.NoError(t, err)
+	comment("Me too")
+	require.Eventually(t, func() bool { return len(recorder.Messages()) == 1 }, time.Second, 10*time.Millisecond)
+	message := recorder.Messages()[0]
+	require.Equal(t, []string{user.Email}, message.To)
+	require.Equal(t, "other commented on your memo", message.Subject)
+	require.Contains(t, message.Body, "Me too")
+	require.Contains(t, message.Body, "http://localhost:8080/"+memo.Name)
+
+	// The digest summarises the unread notifications of the last day.
+	require.NoError(t, ts.Service.SendEmailDigests(ctx))
+	messages := recorder.Messages()
+	require.Len(t, messages, 2)
+	require.Equal(t, []string{user.Email}, messages[1].To)
+	require.Contains(t, messages[1].Body, "You have 2 unread notifications")
+
+	// Comments are no longer emailed once disabled.
+	_, err = ts.Service.UpdateUserSetting(userCtx, &apiv1.UpdateUserSettingRequest{
+		Setting: &apiv1.UserSetting{
+			Name: fmt.Sprintf("users/%d/settings/NOTIFICATION", user.ID),
+			Value: &apiv1.UserSetting_NotificationSetting_{
+				NotificationSetting: &apiv1.UserSetting_NotificationSetting{},
+			},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"email_memo_comment"}},
+	})
+	require.NoError(t, err)
+	setting, err = ts.Service.GetUserSetting(userCtx, &apiv1.GetUserSettingRequest{
+		Name: fmt.Sprintf("users/%d/settings/NOTIFICATION", user.ID),
+	})
+	require.NoError(t, err)
+	require.False(t, setting.GetNotificationSetting().EmailMemoComment)
+	require.True(t, setting.GetNotificationSetting().EmailDigest)
+	comment("Count me out")
+	require.Never(t, func() bool { return len(recorder.Messages()) > 2 }, 200*time.Millisecond, 10*time.Millisecond)
+}
+
+func TestNotificationEmailHeaderInjection(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+	recorder := &emailRecorder{}
+	ts.Service.EmailSender = recorder.Send
+
+	admin, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	adminCtx := ts.CreateUserContext(ctx, admin.ID)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	_, err = ts.Service.UpdateInstanceSetting(adminCtx, &apiv1.UpdateInstanceSettingRequest{
+		Setting: &apiv1.InstanceSetting{
+			Name: "instance/settings/SMTP",
+			Value: &apiv1.InstanceSetting_SmtpSetting_{
+				SmtpSetting: &apiv1.InstanceSetting_SmtpSetting{Host: "smtp.example.com", Port: 587, FromEmail: "memos@example.com"},
+			},
+		},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.UpdateUserSetting(userCtx, &apiv1.UpdateUserSettingRequest{
+		Setting: &apiv1.UserSetting{
+			Name: fmt.Sprintf("users/%d/settings/NOTIFICATION", user.ID),
+			Value: &apiv1.UserSetting_NotificationSetting_{
+				NotificationSetting: &apiv1.UserSetting_NotificationSetting{EmailMemoComment: true},
+			},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"email_memo_comment"}},
+	})
+	require.NoError(t, err)
+
+	// The nickname of the commenter ends up in the subject of the email.
+	_, err = ts.Service.UpdateUser(otherCtx, &apiv1.UpdateUserRequest{
+		User:       &apiv1.User{Name: fmt.Sprintf("users/%d", other.ID), DisplayName: "x\r\nBcc: victim@example.com"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"display_name"}},
+	})
+	require.NoError(t, err)
+	memo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{Content: "Trip plans", Visibility: apiv1.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateMemoComment(otherCtx, &apiv1.CreateMemoCommentRequest{
+		Name:    memo.Name,
+		Comment: &apiv1.Memo{Content: "Count me in", Visibility: apiv1.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool { return len(recorder.Messages()) == 1 }, time.Second, 10*time.Millisecond)
+
+	headers, _, _ := strings.Cut(recorder.Messages()[0].Format("memos@example.com", ""), "\r\n\r\n")
+	for _, line := range strings.Split(headers, "\r\n") {
+		require.False(t, strings.HasPrefix(line, "Bcc:"), "injected header %q", line)
+	}
+	require.Contains(t, headers, "Subject: xBcc: victim@example.com commented on your memo")
+}
@@ -0,0 +1,118 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestTagService(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	parent := fmt.Sprintf("users/%d", user.ID)
+
+	createMemo := func(content string) string {
+		memo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+			Memo: &apiv1.Memo{Content: content, Visibility: apiv1.Visibility_PRIVATE},
+		})
+		require.NoError(t, err)
+		return memo.Name
+	}
+	getContent := func(name string) string {
+		memo, err := ts.Service.GetMemo(userCtx, &apiv1.GetMemoRequest{Name: name})
+		require.NoError(t, err)
+		return memo.Content
+	}
+	project := createMemo("Project notes #work/project #todo")
+	meeting := createMemo("| meeting | #Work |\n| - | - |")
+	homework := createMemo("Math #homework")
+	plain := createMemo("Nothing to see")
+
+	t.Run("ListTags", func(t *testing.T) {
+		resp, err := ts.Service.ListTags(userCtx, &apiv1.ListTagsRequest{Parent: parent})
+		require.NoError(t, err)
+		require.Equal(t, []*apiv1.Tag{
+			{Tag: "homework", MemoCount: 1},
+			{Tag: "todo", MemoCount: 1},
+			{Tag: "work", MemoCount: 1},
+			{Tag: "work/project", MemoCount: 1},
+		}, resp.Tags)
+	})
+
+	t.Run("RenameTag", func(t *testing.T) {
+		resp, err := ts.Service.RenameTag(userCtx, &apiv1.RenameTagRequest{Parent: parent, OldTag: "work", NewTag: "job", ValidateOnly: true})
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{project, meeting}, resp.Memos)
+		require.Equal(t, "Project notes #work/project #todo", getContent(project))
+
+		resp, err = ts.Service.RenameTag(userCtx, &apiv1.RenameTagRequest{Parent: parent, OldTag: "work", NewTag: "job"})
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{project, meeting}, resp.Memos)
+		require.Equal(t, "Project notes #job/project #todo", getContent(project))
+		require.Equal(t, "| meeting | #job |\n| - | - |", getContent(meeting))
+		require.Equal(t, "Math #homework", getContent(homework))
+
+		memo, err := ts.Service.GetMemo(userCtx, &apiv1.GetMemoRequest{Name: project})
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{"job/project", "todo"}, memo.Tags)
+		revisions, err := ts.Service.ListMemoRevisions(userCtx, &apiv1.ListMemoRevisionsRequest{Name: project})
+		require.NoError(t, err)
+		require.Len(t, revisions.Revisions, 2)
+
+		_, err = ts.Service.RenameTag(userCtx, &apiv1.RenameTagRequest{Parent: parent, OldTag: "job", NewTag: "todo"})
+		require.Equal(t, codes.AlreadyExists, status.Code(err))
+		_, err = ts.Service.RenameTag(userCtx, &apiv1.RenameTagRequest{Parent: parent, OldTag: "missing", NewTag: "other"})
+		require.Equal(t, codes.NotFound, status.Code(err))
+		_, err = ts.Service.RenameTag(userCtx, &apiv1.RenameTagRequest{Parent: parent, OldTag: "job", NewTag: "two words"})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("MergeTags", func(t *testing.T) {
+		resp, err := ts.Service.MergeTags(userCtx, &apiv1.MergeTagsRequest{Parent: parent, SourceTags: []string{"job", "homework"}, TargetTag: "todo"})
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{project, meeting, homework}, resp.Memos)
+		require.Equal(t, "Project notes #todo/project #todo", getContent(project))
+		require.Equal(t, "Math #todo", getContent(homework))
+
+		_, err = ts.Service.MergeTags(userCtx, &apiv1.MergeTagsRequest{Parent: parent, SourceTags: []string{"todo"}, TargetTag: "todo"})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("DeleteTag", func(t *testing.T) {
+		resp, err := ts.Service.DeleteTag(userCtx, &apiv1.DeleteTagRequest{Parent: parent, Tag: "todo"})
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{project, meeting, homework}, resp.Memos)
+		require.Equal(t, "Project notes", getContent(project))
+		require.Equal(t, "Math", getContent(homework))
+		require.Equal(t, "Nothing to see", getContent(plain))
+
+		tags, err := ts.Service.ListTags(userCtx, &apiv1.ListTagsRequest{Parent: parent})
+		require.NoError(t, err)
+		require.Empty(t, tags.Tags)
+	})
+
+	t.Run("only the user can manage their tags", func(t *testing.T) {
+		other, err := ts.CreateRegularUser(ctx, "other")
+		require.NoError(t, err)
+		otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+		_, err = ts.Service.ListTags(otherCtx, &apiv1.ListTagsRequest{Parent: parent})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = ts.Service.DeleteTag(otherCtx, &apiv1.DeleteTagRequest{Parent: parent, Tag: "todo"})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = ts.Service.ListTags(ctx, &apiv1.ListTagsRequest{Parent: parent})
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
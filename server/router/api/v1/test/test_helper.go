@@ -5,7 +5,6 @@ import (
 	"testing"
 
 	"github.com/usememos/memos/internal/profile"
-	"github.com/usememos/memos/plugin/markdown"
 	"github.com/usememos/memos/server/auth"
 	apiv1 "github.com/usememos/memos/server/router/api/v1"
 	"github.com/usememos/memos/store"
@@ -38,15 +37,7 @@ func NewTestService(t *testing.T) *TestService {
 
 	// Create APIV1Service with nil grpcServer since we're testing direct calls
 	secret := "test-secret"
-	markdownService := markdown.NewService(
-		markdown.WithTagExtension(),
-	)
-	service := &apiv1.APIV1Service{
-		Secret:          secret,
-		Profile:         testProfile,
-		Store:           testStore,
-		MarkdownService: markdownService,
-	}
+	service := apiv1.NewAPIV1Service(secret, testProfile, testStore)
 
 	// Clear any cached state from previous tests
 	service.ClearInstanceOwnerCache()
@@ -103,3 +103,44 @@ func TestGetUserStats_TagCount(t *testing.T) {
 	require.Contains(t, response3.TagCount, "test")
 	require.Equal(t, int32(2), response3.TagCount["test"], "Original tag count should remain 2")
 }
+
+func TestGetUserStats_NestedTagCount(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateHostUser(ctx, "test_user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	for i, tags := range [][]string{
+		{"work"},
+		{"work/project-a"},
+		{"work/project-a/design", "work/project-b"},
+	} {
+		_, err := ts.Store.CreateMemo(ctx, &store.Memo{
+			UID:        fmt.Sprintf("nested-memo-%d", i),
+			CreatorID:  user.ID,
+			Content:    "Nested tags",
+			Visibility: store.Public,
+			Payload: &storepb.MemoPayload{
+				Tags: tags,
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	response, err := ts.Service.GetUserStats(userCtx, &v1pb.GetUserStatsRequest{
+		Name: fmt.Sprintf("users/%d", user.ID),
+	})
+	require.NoError(t, err)
+
+	// Each memo is counted once for a parent tag, however many nested tags it uses.
+	require.Equal(t, map[string]int32{
+		"work":                  3,
+		"work/project-a":        2,
+		"work/project-a/design": 1,
+		"work/project-b":        1,
+	}, response.TagCount)
+}
@@ -0,0 +1,345 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/totp"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+const twoFactorTestPassword = "correct horse battery staple"
+
+func createUserWithPassword(ctx context.Context, t *testing.T, ts *TestService, username string, role store.Role) *store.User {
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(twoFactorTestPassword), bcrypt.MinCost)
+	require.NoError(t, err)
+	user, err := ts.Store.CreateUser(ctx, &store.User{
+		Username:     username,
+		Role:         role,
+		Email:        username + "@example.com",
+		PasswordHash: string(passwordHash),
+	})
+	require.NoError(t, err)
+	return user
+}
+
+func signInWithPassword(ctx context.Context, ts *TestService, username string) (*v1pb.SignInResponse, error) {
+	return ts.Service.SignIn(apiv1.WithHeaderCarrier(ctx), &v1pb.SignInRequest{
+		Credentials: &v1pb.SignInRequest_PasswordCredentials_{
+			PasswordCredentials: &v1pb.SignInRequest_PasswordCredentials{
+				Username: username,
+				Password: twoFactorTestPassword,
+			},
+		},
+	})
+}
+
+func signInWithCode(ctx context.Context, ts *TestService, challenge, code string) (*v1pb.SignInResponse, error) {
+	return ts.Service.SignIn(apiv1.WithHeaderCarrier(ctx), &v1pb.SignInRequest{
+		Credentials: &v1pb.SignInRequest_TwoFactorCredentials_{
+			TwoFactorCredentials: &v1pb.SignInRequest_TwoFactorCredentials{
+				Challenge: challenge,
+				Code:      code,
+			},
+		},
+	})
+}
+
+// enableTwoFactor enrolls the user and returns the TOTP secret and the recovery codes.
+// The code of the current period is used by the enrollment.
+func enableTwoFactor(ctx context.Context, t *testing.T, ts *TestService, user *store.User) (string, []string) {
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	name := fmt.Sprintf("users/%d", user.ID)
+	setup, err := ts.Service.SetupTwoFactor(userCtx, &v1pb.SetupTwoFactorRequest{Name: name})
+	require.NoError(t, err)
+	code, err := totp.GenerateCode(setup.Secret, totp.Step(time.Now()))
+	require.NoError(t, err)
+	enabled, err := ts.Service.EnableTwoFactor(userCtx, &v1pb.EnableTwoFactorRequest{Name: name, Code: code})
+	require.NoError(t, err)
+	return setup.Secret, enabled.RecoveryCodes
+}
+
+func TestTwoFactorEnrollment(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user := createUserWithPassword(ctx, t, ts, "alice", store.RoleUser)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	name := fmt.Sprintf("users/%d", user.ID)
+
+	setup, err := ts.Service.SetupTwoFactor(userCtx, &v1pb.SetupTwoFactorRequest{Name: name})
+	require.NoError(t, err)
+	require.NotEmpty(t, setup.Secret)
+	require.Contains(t, setup.Uri, "otpauth://totp/Memos:alice?")
+
+	// Not enabled before a code is verified.
+	status1, err := ts.Service.GetTwoFactorStatus(userCtx, &v1pb.GetTwoFactorStatusRequest{Name: name})
+	require.NoError(t, err)
+	require.False(t, status1.Enabled)
+	signIn, err := signInWithPassword(ctx, ts, "alice")
+	require.NoError(t, err)
+	require.NotEmpty(t, signIn.AccessToken)
+
+	_, err = ts.Service.EnableTwoFactor(userCtx, &v1pb.EnableTwoFactorRequest{Name: name, Code: "000000"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	code, err := totp.GenerateCode(setup.Secret, totp.Step(time.Now()))
+	require.NoError(t, err)
+	enabled, err := ts.Service.EnableTwoFactor(userCtx, &v1pb.EnableTwoFactorRequest{Name: name, Code: code})
+	require.NoError(t, err)
+	require.Len(t, enabled.RecoveryCodes, 10)
+	require.Regexp(t, `^[a-z0-9]{5}-[a-z0-9]{5}$`, enabled.RecoveryCodes[0])
+
+	status2, err := ts.Service.GetTwoFactorStatus(userCtx, &v1pb.GetTwoFactorStatusRequest{Name: name})
+	require.NoError(t, err)
+	require.True(t, status2.Enabled)
+	require.Equal(t, int32(10), status2.RecoveryCodeCount)
+
+	// Recovery codes are stored hashed.
+	setting, err := ts.Store.GetUserTwoFactorSetting(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, setting.RecoveryCodeHashes, 10)
+	require.NotContains(t, setting.RecoveryCodeHashes, enabled.RecoveryCodes[0])
+
+	// Setting up again requires disabling first.
+	_, err = ts.Service.SetupTwoFactor(userCtx, &v1pb.SetupTwoFactorRequest{Name: name})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// Other users cannot manage the enrollment.
+	other, err := ts.CreateRegularUser(ctx, "bob")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+	_, err = ts.Service.GetTwoFactorStatus(otherCtx, &v1pb.GetTwoFactorStatusRequest{Name: name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.DisableTwoFactor(otherCtx, &v1pb.DisableTwoFactorRequest{Name: name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Regenerating replaces the recovery codes.
+	regenerated, err := ts.Service.RegenerateRecoveryCodes(userCtx, &v1pb.RegenerateRecoveryCodesRequest{
+		Name: name,
+		Code: enabled.RecoveryCodes[0],
+	})
+	require.NoError(t, err)
+	require.Len(t, regenerated.RecoveryCodes, 10)
+	_, err = ts.Service.DisableTwoFactor(userCtx, &v1pb.DisableTwoFactorRequest{Name: name, Code: enabled.RecoveryCodes[1]})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = ts.Service.DisableTwoFactor(userCtx, &v1pb.DisableTwoFactorRequest{Name: name, Code: regenerated.RecoveryCodes[0]})
+	require.NoError(t, err)
+	status3, err := ts.Service.GetTwoFactorStatus(userCtx, &v1pb.GetTwoFactorStatusRequest{Name: name})
+	require.NoError(t, err)
+	require.False(t, status3.Enabled)
+	require.Equal(t, int32(0), status3.RecoveryCodeCount)
+}
+
+func TestTwoFactorSignIn(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	admin := createUserWithPassword(ctx, t, ts, "admin", store.RoleAdmin)
+	user := createUserWithPassword(ctx, t, ts, "alice", store.RoleUser)
+	secret, recoveryCodes := enableTwoFactor(ctx, t, ts, user)
+	// The attempts of a challenge are limited apart from the failed attempts of the username.
+	updateSignInLimit(ctx, t, ts, admin.ID, &v1pb.InstanceSetting_GeneralSetting_SignInLimit{
+		MaxAttemptsPerUsername: -1,
+	})
+
+	t.Run("password returns a challenge", func(t *testing.T) {
+		response, err := signInWithPassword(ctx, ts, "alice")
+		require.NoError(t, err)
+		require.NotEmpty(t, response.TwoFactorChallenge)
+		require.NotNil(t, response.TwoFactorChallengeExpiresAt)
+		require.Empty(t, response.AccessToken)
+		require.Nil(t, response.User)
+
+		_, err = signInWithCode(ctx, ts, "invalid", recoveryCodes[0])
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("authenticator code", func(t *testing.T) {
+		challenge, err := signInWithPassword(ctx, ts, "alice")
+		require.NoError(t, err)
+
+		// The code used by the enrollment cannot be replayed.
+		setting, err := ts.Store.GetUserTwoFactorSetting(ctx, user.ID)
+		require.NoError(t, err)
+		used, err := totp.GenerateCode(secret, setting.LastUsedStep)
+		require.NoError(t, err)
+		_, err = signInWithCode(ctx, ts, challenge.TwoFactorChallenge, used)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		code, err := totp.GenerateCode(secret, setting.LastUsedStep+1)
+		require.NoError(t, err)
+		response, err := signInWithCode(ctx, ts, challenge.TwoFactorChallenge, code)
+		require.NoError(t, err)
+		require.NotEmpty(t, response.AccessToken)
+		require.Equal(t, "alice", response.User.Username)
+
+		// A challenge is used once.
+		_, err = signInWithCode(ctx, ts, challenge.TwoFactorChallenge, recoveryCodes[0])
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("recovery code is used once", func(t *testing.T) {
+		challenge, err := signInWithPassword(ctx, ts, "alice")
+		require.NoError(t, err)
+		response, err := signInWithCode(ctx, ts, challenge.TwoFactorChallenge, " "+recoveryCodes[1]+" ")
+		require.NoError(t, err)
+		require.NotEmpty(t, response.AccessToken)
+
+		challenge, err = signInWithPassword(ctx, ts, "alice")
+		require.NoError(t, err)
+		_, err = signInWithCode(ctx, ts, challenge.TwoFactorChallenge, recoveryCodes[1])
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		setting, err := ts.Store.GetUserTwoFactorSetting(ctx, user.ID)
+		require.NoError(t, err)
+		require.Len(t, setting.RecoveryCodeHashes, 9)
+	})
+
+	t.Run("challenge attempts are limited", func(t *testing.T) {
+		challenge, err := signInWithPassword(ctx, ts, "alice")
+		require.NoError(t, err)
+		for range 5 {
+			_, err = signInWithCode(ctx, ts, challenge.TwoFactorChallenge, "000000")
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+		}
+		_, err = signInWithCode(ctx, ts, challenge.TwoFactorChallenge, recoveryCodes[2])
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+		// The recovery code was not consumed.
+		challenge, err = signInWithPassword(ctx, ts, "alice")
+		require.NoError(t, err)
+		_, err = signInWithCode(ctx, ts, challenge.TwoFactorChallenge, recoveryCodes[2])
+		require.NoError(t, err)
+	})
+}
+
+func TestTwoFactorConcurrentSignIn(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	// signInConcurrently submits the codes with the challenges at once and counts the responses by status code.
+	signInConcurrently := func(challenges, twoFactorCodes []string) map[codes.Code]int {
+		var mutex sync.Mutex
+		var wg sync.WaitGroup
+		results := map[codes.Code]int{}
+		for i := range twoFactorCodes {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := signInWithCode(ctx, ts, challenges[i], twoFactorCodes[i])
+				mutex.Lock()
+				defer mutex.Unlock()
+				results[status.Code(err)]++
+			}()
+		}
+		wg.Wait()
+		return results
+	}
+
+	// A recovery code signs in once, even when submitted with several challenges at once.
+	alice := createUserWithPassword(ctx, t, ts, "alice", store.RoleUser)
+	_, recoveryCodes := enableTwoFactor(ctx, t, ts, alice)
+	challenges, recoveryCodeAttempts := []string{}, []string{}
+	for range 4 {
+		signIn, err := signInWithPassword(ctx, ts, "alice")
+		require.NoError(t, err)
+		challenges = append(challenges, signIn.TwoFactorChallenge)
+		recoveryCodeAttempts = append(recoveryCodeAttempts, recoveryCodes[0])
+	}
+	results := signInConcurrently(challenges, recoveryCodeAttempts)
+	require.Equal(t, 1, results[codes.OK])
+
+	// The codes tried with a challenge are capped, even when submitted at once.
+	bob := createUserWithPassword(ctx, t, ts, "bob", store.RoleUser)
+	enableTwoFactor(ctx, t, ts, bob)
+	signIn, err := signInWithPassword(ctx, ts, "bob")
+	require.NoError(t, err)
+	challenges, invalidCodes := []string{}, []string{}
+	for range 20 {
+		challenges = append(challenges, signIn.TwoFactorChallenge)
+		invalidCodes = append(invalidCodes, "invalid")
+	}
+	results = signInConcurrently(challenges, invalidCodes)
+	require.LessOrEqual(t, results[codes.InvalidArgument], 5)
+}
+
+func TestRequireAdminTwoFactor(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host := createUserWithPassword(ctx, t, ts, "host", store.RoleAdmin)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+	admin := createUserWithPassword(ctx, t, ts, "admin", store.RoleAdmin)
+	createUserWithPassword(ctx, t, ts, "user", store.RoleUser)
+
+	requireAdminTwoFactor := &v1pb.UpdateInstanceSettingRequest{
+		Setting: &v1pb.InstanceSetting{
+			Name: "instance/settings/GENERAL",
+			Value: &v1pb.InstanceSetting_GeneralSetting_{
+				GeneralSetting: &v1pb.InstanceSetting_GeneralSetting{
+					RequireAdminTwoFactor: true,
+				},
+			},
+		},
+	}
+
+	// All the active admins must use two-factor authentication before it is required, so that none is locked out.
+	_, err := ts.Service.UpdateInstanceSetting(hostCtx, requireAdminTwoFactor)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	hostSecret, _ := enableTwoFactor(ctx, t, ts, host)
+	_, err = ts.Service.UpdateInstanceSetting(hostCtx, requireAdminTwoFactor)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	require.Contains(t, err.Error(), `"admin"`)
+	_, _ = enableTwoFactor(ctx, t, ts, admin)
+	_, err = ts.Service.UpdateInstanceSetting(hostCtx, requireAdminTwoFactor)
+	require.NoError(t, err)
+
+	// Admins sign in with two-factor authentication, users without.
+	response, err := signInWithPassword(ctx, ts, "admin")
+	require.NoError(t, err)
+	require.NotEmpty(t, response.TwoFactorChallenge)
+	response, err = signInWithPassword(ctx, ts, "user")
+	require.NoError(t, err)
+	require.NotEmpty(t, response.AccessToken)
+
+	// Admins cannot disable their own two-factor authentication while it is required,
+	// but can disable it for another admin who lost their authenticator.
+	code, err := totp.GenerateCode(hostSecret, totp.Step(time.Now())+1)
+	require.NoError(t, err)
+	_, err = ts.Service.DisableTwoFactor(hostCtx, &v1pb.DisableTwoFactorRequest{
+		Name: fmt.Sprintf("users/%d", host.ID),
+		Code: code,
+	})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = ts.Service.DisableTwoFactor(hostCtx, &v1pb.DisableTwoFactorRequest{
+		Name: fmt.Sprintf("users/%d", admin.ID),
+	})
+	require.NoError(t, err)
+	setting, err := ts.Store.GetUserTwoFactorSetting(ctx, admin.ID)
+	require.NoError(t, err)
+	require.False(t, setting.Enabled)
+
+	// Admins without two-factor authentication cannot sign in with a password.
+	_, err = signInWithPassword(ctx, ts, "admin")
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Saving the setting again does not require it of the admins anew.
+	_, err = ts.Service.UpdateInstanceSetting(hostCtx, requireAdminTwoFactor)
+	require.NoError(t, err)
+}
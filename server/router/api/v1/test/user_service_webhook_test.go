@@ -0,0 +1,473 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	apiv1 "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/runner/webhookdelivery"
+	"github.com/usememos/memos/store"
+)
+
+func TestListWebhookDeliveries(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("memo changes enqueue deliveries", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+
+		user, err := ts.CreateRegularUser(ctx, "user")
+		require.NoError(t, err)
+		userCtx := ts.CreateUserContext(ctx, user.ID)
+		parent := fmt.Sprintf("users/%d", user.ID)
+
+		webhook, err := ts.Service.CreateUserWebhook(userCtx, &apiv1.CreateUserWebhookRequest{
+			Parent: parent,
+			Webhook: &apiv1.UserWebhook{
+				Url: "https://example.com/webhook",
+			},
+		})
+		require.NoError(t, err)
+
+		memo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+			Memo: &apiv1.Memo{
+				Content:    "Test memo",
+				Visibility: apiv1.Visibility_PRIVATE,
+			},
+		})
+		require.NoError(t, err)
+		_, err = ts.Service.DeleteMemo(userCtx, &apiv1.DeleteMemoRequest{
+			Name: memo.Name,
+		})
+		require.NoError(t, err)
+
+		resp, err := ts.Service.ListWebhookDeliveries(userCtx, &apiv1.ListWebhookDeliveriesRequest{
+			Parent: webhook.Name,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Deliveries, 2)
+		require.Equal(t, "memos.memo.deleted", resp.Deliveries[0].ActivityType)
+		require.Equal(t, "memos.memo.created", resp.Deliveries[1].ActivityType)
+		for _, delivery := range resp.Deliveries {
+			require.Equal(t, apiv1.WebhookDelivery_PENDING, delivery.State)
+			require.Equal(t, "https://example.com/webhook", delivery.Url)
+			require.Contains(t, delivery.Name, webhook.Name+"/deliveries/")
+			require.NotNil(t, delivery.NextAttemptTime)
+		}
+
+		// Paginate across all webhooks of the user.
+		resp, err = ts.Service.ListWebhookDeliveries(userCtx, &apiv1.ListWebhookDeliveriesRequest{
+			Parent:   parent + "/webhooks/-",
+			PageSize: 1,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Deliveries, 1)
+		require.NotEmpty(t, resp.NextPageToken)
+		resp, err = ts.Service.ListWebhookDeliveries(userCtx, &apiv1.ListWebhookDeliveriesRequest{
+			Parent:    parent + "/webhooks/-",
+			PageToken: resp.NextPageToken,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Deliveries, 1)
+		require.Empty(t, resp.NextPageToken)
+
+		// Deleting the webhook drops its deliveries.
+		_, err = ts.Service.DeleteUserWebhook(userCtx, &apiv1.DeleteUserWebhookRequest{
+			Name: webhook.Name,
+		})
+		require.NoError(t, err)
+		resp, err = ts.Service.ListWebhookDeliveries(userCtx, &apiv1.ListWebhookDeliveriesRequest{
+			Parent: parent + "/webhooks/-",
+		})
+		require.NoError(t, err)
+		require.Empty(t, resp.Deliveries)
+	})
+
+	t.Run("permission denied for other users", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+
+		user, err := ts.CreateRegularUser(ctx, "user")
+		require.NoError(t, err)
+		otherUser, err := ts.CreateRegularUser(ctx, "other")
+		require.NoError(t, err)
+		otherCtx := ts.CreateUserContext(ctx, otherUser.ID)
+
+		_, err = ts.Service.ListWebhookDeliveries(otherCtx, &apiv1.ListWebhookDeliveriesRequest{
+			Parent: fmt.Sprintf("users/%d/webhooks/-", user.ID),
+		})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "permission denied")
+	})
+}
+
+func TestUserWebhookSecret(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	parent := fmt.Sprintf("users/%d", user.ID)
+
+	// The secret is shown once on creation.
+	webhook, err := ts.Service.CreateUserWebhook(userCtx, &apiv1.CreateUserWebhookRequest{
+		Parent: parent,
+		Webhook: &apiv1.UserWebhook{
+			Url: "https://example.com/webhook",
+		},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, webhook.Secret)
+
+	listResp, err := ts.Service.ListUserWebhooks(userCtx, &apiv1.ListUserWebhooksRequest{Parent: parent})
+	require.NoError(t, err)
+	require.Len(t, listResp.Webhooks, 1)
+	require.Empty(t, listResp.Webhooks[0].Secret)
+
+	// Updating other fields keeps the secret and doesn't reveal it.
+	updated, err := ts.Service.UpdateUserWebhook(userCtx, &apiv1.UpdateUserWebhookRequest{
+		Webhook:    &apiv1.UserWebhook{Name: webhook.Name, DisplayName: "renamed"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"display_name"}},
+	})
+	require.NoError(t, err)
+	require.Empty(t, updated.Secret)
+	webhooks, err := ts.Store.GetUserWebhooks(ctx, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, webhook.Secret, webhooks[0].Secret)
+
+	// Rotating returns the new secret.
+	rotated, err := ts.Service.UpdateUserWebhook(userCtx, &apiv1.UpdateUserWebhookRequest{
+		Webhook:    &apiv1.UserWebhook{Name: webhook.Name},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"secret"}},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, rotated.Secret)
+	require.NotEqual(t, webhook.Secret, rotated.Secret)
+	webhooks, err = ts.Store.GetUserWebhooks(ctx, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, rotated.Secret, webhooks[0].Secret)
+}
+
+func TestUserWebhookSubscription(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("event types and filter select deliveries", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+
+		user, err := ts.CreateRegularUser(ctx, "user")
+		require.NoError(t, err)
+		userCtx := ts.CreateUserContext(ctx, user.ID)
+		parent := fmt.Sprintf("users/%d", user.ID)
+
+		allHook, err := ts.Service.CreateUserWebhook(userCtx, &apiv1.CreateUserWebhookRequest{
+			Parent:  parent,
+			Webhook: &apiv1.UserWebhook{Url: "https://example.com/all"},
+		})
+		require.NoError(t, err)
+		createdHook, err := ts.Service.CreateUserWebhook(userCtx, &apiv1.CreateUserWebhookRequest{
+			Parent: parent,
+			Webhook: &apiv1.UserWebhook{
+				Url:        "https://example.com/created",
+				EventTypes: []string{"memos.memo.created"},
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"memos.memo.created"}, createdHook.EventTypes)
+		workHook, err := ts.Service.CreateUserWebhook(userCtx, &apiv1.CreateUserWebhookRequest{
+			Parent: parent,
+			Webhook: &apiv1.UserWebhook{
+				Url:    "https://example.com/work",
+				Filter: `tag in ["work"] && visibility == "PUBLIC"`,
+			},
+		})
+		require.NoError(t, err)
+
+		workMemo, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+			Memo: &apiv1.Memo{Content: "Meeting notes #work", Visibility: apiv1.Visibility_PUBLIC},
+		})
+		require.NoError(t, err)
+		_, err = ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+			Memo: &apiv1.Memo{Content: "Private #work", Visibility: apiv1.Visibility_PRIVATE},
+		})
+		require.NoError(t, err)
+		_, err = ts.Service.UpdateMemo(userCtx, &apiv1.UpdateMemoRequest{
+			Memo:       &apiv1.Memo{Name: workMemo.Name, Content: "Meeting notes #work updated"},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+		})
+		require.NoError(t, err)
+
+		countDeliveries := func(webhookName string) int {
+			resp, err := ts.Service.ListWebhookDeliveries(userCtx, &apiv1.ListWebhookDeliveriesRequest{Parent: webhookName})
+			require.NoError(t, err)
+			return len(resp.Deliveries)
+		}
+		require.Equal(t, 3, countDeliveries(allHook.Name))
+		require.Equal(t, 2, countDeliveries(createdHook.Name))
+		require.Equal(t, 2, countDeliveries(workHook.Name))
+	})
+
+	t.Run("invalid subscription is rejected", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+
+		user, err := ts.CreateRegularUser(ctx, "user")
+		require.NoError(t, err)
+		userCtx := ts.CreateUserContext(ctx, user.ID)
+		parent := fmt.Sprintf("users/%d", user.ID)
+
+		_, err = ts.Service.CreateUserWebhook(userCtx, &apiv1.CreateUserWebhookRequest{
+			Parent: parent,
+			Webhook: &apiv1.UserWebhook{
+				Url:        "https://example.com/webhook",
+				EventTypes: []string{"memos.unknown"},
+			},
+		})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unsupported event type")
+
+		_, err = ts.Service.CreateUserWebhook(userCtx, &apiv1.CreateUserWebhookRequest{
+			Parent: parent,
+			Webhook: &apiv1.UserWebhook{
+				Url:    "https://example.com/webhook",
+				Filter: `unknown_field == "x"`,
+			},
+		})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid filter")
+	})
+}
+
+func TestMemoActivityWebhooks(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("comments, reactions, relations and attachments enqueue deliveries", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+
+		owner, err := ts.CreateRegularUser(ctx, "owner")
+		require.NoError(t, err)
+		ownerCtx := ts.CreateUserContext(ctx, owner.ID)
+		other, err := ts.CreateRegularUser(ctx, "other")
+		require.NoError(t, err)
+		otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+		related, err := ts.Service.CreateMemo(ownerCtx, &apiv1.CreateMemoRequest{
+			Memo: &apiv1.Memo{Content: "Related memo", Visibility: apiv1.Visibility_PUBLIC},
+		})
+		require.NoError(t, err)
+
+		hook, err := ts.Service.CreateUserWebhook(ownerCtx, &apiv1.CreateUserWebhookRequest{
+			Parent:  fmt.Sprintf("users/%d", owner.ID),
+			Webhook: &apiv1.UserWebhook{Url: "https://example.com/webhook"},
+		})
+		require.NoError(t, err)
+
+		// Relations set while creating a memo are part of the created event.
+		memo, err := ts.Service.CreateMemo(ownerCtx, &apiv1.CreateMemoRequest{
+			Memo: &apiv1.Memo{
+				Content:    "Test memo",
+				Visibility: apiv1.Visibility_PUBLIC,
+				Relations: []*apiv1.MemoRelation{
+					{RelatedMemo: &apiv1.MemoRelation_Memo{Name: related.Name}, Type: apiv1.MemoRelation_REFERENCE},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		_, err = ts.Service.CreateMemoComment(otherCtx, &apiv1.CreateMemoCommentRequest{
+			Name:    memo.Name,
+			Comment: &apiv1.Memo{Content: "Nice", Visibility: apiv1.Visibility_PUBLIC},
+		})
+		require.NoError(t, err)
+		reaction, err := ts.Service.UpsertMemoReaction(otherCtx, &apiv1.UpsertMemoReactionRequest{
+			Name:     memo.Name,
+			Reaction: &apiv1.Reaction{ContentId: memo.Name, ReactionType: "👍"},
+		})
+		require.NoError(t, err)
+		_, err = ts.Service.DeleteMemoReaction(otherCtx, &apiv1.DeleteMemoReactionRequest{Name: reaction.Name})
+		require.NoError(t, err)
+		_, err = ts.Service.SetMemoRelations(ownerCtx, &apiv1.SetMemoRelationsRequest{Name: memo.Name})
+		require.NoError(t, err)
+		_, err = ts.Service.SetMemoAttachments(ownerCtx, &apiv1.SetMemoAttachmentsRequest{Name: memo.Name})
+		require.NoError(t, err)
+
+		resp, err := ts.Service.ListWebhookDeliveries(ownerCtx, &apiv1.ListWebhookDeliveriesRequest{Parent: hook.Name})
+		require.NoError(t, err)
+		activityTypes := []string{}
+		for _, delivery := range resp.Deliveries {
+			activityTypes = append(activityTypes, delivery.ActivityType)
+		}
+		require.Equal(t, []string{
+			"memos.memo.attachments.updated",
+			"memos.memo.relations.updated",
+			"memos.reaction.deleted",
+			"memos.reaction.created",
+			"memos.memo.comment.created",
+			"memos.memo.created",
+		}, activityTypes)
+	})
+}
+
+func TestUserWebhookFormat(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	parent := fmt.Sprintf("users/%d", user.ID)
+
+	hook, err := ts.Service.CreateUserWebhook(userCtx, &apiv1.CreateUserWebhookRequest{
+		Parent: parent,
+		Webhook: &apiv1.UserWebhook{
+			Url:    "https://hooks.slack.com/services/test",
+			Format: apiv1.UserWebhook_SLACK,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, apiv1.UserWebhook_SLACK, hook.Format)
+
+	// A template is required for the template format.
+	_, err = ts.Service.UpdateUserWebhook(userCtx, &apiv1.UpdateUserWebhookRequest{
+		Webhook:    &apiv1.UserWebhook{Name: hook.Name, Format: apiv1.UserWebhook_TEMPLATE},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"format"}},
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid template")
+
+	updated, err := ts.Service.UpdateUserWebhook(userCtx, &apiv1.UpdateUserWebhookRequest{
+		Webhook: &apiv1.UserWebhook{
+			Name:     hook.Name,
+			Format:   apiv1.UserWebhook_TEMPLATE,
+			Template: `{"text": {{json .Title}}}`,
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"format", "template"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, apiv1.UserWebhook_TEMPLATE, updated.Format)
+	require.Equal(t, "https://hooks.slack.com/services/test", updated.Url)
+
+	// Rendered deliveries are enqueued like JSON ones.
+	_, err = ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{Content: "Hello", Visibility: apiv1.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	resp, err := ts.Service.ListWebhookDeliveries(userCtx, &apiv1.ListWebhookDeliveriesRequest{Parent: hook.Name})
+	require.NoError(t, err)
+	require.Len(t, resp.Deliveries, 1)
+}
+
+func TestUserWebhookBrokenTemplate(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	parent := fmt.Sprintf("users/%d", user.ID)
+
+	// The template parses, but fails to render memos without tags.
+	broken, err := ts.Service.CreateUserWebhook(userCtx, &apiv1.CreateUserWebhookRequest{
+		Parent: parent,
+		Webhook: &apiv1.UserWebhook{
+			Url:      "https://example.com/broken",
+			Format:   apiv1.UserWebhook_TEMPLATE,
+			Template: `{"tag": {{json (index .Memo.Tags 0)}}}`,
+		},
+	})
+	require.NoError(t, err)
+	hook, err := ts.Service.CreateUserWebhook(userCtx, &apiv1.CreateUserWebhookRequest{
+		Parent:  parent,
+		Webhook: &apiv1.UserWebhook{Url: "https://example.com/webhook"},
+	})
+	require.NoError(t, err)
+
+	_, err = ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+		Memo: &apiv1.Memo{Content: "Hello", Visibility: apiv1.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+
+	// The broken webhook records a failed delivery and does not hold back the others.
+	resp, err := ts.Service.ListWebhookDeliveries(userCtx, &apiv1.ListWebhookDeliveriesRequest{Parent: broken.Name})
+	require.NoError(t, err)
+	require.Len(t, resp.Deliveries, 1)
+	require.Equal(t, apiv1.WebhookDelivery_FAILED, resp.Deliveries[0].State)
+	require.Contains(t, resp.Deliveries[0].LastError, "failed to execute template")
+	resp, err = ts.Service.ListWebhookDeliveries(userCtx, &apiv1.ListWebhookDeliveriesRequest{Parent: hook.Name})
+	require.NoError(t, err)
+	require.Len(t, resp.Deliveries, 1)
+	require.Equal(t, apiv1.WebhookDelivery_PENDING, resp.Deliveries[0].State)
+}
+
+func TestDeliverPendingWebhooks(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	var failingRequests, succeedingRequests atomic.Int32
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		failingRequests.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failing.Close()
+	succeeding := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		succeedingRequests.Add(1)
+	}))
+	defer succeeding.Close()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	for _, url := range []string{failing.URL, succeeding.URL} {
+		_, err := ts.Service.CreateUserWebhook(userCtx, &apiv1.CreateUserWebhookRequest{
+			Parent:  fmt.Sprintf("users/%d", user.ID),
+			Webhook: &apiv1.UserWebhook{Url: url},
+		})
+		require.NoError(t, err)
+	}
+	for range 3 {
+		_, err := ts.Service.CreateMemo(userCtx, &apiv1.CreateMemoRequest{
+			Memo: &apiv1.Memo{Content: "Test memo", Visibility: apiv1.Visibility_PRIVATE},
+		})
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, webhookdelivery.NewRunner(ts.Store).DeliverPending(ctx))
+	// The deliveries to the failing receiver wait for the retry of the first one instead of being posted too.
+	require.Equal(t, int32(1), failingRequests.Load())
+	require.Equal(t, int32(3), succeedingRequests.Load())
+
+	deliveries, err := ts.Store.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{CreatorID: &user.ID, OrderByIDAsc: true})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 6)
+	var retryTs int64
+	attempts := []int32{}
+	for _, delivery := range deliveries {
+		if delivery.URL == succeeding.URL {
+			require.Equal(t, store.WebhookDeliverySucceeded, delivery.Status)
+			continue
+		}
+		require.Equal(t, store.WebhookDeliveryPending, delivery.Status)
+		require.Greater(t, delivery.NextAttemptTs, time.Now().Unix())
+		if retryTs == 0 {
+			retryTs = delivery.NextAttemptTs
+		}
+		require.Equal(t, retryTs, delivery.NextAttemptTs)
+		attempts = append(attempts, delivery.Attempts)
+	}
+	require.Equal(t, []int32{1, 0, 0}, attempts)
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -23,6 +24,7 @@ import (
 
 	"github.com/usememos/memos/internal/base"
 	"github.com/usememos/memos/internal/util"
+	"github.com/usememos/memos/plugin/webhook"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/server/auth"
@@ -176,6 +178,15 @@ func (s *APIV1Service) CreateUser(ctx context.Context, request *v1pb.CreateUserR
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create user: %v", err)
 	}
+	// Users signing up create themselves.
+	creatorID := user.ID
+	if currentUser != nil {
+		creatorID = currentUser.ID
+	}
+	s.recordAuditEvent(ctx, creatorID, store.ActivityTypeUserCreated, &storepb.ActivityAuditPayload{
+		Resource: fmt.Sprintf("%s%d", UserNamePrefix, user.ID),
+		NewValue: user.Role.String(),
+	})
 
 	// If this is the first admin user being created, clear the owner cache
 	// so that GetInstanceProfile will return initialized=true
@@ -294,6 +305,23 @@ func (s *APIV1Service) UpdateUser(ctx context.Context, request *v1pb.UpdateUserR
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update user: %v", err)
 	}
+	userName := fmt.Sprintf("%s%d", UserNamePrefix, user.ID)
+	if updatedUser.Role != user.Role {
+		s.recordAuditEvent(ctx, currentUser.ID, store.ActivityTypeUserRoleChanged, &storepb.ActivityAuditPayload{
+			Resource: userName,
+			OldValue: user.Role.String(),
+			NewValue: updatedUser.Role.String(),
+		})
+	}
+	if updatedUser.RowStatus != user.RowStatus {
+		activityType := store.ActivityTypeUserRestored
+		if updatedUser.RowStatus == store.Archived {
+			activityType = store.ActivityTypeUserArchived
+		}
+		s.recordAuditEvent(ctx, currentUser.ID, activityType, &storepb.ActivityAuditPayload{
+			Resource: userName,
+		})
+	}
 
 	return convertUserFromStore(updatedUser), nil
 }
@@ -324,6 +352,11 @@ func (s *APIV1Service) DeleteUser(ctx context.Context, request *v1pb.DeleteUserR
 	}); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete user: %v", err)
 	}
+	// The username identifies the deleted user once the user is gone.
+	s.recordAuditEvent(ctx, currentUser.ID, store.ActivityTypeUserDeleted, &storepb.ActivityAuditPayload{
+		Resource: fmt.Sprintf("%s%d", UserNamePrefix, user.ID),
+		OldValue: user.Username,
+	})
 
 	return &emptypb.Empty{}, nil
 }
@@ -403,9 +436,9 @@ func (s *APIV1Service) UpdateUserSetting(ctx context.Context, request *v1pb.Upda
 		return nil, status.Errorf(codes.InvalidArgument, "invalid setting key: %v", err)
 	}
 
-	// Only GENERAL settings are supported via UpdateUserSetting
+	// Only GENERAL and NOTIFICATION settings are supported via UpdateUserSetting
 	// Other setting types have dedicated service methods
-	if storeKey != storepb.UserSetting_GENERAL {
+	if storeKey != storepb.UserSetting_GENERAL && storeKey != storepb.UserSetting_NOTIFICATION {
 		return nil, status.Errorf(codes.InvalidArgument, "setting type %s should not be updated via UpdateUserSetting", storeKey.String())
 	}
 
@@ -414,16 +447,37 @@ func (s *APIV1Service) UpdateUserSetting(ctx context.Context, request *v1pb.Upda
 		Key:    storeKey,
 	})
 
-	generalSetting := &storepb.GeneralUserSetting{}
-	if existingUserSetting != nil {
-		// Start with existing general setting values
-		generalSetting = existingUserSetting.GetGeneral()
+	var updatedSetting *v1pb.UserSetting
+	if storeKey == storepb.UserSetting_NOTIFICATION {
+		updatedSetting = applyUserNotificationSettingUpdate(request, existingUserSetting.GetNotification())
+	} else {
+		updatedSetting, err = applyUserGeneralSettingUpdate(request, existingUserSetting.GetGeneral())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Convert API setting to store setting
+	storeSetting, err := convertUserSettingToStore(updatedSetting, userID, storeKey)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to convert setting: %v", err)
+	}
+
+	// Upsert the setting
+	if _, err := s.Store.UpsertUserSetting(ctx, storeSetting); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
 	}
 
+	return s.GetUserSetting(ctx, &v1pb.GetUserSettingRequest{Name: request.Setting.Name})
+}
+
+// applyUserGeneralSettingUpdate applies the fields of the update mask to the existing general setting.
+func applyUserGeneralSettingUpdate(request *v1pb.UpdateUserSettingRequest, generalSetting *storepb.GeneralUserSetting) (*v1pb.UserSetting, error) {
 	updatedGeneral := &v1pb.UserSetting_GeneralSetting{
 		MemoVisibility: generalSetting.GetMemoVisibility(),
 		Locale:         generalSetting.GetLocale(),
 		Theme:          generalSetting.GetTheme(),
+		Timezone:       generalSetting.GetTimezone(),
 	}
 
 	// Apply updates for fields specified in the update mask
@@ -431,36 +485,54 @@ func (s *APIV1Service) UpdateUserSetting(ctx context.Context, request *v1pb.Upda
 	for _, field := range request.UpdateMask.Paths {
 		switch field {
 		case "memo_visibility":
-			updatedGeneral.MemoVisibility = incomingGeneral.MemoVisibility
+			updatedGeneral.MemoVisibility = incomingGeneral.GetMemoVisibility()
 		case "theme":
-			updatedGeneral.Theme = incomingGeneral.Theme
+			updatedGeneral.Theme = incomingGeneral.GetTheme()
 		case "locale":
-			updatedGeneral.Locale = incomingGeneral.Locale
+			updatedGeneral.Locale = incomingGeneral.GetLocale()
+		case "timezone":
+			if _, err := time.LoadLocation(incomingGeneral.GetTimezone()); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid timezone %q", incomingGeneral.GetTimezone())
+			}
+			updatedGeneral.Timezone = incomingGeneral.GetTimezone()
 		default:
 			// Ignore unsupported fields
 		}
 	}
 
-	// Create the updated setting
-	updatedSetting := &v1pb.UserSetting{
+	return &v1pb.UserSetting{
 		Name: request.Setting.Name,
 		Value: &v1pb.UserSetting_GeneralSetting_{
 			GeneralSetting: updatedGeneral,
 		},
-	}
+	}, nil
+}
 
-	// Convert API setting to store setting
-	storeSetting, err := convertUserSettingToStore(updatedSetting, userID, storeKey)
-	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "failed to convert setting: %v", err)
+// applyUserNotificationSettingUpdate applies the fields of the update mask to the existing notification setting.
+func applyUserNotificationSettingUpdate(request *v1pb.UpdateUserSettingRequest, notificationSetting *storepb.NotificationUserSetting) *v1pb.UserSetting {
+	updatedNotification := convertUserNotificationSettingFromStore(notificationSetting)
+	incomingNotification := request.Setting.GetNotificationSetting()
+	for _, field := range request.UpdateMask.Paths {
+		switch field {
+		case "email_memo_comment":
+			updatedNotification.EmailMemoComment = incomingNotification.GetEmailMemoComment()
+		case "email_task_due":
+			updatedNotification.EmailTaskDue = incomingNotification.GetEmailTaskDue()
+		case "email_memo_mention":
+			updatedNotification.EmailMemoMention = incomingNotification.GetEmailMemoMention()
+		case "email_digest":
+			updatedNotification.EmailDigest = incomingNotification.GetEmailDigest()
+		default:
+			// Ignore unsupported fields
+		}
 	}
 
-	// Upsert the setting
-	if _, err := s.Store.UpsertUserSetting(ctx, storeSetting); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
+	return &v1pb.UserSetting{
+		Name: request.Setting.Name,
+		Value: &v1pb.UserSetting_NotificationSetting_{
+			NotificationSetting: updatedNotification,
+		},
 	}
-
-	return s.GetUserSetting(ctx, &v1pb.GetUserSettingRequest{Name: request.Setting.Name})
 }
 
 func (s *APIV1Service) ListUserSettings(ctx context.Context, request *v1pb.ListUserSettingsRequest) (*v1pb.ListUserSettingsResponse, error) {
@@ -628,10 +700,15 @@ func (s *APIV1Service) CreatePersonalAccessToken(ctx context.Context, request *v
 	if err := s.Store.AddUserPersonalAccessToken(ctx, userID, patRecord); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create access token: %v", err)
 	}
+	name := fmt.Sprintf("%s/personalAccessTokens/%s", request.Parent, tokenID)
+	s.recordAuditEvent(ctx, userID, store.ActivityTypePersonalAccessTokenCreated, &storepb.ActivityAuditPayload{
+		Resource: name,
+		NewValue: request.Description,
+	})
 
 	return &v1pb.CreatePersonalAccessTokenResponse{
 		PersonalAccessToken: &v1pb.PersonalAccessToken{
-			Name:        fmt.Sprintf("%s/personalAccessTokens/%s", request.Parent, tokenID),
+			Name:        name,
 			Description: request.Description,
 			ExpiresAt:   expiresAt,
 			CreatedAt:   patRecord.CreatedAt,
@@ -678,6 +755,9 @@ func (s *APIV1Service) DeletePersonalAccessToken(ctx context.Context, request *v
 	if err := s.Store.RemoveUserPersonalAccessToken(ctx, userID, tokenID); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete access token: %v", err)
 	}
+	s.recordAuditEvent(ctx, userID, store.ActivityTypePersonalAccessTokenDeleted, &storepb.ActivityAuditPayload{
+		Resource: request.Name,
+	})
 
 	return &emptypb.Empty{}, nil
 }
@@ -734,12 +814,23 @@ func (s *APIV1Service) CreateUserWebhook(ctx context.Context, request *v1pb.Crea
 	if request.Webhook.Url == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "webhook URL is required")
 	}
+	if err := s.validateUserWebhookSubscription(ctx, request.Webhook.EventTypes, request.Webhook.Filter); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := validateUserWebhookFormat(request.Webhook.Format, request.Webhook.Template); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
 
 	webhookID := generateUserWebhookID()
 	webhook := &storepb.WebhooksUserSetting_Webhook{
-		Id:    webhookID,
-		Title: request.Webhook.DisplayName,
-		Url:   strings.TrimSpace(request.Webhook.Url),
+		Id:         webhookID,
+		Title:      request.Webhook.DisplayName,
+		Url:        strings.TrimSpace(request.Webhook.Url),
+		Secret:     generateUserWebhookSecret(),
+		EventTypes: request.Webhook.EventTypes,
+		Filter:     strings.TrimSpace(request.Webhook.Filter),
+		Format:     storepb.WebhooksUserSetting_Webhook_Format(request.Webhook.Format),
+		Template:   request.Webhook.Template,
 	}
 
 	err = s.Store.AddUserWebhook(ctx, userID, webhook)
@@ -747,7 +838,10 @@ func (s *APIV1Service) CreateUserWebhook(ctx context.Context, request *v1pb.Crea
 		return nil, status.Errorf(codes.Internal, "failed to create webhook: %v", err)
 	}
 
-	return convertUserWebhookFromUserSetting(webhook, userID), nil
+	// The secret is only shown once, on creation.
+	userWebhook := convertUserWebhookFromUserSetting(webhook, userID)
+	userWebhook.Secret = webhook.Secret
+	return userWebhook, nil
 }
 
 func (s *APIV1Service) UpdateUserWebhook(ctx context.Context, request *v1pb.UpdateUserWebhookRequest) (*v1pb.UserWebhook, error) {
@@ -792,11 +886,17 @@ func (s *APIV1Service) UpdateUserWebhook(ctx context.Context, request *v1pb.Upda
 
 	// Update the webhook
 	updatedWebhook := &storepb.WebhooksUserSetting_Webhook{
-		Id:    webhookID,
-		Title: targetWebhook.Title,
-		Url:   targetWebhook.Url,
+		Id:         webhookID,
+		Title:      targetWebhook.Title,
+		Url:        targetWebhook.Url,
+		Secret:     targetWebhook.Secret,
+		EventTypes: targetWebhook.EventTypes,
+		Filter:     targetWebhook.Filter,
+		Format:     targetWebhook.Format,
+		Template:   targetWebhook.Template,
 	}
 
+	rotateSecret := false
 	if request.UpdateMask != nil {
 		for _, path := range request.UpdateMask.Paths {
 			switch path {
@@ -806,6 +906,18 @@ func (s *APIV1Service) UpdateUserWebhook(ctx context.Context, request *v1pb.Upda
 				}
 			case "display_name":
 				updatedWebhook.Title = request.Webhook.DisplayName
+			case "event_types":
+				updatedWebhook.EventTypes = request.Webhook.EventTypes
+			case "filter":
+				updatedWebhook.Filter = strings.TrimSpace(request.Webhook.Filter)
+			case "format":
+				updatedWebhook.Format = storepb.WebhooksUserSetting_Webhook_Format(request.Webhook.Format)
+			case "template":
+				updatedWebhook.Template = request.Webhook.Template
+			case "secret":
+				// The secret can't be set by clients, updating it generates a new one.
+				updatedWebhook.Secret = generateUserWebhookSecret()
+				rotateSecret = true
 			default:
 				// Ignore unsupported fields
 			}
@@ -816,6 +928,17 @@ func (s *APIV1Service) UpdateUserWebhook(ctx context.Context, request *v1pb.Upda
 			updatedWebhook.Url = strings.TrimSpace(request.Webhook.Url)
 		}
 		updatedWebhook.Title = request.Webhook.DisplayName
+		updatedWebhook.EventTypes = request.Webhook.EventTypes
+		updatedWebhook.Filter = strings.TrimSpace(request.Webhook.Filter)
+		updatedWebhook.Format = storepb.WebhooksUserSetting_Webhook_Format(request.Webhook.Format)
+		updatedWebhook.Template = request.Webhook.Template
+	}
+
+	if err := s.validateUserWebhookSubscription(ctx, updatedWebhook.EventTypes, updatedWebhook.Filter); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := validateUserWebhookFormat(v1pb.UserWebhook_Format(updatedWebhook.Format), updatedWebhook.Template); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
 
 	err = s.Store.UpdateUserWebhook(ctx, userID, updatedWebhook)
@@ -823,7 +946,11 @@ func (s *APIV1Service) UpdateUserWebhook(ctx context.Context, request *v1pb.Upda
 		return nil, status.Errorf(codes.Internal, "failed to update webhook: %v", err)
 	}
 
-	return convertUserWebhookFromUserSetting(updatedWebhook, userID), nil
+	userWebhook := convertUserWebhookFromUserSetting(updatedWebhook, userID)
+	if rotateSecret {
+		userWebhook.Secret = updatedWebhook.Secret
+	}
+	return userWebhook, nil
 }
 
 func (s *APIV1Service) DeleteUserWebhook(ctx context.Context, request *v1pb.DeleteUserWebhookRequest) (*emptypb.Empty, error) {
@@ -866,10 +993,85 @@ func (s *APIV1Service) DeleteUserWebhook(ctx context.Context, request *v1pb.Dele
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete webhook: %v", err)
 	}
+	if err := s.Store.DeleteWebhookDeliveries(ctx, &store.DeleteWebhookDelivery{
+		CreatorID: &userID,
+		WebhookID: &webhookID,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete webhook deliveries: %v", err)
+	}
 
 	return &emptypb.Empty{}, nil
 }
 
+func (s *APIV1Service) ListWebhookDeliveries(ctx context.Context, request *v1pb.ListWebhookDeliveriesRequest) (*v1pb.ListWebhookDeliveriesResponse, error) {
+	webhookID, userID, err := parseUserWebhookName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", err)
+	}
+
+	currentUser, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if currentUser.ID != userID && currentUser.Role != store.RoleAdmin {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	deliveryFind := &store.FindWebhookDelivery{
+		CreatorID: &userID,
+	}
+	// The "-" wildcard lists deliveries of all webhooks of the user.
+	if webhookID != "-" {
+		deliveryFind.WebhookID = &webhookID
+	}
+
+	var limit, offset int
+	if request.PageToken != "" {
+		var pageToken v1pb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		limit = int(pageToken.Limit)
+		offset = int(pageToken.Offset)
+	} else {
+		limit = int(request.PageSize)
+	}
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	if limit > MaxPageSize {
+		limit = MaxPageSize
+	}
+	limitPlusOne := limit + 1
+	deliveryFind.Limit = &limitPlusOne
+	deliveryFind.Offset = &offset
+	deliveries, err := s.Store.ListWebhookDeliveries(ctx, deliveryFind)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list webhook deliveries: %v", err)
+	}
+
+	nextPageToken := ""
+	if len(deliveries) == limitPlusOne {
+		deliveries = deliveries[:limit]
+		nextPageToken, err = getPageToken(limit, offset+limit)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
+		}
+	}
+
+	response := &v1pb.ListWebhookDeliveriesResponse{
+		Deliveries:    make([]*v1pb.WebhookDelivery, 0, len(deliveries)),
+		NextPageToken: nextPageToken,
+	}
+	for _, delivery := range deliveries {
+		response.Deliveries = append(response.Deliveries, convertWebhookDeliveryFromStore(delivery))
+	}
+	return response, nil
+}
+
 // Helper functions for webhook operations
 
 // generateUserWebhookID generates a unique ID for user webhooks.
@@ -879,6 +1081,41 @@ func generateUserWebhookID() string {
 	return hex.EncodeToString(b)
 }
 
+// validateUserWebhookSubscription validates the event types and the memo filter of a webhook.
+func (s *APIV1Service) validateUserWebhookSubscription(ctx context.Context, eventTypes []string, filter string) error {
+	for _, eventType := range eventTypes {
+		if !slices.Contains(webhookActivityTypes, eventType) {
+			return errors.Errorf("unsupported event type: %s", eventType)
+		}
+	}
+	if filter = strings.TrimSpace(filter); filter != "" {
+		if err := s.validateFilter(ctx, filter); err != nil {
+			return errors.Wrap(err, "invalid filter")
+		}
+	}
+	return nil
+}
+
+// validateUserWebhookFormat validates the payload format of a webhook and its template.
+func validateUserWebhookFormat(format v1pb.UserWebhook_Format, template string) error {
+	if _, ok := v1pb.UserWebhook_Format_name[int32(format)]; !ok {
+		return errors.Errorf("unsupported format: %d", format)
+	}
+	if format == v1pb.UserWebhook_TEMPLATE {
+		if _, err := webhook.ParseTemplate(template); err != nil {
+			return errors.Wrap(err, "invalid template")
+		}
+	}
+	return nil
+}
+
+// generateUserWebhookSecret generates a random secret used to sign webhook payloads.
+func generateUserWebhookSecret() string {
+	b := make([]byte, 32)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
 // parseUserWebhookName parses a webhook name and returns the webhook ID and user ID.
 // Format: users/{user}/webhooks/{webhook}.
 func parseUserWebhookName(name string) (string, int32, error) {
@@ -901,11 +1138,41 @@ func convertUserWebhookFromUserSetting(webhook *storepb.WebhooksUserSetting_Webh
 		Name:        fmt.Sprintf("users/%d/webhooks/%s", userID, webhook.Id),
 		Url:         webhook.Url,
 		DisplayName: webhook.Title,
+		EventTypes:  webhook.EventTypes,
+		Filter:      webhook.Filter,
+		Format:      v1pb.UserWebhook_Format(webhook.Format),
+		Template:    webhook.Template,
 		// Note: create_time and update_time are not available in the user setting webhook structure
 		// This is a limitation of storing webhooks in user settings vs the dedicated webhook table
 	}
 }
 
+// convertWebhookDeliveryFromStore converts a store webhook delivery to a v1pb WebhookDelivery.
+func convertWebhookDeliveryFromStore(delivery *store.WebhookDelivery) *v1pb.WebhookDelivery {
+	deliverypb := &v1pb.WebhookDelivery{
+		Name:               fmt.Sprintf("users/%d/webhooks/%s/deliveries/%d", delivery.CreatorID, delivery.WebhookID, delivery.ID),
+		ActivityType:       delivery.ActivityType,
+		Url:                delivery.URL,
+		AttemptCount:       delivery.Attempts,
+		ResponseStatusCode: delivery.ResponseCode,
+		LastError:          delivery.LastError,
+		CreateTime:         timestamppb.New(time.Unix(delivery.CreatedTs, 0)),
+		UpdateTime:         timestamppb.New(time.Unix(delivery.UpdatedTs, 0)),
+	}
+	switch delivery.Status {
+	case store.WebhookDeliveryPending:
+		deliverypb.State = v1pb.WebhookDelivery_PENDING
+		deliverypb.NextAttemptTime = timestamppb.New(time.Unix(delivery.NextAttemptTs, 0))
+	case store.WebhookDeliverySucceeded:
+		deliverypb.State = v1pb.WebhookDelivery_SUCCEEDED
+	case store.WebhookDeliveryFailed:
+		deliverypb.State = v1pb.WebhookDelivery_FAILED
+	default:
+		deliverypb.State = v1pb.WebhookDelivery_STATE_UNSPECIFIED
+	}
+	return deliverypb
+}
+
 func convertUserFromStore(user *store.User) *v1pb.User {
 	userpb := &v1pb.User{
 		Name:        fmt.Sprintf("%s%d", UserNamePrefix, user.ID),
@@ -992,6 +1259,8 @@ func convertSettingKeyToStore(key string) (storepb.UserSetting_Key, error) {
 		return storepb.UserSetting_GENERAL, nil
 	case v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_WEBHOOKS)]:
 		return storepb.UserSetting_WEBHOOKS, nil
+	case v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_NOTIFICATION)]:
+		return storepb.UserSetting_NOTIFICATION, nil
 	default:
 		return storepb.UserSetting_KEY_UNSPECIFIED, errors.Errorf("unknown setting key: %s", key)
 	}
@@ -1006,6 +1275,8 @@ func convertSettingKeyFromStore(key storepb.UserSetting_Key) string {
 		return "SHORTCUTS" // Not defined in API proto
 	case storepb.UserSetting_WEBHOOKS:
 		return v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_WEBHOOKS)]
+	case storepb.UserSetting_NOTIFICATION:
+		return v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_NOTIFICATION)]
 	default:
 		return "unknown"
 	}
@@ -1027,6 +1298,10 @@ func convertUserSettingFromStore(storeSetting *storepb.UserSetting, userID int32
 					Webhooks: []*v1pb.UserWebhook{},
 				},
 			}
+		case storepb.UserSetting_NOTIFICATION:
+			setting.Value = &v1pb.UserSetting_NotificationSetting_{
+				NotificationSetting: &v1pb.UserSetting_NotificationSetting{},
+			}
 		default:
 			// Default to general setting
 			setting.Value = &v1pb.UserSetting_GeneralSetting_{
@@ -1049,6 +1324,7 @@ func convertUserSettingFromStore(storeSetting *storepb.UserSetting, userID int32
 					Locale:         general.Locale,
 					MemoVisibility: general.MemoVisibility,
 					Theme:          general.Theme,
+					Timezone:       general.Timezone,
 				},
 			}
 		} else {
@@ -1060,18 +1336,17 @@ func convertUserSettingFromStore(storeSetting *storepb.UserSetting, userID int32
 		webhooks := storeSetting.GetWebhooks()
 		apiWebhooks := make([]*v1pb.UserWebhook, 0, len(webhooks.Webhooks))
 		for _, webhook := range webhooks.Webhooks {
-			apiWebhook := &v1pb.UserWebhook{
-				Name:        fmt.Sprintf("users/%d/webhooks/%s", userID, webhook.Id),
-				Url:         webhook.Url,
-				DisplayName: webhook.Title,
-			}
-			apiWebhooks = append(apiWebhooks, apiWebhook)
+			apiWebhooks = append(apiWebhooks, convertUserWebhookFromUserSetting(webhook, userID))
 		}
 		setting.Value = &v1pb.UserSetting_WebhooksSetting_{
 			WebhooksSetting: &v1pb.UserSetting_WebhooksSetting{
 				Webhooks: apiWebhooks,
 			},
 		}
+	case storepb.UserSetting_NOTIFICATION:
+		setting.Value = &v1pb.UserSetting_NotificationSetting_{
+			NotificationSetting: convertUserNotificationSettingFromStore(storeSetting.GetNotification()),
+		}
 	default:
 		// Default to general setting if unknown key
 		setting.Value = &v1pb.UserSetting_GeneralSetting_{
@@ -1097,6 +1372,7 @@ func convertUserSettingToStore(apiSetting *v1pb.UserSetting, userID int32, key s
 					Locale:         general.Locale,
 					MemoVisibility: general.MemoVisibility,
 					Theme:          general.Theme,
+					Timezone:       general.Timezone,
 				},
 			}
 		} else {
@@ -1121,6 +1397,19 @@ func convertUserSettingToStore(apiSetting *v1pb.UserSetting, userID int32, key s
 		} else {
 			return nil, errors.Errorf("webhooks setting is required")
 		}
+	case storepb.UserSetting_NOTIFICATION:
+		if notification := apiSetting.GetNotificationSetting(); notification != nil {
+			storeSetting.Value = &storepb.UserSetting_Notification{
+				Notification: &storepb.NotificationUserSetting{
+					EmailMemoComment: notification.EmailMemoComment,
+					EmailTaskDue:     notification.EmailTaskDue,
+					EmailDigest:      notification.EmailDigest,
+					EmailMemoMention: notification.EmailMemoMention,
+				},
+			}
+		} else {
+			return nil, errors.Errorf("notification setting is required")
+		}
 	default:
 		return nil, errors.Errorf("unsupported setting key: %v", key)
 	}
@@ -1128,6 +1417,16 @@ func convertUserSettingToStore(apiSetting *v1pb.UserSetting, userID int32, key s
 	return storeSetting, nil
 }
 
+// convertUserNotificationSettingFromStore converts store NotificationUserSetting to API NotificationSetting.
+func convertUserNotificationSettingFromStore(setting *storepb.NotificationUserSetting) *v1pb.UserSetting_NotificationSetting {
+	return &v1pb.UserSetting_NotificationSetting{
+		EmailMemoComment: setting.GetEmailMemoComment(),
+		EmailTaskDue:     setting.GetEmailTaskDue(),
+		EmailDigest:      setting.GetEmailDigest(),
+		EmailMemoMention: setting.GetEmailMemoMention(),
+	}
+}
+
 // extractWebhookIDFromName extracts webhook ID from resource name.
 // e.g., "users/123/webhooks/webhook-id" -> "webhook-id".
 func extractWebhookIDFromName(name string) string {
@@ -1253,11 +1552,14 @@ func (s *APIV1Service) ListUserNotifications(ctx context.Context, request *v1pb.
 	}
 
 	// Fetch inbox items from storage
-	// Filter at database level to only include MEMO_COMMENT notifications (ignore legacy VERSION_UPDATE entries)
-	memoCommentType := storepb.InboxMessage_MEMO_COMMENT
+	// Filter at database level to only include the known notification types (ignore legacy VERSION_UPDATE entries)
 	inboxes, err := s.Store.ListInboxes(ctx, &store.FindInbox{
-		ReceiverID:  &userID,
-		MessageType: &memoCommentType,
+		ReceiverID: &userID,
+		MessageTypeList: []storepb.InboxMessage_Type{
+			storepb.InboxMessage_MEMO_COMMENT,
+			storepb.InboxMessage_TASK_DUE,
+			storepb.InboxMessage_MEMO_MENTION,
+		},
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list inboxes: %v", err)
@@ -1414,6 +1716,10 @@ func (*APIV1Service) convertInboxToUserNotification(_ context.Context, inbox *st
 		switch inbox.Message.Type {
 		case storepb.InboxMessage_MEMO_COMMENT:
 			notification.Type = v1pb.UserNotification_MEMO_COMMENT
+		case storepb.InboxMessage_TASK_DUE:
+			notification.Type = v1pb.UserNotification_TASK_DUE
+		case storepb.InboxMessage_MEMO_MENTION:
+			notification.Type = v1pb.UserNotification_MEMO_MENTION
 		default:
 			notification.Type = v1pb.UserNotification_TYPE_UNSPECIFIED
 		}
@@ -3,6 +3,7 @@ package v1
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/pkg/errors"
@@ -89,7 +90,7 @@ func (s *APIV1Service) ListAllUserStats(ctx context.Context, _ *v1pb.ListAllUser
 
 			// Count tags and other properties
 			if memo.Payload != nil {
-				for _, tag := range memo.Payload.Tags {
+				for _, tag := range rollUpTags(memo.Payload.Tags) {
 					stats.TagCount[tag]++
 				}
 				if memo.Payload.Property != nil {
@@ -192,7 +193,7 @@ func (s *APIV1Service) GetUserStats(ctx context.Context, request *v1pb.GetUserSt
 			displayTimestamps = append(displayTimestamps, timestamppb.New(time.Unix(displayTs, 0)))
 			// Count different memo types based on content.
 			if memo.Payload != nil {
-				for _, tag := range memo.Payload.Tags {
+				for _, tag := range rollUpTags(memo.Payload.Tags) {
 					tagCount[tag]++
 				}
 				if memo.Payload.Property != nil {
@@ -234,3 +235,20 @@ func (s *APIV1Service) GetUserStats(ctx context.Context, request *v1pb.GetUserSt
 
 	return userStats, nil
 }
+
+// rollUpTags returns the distinct tags with the parents of nested tags, e.g. "work" and "work/project" for "work/project".
+// A memo is counted once for a parent tag, however many of its nested tags it uses.
+func rollUpTags(tags []string) []string {
+	rolledUp := []string{}
+	for _, tag := range tags {
+		for i := range tag {
+			if tag[i] == '/' && i > 0 && !slices.Contains(rolledUp, tag[:i]) {
+				rolledUp = append(rolledUp, tag[:i])
+			}
+		}
+		if !slices.Contains(rolledUp, tag) {
+			rolledUp = append(rolledUp, tag)
+		}
+	}
+	return rolledUp
+}
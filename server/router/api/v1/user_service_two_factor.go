@@ -0,0 +1,343 @@
+package v1
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/util"
+	"github.com/usememos/memos/plugin/totp"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/auth"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// recoveryCodeCount is the number of recovery codes generated at once.
+	recoveryCodeCount = 10
+	// recoveryCodeLength is the number of characters of a recovery code, without the separator.
+	recoveryCodeLength = 10
+	// recoveryCodeAlphabet leaves out characters easily mistaken for each other.
+	recoveryCodeAlphabet = "abcdefghjkmnpqrstuvwxyz23456789"
+	// maxTwoFactorChallengeAttempts is the number of codes which can be tried with a sign-in challenge.
+	maxTwoFactorChallengeAttempts = 5
+	// defaultTwoFactorIssuer is the issuer shown by authenticator apps without a custom instance title.
+	defaultTwoFactorIssuer = "Memos"
+)
+
+func (s *APIV1Service) GetTwoFactorStatus(ctx context.Context, request *v1pb.GetTwoFactorStatusRequest) (*v1pb.TwoFactorStatus, error) {
+	currentUser, userID, err := s.getTwoFactorUser(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if currentUser.ID != userID && !isSuperUser(currentUser) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	setting, err := s.Store.GetUserTwoFactorSetting(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get two-factor setting: %v", err)
+	}
+	return &v1pb.TwoFactorStatus{
+		Enabled:           setting.Enabled,
+		RecoveryCodeCount: int32(len(setting.RecoveryCodeHashes)),
+	}, nil
+}
+
+func (s *APIV1Service) SetupTwoFactor(ctx context.Context, request *v1pb.SetupTwoFactorRequest) (*v1pb.SetupTwoFactorResponse, error) {
+	currentUser, userID, err := s.getTwoFactorUser(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if currentUser.ID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	s.twoFactorMutex.Lock()
+	defer s.twoFactorMutex.Unlock()
+	setting, err := s.Store.GetUserTwoFactorSetting(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get two-factor setting: %v", err)
+	}
+	if setting.Enabled {
+		return nil, status.Errorf(codes.FailedPrecondition, "two-factor authentication is already enabled")
+	}
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate secret: %v", err)
+	}
+	// Setting up again replaces an enrollment which was never verified.
+	if err := s.Store.UpdateUserTwoFactorSetting(ctx, userID, &storepb.TwoFactorUserSetting{
+		TotpSecret: secret,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update two-factor setting: %v", err)
+	}
+
+	instanceGeneralSetting, err := s.Store.GetInstanceGeneralSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance general setting: %v", err)
+	}
+	issuer := instanceGeneralSetting.GetCustomProfile().GetTitle()
+	if issuer == "" {
+		issuer = defaultTwoFactorIssuer
+	}
+	return &v1pb.SetupTwoFactorResponse{
+		Secret: secret,
+		Uri:    totp.URI(issuer, currentUser.Username, secret),
+	}, nil
+}
+
+func (s *APIV1Service) EnableTwoFactor(ctx context.Context, request *v1pb.EnableTwoFactorRequest) (*v1pb.EnableTwoFactorResponse, error) {
+	currentUser, userID, err := s.getTwoFactorUser(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if currentUser.ID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	s.twoFactorMutex.Lock()
+	defer s.twoFactorMutex.Unlock()
+	setting, err := s.Store.GetUserTwoFactorSetting(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get two-factor setting: %v", err)
+	}
+	if setting.Enabled {
+		return nil, status.Errorf(codes.FailedPrecondition, "two-factor authentication is already enabled")
+	}
+	if setting.TotpSecret == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "two-factor authentication is not set up")
+	}
+	step, ok := totp.Validate(setting.TotpSecret, request.Code, time.Now())
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid code")
+	}
+
+	recoveryCodes, recoveryCodeHashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate recovery codes: %v", err)
+	}
+	setting.Enabled = true
+	setting.LastUsedStep = step
+	setting.RecoveryCodeHashes = recoveryCodeHashes
+	if err := s.Store.UpdateUserTwoFactorSetting(ctx, userID, setting); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update two-factor setting: %v", err)
+	}
+	return &v1pb.EnableTwoFactorResponse{RecoveryCodes: recoveryCodes}, nil
+}
+
+func (s *APIV1Service) DisableTwoFactor(ctx context.Context, request *v1pb.DisableTwoFactorRequest) (*emptypb.Empty, error) {
+	currentUser, userID, err := s.getTwoFactorUser(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	// Admins can disable two-factor authentication of users who lost their authenticator and recovery codes.
+	if currentUser.ID != userID && !isSuperUser(currentUser) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	s.twoFactorMutex.Lock()
+	defer s.twoFactorMutex.Unlock()
+	setting, err := s.Store.GetUserTwoFactorSetting(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get two-factor setting: %v", err)
+	}
+	if currentUser.ID == userID && setting.Enabled {
+		if isSuperUser(currentUser) {
+			instanceGeneralSetting, err := s.Store.GetInstanceGeneralSetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get instance general setting: %v", err)
+			}
+			if instanceGeneralSetting.RequireAdminTwoFactor {
+				return nil, status.Errorf(codes.FailedPrecondition, "two-factor authentication is required for admins")
+			}
+		}
+		if !consumeTwoFactorCode(setting, request.Code, time.Now()) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid code")
+		}
+	}
+
+	if err := s.Store.UpdateUserTwoFactorSetting(ctx, userID, &storepb.TwoFactorUserSetting{}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update two-factor setting: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) RegenerateRecoveryCodes(ctx context.Context, request *v1pb.RegenerateRecoveryCodesRequest) (*v1pb.RegenerateRecoveryCodesResponse, error) {
+	currentUser, userID, err := s.getTwoFactorUser(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if currentUser.ID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	s.twoFactorMutex.Lock()
+	defer s.twoFactorMutex.Unlock()
+	setting, err := s.Store.GetUserTwoFactorSetting(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get two-factor setting: %v", err)
+	}
+	if !setting.Enabled {
+		return nil, status.Errorf(codes.FailedPrecondition, "two-factor authentication is not enabled")
+	}
+	if !consumeTwoFactorCode(setting, request.Code, time.Now()) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid code")
+	}
+
+	recoveryCodes, recoveryCodeHashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate recovery codes: %v", err)
+	}
+	setting.RecoveryCodeHashes = recoveryCodeHashes
+	if err := s.Store.UpdateUserTwoFactorSetting(ctx, userID, setting); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update two-factor setting: %v", err)
+	}
+	return &v1pb.RegenerateRecoveryCodesResponse{RecoveryCodes: recoveryCodes}, nil
+}
+
+// getTwoFactorUser returns the current user and the ID of the user named by the request.
+func (s *APIV1Service) getTwoFactorUser(ctx context.Context, name string) (*store.User, int32, error) {
+	userID, err := ExtractUserIDFromName(name)
+	if err != nil {
+		return nil, 0, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	currentUser, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, 0, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, 0, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	return currentUser, userID, nil
+}
+
+// createTwoFactorChallenge returns the response of a password sign-in which requires a second factor.
+func (s *APIV1Service) createTwoFactorChallenge(user *store.User) (*v1pb.SignInResponse, error) {
+	challenge, expiresAt, err := auth.GenerateTwoFactorChallenge(user.ID, util.GenUUID(), []byte(s.Secret))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate two-factor challenge: %v", err)
+	}
+	return &v1pb.SignInResponse{
+		TwoFactorChallenge:          challenge,
+		TwoFactorChallengeExpiresAt: timestamppb.New(expiresAt),
+	}, nil
+}
+
+// verifyTwoFactorChallenge checks the code of the second sign-in step and returns the signing in user.
+// A challenge is invalidated once used, or after too many invalid codes.
+func (s *APIV1Service) verifyTwoFactorChallenge(ctx context.Context, credentials *v1pb.SignInRequest_TwoFactorCredentials) (*store.User, error) {
+	claims, err := auth.ParseTwoFactorChallenge(credentials.Challenge, []byte(s.Secret))
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid two-factor challenge")
+	}
+	s.twoFactorMutex.Lock()
+	defer s.twoFactorMutex.Unlock()
+	attemptsKey := "two-factor-challenge:" + claims.ID
+	attempts := 0
+	if value, ok := s.twoFactorChallengeCache.Get(ctx, attemptsKey); ok {
+		attempts = value.(int)
+	}
+	if attempts >= maxTwoFactorChallengeAttempts {
+		return nil, status.Errorf(codes.Unauthenticated, "two-factor challenge is no longer valid")
+	}
+
+	userID, err := util.ConvertStringToInt32(claims.Subject)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid two-factor challenge")
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user, error: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid two-factor challenge")
+	}
+	setting, err := s.Store.GetUserTwoFactorSetting(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get two-factor setting: %v", err)
+	}
+	if !setting.Enabled {
+		return nil, status.Errorf(codes.FailedPrecondition, "two-factor authentication is not enabled")
+	}
+	// New challenges are issued for each correct password, so failed codes also count against the username.
+	if err := s.checkSignInLimit(ctx, user.Username); err != nil {
+		return nil, err
+	}
+	if !consumeTwoFactorCode(setting, credentials.Code, time.Now()) {
+		s.twoFactorChallengeCache.SetWithTTL(ctx, attemptsKey, attempts+1, auth.TwoFactorChallengeDuration)
+		s.recordSignInFailure(ctx, user.ID, user.Username, "invalid two-factor code")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid two-factor code")
+	}
+	if err := s.Store.UpdateUserTwoFactorSetting(ctx, user.ID, setting); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update two-factor setting: %v", err)
+	}
+	s.twoFactorChallengeCache.SetWithTTL(ctx, attemptsKey, maxTwoFactorChallengeAttempts, auth.TwoFactorChallengeDuration)
+	return user, nil
+}
+
+// consumeTwoFactorCode checks the code against the TOTP secret and the recovery codes of the setting.
+// The setting is changed so that the code cannot be used again, and must be saved by the caller on success.
+func consumeTwoFactorCode(setting *storepb.TwoFactorUserSetting, code string, now time.Time) bool {
+	if step, ok := totp.Validate(setting.TotpSecret, code, now); ok {
+		if step <= setting.LastUsedStep {
+			return false
+		}
+		setting.LastUsedStep = step
+		return true
+	}
+	hash := hashRecoveryCode(code)
+	index := slices.Index(setting.RecoveryCodeHashes, hash)
+	if index < 0 {
+		return false
+	}
+	setting.RecoveryCodeHashes = slices.Delete(setting.RecoveryCodeHashes, index, index+1)
+	return true
+}
+
+// generateRecoveryCodes returns new recovery codes formatted as "xxxxx-xxxxx", and their hashes.
+func generateRecoveryCodes() ([]string, []string, error) {
+	recoveryCodes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+	for range recoveryCodeCount {
+		var builder strings.Builder
+		for i := range recoveryCodeLength {
+			if i == recoveryCodeLength/2 {
+				builder.WriteByte('-')
+			}
+			n, err := rand.Int(rand.Reader, big.NewInt(int64(len(recoveryCodeAlphabet))))
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "failed to generate random number")
+			}
+			builder.WriteByte(recoveryCodeAlphabet[n.Int64()])
+		}
+		code := builder.String()
+		recoveryCodes = append(recoveryCodes, code)
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+	return recoveryCodes, hashes, nil
+}
+
+// hashRecoveryCode returns the SHA-256 hash of the recovery code, ignoring case, spaces and separators.
+func hashRecoveryCode(code string) string {
+	normalized := strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, strings.ToLower(strings.TrimSpace(code)))
+	hash := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(hash[:])
+}
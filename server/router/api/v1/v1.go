@@ -3,6 +3,8 @@ package v1
 import (
 	"context"
 	"net/http"
+	"sync"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
@@ -11,10 +13,13 @@ import (
 	"golang.org/x/sync/semaphore"
 
 	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/plugin/email"
 	"github.com/usememos/memos/plugin/markdown"
+	"github.com/usememos/memos/plugin/scheduler"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	"github.com/usememos/memos/server/auth"
 	"github.com/usememos/memos/store"
+	"github.com/usememos/memos/store/cache"
 )
 
 type APIV1Service struct {
@@ -24,6 +29,7 @@ type APIV1Service struct {
 	v1pb.UnimplementedMemoServiceServer
 	v1pb.UnimplementedAttachmentServiceServer
 	v1pb.UnimplementedShortcutServiceServer
+	v1pb.UnimplementedTagServiceServer
 	v1pb.UnimplementedActivityServiceServer
 	v1pb.UnimplementedIdentityProviderServiceServer
 
@@ -31,6 +37,21 @@ type APIV1Service struct {
 	Profile         *profile.Profile
 	Store           *store.Store
 	MarkdownService markdown.Service
+	// SignInLimiter throttles failed sign-in attempts per username and per client IP.
+	SignInLimiter auth.AttemptLimiter
+	// Scheduler runs the background jobs, it is nil until the server starts them.
+	Scheduler *scheduler.Scheduler
+	// EmailSender sends the notification emails with the SMTP setting of the instance.
+	EmailSender func(config *email.Config, message *email.Message) error
+
+	// embeddingCache caches query embeddings of semantic search by content hash.
+	embeddingCache *cache.Cache
+
+	// twoFactorChallengeCache counts the codes tried with each two-factor sign-in challenge.
+	twoFactorChallengeCache *cache.Cache
+	// twoFactorMutex serializes the read-modify-write of the two-factor settings and challenge attempts,
+	// so that the attempts are capped and each code is used once even by concurrent requests.
+	twoFactorMutex sync.Mutex
 
 	// thumbnailSemaphore limits concurrent thumbnail generation to prevent memory exhaustion
 	thumbnailSemaphore *semaphore.Weighted
@@ -39,12 +60,30 @@ type APIV1Service struct {
 func NewAPIV1Service(secret string, profile *profile.Profile, store *store.Store) *APIV1Service {
 	markdownService := markdown.NewService(
 		markdown.WithTagExtension(),
+		markdown.WithDueExtension(),
+		markdown.WithMentionExtension(),
 	)
 	return &APIV1Service{
-		Secret:             secret,
-		Profile:            profile,
-		Store:              store,
-		MarkdownService:    markdownService,
+		Secret:          secret,
+		Profile:         profile,
+		Store:           store,
+		MarkdownService: markdownService,
+		EmailSender:     email.Send,
+		SignInLimiter: auth.NewMemoryAttemptLimiter(cache.New(cache.Config{
+			DefaultTTL:      time.Hour,
+			CleanupInterval: 5 * time.Minute,
+			MaxItems:        10000,
+		})),
+		embeddingCache: cache.New(cache.Config{
+			DefaultTTL:      24 * time.Hour,
+			CleanupInterval: time.Hour,
+			MaxItems:        1000,
+		}),
+		twoFactorChallengeCache: cache.New(cache.Config{
+			DefaultTTL:      auth.TwoFactorChallengeDuration,
+			CleanupInterval: time.Minute,
+			MaxItems:        10000,
+		}),
 		thumbnailSemaphore: semaphore.NewWeighted(3), // Limit to 3 concurrent thumbnail generations
 	}
 }
@@ -112,6 +151,9 @@ func (s *APIV1Service) RegisterGateway(ctx context.Context, echoServer *echo.Ech
 	if err := v1pb.RegisterShortcutServiceHandlerServer(ctx, gwMux, s); err != nil {
 		return err
 	}
+	if err := v1pb.RegisterTagServiceHandlerServer(ctx, gwMux, s); err != nil {
+		return err
+	}
 	if err := v1pb.RegisterActivityServiceHandlerServer(ctx, gwMux, s); err != nil {
 		return err
 	}
@@ -1,94 +1,77 @@
 package embedding
 
 import (
-	"bytes"
-	"encoding/json"
-	"io"
+	"log/slog"
 	"net/http"
-	"os"
 	"time"
 
 	"github.com/labstack/echo/v4"
-)
 
-const (
-	huggingFaceAPIURL = "https://router.huggingface.co/hf-inference/models/sentence-transformers/all-MiniLM-L6-v2/pipeline/feature-extraction"
+	"github.com/usememos/memos/plugin/embedding"
+	"github.com/usememos/memos/server/auth"
+	"github.com/usememos/memos/store"
+	"github.com/usememos/memos/store/cache"
 )
 
-func getHuggingFaceToken() string {
-	return os.Getenv("HF_TOKEN")
-}
+type EmbeddingService struct {
+	Store *store.Store
 
-type EmbeddingService struct{}
+	authenticator *auth.Authenticator
+	// cache caches embeddings by content hash.
+	cache *cache.Cache
+}
 
-func NewEmbeddingService() *EmbeddingService {
-	return &EmbeddingService{}
+func NewEmbeddingService(store *store.Store, secret string) *EmbeddingService {
+	return &EmbeddingService{
+		Store:         store,
+		authenticator: auth.NewAuthenticator(store, secret),
+		cache: cache.New(cache.Config{
+			DefaultTTL:      24 * time.Hour,
+			CleanupInterval: time.Hour,
+			MaxItems:        1000,
+		}),
+	}
 }
 
 type EmbeddingRequest struct {
-	Inputs  string                 `json:"inputs"`
-	Options map[string]interface{} `json:"options,omitempty"`
+	Inputs string `json:"inputs"`
 }
 
 func (s *EmbeddingService) RegisterRoutes(e *echo.Echo) {
 	e.POST("/api/embedding", s.generateEmbedding)
 }
 
+// generateEmbedding returns the embedding vector of the inputs as a JSON array.
 func (s *EmbeddingService) generateEmbedding(c echo.Context) error {
-	// Parse request body
+	ctx := c.Request().Context()
+	if result := s.authenticator.Authenticate(ctx, c.Request().Header.Get("Authorization")); result == nil {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "authentication required"})
+	}
+
 	var req EmbeddingRequest
 	if err := c.Bind(&req); err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request body"})
 	}
-
 	if req.Inputs == "" {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "inputs field is required"})
 	}
 
-	// Add wait_for_model option
-	if req.Options == nil {
-		req.Options = make(map[string]interface{})
-	}
-	req.Options["wait_for_model"] = true
-
-	// Marshal request for HuggingFace
-	jsonBody, err := json.Marshal(req)
+	instanceEmbeddingSetting, err := s.Store.GetInstanceEmbeddingSetting(ctx)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to marshal request"})
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to get embedding setting"})
 	}
-
-	// Create HTTP client with timeout
-	client := &http.Client{
-		Timeout: 60 * time.Second,
-	}
-
-	// Create request to HuggingFace
-	hfReq, err := http.NewRequest("POST", huggingFaceAPIURL, bytes.NewBuffer(jsonBody))
+	provider, err := embedding.NewProvider(instanceEmbeddingSetting)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to create request"})
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to create embedding provider: " + err.Error()})
 	}
-
-	hfReq.Header.Set("Authorization", "Bearer "+getHuggingFaceToken())
-	hfReq.Header.Set("Content-Type", "application/json")
-
-	// Make the request
-	resp, err := client.Do(hfReq)
-	if err != nil {
-		return c.JSON(http.StatusBadGateway, map[string]string{"error": "Failed to call HuggingFace API: " + err.Error()})
+	if provider == nil {
+		return c.JSON(http.StatusServiceUnavailable, map[string]string{"error": "Embedding provider is not configured"})
 	}
-	defer resp.Body.Close()
 
-	// Read response body
-	body, err := io.ReadAll(resp.Body)
+	vector, err := embedding.NewCachedProvider(provider, s.cache).Embed(ctx, req.Inputs)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to read response"})
-	}
-
-	// If HuggingFace returned an error, pass it through
-	if resp.StatusCode != http.StatusOK {
-		return c.JSONBlob(resp.StatusCode, body)
+		slog.Warn("failed to generate embedding", "error", err)
+		return c.JSON(http.StatusBadGateway, map[string]string{"error": "Failed to generate embedding: " + err.Error()})
 	}
-
-	// Return the embedding
-	return c.JSONBlob(http.StatusOK, body)
+	return c.JSON(http.StatusOK, vector)
 }
@@ -274,12 +274,12 @@ func (s *FileServerService) checkAttachmentPermission(ctx context.Context, c ech
 		return echo.NewHTTPError(http.StatusNotFound, "memo not found")
 	}
 
-	// Public memos are accessible to everyone
-	if memo.Visibility == store.Public {
+	// Public memos are accessible to everyone, unless they are drafts
+	if memo.Visibility == store.Public && memo.RowStatus != store.Draft {
 		return nil
 	}
 
-	// For non-public memos, check authentication
+	// For non-public memos and drafts, check authentication
 	user, err := s.getCurrentUser(ctx, c)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get current user").SetInternal(err)
@@ -288,8 +288,8 @@ func (s *FileServerService) checkAttachmentPermission(ctx context.Context, c ech
 		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized access")
 	}
 
-	// Private memos can only be accessed by the creator
-	if memo.Visibility == store.Private && user.ID != attachment.CreatorID {
+	// Private memos and drafts can only be accessed by the creator
+	if (memo.Visibility == store.Private || memo.RowStatus == store.Draft) && user.ID != attachment.CreatorID {
 		return echo.NewHTTPError(http.StatusForbidden, "forbidden access")
 	}
 
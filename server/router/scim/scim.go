@@ -0,0 +1,410 @@
+// Package scim serves the SCIM 2.0 (RFC 7643, RFC 7644) user provisioning endpoint.
+// Identity providers push users to /scim/v2/Users with a personal access token of an admin.
+// Memos has no groups, so the Groups resource is not served.
+package scim
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/base"
+	"github.com/usememos/memos/internal/util"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/auth"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	userSchema                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	listResponseSchema          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	patchOpSchema               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	errorSchema                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+	serviceProviderConfigSchema = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+
+	contentTypeSCIM = "application/scim+json"
+	basePath        = "/scim/v2"
+	// maxResults is the maximum number of users returned by a list request.
+	maxResults = 200
+	// currentUserKey is the context key of the admin authenticated by the token.
+	currentUserKey = "scim_user"
+)
+
+type SCIMService struct {
+	Store *store.Store
+
+	authenticator *auth.Authenticator
+}
+
+func NewSCIMService(store *store.Store, secret string) *SCIMService {
+	return &SCIMService{
+		Store:         store,
+		authenticator: auth.NewAuthenticator(store, secret),
+	}
+}
+
+func (s *SCIMService) RegisterRoutes(e *echo.Echo) {
+	g := e.Group(basePath, s.authenticate)
+	g.GET("/ServiceProviderConfig", s.getServiceProviderConfig)
+	g.GET("/Users", s.listUsers)
+	g.POST("/Users", s.createUser)
+	g.GET("/Users/:id", s.getUser)
+	g.PUT("/Users/:id", s.replaceUser)
+	g.PATCH("/Users/:id", s.patchUser)
+	g.DELETE("/Users/:id", s.deleteUser)
+}
+
+// authenticate only lets personal access tokens of admins through.
+// Access tokens of sessions are rejected, provisioning is configured once with a long-lived token.
+func (s *SCIMService) authenticate(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		token := auth.ExtractBearerToken(c.Request().Header.Get("Authorization"))
+		if token == "" {
+			return writeError(c, newError(http.StatusUnauthorized, "", "personal access token required"))
+		}
+		user, pat, err := s.authenticator.AuthenticateByPAT(ctx, token)
+		if err != nil {
+			return writeError(c, newError(http.StatusUnauthorized, "", "invalid personal access token"))
+		}
+		if user.Role != store.RoleAdmin {
+			return writeError(c, newError(http.StatusForbidden, "", "admin personal access token required"))
+		}
+		if err := s.Store.UpdatePATLastUsed(ctx, user.ID, pat.TokenId, timestamppb.Now()); err != nil {
+			slog.Warn("failed to update PAT last used time", "error", err, "userID", user.ID)
+		}
+		c.Set(currentUserKey, user)
+		if err := next(c); err != nil {
+			var scimErr *Error
+			if errors.As(err, &scimErr) {
+				return writeError(c, scimErr)
+			}
+			return err
+		}
+		return nil
+	}
+}
+
+func (*SCIMService) getServiceProviderConfig(c echo.Context) error {
+	supported := func(supported bool) map[string]bool {
+		return map[string]bool{"supported": supported}
+	}
+	return writeJSON(c, http.StatusOK, map[string]any{
+		"schemas":        []string{serviceProviderConfigSchema},
+		"patch":          supported(true),
+		"bulk":           map[string]any{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]any{"supported": true, "maxResults": maxResults},
+		"changePassword": supported(true),
+		"sort":           supported(false),
+		"etag":           supported(false),
+		"authenticationSchemes": []map[string]any{{
+			"type":        "oauthbearertoken",
+			"name":        "Personal access token",
+			"description": "Personal access token of an admin sent as a bearer token.",
+			"primary":     true,
+		}},
+	})
+}
+
+func (s *SCIMService) listUsers(c echo.Context) error {
+	ctx := c.Request().Context()
+	find, err := parseFilter(c.QueryParam("filter"))
+	if err != nil {
+		return newError(http.StatusBadRequest, "invalidFilter", err.Error())
+	}
+	// startIndex is 1-based, both parameters are clamped as the RFC asks instead of failing.
+	startIndex, count := 1, maxResults
+	if v, err := strconv.Atoi(c.QueryParam("startIndex")); err == nil && v > 1 {
+		startIndex = v
+	}
+	if v, err := strconv.Atoi(c.QueryParam("count")); err == nil {
+		count = min(max(v, 0), maxResults)
+	}
+
+	users, err := s.Store.ListUsers(ctx, find)
+	if err != nil {
+		return newError(http.StatusInternalServerError, "", "failed to list users")
+	}
+	// Pages are cut from users ordered by ID, which is stable across requests unlike the creation time.
+	slices.SortFunc(users, func(a, b *store.User) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+	resources := []*User{}
+	for i := startIndex - 1; i < len(users) && len(resources) < count; i++ {
+		resources = append(resources, convertUserFromStore(users[i], baseURL(c)))
+	}
+	return writeJSON(c, http.StatusOK, &ListResponse{
+		Schemas:      []string{listResponseSchema},
+		TotalResults: len(users),
+		StartIndex:   startIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	})
+}
+
+func (s *SCIMService) getUser(c echo.Context) error {
+	user, err := s.findUser(c)
+	if err != nil {
+		return err
+	}
+	return writeJSON(c, http.StatusOK, convertUserFromStore(user, baseURL(c)))
+}
+
+func (s *SCIMService) createUser(c echo.Context) error {
+	ctx := c.Request().Context()
+	scimUser := &User{}
+	if err := json.NewDecoder(c.Request().Body).Decode(scimUser); err != nil {
+		return newError(http.StatusBadRequest, "invalidSyntax", "invalid request body")
+	}
+	if err := s.validateUser(c, scimUser, 0); err != nil {
+		return err
+	}
+
+	// Users provisioned without a password sign in through the identity provider.
+	password := scimUser.Password
+	if password == "" {
+		randomPassword, err := util.RandomString(20)
+		if err != nil {
+			return newError(http.StatusInternalServerError, "", "failed to generate password")
+		}
+		password = randomPassword
+	}
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return newError(http.StatusInternalServerError, "", "failed to generate password hash")
+	}
+	user, err := s.Store.CreateUser(ctx, &store.User{
+		Username:     scimUser.UserName,
+		Role:         store.RoleUser,
+		Email:        scimUser.email(),
+		Nickname:     scimUser.nickname(),
+		PasswordHash: string(passwordHash),
+	})
+	if err != nil {
+		return newError(http.StatusInternalServerError, "", "failed to create user")
+	}
+	s.recordAuditEvent(c, store.ActivityTypeUserCreated, &storepb.ActivityAuditPayload{
+		Resource: userResourceName(user.ID),
+		NewValue: user.Role.String(),
+	})
+	if !scimUser.isActive() {
+		rowStatus := store.Archived
+		if user, err = s.Store.UpdateUser(ctx, &store.UpdateUser{ID: user.ID, RowStatus: &rowStatus}); err != nil {
+			return newError(http.StatusInternalServerError, "", "failed to deactivate user")
+		}
+		s.recordAuditEvent(c, store.ActivityTypeUserArchived, &storepb.ActivityAuditPayload{
+			Resource: userResourceName(user.ID),
+		})
+	}
+	return writeJSON(c, http.StatusCreated, convertUserFromStore(user, baseURL(c)))
+}
+
+func (s *SCIMService) replaceUser(c echo.Context) error {
+	user, err := s.findUser(c)
+	if err != nil {
+		return err
+	}
+	scimUser := &User{}
+	if err := json.NewDecoder(c.Request().Body).Decode(scimUser); err != nil {
+		return newError(http.StatusBadRequest, "invalidSyntax", "invalid request body")
+	}
+	return s.updateUser(c, user, scimUser)
+}
+
+func (s *SCIMService) patchUser(c echo.Context) error {
+	user, err := s.findUser(c)
+	if err != nil {
+		return err
+	}
+	request := &PatchRequest{}
+	if err := json.NewDecoder(c.Request().Body).Decode(request); err != nil {
+		return newError(http.StatusBadRequest, "invalidSyntax", "invalid request body")
+	}
+	if len(request.Schemas) != 1 || request.Schemas[0] != patchOpSchema {
+		return newError(http.StatusBadRequest, "invalidSyntax", "schemas must be "+patchOpSchema)
+	}
+
+	// The operations are applied to the current representation, which then replaces the user.
+	scimUser := convertUserFromStore(user, baseURL(c))
+	for _, operation := range request.Operations {
+		if err := scimUser.applyPatchOperation(operation); err != nil {
+			return newError(http.StatusBadRequest, "invalidValue", err.Error())
+		}
+	}
+	return s.updateUser(c, user, scimUser)
+}
+
+func (s *SCIMService) deleteUser(c echo.Context) error {
+	user, err := s.findUser(c)
+	if err != nil {
+		return err
+	}
+	if user.ID == currentUser(c).ID {
+		return newError(http.StatusBadRequest, "mutability", "the token owner cannot be deleted")
+	}
+	if err := s.Store.DeleteUser(c.Request().Context(), &store.DeleteUser{ID: user.ID}); err != nil {
+		return newError(http.StatusInternalServerError, "", "failed to delete user")
+	}
+	// The username identifies the deleted user once the user is gone.
+	s.recordAuditEvent(c, store.ActivityTypeUserDeleted, &storepb.ActivityAuditPayload{
+		Resource: userResourceName(user.ID),
+		OldValue: user.Username,
+	})
+	return c.NoContent(http.StatusNoContent)
+}
+
+// findUser returns the user of the id path parameter.
+func (s *SCIMService) findUser(c echo.Context) (*store.User, error) {
+	id, err := util.ConvertStringToInt32(c.Param("id"))
+	if err != nil {
+		return nil, newError(http.StatusNotFound, "", "user not found")
+	}
+	user, err := s.Store.GetUser(c.Request().Context(), &store.FindUser{ID: &id})
+	if err != nil {
+		return nil, newError(http.StatusInternalServerError, "", "failed to get user")
+	}
+	if user == nil || user.ID == store.SystemBotID {
+		return nil, newError(http.StatusNotFound, "", "user not found")
+	}
+	return user, nil
+}
+
+// validateUser validates the user to save, userID is the ID of the user being replaced or 0 when creating.
+func (s *SCIMService) validateUser(c echo.Context, scimUser *User, userID int32) error {
+	if !base.UIDMatcher.MatchString(strings.ToLower(scimUser.UserName)) {
+		return newError(http.StatusBadRequest, "invalidValue", "invalid userName: "+scimUser.UserName)
+	}
+	if email := scimUser.email(); email != "" && !util.ValidateEmail(email) {
+		return newError(http.StatusBadRequest, "invalidValue", "invalid email: "+email)
+	}
+	existingUser, err := s.Store.GetUser(c.Request().Context(), &store.FindUser{Username: &scimUser.UserName})
+	if err != nil {
+		return newError(http.StatusInternalServerError, "", "failed to get user")
+	}
+	if existingUser != nil && existingUser.ID != userID {
+		return newError(http.StatusConflict, "uniqueness", "userName is already taken")
+	}
+	return nil
+}
+
+// updateUser replaces the attributes of the user with the SCIM user.
+// Attributes memos does not map, such as the role and the avatar, are kept.
+func (s *SCIMService) updateUser(c echo.Context, user *store.User, scimUser *User) error {
+	ctx := c.Request().Context()
+	if err := s.validateUser(c, scimUser, user.ID); err != nil {
+		return err
+	}
+	rowStatus := store.Normal
+	if !scimUser.isActive() {
+		rowStatus = store.Archived
+		if user.ID == currentUser(c).ID {
+			return newError(http.StatusBadRequest, "mutability", "the token owner cannot be deactivated")
+		}
+	}
+
+	email, nickname := scimUser.email(), scimUser.nickname()
+	update := &store.UpdateUser{
+		ID:        user.ID,
+		UpdatedTs: func() *int64 { ts := time.Now().Unix(); return &ts }(),
+		RowStatus: &rowStatus,
+		Username:  &scimUser.UserName,
+		Email:     &email,
+		Nickname:  &nickname,
+	}
+	if scimUser.Password != "" {
+		passwordHash, err := bcrypt.GenerateFromPassword([]byte(scimUser.Password), bcrypt.DefaultCost)
+		if err != nil {
+			return newError(http.StatusInternalServerError, "", "failed to generate password hash")
+		}
+		update.PasswordHash = func() *string { hash := string(passwordHash); return &hash }()
+	}
+	updatedUser, err := s.Store.UpdateUser(ctx, update)
+	if err != nil {
+		return newError(http.StatusInternalServerError, "", "failed to update user")
+	}
+	if updatedUser.RowStatus != user.RowStatus {
+		activityType := store.ActivityTypeUserRestored
+		if updatedUser.RowStatus == store.Archived {
+			activityType = store.ActivityTypeUserArchived
+		}
+		s.recordAuditEvent(c, activityType, &storepb.ActivityAuditPayload{
+			Resource: userResourceName(user.ID),
+		})
+	}
+	return writeJSON(c, http.StatusOK, convertUserFromStore(updatedUser, baseURL(c)))
+}
+
+// recordAuditEvent records an audit event caused by the admin of the token, with the client of the request.
+// Failing to record it does not fail the request.
+func (s *SCIMService) recordAuditEvent(c echo.Context, activityType store.ActivityType, payload *storepb.ActivityAuditPayload) {
+	payload.IpAddress = c.RealIP()
+	payload.UserAgent = c.Request().UserAgent()
+	if _, err := s.Store.CreateActivity(c.Request().Context(), &store.Activity{
+		CreatorID: currentUser(c).ID,
+		Type:      activityType,
+		Level:     store.ActivityLevelInfo,
+		Payload:   &storepb.ActivityPayload{Audit: payload},
+	}); err != nil {
+		slog.Warn("failed to create audit activity", slog.String("type", activityType.String()), slog.Any("err", err))
+	}
+}
+
+// userResourceName returns the API resource name of the user, which audit events refer to.
+func userResourceName(userID int32) string {
+	return fmt.Sprintf("users/%d", userID)
+}
+
+func currentUser(c echo.Context) *store.User {
+	user, _ := c.Get(currentUserKey).(*store.User)
+	return user
+}
+
+// baseURL returns the absolute URL of the endpoint used in resource locations.
+func baseURL(c echo.Context) string {
+	return c.Scheme() + "://" + c.Request().Host + basePath
+}
+
+func writeJSON(c echo.Context, code int, v any) error {
+	c.Response().Header().Set(echo.HeaderContentType, contentTypeSCIM)
+	c.Response().WriteHeader(code)
+	return json.NewEncoder(c.Response()).Encode(v)
+}
+
+// Error is a SCIM error response returned by the handlers.
+type Error struct {
+	Status int
+	// ScimType is the SCIM error type, it is empty for errors without one.
+	ScimType string
+	Detail   string
+}
+
+func newError(status int, scimType, detail string) *Error {
+	return &Error{Status: status, ScimType: scimType, Detail: detail}
+}
+
+func (e *Error) Error() string {
+	return e.Detail
+}
+
+func writeError(c echo.Context, scimErr *Error) error {
+	body := map[string]any{
+		"schemas": []string{errorSchema},
+		"status":  strconv.Itoa(scimErr.Status),
+		"detail":  scimErr.Detail,
+	}
+	if scimErr.ScimType != "" {
+		body["scimType"] = scimErr.ScimType
+	}
+	return writeJSON(c, scimErr.Status, body)
+}
@@ -0,0 +1,200 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/util"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/auth"
+	"github.com/usememos/memos/store"
+	teststore "github.com/usememos/memos/store/test"
+)
+
+type testServer struct {
+	echo  *echo.Echo
+	store *store.Store
+	admin *store.User
+	token string
+}
+
+func newTestServer(t *testing.T) *testServer {
+	ctx := context.Background()
+	ts := &testServer{echo: echo.New(), store: teststore.NewTestingStore(ctx, t)}
+	t.Cleanup(func() { ts.store.Close() })
+	NewSCIMService(ts.store, "test-secret").RegisterRoutes(ts.echo)
+
+	ts.admin = ts.createUser(t, "admin", store.RoleAdmin)
+	ts.token = ts.createToken(t, ts.admin.ID)
+	return ts
+}
+
+func (ts *testServer) createUser(t *testing.T, username string, role store.Role) *store.User {
+	user, err := ts.store.CreateUser(context.Background(), &store.User{
+		Username:     username,
+		Role:         role,
+		Nickname:     username,
+		PasswordHash: "hash",
+	})
+	require.NoError(t, err)
+	return user
+}
+
+func (ts *testServer) createToken(t *testing.T, userID int32) string {
+	token := auth.GeneratePersonalAccessToken()
+	require.NoError(t, ts.store.AddUserPersonalAccessToken(context.Background(), userID, &storepb.PersonalAccessTokensUserSetting_PersonalAccessToken{
+		TokenId:     util.GenUUID(),
+		TokenHash:   auth.HashPersonalAccessToken(token),
+		Description: "SCIM",
+		CreatedAt:   timestamppb.Now(),
+	}))
+	return token
+}
+
+// do sends the request with the token and decodes the response body into v if it is not nil.
+func (ts *testServer) do(t *testing.T, token, method, path, body string, v any) int {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, contentTypeSCIM)
+	if token != "" {
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	ts.echo.ServeHTTP(rec, req)
+	if v != nil {
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), v), rec.Body.String())
+	}
+	return rec.Code
+}
+
+func TestAuthentication(t *testing.T) {
+	ts := newTestServer(t)
+	regular := ts.createUser(t, "bob", store.RoleUser)
+
+	scimErr := map[string]any{}
+	require.Equal(t, http.StatusUnauthorized, ts.do(t, "", http.MethodGet, "/scim/v2/Users", "", &scimErr))
+	require.Equal(t, []any{errorSchema}, scimErr["schemas"])
+	require.Equal(t, "401", scimErr["status"])
+	require.Equal(t, http.StatusUnauthorized, ts.do(t, "memos_pat_invalid", http.MethodGet, "/scim/v2/Users", "", nil))
+	require.Equal(t, http.StatusForbidden, ts.do(t, ts.createToken(t, regular.ID), http.MethodGet, "/scim/v2/Users", "", nil))
+	require.Equal(t, http.StatusOK, ts.do(t, ts.token, http.MethodGet, "/scim/v2/ServiceProviderConfig", "", nil))
+}
+
+func TestUserProvisioning(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestServer(t)
+
+	// Create
+	created := &User{}
+	require.Equal(t, http.StatusCreated, ts.do(t, ts.token, http.MethodPost, "/scim/v2/Users", `{
+		"schemas": ["urn:ietf:params:scim:schemas:core:2.0:User"],
+		"userName": "alice",
+		"name": {"givenName": "Alice", "familyName": "Liddell"},
+		"emails": [{"value": "alice@home.example.com", "type": "home"}, {"value": "alice@example.com", "type": "work", "primary": true}],
+		"active": true
+	}`, created))
+	require.Equal(t, "alice", created.UserName)
+	require.Equal(t, "Alice Liddell", created.DisplayName)
+	require.Equal(t, "alice@example.com", created.Emails[0].Value)
+	require.True(t, *created.Active)
+	require.Equal(t, "http://example.com/scim/v2/Users/"+created.ID, created.Meta.Location)
+	require.Empty(t, created.Password)
+
+	id, err := strconv.Atoi(created.ID)
+	require.NoError(t, err)
+	userID := int32(id)
+	user, err := ts.store.GetUser(ctx, &store.FindUser{ID: &userID})
+	require.NoError(t, err)
+	require.Equal(t, store.RoleUser, user.Role)
+	require.Equal(t, "Alice Liddell", user.Nickname)
+	require.NotEmpty(t, user.PasswordHash)
+
+	scimErr := map[string]any{}
+	require.Equal(t, http.StatusConflict, ts.do(t, ts.token, http.MethodPost, "/scim/v2/Users", `{"userName": "alice"}`, &scimErr))
+	require.Equal(t, "uniqueness", scimErr["scimType"])
+	require.Equal(t, http.StatusBadRequest, ts.do(t, ts.token, http.MethodPost, "/scim/v2/Users", `{"userName": "not valid"}`, nil))
+
+	// Filter
+	list := &ListResponse{}
+	require.Equal(t, http.StatusOK, ts.do(t, ts.token, http.MethodGet, `/scim/v2/Users?filter=userName%20eq%20%22alice%22`, "", list))
+	require.Equal(t, 1, list.TotalResults)
+	require.Equal(t, created.ID, list.Resources[0].ID)
+	require.Equal(t, http.StatusOK, ts.do(t, ts.token, http.MethodGet, `/scim/v2/Users?filter=userName%20eq%20%22unknown%22`, "", list))
+	require.Equal(t, 0, list.TotalResults)
+	require.Empty(t, list.Resources)
+	require.Equal(t, http.StatusBadRequest, ts.do(t, ts.token, http.MethodGet, `/scim/v2/Users?filter=title%20sw%20%22a%22`, "", nil))
+	require.Equal(t, http.StatusOK, ts.do(t, ts.token, http.MethodGet, "/scim/v2/Users?startIndex=2&count=1", "", list))
+	require.Equal(t, 2, list.TotalResults)
+	require.Equal(t, 2, list.StartIndex)
+	require.Len(t, list.Resources, 1)
+	require.Equal(t, created.ID, list.Resources[0].ID)
+
+	// Patch
+	patched := &User{}
+	require.Equal(t, http.StatusOK, ts.do(t, ts.token, http.MethodPatch, "/scim/v2/Users/"+created.ID, `{
+		"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+		"Operations": [
+			{"op": "Replace", "path": "emails[type eq \"work\"].value", "value": "liddell@example.com"},
+			{"op": "replace", "value": {"displayName": "Alice L.", "title": "Engineer"}},
+			{"op": "replace", "path": "active", "value": "False"}
+		]
+	}`, patched))
+	require.Equal(t, "liddell@example.com", patched.Emails[0].Value)
+	require.Equal(t, "Alice L.", patched.DisplayName)
+	require.False(t, *patched.Active)
+	user, err = ts.store.GetUser(ctx, &store.FindUser{ID: &userID})
+	require.NoError(t, err)
+	require.Equal(t, store.Archived, user.RowStatus)
+
+	// Replace
+	replaced := &User{}
+	require.Equal(t, http.StatusOK, ts.do(t, ts.token, http.MethodPut, "/scim/v2/Users/"+created.ID, `{
+		"schemas": ["urn:ietf:params:scim:schemas:core:2.0:User"],
+		"userName": "alice-liddell",
+		"displayName": "Alice",
+		"active": true
+	}`, replaced))
+	require.Equal(t, "alice-liddell", replaced.UserName)
+	require.Empty(t, replaced.Emails)
+	require.True(t, *replaced.Active)
+	require.Equal(t, http.StatusConflict, ts.do(t, ts.token, http.MethodPut, "/scim/v2/Users/"+created.ID, `{"userName": "admin"}`, nil))
+
+	// The token owner cannot lock themselves out.
+	adminPath := "/scim/v2/Users/" + strconv.Itoa(int(ts.admin.ID))
+	require.Equal(t, http.StatusBadRequest, ts.do(t, ts.token, http.MethodPatch, adminPath, `{
+		"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+		"Operations": [{"op": "replace", "path": "active", "value": false}]
+	}`, nil))
+	require.Equal(t, http.StatusBadRequest, ts.do(t, ts.token, http.MethodDelete, adminPath, "", nil))
+
+	// Delete
+	require.Equal(t, http.StatusNoContent, ts.do(t, ts.token, http.MethodDelete, "/scim/v2/Users/"+created.ID, "", nil))
+	require.Equal(t, http.StatusNotFound, ts.do(t, ts.token, http.MethodGet, "/scim/v2/Users/"+created.ID, "", nil))
+	user, err = ts.store.GetUser(ctx, &store.FindUser{ID: &userID})
+	require.NoError(t, err)
+	require.Nil(t, user)
+
+	// Provisioning is audited as the admin of the token.
+	activities, err := ts.store.ListActivities(ctx, &store.FindActivity{TypeList: store.AuditActivityTypes})
+	require.NoError(t, err)
+	activityTypes := []store.ActivityType{}
+	for _, activity := range activities {
+		require.Equal(t, ts.admin.ID, activity.CreatorID)
+		require.Equal(t, "users/"+created.ID, activity.Payload.GetAudit().Resource)
+		activityTypes = append(activityTypes, activity.Type)
+	}
+	require.ElementsMatch(t, []store.ActivityType{
+		store.ActivityTypeUserCreated,
+		store.ActivityTypeUserArchived,
+		store.ActivityTypeUserRestored,
+		store.ActivityTypeUserDeleted,
+	}, activityTypes)
+}
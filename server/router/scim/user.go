@@ -0,0 +1,286 @@
+package scim
+
+import (
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+// User is the SCIM representation of a user.
+// The SCIM id is the user ID, displayName and name.formatted map to the nickname
+// and active maps to the row status.
+type User struct {
+	Schemas     []string      `json:"schemas"`
+	ID          string        `json:"id,omitempty"`
+	ExternalID  string        `json:"externalId,omitempty"`
+	UserName    string        `json:"userName"`
+	Name        *Name         `json:"name,omitempty"`
+	DisplayName string        `json:"displayName,omitempty"`
+	Emails      []MultiValued `json:"emails,omitempty"`
+	Photos      []MultiValued `json:"photos,omitempty"`
+	Active      *bool         `json:"active,omitempty"`
+	// Password is write-only and never returned.
+	Password string `json:"password,omitempty"`
+	Meta     *Meta  `json:"meta,omitempty"`
+}
+
+type Name struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+// MultiValued is a value of a multi-valued attribute such as emails.
+type MultiValued struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+type Meta struct {
+	ResourceType string `json:"resourceType"`
+	Created      string `json:"created"`
+	LastModified string `json:"lastModified"`
+	Location     string `json:"location"`
+}
+
+// PatchRequest is the body of a PATCH request.
+type PatchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []PatchOperation `json:"Operations"`
+}
+
+type PatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// ListResponse is the body of a list response.
+type ListResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []*User  `json:"Resources"`
+}
+
+var (
+	// filterRegexp matches the `attribute eq "value"` filters sent by identity providers to look up users.
+	filterRegexp = regexp.MustCompile(`(?i)^\s*([a-z.]+)\s+eq\s+"((?:[^"\\]|\\.)*)"\s*$`)
+	// emailValuePathRegexp matches value paths such as `emails[type eq "work"].value`.
+	emailValuePathRegexp = regexp.MustCompile(`(?i)^emails\[.*\]\.value$`)
+)
+
+// convertUserFromStore converts the store user to its SCIM representation served under the base URL.
+func convertUserFromStore(user *store.User, baseURL string) *User {
+	active := user.RowStatus == store.Normal
+	scimUser := &User{
+		Schemas:     []string{userSchema},
+		ID:          strconv.Itoa(int(user.ID)),
+		UserName:    user.Username,
+		Name:        &Name{Formatted: user.Nickname},
+		DisplayName: user.Nickname,
+		Active:      &active,
+		Meta: &Meta{
+			ResourceType: "User",
+			Created:      time.Unix(user.CreatedTs, 0).UTC().Format(time.RFC3339),
+			LastModified: time.Unix(user.UpdatedTs, 0).UTC().Format(time.RFC3339),
+			Location:     baseURL + "/Users/" + strconv.Itoa(int(user.ID)),
+		},
+	}
+	if user.Email != "" {
+		scimUser.Emails = []MultiValued{{Value: user.Email, Type: "work", Primary: true}}
+	}
+	// Uploaded avatars are data URIs, only links are worth returning.
+	if strings.HasPrefix(user.AvatarURL, "http://") || strings.HasPrefix(user.AvatarURL, "https://") {
+		scimUser.Photos = []MultiValued{{Value: user.AvatarURL, Type: "photo", Primary: true}}
+	}
+	return scimUser
+}
+
+// nickname returns the display name of the user, falling back to the name and the username.
+func (u *User) nickname() string {
+	if u.DisplayName != "" {
+		return u.DisplayName
+	}
+	if u.Name != nil {
+		if u.Name.Formatted != "" {
+			return u.Name.Formatted
+		}
+		if name := strings.TrimSpace(u.Name.GivenName + " " + u.Name.FamilyName); name != "" {
+			return name
+		}
+	}
+	return u.UserName
+}
+
+// email returns the primary email of the user, or the first one if none is primary.
+func (u *User) email() string {
+	for _, email := range u.Emails {
+		if email.Primary {
+			return email.Value
+		}
+	}
+	if len(u.Emails) > 0 {
+		return u.Emails[0].Value
+	}
+	return ""
+}
+
+// isActive reports whether the user is active, users are active unless stated otherwise.
+func (u *User) isActive() bool {
+	return u.Active == nil || *u.Active
+}
+
+// applyPatchOperation applies the operation to the user.
+// Attributes that have no counterpart in memos, such as phone numbers, are ignored.
+func (u *User) applyPatchOperation(operation PatchOperation) error {
+	op := strings.ToLower(operation.Op)
+	if op != "add" && op != "replace" && op != "remove" {
+		return errors.Errorf("unsupported operation %q", operation.Op)
+	}
+	if operation.Path != "" {
+		return u.applyAttribute(operation.Path, operation.Value, op == "remove")
+	}
+	if op == "remove" {
+		return errors.New("remove operation requires a path")
+	}
+
+	// Without a path, the value holds the attributes to set.
+	values := map[string]json.RawMessage{}
+	if err := json.Unmarshal(operation.Value, &values); err != nil {
+		return errors.Wrap(err, "value must be an object")
+	}
+	for path, value := range values {
+		if err := u.applyAttribute(path, value, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (u *User) applyAttribute(path string, value json.RawMessage, remove bool) error {
+	if u.Name == nil {
+		u.Name = &Name{}
+	}
+	switch path = strings.ToLower(path); {
+	case path == "active":
+		if remove {
+			return errors.New("active cannot be removed")
+		}
+		active, err := unmarshalBool(value)
+		if err != nil {
+			return err
+		}
+		u.Active = &active
+	case path == "username":
+		if remove {
+			return errors.New("userName cannot be removed")
+		}
+		return unmarshalString(value, &u.UserName)
+	case path == "displayname":
+		u.DisplayName = ""
+		if !remove {
+			return unmarshalString(value, &u.DisplayName)
+		}
+	case path == "name":
+		u.Name = &Name{}
+		if !remove {
+			if err := json.Unmarshal(value, u.Name); err != nil {
+				return errors.Wrap(err, "invalid name")
+			}
+		}
+		u.DisplayName = ""
+	case path == "name.formatted":
+		u.Name.Formatted, u.DisplayName = "", ""
+		if !remove {
+			return unmarshalString(value, &u.Name.Formatted)
+		}
+	case path == "name.givenname":
+		u.Name.Formatted, u.DisplayName = "", ""
+		u.Name.GivenName = ""
+		if !remove {
+			return unmarshalString(value, &u.Name.GivenName)
+		}
+	case path == "name.familyname":
+		u.Name.Formatted, u.DisplayName = "", ""
+		u.Name.FamilyName = ""
+		if !remove {
+			return unmarshalString(value, &u.Name.FamilyName)
+		}
+	case path == "emails":
+		u.Emails = nil
+		if !remove {
+			if err := json.Unmarshal(value, &u.Emails); err != nil {
+				return errors.Wrap(err, "invalid emails")
+			}
+		}
+	case emailValuePathRegexp.MatchString(path):
+		u.Emails = nil
+		if !remove {
+			email := ""
+			if err := unmarshalString(value, &email); err != nil {
+				return err
+			}
+			u.Emails = []MultiValued{{Value: email, Primary: true}}
+		}
+	}
+	return nil
+}
+
+// unmarshalBool accepts booleans and the "True" and "False" strings sent by some identity providers.
+func unmarshalBool(value json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(value, &b); err == nil {
+		return b, nil
+	}
+	var s string
+	if err := json.Unmarshal(value, &s); err != nil {
+		return false, errors.Errorf("invalid boolean %s", string(value))
+	}
+	b, err := strconv.ParseBool(strings.ToLower(s))
+	if err != nil {
+		return false, errors.Errorf("invalid boolean %q", s)
+	}
+	return b, nil
+}
+
+func unmarshalString(value json.RawMessage, s *string) error {
+	if err := json.Unmarshal(value, s); err != nil {
+		return errors.Errorf("invalid string %s", string(value))
+	}
+	return nil
+}
+
+// parseFilter converts the filter of a list request to a user query.
+// Only equality on userName and emails is supported.
+func parseFilter(filter string) (*store.FindUser, error) {
+	find := &store.FindUser{}
+	if filter == "" {
+		return find, nil
+	}
+	matches := filterRegexp.FindStringSubmatch(filter)
+	if matches == nil {
+		return nil, errors.Errorf("unsupported filter %q", filter)
+	}
+	value, err := strconv.Unquote(`"` + matches[2] + `"`)
+	if err != nil {
+		return nil, errors.Errorf("invalid filter value %q", matches[2])
+	}
+	switch strings.ToLower(matches[1]) {
+	case "username":
+		find.Username = &value
+	case "emails", "emails.value":
+		find.Email = &value
+	default:
+		return nil, errors.Errorf("unsupported filter attribute %q", matches[1])
+	}
+	return find, nil
+}
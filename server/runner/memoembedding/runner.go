@@ -0,0 +1,113 @@
+package memoembedding
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/embedding"
+	"github.com/usememos/memos/store"
+)
+
+type Runner struct {
+	Store *store.Store
+}
+
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		Store: store,
+	}
+}
+
+// Schedule refreshes embeddings every minute so that new and edited memos become searchable shortly.
+const Schedule = "* * * * *"
+
+// batchSize is the number of memos loaded at once while scanning for stale embeddings.
+const batchSize = 100
+
+// RunOnce generates the embeddings of memos which have none, or whose content or model changed.
+// It does nothing if no embedding provider is configured.
+// Memos which fail to be embedded are skipped and reported in the returned error.
+func (r *Runner) RunOnce(ctx context.Context) error {
+	instanceEmbeddingSetting, err := r.Store.GetInstanceEmbeddingSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get instance embedding setting")
+	}
+	provider, err := embedding.NewProvider(instanceEmbeddingSetting)
+	if err != nil {
+		return errors.Wrap(err, "failed to create embedding provider")
+	}
+	if provider == nil {
+		return nil
+	}
+
+	model := provider.Model()
+	memoEmbeddings, err := r.Store.ListMemoEmbeddings(ctx, &store.FindMemoEmbedding{})
+	if err != nil {
+		return errors.Wrap(err, "failed to list memo embeddings")
+	}
+	existing := make(map[int32]*store.MemoEmbedding, len(memoEmbeddings))
+	for _, memoEmbedding := range memoEmbeddings {
+		existing[memoEmbedding.MemoID] = memoEmbedding
+	}
+
+	offset := 0
+	updated := 0
+	failed := 0
+	for {
+		limit := batchSize
+		memos, err := r.Store.ListMemos(ctx, &store.FindMemo{
+			ExcludeComments: true,
+			Limit:           &limit,
+			Offset:          &offset,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to list memos")
+		}
+		if len(memos) == 0 {
+			break
+		}
+
+		for _, memo := range memos {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if strings.TrimSpace(memo.Content) == "" {
+				continue
+			}
+			contentHash := embedding.ContentHash(memo.Content)
+			if current, ok := existing[memo.ID]; ok && current.Model == model && current.ContentHash == contentHash {
+				continue
+			}
+			vector, err := provider.Embed(ctx, memo.Content)
+			if err != nil {
+				slog.Error("failed to generate memo embedding", "err", err, "memoID", memo.ID)
+				failed++
+				continue
+			}
+			if _, err := r.Store.UpsertMemoEmbedding(ctx, &store.MemoEmbedding{
+				MemoID:      memo.ID,
+				Model:       model,
+				ContentHash: contentHash,
+				Embedding:   vector,
+			}); err != nil {
+				slog.Error("failed to upsert memo embedding", "err", err, "memoID", memo.ID)
+				failed++
+				continue
+			}
+			updated++
+		}
+
+		offset += len(memos)
+	}
+
+	if updated > 0 {
+		slog.Info("Updated memo embeddings", "count", updated)
+	}
+	if failed > 0 {
+		return errors.Errorf("failed to embed %d memos", failed)
+	}
+	return nil
+}
@@ -1,10 +1,17 @@
 package memopayload
 
 import (
+	"cmp"
 	"context"
+	"fmt"
 	"log/slog"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/usememos/memos/plugin/markdown"
 	storepb "github.com/usememos/memos/proto/gen/store"
@@ -23,12 +30,19 @@ func NewRunner(store *store.Store, markdownService markdown.Service) *Runner {
 	}
 }
 
+// Schedule runs the runner daily at 03:00 to repair payloads left stale by parser changes.
+const Schedule = "0 3 * * *"
+
 // RunOnce rebuilds the payload of all memos.
-func (r *Runner) RunOnce(ctx context.Context) {
+// Memos which fail to be rebuilt are skipped and reported in the returned error.
+func (r *Runner) RunOnce(ctx context.Context) error {
 	// Process memos in batches to avoid loading all memos into memory at once
 	const batchSize = 100
 	offset := 0
 	processed := 0
+	failed := 0
+	// The timezones of the memo creators, to evaluate due markers in.
+	locations := map[int32]*time.Location{}
 
 	for {
 		limit := batchSize
@@ -37,8 +51,7 @@ func (r *Runner) RunOnce(ctx context.Context) {
 			Offset: &offset,
 		})
 		if err != nil {
-			slog.Error("failed to list memos", "err", err)
-			return
+			return errors.Wrap(err, "failed to list memos")
 		}
 
 		// Break if no more memos
@@ -49,15 +62,34 @@ func (r *Runner) RunOnce(ctx context.Context) {
 		// Process batch
 		batchSuccessCount := 0
 		for _, memo := range memos {
-			if err := RebuildMemoPayload(memo, r.MarkdownService); err != nil {
+			location, ok := locations[memo.CreatorID]
+			if !ok {
+				location, err = r.Store.GetUserLocation(ctx, memo.CreatorID)
+				if err != nil {
+					slog.Error("failed to get user timezone", "err", err, "userID", memo.CreatorID)
+					failed++
+					continue
+				}
+				locations[memo.CreatorID] = location
+			}
+			payload := proto.Clone(memo.Payload)
+			if err := RebuildMemoPayload(ctx, r.Store, memo, r.MarkdownService, location); err != nil {
 				slog.Error("failed to rebuild memo payload", "err", err, "memoID", memo.ID)
+				failed++
+				continue
+			}
+			if proto.Equal(payload, memo.Payload) {
+				batchSuccessCount++
 				continue
 			}
+			// Memos updated since they were listed already had their payload rebuilt by the update.
 			if err := r.Store.UpdateMemo(ctx, &store.UpdateMemo{
-				ID:      memo.ID,
-				Payload: memo.Payload,
-			}); err != nil {
+				ID:              memo.ID,
+				Payload:         memo.Payload,
+				ExpectedVersion: &memo.Version,
+			}); err != nil && !errors.Is(err, store.ErrMemoModified) {
 				slog.Error("failed to update memo", "err", err, "memoID", memo.ID)
+				failed++
 				continue
 			}
 			batchSuccessCount++
@@ -69,9 +101,16 @@ func (r *Runner) RunOnce(ctx context.Context) {
 		// Move to next batch
 		offset += len(memos)
 	}
+
+	if failed > 0 {
+		return errors.Errorf("failed to rebuild the payload of %d memos", failed)
+	}
+	return nil
 }
 
-func RebuildMemoPayload(memo *store.Memo, markdownService markdown.Service) error {
+// RebuildMemoPayload rebuilds the tags, properties, due tasks and mentions of the memo from its content.
+// Due markers are evaluated in location, the timezone of the memo creator.
+func RebuildMemoPayload(ctx context.Context, stores *store.Store, memo *store.Memo, markdownService markdown.Service, location *time.Location) error {
 	if memo.Payload == nil {
 		memo.Payload = &storepb.MemoPayload{}
 	}
@@ -82,7 +121,92 @@ func RebuildMemoPayload(memo *store.Memo, markdownService markdown.Service) erro
 		return errors.Wrap(err, "failed to extract markdown metadata")
 	}
 
+	// Tasks keep being reminded once, as long as their text and due time do not change.
+	reminded := map[string]bool{}
+	for _, dueTask := range memo.Payload.DueTasks {
+		if dueTask.Reminded {
+			reminded[dueTaskKey(dueTask.Content, dueTask.DueTime.AsTime())] = true
+		}
+	}
+	dueTasks := []*storepb.MemoPayload_DueTask{}
+	for _, task := range data.DueTasks {
+		due := time.Date(task.Due.Year(), task.Due.Month(), task.Due.Day(), task.Due.Hour(), task.Due.Minute(), 0, 0, location)
+		dueTasks = append(dueTasks, &storepb.MemoPayload_DueTask{
+			Content:   task.Content,
+			Completed: task.Completed,
+			DueTime:   timestamppb.New(due),
+			Reminded:  reminded[dueTaskKey(task.Content, due)],
+		})
+		if !task.Completed && (data.Property.DueTs == 0 || due.Unix() < data.Property.DueTs) {
+			data.Property.DueTs = due.Unix()
+		}
+	}
+
+	mentions, err := resolveMentions(ctx, stores, data.Mentions)
+	if err != nil {
+		return err
+	}
+
 	memo.Payload.Tags = data.Tags
 	memo.Payload.Property = data.Property
+	memo.Payload.DueTasks = dueTasks
+	memo.Payload.Mentions = mentions
+	SetRemindTs(memo.Payload)
 	return nil
 }
+
+// resolveMentions returns the IDs of the mentioned users, looked up at once.
+// Usernames are matched case-insensitively, preferring the exact match, and mentions of unknown users are plain text.
+func resolveMentions(ctx context.Context, stores *store.Store, usernames []string) ([]int32, error) {
+	mentions := []int32{}
+	if len(usernames) == 0 {
+		return mentions, nil
+	}
+	users, err := stores.ListUsers(ctx, &store.FindUser{UsernameList: usernames})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list mentioned users")
+	}
+	slices.SortFunc(users, func(a, b *store.User) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+	for _, username := range usernames {
+		var mentioned *store.User
+		for _, user := range users {
+			if user.Username == username {
+				mentioned = user
+				break
+			}
+			if mentioned == nil && strings.EqualFold(user.Username, username) {
+				mentioned = user
+			}
+		}
+		if mentioned != nil && !slices.Contains(mentions, mentioned.ID) {
+			mentions = append(mentions, mentioned.ID)
+		}
+	}
+	return mentions, nil
+}
+
+// SetRemindTs sets the remind timestamp of the payload to the earliest due time of the incomplete tasks not reminded of yet.
+func SetRemindTs(payload *storepb.MemoPayload) {
+	remindTs := int64(0)
+	for _, dueTask := range payload.DueTasks {
+		if dueTask.Completed || dueTask.Reminded {
+			continue
+		}
+		if due := dueTask.DueTime.AsTime().Unix(); remindTs == 0 || due < remindTs {
+			remindTs = due
+		}
+	}
+	if payload.Property == nil {
+		if remindTs == 0 {
+			return
+		}
+		payload.Property = &storepb.MemoPayload_Property{}
+	}
+	payload.Property.RemindTs = remindTs
+}
+
+func dueTaskKey(content string, due time.Time) string {
+	return fmt.Sprintf("%d/%s", due.Unix(), content)
+}
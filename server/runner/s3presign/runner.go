@@ -5,6 +5,7 @@ import (
 	"log/slog"
 	"time"
 
+	"github.com/pkg/errors"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/usememos/memos/plugin/storage/s3"
@@ -22,37 +23,26 @@ func NewRunner(store *store.Store) *Runner {
 	}
 }
 
-// Schedule runner every 12 hours.
-const runnerInterval = time.Hour * 12
+// Schedule runs the runner every 12 hours.
+const Schedule = "0 */12 * * *"
 
-func (r *Runner) Run(ctx context.Context) {
-	ticker := time.NewTicker(runnerInterval)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ticker.C:
-			r.RunOnce(ctx)
-		case <-ctx.Done():
-			return
-		}
-	}
+func (r *Runner) RunOnce(ctx context.Context) error {
+	return r.CheckAndPresign(ctx)
 }
 
-func (r *Runner) RunOnce(ctx context.Context) {
-	r.CheckAndPresign(ctx)
-}
-
-func (r *Runner) CheckAndPresign(ctx context.Context) {
+// CheckAndPresign refreshes the presigned URLs of S3 attachments which are about to expire.
+// Attachments which fail to be presigned are skipped and reported in the returned error.
+func (r *Runner) CheckAndPresign(ctx context.Context) error {
 	instanceStorageSetting, err := r.Store.GetInstanceStorageSetting(ctx)
 	if err != nil {
-		return
+		return errors.Wrap(err, "failed to get instance storage setting")
 	}
 
 	s3StorageType := storepb.AttachmentStorageType_S3
 	// Limit attachments to a reasonable batch size
 	const batchSize = 100
 	offset := 0
+	failed := 0
 
 	for {
 		limit := batchSize
@@ -63,8 +53,7 @@ func (r *Runner) CheckAndPresign(ctx context.Context) {
 			Offset:      &offset,
 		})
 		if err != nil {
-			slog.Error("Failed to list attachments for presigning", "error", err)
-			return
+			return errors.Wrap(err, "failed to list attachments for presigning")
 		}
 
 		// Break if no more attachments
@@ -94,18 +83,21 @@ func (r *Runner) CheckAndPresign(ctx context.Context) {
 			}
 			if s3Config == nil {
 				slog.Error("S3 config is not found")
+				failed++
 				continue
 			}
 
 			s3Client, err := s3.NewClient(ctx, s3Config)
 			if err != nil {
 				slog.Error("Failed to create S3 client", "error", err)
+				failed++
 				continue
 			}
 
 			presignURL, err := s3Client.PresignGetObject(ctx, s3ObjectPayload.Key)
 			if err != nil {
 				slog.Error("Failed to presign URL", "error", err, "attachmentID", attachment.ID)
+				failed++
 				continue
 			}
 
@@ -121,6 +113,7 @@ func (r *Runner) CheckAndPresign(ctx context.Context) {
 				},
 			}); err != nil {
 				slog.Error("Failed to update attachment", "error", err, "attachmentID", attachment.ID)
+				failed++
 				continue
 			}
 			presignCount++
@@ -131,4 +124,9 @@ func (r *Runner) CheckAndPresign(ctx context.Context) {
 		// Move to next batch
 		offset += len(attachments)
 	}
+
+	if failed > 0 {
+		return errors.Errorf("failed to presign %d attachments", failed)
+	}
+	return nil
 }
@@ -0,0 +1,224 @@
+package webhookdelivery
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sync/semaphore"
+
+	"github.com/usememos/memos/plugin/webhook"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+type Runner struct {
+	Store *store.Store
+}
+
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		Store: store,
+	}
+}
+
+const (
+	// Schedule polls the outbox every few seconds so that new deliveries go out promptly.
+	Schedule = "*/5 * * * * *"
+	// PruneSchedule removes finished deliveries past their retention every hour.
+	PruneSchedule = "0 * * * *"
+)
+
+const (
+	// batchSize is the maximum number of due deliveries processed per run.
+	batchSize = 50
+	// maxConcurrentWebhooks is the maximum number of webhooks delivered to at once.
+	maxConcurrentWebhooks = 8
+	// MaxAttempts is the number of attempts after which a delivery is marked as failed.
+	MaxAttempts = 10
+	// baseBackoff is the delay before the first retry, doubled for every following attempt.
+	baseBackoff = time.Second * 30
+	// maxBackoff caps the delay between two attempts.
+	maxBackoff = time.Hour
+	// retention is how long finished deliveries are kept for inspection.
+	retention = time.Hour * 24 * 30
+)
+
+// webhookKey identifies a webhook by its owner and its ID in the owner's webhooks setting.
+type webhookKey struct {
+	creatorID int32
+	webhookID string
+}
+
+// DeliverPending attempts every pending delivery that is due, oldest first.
+// The deliveries to different webhooks are sent concurrently, so that a slow receiver does not hold up the others.
+// Failed attempts are recorded on the deliveries and retried later, they are not reported as errors.
+func (r *Runner) DeliverPending(ctx context.Context) error {
+	status := store.WebhookDeliveryPending
+	now := time.Now().Unix()
+	limit := batchSize
+	deliveries, err := r.Store.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{
+		Status:            &status,
+		NextAttemptBefore: &now,
+		OrderByIDAsc:      true,
+		Limit:             &limit,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list pending webhook deliveries")
+	}
+
+	// Webhooks are looked up at delivery time so that the current signing secret is used.
+	webhooksByCreator := map[int32][]*storepb.WebhooksUserSetting_Webhook{}
+	keys := []webhookKey{}
+	deliveriesByWebhook := map[webhookKey][]*store.WebhookDelivery{}
+	hooks := map[webhookKey]*storepb.WebhooksUserSetting_Webhook{}
+	for _, delivery := range deliveries {
+		webhooks, ok := webhooksByCreator[delivery.CreatorID]
+		if !ok {
+			webhooks, err = r.Store.GetUserWebhooks(ctx, delivery.CreatorID)
+			if err != nil {
+				slog.Error("Failed to get user webhooks", "user", delivery.CreatorID, "error", err)
+				continue
+			}
+			webhooksByCreator[delivery.CreatorID] = webhooks
+		}
+
+		key := webhookKey{creatorID: delivery.CreatorID, webhookID: delivery.WebhookID}
+		if _, ok := deliveriesByWebhook[key]; !ok {
+			keys = append(keys, key)
+			for _, candidate := range webhooks {
+				if candidate.Id == delivery.WebhookID {
+					hooks[key] = candidate
+					break
+				}
+			}
+		}
+		deliveriesByWebhook[key] = append(deliveriesByWebhook[key], delivery)
+	}
+
+	sem := semaphore.NewWeighted(maxConcurrentWebhooks)
+	var wg sync.WaitGroup
+	for _, key := range keys {
+		if err := sem.Acquire(ctx, 1); err != nil {
+			break
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer sem.Release(1)
+			r.deliverToWebhook(ctx, deliveriesByWebhook[key], hooks[key])
+		}()
+	}
+	wg.Wait()
+	return ctx.Err()
+}
+
+// deliverToWebhook attempts the deliveries to a webhook in order.
+// Once an attempt fails, the following deliveries are rescheduled with it instead of being sent to the failing receiver.
+func (r *Runner) deliverToWebhook(ctx context.Context, deliveries []*store.WebhookDelivery, hook *storepb.WebhooksUserSetting_Webhook) {
+	for i, delivery := range deliveries {
+		if ctx.Err() != nil {
+			return
+		}
+		nextAttemptTs, ok := r.deliver(ctx, delivery, hook)
+		if ok || hook == nil {
+			continue
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		for _, pending := range deliveries[i+1:] {
+			if err := r.Store.UpdateWebhookDelivery(ctx, &store.UpdateWebhookDelivery{
+				ID:            pending.ID,
+				NextAttemptTs: &nextAttemptTs,
+			}); err != nil {
+				slog.Error("Failed to reschedule webhook delivery", "id", pending.ID, "error", err)
+			}
+		}
+		return
+	}
+}
+
+// deliver attempts the delivery and records the outcome on it.
+// It reports whether the delivery succeeded, and otherwise returns when the webhook should be tried again.
+func (r *Runner) deliver(ctx context.Context, delivery *store.WebhookDelivery, hook *storepb.WebhooksUserSetting_Webhook) (int64, bool) {
+	var responseCode int
+	var err error
+	if hook == nil {
+		err = errors.New("webhook no longer exists")
+	} else {
+		responseCode, err = webhook.Post(ctx, &webhook.Request{
+			URL:    delivery.URL,
+			Body:   []byte(delivery.Payload),
+			Secret: hook.Secret,
+		})
+		if err != nil && ctx.Err() != nil {
+			// The server is shutting down, leave the delivery to be retried on the next start.
+			return delivery.NextAttemptTs, false
+		}
+	}
+
+	now := time.Now()
+	updatedTs := now.Unix()
+	attempts := delivery.Attempts + 1
+	code := int32(responseCode)
+	lastError := ""
+	status := store.WebhookDeliverySucceeded
+	nextAttemptTs := delivery.NextAttemptTs
+	retryTs := nextAttemptTs
+	if err != nil {
+		lastError = err.Error()
+		if hook == nil || attempts >= MaxAttempts {
+			status = store.WebhookDeliveryFailed
+			retryTs = now.Add(baseBackoff).Unix()
+		} else {
+			status = store.WebhookDeliveryPending
+			nextAttemptTs = now.Add(Backoff(attempts)).Unix()
+			retryTs = nextAttemptTs
+		}
+		slog.Warn("Failed to deliver webhook",
+			slog.Int("id", int(delivery.ID)),
+			slog.String("url", delivery.URL),
+			slog.String("activityType", delivery.ActivityType),
+			slog.Int("attempts", int(attempts)),
+			slog.Any("err", err))
+	}
+
+	if err := r.Store.UpdateWebhookDelivery(ctx, &store.UpdateWebhookDelivery{
+		ID:            delivery.ID,
+		UpdatedTs:     &updatedTs,
+		Status:        &status,
+		Attempts:      &attempts,
+		NextAttemptTs: &nextAttemptTs,
+		ResponseCode:  &code,
+		LastError:     &lastError,
+	}); err != nil {
+		slog.Error("Failed to update webhook delivery", "id", delivery.ID, "error", err)
+	}
+	return retryTs, err == nil
+}
+
+// PruneFinished removes succeeded and failed deliveries older than the retention period.
+func (r *Runner) PruneFinished(ctx context.Context) error {
+	finishedBefore := time.Now().Add(-retention).Unix()
+	if err := r.Store.DeleteWebhookDeliveries(ctx, &store.DeleteWebhookDelivery{
+		FinishedBefore: &finishedBefore,
+	}); err != nil {
+		return errors.Wrap(err, "failed to prune webhook deliveries")
+	}
+	return nil
+}
+
+// Backoff returns the delay before the next attempt after the given number of failed attempts.
+func Backoff(attempts int32) time.Duration {
+	backoff := baseBackoff
+	for i := int32(1); i < attempts; i++ {
+		backoff *= 2
+		if backoff >= maxBackoff {
+			return maxBackoff
+		}
+	}
+	return backoff
+}
@@ -0,0 +1,89 @@
+package server
+
+import (
+	"log/slog"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/scheduler"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/server/runner/memoembedding"
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/server/runner/s3presign"
+	"github.com/usememos/memos/server/runner/webhookdelivery"
+)
+
+// emailDigestSchedule sends the daily digest at 08:00 UTC, the same time for all users.
+const emailDigestSchedule = "0 8 * * *"
+
+// startupJobs are the jobs run once when the server starts, besides their schedule.
+var startupJobs = []string{"s3-presign", "memo-embedding"}
+
+// newScheduler creates the scheduler running the background jobs, recording their state in the store.
+func newScheduler(apiV1Service *apiv1.APIV1Service) (*scheduler.Scheduler, error) {
+	// Panics are recovered inside the job state middleware, so that panicking runs are recorded as failed.
+	s := scheduler.New(scheduler.WithMiddleware(
+		apiV1Service.JobStateMiddleware(),
+		scheduler.Recovery(func(jobName string, recovered any) {
+			slog.Error("Job panicked", "job", jobName, "panic", recovered)
+		}),
+	))
+
+	webhookDeliveryRunner := webhookdelivery.NewRunner(apiV1Service.Store)
+	jobs := []*scheduler.Job{
+		{
+			Name:        "s3-presign",
+			Description: "Refreshes the presigned URLs of S3 attachments before they expire.",
+			Schedule:    s3presign.Schedule,
+			Handler:     s3presign.NewRunner(apiV1Service.Store).RunOnce,
+		},
+		{
+			Name:        "memo-payload",
+			Description: "Rebuilds the tags and properties extracted from the content of memos.",
+			Schedule:    memopayload.Schedule,
+			Handler:     memopayload.NewRunner(apiV1Service.Store, apiV1Service.MarkdownService).RunOnce,
+		},
+		{
+			Name:        "memo-embedding",
+			Description: "Generates the embeddings of new and edited memos for semantic search.",
+			Schedule:    memoembedding.Schedule,
+			Handler:     memoembedding.NewRunner(apiV1Service.Store).RunOnce,
+		},
+		{
+			Name:        "email-digest",
+			Description: "Emails the users who enabled it a daily digest of their unread notifications, at 08:00 UTC.",
+			Schedule:    emailDigestSchedule,
+			Handler:     apiV1Service.SendEmailDigests,
+		},
+		{
+			Name:        "memo-publish",
+			Description: "Publishes scheduled memos and the copies of recurring memos.",
+			Schedule:    "* * * * *",
+			Handler:     apiV1Service.PublishScheduledMemos,
+		},
+		{
+			Name:        "task-reminder",
+			Description: "Notifies the creators of the tasks which came due.",
+			Schedule:    "* * * * *",
+			Handler:     apiV1Service.RemindDueTasks,
+		},
+		{
+			Name:        "webhook-delivery",
+			Description: "Delivers pending webhook events and retries failed ones.",
+			Schedule:    webhookdelivery.Schedule,
+			Handler:     webhookDeliveryRunner.DeliverPending,
+		},
+		{
+			Name:        "webhook-delivery-prune",
+			Description: "Removes finished webhook deliveries past their retention.",
+			Schedule:    webhookdelivery.PruneSchedule,
+			Handler:     webhookDeliveryRunner.PruneFinished,
+		},
+	}
+	for _, job := range jobs {
+		if err := s.Register(job); err != nil {
+			return nil, errors.Wrapf(err, "failed to register job %q", job.Name)
+		}
+	}
+	return s, nil
+}
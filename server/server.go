@@ -15,13 +15,14 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/plugin/scheduler"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	apiv1 "github.com/usememos/memos/server/router/api/v1"
 	"github.com/usememos/memos/server/router/embedding"
 	"github.com/usememos/memos/server/router/fileserver"
 	"github.com/usememos/memos/server/router/frontend"
 	"github.com/usememos/memos/server/router/rss"
-	"github.com/usememos/memos/server/runner/s3presign"
+	"github.com/usememos/memos/server/router/scim"
 	"github.com/usememos/memos/store"
 )
 
@@ -30,8 +31,8 @@ type Server struct {
 	Profile *profile.Profile
 	Store   *store.Store
 
-	echoServer        *echo.Echo
-	runnerCancelFuncs []context.CancelFunc
+	echoServer *echo.Echo
+	scheduler  *scheduler.Scheduler
 }
 
 func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store) (*Server, error) {
@@ -63,8 +64,10 @@ func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store
 		return c.String(http.StatusOK, "Service ready.")
 	})
 
-	// Register embedding proxy for HuggingFace API (before frontend to avoid catch-all).
-	embedding.NewEmbeddingService().RegisterRoutes(echoServer)
+	// Register embedding endpoint backed by the configured provider (before frontend to avoid catch-all).
+	embedding.NewEmbeddingService(store, s.Secret).RegisterRoutes(echoServer)
+	// Register SCIM provisioning endpoint (before frontend to avoid catch-all).
+	scim.NewSCIMService(store, s.Secret).RegisterRoutes(echoServer)
 
 	// Serve frontend static files.
 	frontend.NewFrontendService(profile, store).Serve(ctx, echoServer)
@@ -80,6 +83,13 @@ func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store
 
 	// Create and register RSS routes (needs markdown service from apiV1Service).
 	rss.NewRSSService(s.Profile, s.Store, apiV1Service.MarkdownService).RegisterRoutes(rootGroup)
+	// Create the scheduler of background jobs, which the API manages once started.
+	s.scheduler, err = newScheduler(apiV1Service)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create scheduler")
+	}
+	apiV1Service.Scheduler = s.scheduler
+
 	// Register gRPC gateway as api v1.
 	if err := apiV1Service.RegisterGateway(ctx, echoServer); err != nil {
 		return nil, errors.Wrap(err, "failed to register gRPC gateway")
@@ -120,11 +130,9 @@ func (s *Server) Shutdown(ctx context.Context) {
 
 	slog.Info("server shutting down")
 
-	// Cancel all background runners
-	for _, cancelFunc := range s.runnerCancelFuncs {
-		if cancelFunc != nil {
-			cancelFunc()
-		}
+	// Stop background jobs, waiting for running ones to finish.
+	if err := s.scheduler.Stop(ctx); err != nil {
+		slog.Error("failed to stop scheduler", slog.String("error", err.Error()))
 	}
 
 	// Shutdown echo server.
@@ -140,26 +148,19 @@ func (s *Server) Shutdown(ctx context.Context) {
 	slog.Info("memos stopped properly")
 }
 
-func (s *Server) StartBackgroundRunners(ctx context.Context) {
-	// Create a separate context for each background runner
-	// This allows us to control cancellation for each runner independently
-	s3Context, s3Cancel := context.WithCancel(ctx)
-
-	// Store the cancel function so we can properly shut down runners
-	s.runnerCancelFuncs = append(s.runnerCancelFuncs, s3Cancel)
-
-	// Create and start S3 presign runner
-	s3presignRunner := s3presign.NewRunner(s.Store)
-	s3presignRunner.RunOnce(ctx)
-
-	// Start continuous S3 presign runner
-	go func() {
-		s3presignRunner.Run(s3Context)
-		slog.Info("s3presign runner stopped")
-	}()
+func (s *Server) StartBackgroundRunners(_ context.Context) {
+	if err := s.scheduler.Start(); err != nil {
+		slog.Error("failed to start scheduler", slog.String("error", err.Error()))
+		return
+	}
+	for _, name := range startupJobs {
+		if err := s.scheduler.Trigger(name); err != nil {
+			slog.Error("failed to trigger job", slog.String("job", name), slog.String("error", err.Error()))
+		}
+	}
 
 	// Log the number of goroutines running
-	slog.Info("background runners started", "goroutines", runtime.NumGoroutine())
+	slog.Info("background runners started", "jobs", len(s.scheduler.Jobs()), "goroutines", runtime.NumGoroutine())
 }
 
 func (s *Server) getOrUpsertInstanceBasicSetting(ctx context.Context) (*storepb.InstanceBasicSetting, error) {
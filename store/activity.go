@@ -9,9 +9,43 @@ import (
 type ActivityType string
 
 const (
-	ActivityTypeMemoComment ActivityType = "MEMO_COMMENT"
+	ActivityTypeMemoComment  ActivityType = "MEMO_COMMENT"
+	ActivityTypeMemoMention  ActivityType = "MEMO_MENTION"
+	ActivityTypeSignInFailed ActivityType = "SIGN_IN_FAILED"
+	ActivityTypeTaskDue      ActivityType = "TASK_DUE"
+
+	// Audit activity types record administrative and security events.
+	ActivityTypeSignIn                     ActivityType = "SIGN_IN"
+	ActivityTypeUserCreated                ActivityType = "USER_CREATED"
+	ActivityTypeUserRoleChanged            ActivityType = "USER_ROLE_CHANGED"
+	ActivityTypeUserArchived               ActivityType = "USER_ARCHIVED"
+	ActivityTypeUserRestored               ActivityType = "USER_RESTORED"
+	ActivityTypeUserDeleted                ActivityType = "USER_DELETED"
+	ActivityTypePersonalAccessTokenCreated ActivityType = "PERSONAL_ACCESS_TOKEN_CREATED"
+	ActivityTypePersonalAccessTokenDeleted ActivityType = "PERSONAL_ACCESS_TOKEN_DELETED"
+	ActivityTypeInstanceSettingUpdated     ActivityType = "INSTANCE_SETTING_UPDATED"
+	ActivityTypeIdentityProviderCreated    ActivityType = "IDENTITY_PROVIDER_CREATED"
+	ActivityTypeIdentityProviderUpdated    ActivityType = "IDENTITY_PROVIDER_UPDATED"
+	ActivityTypeIdentityProviderDeleted    ActivityType = "IDENTITY_PROVIDER_DELETED"
 )
 
+// AuditActivityTypes lists the activity types of the audit log, failed sign-ins included.
+var AuditActivityTypes = []ActivityType{
+	ActivityTypeSignIn,
+	ActivityTypeSignInFailed,
+	ActivityTypeUserCreated,
+	ActivityTypeUserRoleChanged,
+	ActivityTypeUserArchived,
+	ActivityTypeUserRestored,
+	ActivityTypeUserDeleted,
+	ActivityTypePersonalAccessTokenCreated,
+	ActivityTypePersonalAccessTokenDeleted,
+	ActivityTypeInstanceSettingUpdated,
+	ActivityTypeIdentityProviderCreated,
+	ActivityTypeIdentityProviderUpdated,
+	ActivityTypeIdentityProviderDeleted,
+}
+
 func (t ActivityType) String() string {
 	return string(t)
 }
@@ -20,6 +54,7 @@ type ActivityLevel string
 
 const (
 	ActivityLevelInfo ActivityLevel = "INFO"
+	ActivityLevelWarn ActivityLevel = "WARN"
 )
 
 func (l ActivityLevel) String() string {
@@ -40,8 +75,26 @@ type Activity struct {
 }
 
 type FindActivity struct {
-	ID   *int32
-	Type *ActivityType
+	ID       *int32
+	Type     *ActivityType
+	TypeList []ActivityType
+
+	// PartyID limits the activities to those the user is party to,
+	// either as the creator or as the receiver of an inbox message about the activity.
+	PartyID      *int32
+	ExcludeTypes []ActivityType
+	Filters      []string
+
+	// Pagination
+	Limit *int
+	// Cursor lists the activities created before the activity with the sort key, for keyset pagination.
+	Cursor *ActivityCursor
+}
+
+// ActivityCursor is the sort key of an activity, activities are listed from the newest.
+type ActivityCursor struct {
+	CreatedTs int64
+	ID        int32
 }
 
 func (s *Store) CreateActivity(ctx context.Context, create *Activity) (*Activity, error) {
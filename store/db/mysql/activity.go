@@ -2,11 +2,13 @@ package mysql
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/encoding/protojson"
 
+	"github.com/usememos/memos/plugin/filter"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
 )
@@ -54,8 +56,37 @@ func (d *DB) ListActivities(ctx context.Context, find *store.FindActivity) ([]*s
 	if find.Type != nil {
 		where, args = append(where, "`type` = ?"), append(args, find.Type.String())
 	}
+	if v := find.TypeList; len(v) != 0 {
+		placeholder := []string{}
+		for _, activityType := range v {
+			placeholder = append(placeholder, "?")
+			args = append(args, activityType.String())
+		}
+		where = append(where, fmt.Sprintf("`type` IN (%s)", strings.Join(placeholder, ",")))
+	}
+	if v := find.PartyID; v != nil {
+		where, args = append(where, "(`creator_id` = ? OR `id` IN (SELECT CAST(JSON_EXTRACT(`message`, '$.activityId') AS SIGNED) FROM `inbox` WHERE `receiver_id` = ?))"), append(args, *v, *v)
+	}
+	for _, activityType := range find.ExcludeTypes {
+		where, args = append(where, "`type` != ?"), append(args, activityType.String())
+	}
+	if len(find.Filters) > 0 {
+		engine, err := filter.DefaultActivityEngine()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get filter engine")
+		}
+		if err := filter.AppendConditions(ctx, engine, find.Filters, filter.DialectMySQL, &where, &args); err != nil {
+			return nil, errors.Wrap(err, "failed to append filter conditions")
+		}
+	}
+	if v := find.Cursor; v != nil {
+		where, args = append(where, "(`created_ts` < FROM_UNIXTIME(?) OR (`created_ts` = FROM_UNIXTIME(?) AND `id` < ?))"), append(args, v.CreatedTs, v.CreatedTs, v.ID)
+	}
 
-	query := "SELECT `id`, `creator_id`, `type`, `level`, `payload`, UNIX_TIMESTAMP(`created_ts`) FROM `activity` WHERE " + strings.Join(where, " AND ") + " ORDER BY `created_ts` DESC"
+	query := "SELECT `id`, `creator_id`, `type`, `level`, `payload`, UNIX_TIMESTAMP(`created_ts`) FROM `activity` WHERE " + strings.Join(where, " AND ") + " ORDER BY `created_ts` DESC, `id` DESC"
+	if find.Limit != nil {
+		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
+	}
 	rows, err := d.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
@@ -2,6 +2,7 @@ package mysql
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"strings"
 
@@ -37,6 +38,11 @@ func (d *DB) CreateMemo(ctx context.Context, create *store.Memo) (*store.Memo, e
 		placeholder = append(placeholder, "?")
 		args = append(args, create.UpdatedTs)
 	}
+	if create.RowStatus != "" {
+		fields = append(fields, "`row_status`")
+		placeholder = append(placeholder, "?")
+		args = append(args, create.RowStatus)
+	}
 
 	stmt := "INSERT INTO `memo` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
 	result, err := d.db.ExecContext(ctx, stmt, args...)
@@ -112,6 +118,29 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if find.ExcludeComments {
 		having = append(having, "`parent_uid` IS NULL")
 	}
+	if v := find.RemindBefore; v != nil {
+		where, args = append(where, "CAST(JSON_UNQUOTE(JSON_EXTRACT(`memo`.`payload`, '$.property.remindTs')) AS SIGNED) BETWEEN 1 AND ?"), append(args, *v)
+	}
+	if v := find.Cursor; v != nil {
+		if find.OrderByRelevance {
+			return nil, errors.New("cursor cannot be combined with relevance ordering")
+		}
+		// Memos after the cursor in the order of pinned, the timestamp and the id.
+		column, ts, operator := "`memo`.`created_ts`", v.CreatedTs, "<"
+		if find.OrderByUpdatedTs {
+			column, ts = "`memo`.`updated_ts`", v.UpdatedTs
+		}
+		if find.OrderByTimeAsc {
+			operator = ">"
+		}
+		condition := fmt.Sprintf("(%s %s FROM_UNIXTIME(?) OR (%s = FROM_UNIXTIME(?) AND `memo`.`id` < ?))", column, operator, column)
+		conditionArgs := []any{ts, ts, v.ID}
+		if find.OrderByPinned {
+			condition = "(`memo`.`pinned` < ? OR (`memo`.`pinned` = ? AND " + condition + "))"
+			conditionArgs = append([]any{v.Pinned, v.Pinned}, conditionArgs...)
+		}
+		where, args = append(where, condition), append(args, conditionArgs...)
+	}
 
 	order := "DESC"
 	if find.OrderByTimeAsc {
@@ -121,6 +150,11 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if find.OrderByPinned {
 		orderBy = append(orderBy, "`pinned` DESC")
 	}
+	if find.OrderByRelevance {
+		if err := filter.AppendSearchRank(ctx, engine, find.Filters, filter.DialectMySQL, &orderBy, &args); err != nil {
+			return nil, err
+		}
+	}
 	if find.OrderByUpdatedTs {
 		orderBy = append(orderBy, "`updated_ts` "+order)
 	} else {
@@ -134,6 +168,7 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 		"`memo`.`creator_id` AS `creator_id`",
 		"UNIX_TIMESTAMP(`memo`.`created_ts`) AS `created_ts`",
 		"UNIX_TIMESTAMP(`memo`.`updated_ts`) AS `updated_ts`",
+		"`memo`.`version` AS `version`",
 		"`memo`.`row_status` AS `row_status`",
 		"`memo`.`visibility` AS `visibility`",
 		"`memo`.`pinned` AS `pinned`",
@@ -173,6 +208,7 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 			&memo.CreatorID,
 			&memo.CreatedTs,
 			&memo.UpdatedTs,
+			&memo.Version,
 			&memo.RowStatus,
 			&memo.Visibility,
 			&memo.Pinned,
@@ -214,6 +250,25 @@ func (d *DB) GetMemo(ctx context.Context, find *store.FindMemo) (*store.Memo, er
 }
 
 func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
+	return d.UpdateMemos(ctx, []*store.UpdateMemo{update})
+}
+
+func (d *DB) UpdateMemos(ctx context.Context, updates []*store.UpdateMemo) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, update := range updates {
+		if err := updateMemo(ctx, tx, update); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func updateMemo(ctx context.Context, tx *sql.Tx, update *store.UpdateMemo) error {
 	set, args := []string{}, []any{}
 	if v := update.UID; v != nil {
 		set, args = append(set, "`uid` = ?"), append(args, *v)
@@ -243,15 +298,30 @@ func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
 		}
 		set, args = append(set, "`payload` = ?"), append(args, string(payloadBytes))
 	}
-	if len(set) == 0 {
+	if len(set) == 0 && update.ExpectedVersion == nil {
 		return nil
 	}
+	set = append(set, "`version` = `version` + 1")
+	where := []string{"`id` = ?"}
 	args = append(args, update.ID)
+	if v := update.ExpectedVersion; v != nil {
+		where, args = append(where, "`version` = ?"), append(args, *v)
+	}
 
-	stmt := "UPDATE `memo` SET " + strings.Join(set, ", ") + " WHERE `id` = ?"
-	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+	stmt := "UPDATE `memo` SET " + strings.Join(set, ", ") + " WHERE " + strings.Join(where, " AND ")
+	result, err := tx.ExecContext(ctx, stmt, args...)
+	if err != nil {
 		return err
 	}
+	if update.ExpectedVersion != nil {
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return store.ErrMemoModified
+		}
+	}
 	return nil
 }
 
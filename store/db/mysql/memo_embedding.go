@@ -0,0 +1,82 @@
+package mysql
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) UpsertMemoEmbedding(ctx context.Context, upsert *store.MemoEmbedding) (*store.MemoEmbedding, error) {
+	stmt := "INSERT INTO `memo_embedding` (`memo_id`, `model`, `content_hash`, `embedding`) VALUES (?, ?, ?, ?) ON DUPLICATE KEY UPDATE `model` = VALUES(`model`), `content_hash` = VALUES(`content_hash`), `embedding` = VALUES(`embedding`), `updated_ts` = CURRENT_TIMESTAMP"
+	if _, err := d.db.ExecContext(ctx, stmt, upsert.MemoID, upsert.Model, upsert.ContentHash, store.EncodeEmbedding(upsert.Embedding)); err != nil {
+		return nil, err
+	}
+
+	list, err := d.ListMemoEmbeddings(ctx, &store.FindMemoEmbedding{MemoID: &upsert.MemoID})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) != 1 {
+		return nil, errors.Errorf("failed to upsert memo embedding")
+	}
+	return list[0], nil
+}
+
+func (d *DB) ListMemoEmbeddings(ctx context.Context, find *store.FindMemoEmbedding) ([]*store.MemoEmbedding, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.MemoID != nil {
+		where, args = append(where, "`memo_id` = ?"), append(args, *find.MemoID)
+	}
+	if find.Model != nil {
+		where, args = append(where, "`model` = ?"), append(args, *find.Model)
+	}
+
+	rows, err := d.db.QueryContext(ctx, "SELECT `memo_id`, UNIX_TIMESTAMP(`updated_ts`), `model`, `content_hash`, `embedding` FROM `memo_embedding` WHERE "+strings.Join(where, " AND ")+" ORDER BY `memo_id` ASC", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoEmbedding{}
+	for rows.Next() {
+		memoEmbedding := &store.MemoEmbedding{}
+		var data []byte
+		if err := rows.Scan(
+			&memoEmbedding.MemoID,
+			&memoEmbedding.UpdatedTs,
+			&memoEmbedding.Model,
+			&memoEmbedding.ContentHash,
+			&data,
+		); err != nil {
+			return nil, err
+		}
+		if memoEmbedding.Embedding, err = store.DecodeEmbedding(data); err != nil {
+			return nil, err
+		}
+		list = append(list, memoEmbedding)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteMemoEmbedding(ctx context.Context, delete *store.DeleteMemoEmbedding) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if delete.MemoID != nil {
+		where, args = append(where, "`memo_id` = ?"), append(args, *delete.MemoID)
+	}
+	result, err := d.db.ExecContext(ctx, "DELETE FROM `memo_embedding` WHERE "+strings.Join(where, " AND "), args...)
+	if err != nil {
+		return err
+	}
+	if _, err := result.RowsAffected(); err != nil {
+		return err
+	}
+	return nil
+}
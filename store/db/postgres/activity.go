@@ -2,11 +2,13 @@ package postgres
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/encoding/protojson"
 
+	"github.com/usememos/memos/plugin/filter"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
 )
@@ -42,8 +44,39 @@ func (d *DB) ListActivities(ctx context.Context, find *store.FindActivity) ([]*s
 	if find.Type != nil {
 		where, args = append(where, "type = "+placeholder(len(args)+1)), append(args, find.Type.String())
 	}
+	if v := find.TypeList; len(v) != 0 {
+		holders := []string{}
+		for _, activityType := range v {
+			holders = append(holders, placeholder(len(args)+1))
+			args = append(args, activityType.String())
+		}
+		where = append(where, fmt.Sprintf("type IN (%s)", strings.Join(holders, ", ")))
+	}
+	if v := find.PartyID; v != nil {
+		where = append(where, fmt.Sprintf("(creator_id = %s OR id IN (SELECT (message::JSONB->>'activityId')::INTEGER FROM inbox WHERE receiver_id = %s))", placeholder(len(args)+1), placeholder(len(args)+2)))
+		args = append(args, *v, *v)
+	}
+	for _, activityType := range find.ExcludeTypes {
+		where, args = append(where, "type != "+placeholder(len(args)+1)), append(args, activityType.String())
+	}
+	if len(find.Filters) > 0 {
+		engine, err := filter.DefaultActivityEngine()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get filter engine")
+		}
+		if err := filter.AppendConditions(ctx, engine, find.Filters, filter.DialectPostgres, &where, &args); err != nil {
+			return nil, errors.Wrap(err, "failed to append filter conditions")
+		}
+	}
+	if v := find.Cursor; v != nil {
+		where = append(where, fmt.Sprintf("(created_ts < %s OR (created_ts = %s AND id < %s))", placeholder(len(args)+1), placeholder(len(args)+2), placeholder(len(args)+3)))
+		args = append(args, v.CreatedTs, v.CreatedTs, v.ID)
+	}
 
-	query := "SELECT id, creator_id, type, level, payload, created_ts FROM activity WHERE " + strings.Join(where, " AND ") + " ORDER BY created_ts DESC"
+	query := "SELECT id, creator_id, type, level, payload, created_ts FROM activity WHERE " + strings.Join(where, " AND ") + " ORDER BY created_ts DESC, id DESC"
+	if find.Limit != nil {
+		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
+	}
 	rows, err := d.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
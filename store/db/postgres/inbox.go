@@ -61,6 +61,15 @@ func (d *DB) ListInboxes(ctx context.Context, find *store.FindInbox) ([]*store.I
 		}
 	}
 
+	if v := find.MessageTypeList; len(v) != 0 {
+		holders := []string{}
+		for _, messageType := range v {
+			holders = append(holders, placeholder(len(args)+1))
+			args = append(args, messageType.String())
+		}
+		where = append(where, fmt.Sprintf("message::JSONB->>'type' IN (%s)", strings.Join(holders, ",")))
+	}
+
 	query := "SELECT id, created_ts, sender_id, receiver_id, status, message FROM inbox WHERE " + strings.Join(where, " AND ") + " ORDER BY created_ts DESC"
 	if find.Limit != nil {
 		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"strings"
 
@@ -34,6 +35,10 @@ func (d *DB) CreateMemo(ctx context.Context, create *store.Memo) (*store.Memo, e
 		fields = append(fields, "updated_ts")
 		args = append(args, create.UpdatedTs)
 	}
+	if create.RowStatus != "" {
+		fields = append(fields, "row_status")
+		args = append(args, create.RowStatus)
+	}
 
 	stmt := "INSERT INTO memo (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, created_ts, updated_ts, row_status"
 	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
@@ -97,6 +102,31 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if find.ExcludeComments {
 		where = append(where, "memo_relation.related_memo_id IS NULL")
 	}
+	if v := find.RemindBefore; v != nil {
+		where, args = append(where, "(memo.payload->'property'->>'remindTs')::BIGINT BETWEEN 1 AND "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.Cursor; v != nil {
+		if find.OrderByRelevance {
+			return nil, errors.New("cursor cannot be combined with relevance ordering")
+		}
+		// Memos after the cursor in the order of pinned, the timestamp and the id.
+		column, ts, operator := "memo.created_ts", v.CreatedTs, "<"
+		if find.OrderByUpdatedTs {
+			column, ts = "memo.updated_ts", v.UpdatedTs
+		}
+		if find.OrderByTimeAsc {
+			operator = ">"
+		}
+		// Postgres placeholders are numbered, so the pinned arguments can follow the others.
+		n := len(args)
+		condition := fmt.Sprintf("(%s %s %s OR (%s = %s AND memo.id < %s))", column, operator, placeholder(n+1), column, placeholder(n+2), placeholder(n+3))
+		conditionArgs := []any{ts, ts, v.ID}
+		if find.OrderByPinned {
+			condition = fmt.Sprintf("(memo.pinned < %s OR (memo.pinned = %s AND %s))", placeholder(n+4), placeholder(n+5), condition)
+			conditionArgs = append(conditionArgs, v.Pinned, v.Pinned)
+		}
+		where, args = append(where, condition), append(args, conditionArgs...)
+	}
 
 	order := "DESC"
 	if find.OrderByTimeAsc {
@@ -106,6 +136,11 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if find.OrderByPinned {
 		orderBy = append(orderBy, "pinned DESC")
 	}
+	if find.OrderByRelevance {
+		if err := filter.AppendSearchRank(ctx, engine, find.Filters, filter.DialectPostgres, &orderBy, &args); err != nil {
+			return nil, err
+		}
+	}
 	if find.OrderByUpdatedTs {
 		orderBy = append(orderBy, "updated_ts "+order)
 	} else {
@@ -119,6 +154,7 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 		`memo.creator_id AS creator_id`,
 		`memo.created_ts AS created_ts`,
 		`memo.updated_ts AS updated_ts`,
+		`memo.version AS version`,
 		`memo.row_status AS row_status`,
 		`memo.visibility AS visibility`,
 		`memo.pinned AS pinned`,
@@ -158,6 +194,7 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 			&memo.CreatorID,
 			&memo.CreatedTs,
 			&memo.UpdatedTs,
+			&memo.Version,
 			&memo.RowStatus,
 			&memo.Visibility,
 			&memo.Pinned,
@@ -199,6 +236,25 @@ func (d *DB) GetMemo(ctx context.Context, find *store.FindMemo) (*store.Memo, er
 }
 
 func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
+	return d.UpdateMemos(ctx, []*store.UpdateMemo{update})
+}
+
+func (d *DB) UpdateMemos(ctx context.Context, updates []*store.UpdateMemo) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, update := range updates {
+		if err := updateMemo(ctx, tx, update); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func updateMemo(ctx context.Context, tx *sql.Tx, update *store.UpdateMemo) error {
 	set, args := []string{}, []any{}
 	if v := update.UID; v != nil {
 		set, args = append(set, "uid = "+placeholder(len(args)+1)), append(args, *v)
@@ -228,15 +284,30 @@ func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
 		}
 		set, args = append(set, "payload = "+placeholder(len(args)+1)), append(args, string(payloadBytes))
 	}
-	if len(set) == 0 {
+	if len(set) == 0 && update.ExpectedVersion == nil {
 		return nil
 	}
-
-	stmt := `UPDATE memo SET ` + strings.Join(set, ", ") + ` WHERE id = ` + placeholder(len(args)+1)
+	set = append(set, "version = version + 1")
+	where := []string{"id = " + placeholder(len(args)+1)}
 	args = append(args, update.ID)
-	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+	if v := update.ExpectedVersion; v != nil {
+		where, args = append(where, "version = "+placeholder(len(args)+1)), append(args, *v)
+	}
+
+	stmt := `UPDATE memo SET ` + strings.Join(set, ", ") + ` WHERE ` + strings.Join(where, " AND ")
+	result, err := tx.ExecContext(ctx, stmt, args...)
+	if err != nil {
 		return err
 	}
+	if update.ExpectedVersion != nil {
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return store.ErrMemoModified
+		}
+	}
 	return nil
 }
 
@@ -0,0 +1,74 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) UpsertMemoEmbedding(ctx context.Context, upsert *store.MemoEmbedding) (*store.MemoEmbedding, error) {
+	stmt := "INSERT INTO memo_embedding (memo_id, model, content_hash, embedding) VALUES (" + placeholders(4) + ") ON CONFLICT (memo_id) DO UPDATE SET model = EXCLUDED.model, content_hash = EXCLUDED.content_hash, embedding = EXCLUDED.embedding, updated_ts = EXTRACT(EPOCH FROM NOW()) RETURNING updated_ts"
+	if err := d.db.QueryRowContext(ctx, stmt, upsert.MemoID, upsert.Model, upsert.ContentHash, store.EncodeEmbedding(upsert.Embedding)).Scan(
+		&upsert.UpdatedTs,
+	); err != nil {
+		return nil, err
+	}
+	return upsert, nil
+}
+
+func (d *DB) ListMemoEmbeddings(ctx context.Context, find *store.FindMemoEmbedding) ([]*store.MemoEmbedding, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.MemoID != nil {
+		where, args = append(where, "memo_id = "+placeholder(len(args)+1)), append(args, *find.MemoID)
+	}
+	if find.Model != nil {
+		where, args = append(where, "model = "+placeholder(len(args)+1)), append(args, *find.Model)
+	}
+
+	rows, err := d.db.QueryContext(ctx, "SELECT memo_id, updated_ts, model, content_hash, embedding FROM memo_embedding WHERE "+strings.Join(where, " AND ")+" ORDER BY memo_id ASC", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoEmbedding{}
+	for rows.Next() {
+		memoEmbedding := &store.MemoEmbedding{}
+		var data []byte
+		if err := rows.Scan(
+			&memoEmbedding.MemoID,
+			&memoEmbedding.UpdatedTs,
+			&memoEmbedding.Model,
+			&memoEmbedding.ContentHash,
+			&data,
+		); err != nil {
+			return nil, err
+		}
+		if memoEmbedding.Embedding, err = store.DecodeEmbedding(data); err != nil {
+			return nil, err
+		}
+		list = append(list, memoEmbedding)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteMemoEmbedding(ctx context.Context, delete *store.DeleteMemoEmbedding) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if delete.MemoID != nil {
+		where, args = append(where, "memo_id = "+placeholder(len(args)+1)), append(args, *delete.MemoID)
+	}
+	result, err := d.db.ExecContext(ctx, "DELETE FROM memo_embedding WHERE "+strings.Join(where, " AND "), args...)
+	if err != nil {
+		return err
+	}
+	if _, err := result.RowsAffected(); err != nil {
+		return err
+	}
+	return nil
+}
@@ -97,6 +97,14 @@ func (d *DB) ListUsers(ctx context.Context, find *store.FindUser) ([]*store.User
 	if v := find.Username; v != nil {
 		where, args = append(where, "username = "+placeholder(len(args)+1)), append(args, *v)
 	}
+	if len(find.UsernameList) > 0 {
+		holders := make([]string, 0, len(find.UsernameList))
+		for _, username := range find.UsernameList {
+			holders = append(holders, placeholder(len(args)+1))
+			args = append(args, strings.ToLower(username))
+		}
+		where = append(where, "LOWER(username) IN ("+strings.Join(holders, ", ")+")")
+	}
 	if v := find.Role; v != nil {
 		where, args = append(where, "role = "+placeholder(len(args)+1)), append(args, *v)
 	}
@@ -0,0 +1,151 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateWebhookDelivery(ctx context.Context, create *store.WebhookDelivery) (*store.WebhookDelivery, error) {
+	fields := []string{"creator_id", "webhook_id", "url", "activity_type", "payload", "status", "next_attempt_ts", "last_error"}
+	args := []any{create.CreatorID, create.WebhookID, create.URL, create.ActivityType, create.Payload, create.Status, create.NextAttemptTs, create.LastError}
+	stmt := "INSERT INTO webhook_delivery (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, created_ts, updated_ts"
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+		&create.ID,
+		&create.CreatedTs,
+		&create.UpdatedTs,
+	); err != nil {
+		return nil, err
+	}
+
+	return create, nil
+}
+
+func (d *DB) ListWebhookDeliveries(ctx context.Context, find *store.FindWebhookDelivery) ([]*store.WebhookDelivery, error) {
+	where, args := []string{"1 = 1"}, []any{}
+
+	if find.ID != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *find.ID)
+	}
+	if find.CreatorID != nil {
+		where, args = append(where, "creator_id = "+placeholder(len(args)+1)), append(args, *find.CreatorID)
+	}
+	if find.WebhookID != nil {
+		where, args = append(where, "webhook_id = "+placeholder(len(args)+1)), append(args, *find.WebhookID)
+	}
+	if find.Status != nil {
+		where, args = append(where, "status = "+placeholder(len(args)+1)), append(args, *find.Status)
+	}
+	if find.NextAttemptBefore != nil {
+		where, args = append(where, "next_attempt_ts <= "+placeholder(len(args)+1)), append(args, *find.NextAttemptBefore)
+	}
+
+	orderBy := "id DESC"
+	if find.OrderByIDAsc {
+		orderBy = "id ASC"
+	}
+	query := "SELECT id, created_ts, updated_ts, creator_id, webhook_id, url, activity_type, payload, status, attempts, next_attempt_ts, response_code, last_error FROM webhook_delivery WHERE " + strings.Join(where, " AND ") + " ORDER BY " + orderBy
+	if find.Limit != nil {
+		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
+		if find.Offset != nil {
+			query = fmt.Sprintf("%s OFFSET %d", query, *find.Offset)
+		}
+	}
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.WebhookDelivery{}
+	for rows.Next() {
+		delivery := &store.WebhookDelivery{}
+		if err := rows.Scan(
+			&delivery.ID,
+			&delivery.CreatedTs,
+			&delivery.UpdatedTs,
+			&delivery.CreatorID,
+			&delivery.WebhookID,
+			&delivery.URL,
+			&delivery.ActivityType,
+			&delivery.Payload,
+			&delivery.Status,
+			&delivery.Attempts,
+			&delivery.NextAttemptTs,
+			&delivery.ResponseCode,
+			&delivery.LastError,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, delivery)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) UpdateWebhookDelivery(ctx context.Context, update *store.UpdateWebhookDelivery) error {
+	set, args := []string{}, []any{}
+	if v := update.UpdatedTs; v != nil {
+		set, args = append(set, "updated_ts = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.Status; v != nil {
+		set, args = append(set, "status = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.Attempts; v != nil {
+		set, args = append(set, "attempts = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.NextAttemptTs; v != nil {
+		set, args = append(set, "next_attempt_ts = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.ResponseCode; v != nil {
+		set, args = append(set, "response_code = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.LastError; v != nil {
+		set, args = append(set, "last_error = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if len(set) == 0 {
+		return errors.New("no fields to update")
+	}
+
+	stmt := "UPDATE webhook_delivery SET " + strings.Join(set, ", ") + " WHERE id = " + placeholder(len(args)+1)
+	args = append(args, update.ID)
+	result, err := d.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return errors.Wrap(err, "failed to update webhook delivery")
+	}
+	if _, err := result.RowsAffected(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (d *DB) DeleteWebhookDeliveries(ctx context.Context, delete *store.DeleteWebhookDelivery) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if delete.CreatorID != nil {
+		where, args = append(where, "creator_id = "+placeholder(len(args)+1)), append(args, *delete.CreatorID)
+	}
+	if delete.WebhookID != nil {
+		where, args = append(where, "webhook_id = "+placeholder(len(args)+1)), append(args, *delete.WebhookID)
+	}
+	if delete.FinishedBefore != nil {
+		where = append(where, "status != "+placeholder(len(args)+1), "updated_ts < "+placeholder(len(args)+2))
+		args = append(args, store.WebhookDeliveryPending, *delete.FinishedBefore)
+	}
+
+	result, err := d.db.ExecContext(ctx, "DELETE FROM webhook_delivery WHERE "+strings.Join(where, " AND "), args...)
+	if err != nil {
+		return err
+	}
+	if _, err := result.RowsAffected(); err != nil {
+		return err
+	}
+	return nil
+}
@@ -62,6 +62,15 @@ func (d *DB) ListInboxes(ctx context.Context, find *store.FindInbox) ([]*store.I
 		}
 	}
 
+	if v := find.MessageTypeList; len(v) != 0 {
+		placeholder := []string{}
+		for _, messageType := range v {
+			placeholder = append(placeholder, "?")
+			args = append(args, messageType.String())
+		}
+		where = append(where, fmt.Sprintf("JSON_EXTRACT(`message`, '$.type') IN (%s)", strings.Join(placeholder, ",")))
+	}
+
 	query := "SELECT `id`, `created_ts`, `sender_id`, `receiver_id`, `status`, `message` FROM `inbox` WHERE " + strings.Join(where, " AND ") + " ORDER BY `created_ts` DESC"
 	if find.Limit != nil {
 		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
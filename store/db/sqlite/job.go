@@ -0,0 +1,81 @@
+package sqlite
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) UpsertJob(ctx context.Context, upsert *store.UpsertJob) (*store.Job, error) {
+	// Only the given fields are updated, so that recording a run does not undo a concurrent pause.
+	set := []string{"`updated_ts` = strftime('%s', 'now')"}
+	job := &store.Job{Name: upsert.Name}
+	if v := upsert.Paused; v != nil {
+		job.Paused = *v
+		set = append(set, "`paused` = EXCLUDED.`paused`")
+	}
+	if v := upsert.LastRunTs; v != nil {
+		job.LastRunTs = *v
+		set = append(set, "`last_run_ts` = EXCLUDED.`last_run_ts`")
+	}
+	if v := upsert.LastError; v != nil {
+		job.LastError = *v
+		set = append(set, "`last_error` = EXCLUDED.`last_error`")
+	}
+	if v := upsert.NextRunTs; v != nil {
+		job.NextRunTs = *v
+		set = append(set, "`next_run_ts` = EXCLUDED.`next_run_ts`")
+	}
+
+	stmt := "INSERT INTO `job` (`name`, `paused`, `last_run_ts`, `last_error`, `next_run_ts`) VALUES (?, ?, ?, ?, ?) ON CONFLICT(`name`) DO UPDATE SET " + strings.Join(set, ", ")
+	if _, err := d.db.ExecContext(ctx, stmt, job.Name, job.Paused, job.LastRunTs, job.LastError, job.NextRunTs); err != nil {
+		return nil, err
+	}
+
+	list, err := d.ListJobs(ctx, &store.FindJob{Name: &upsert.Name})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) != 1 {
+		return nil, errors.Errorf("failed to upsert job")
+	}
+	return list[0], nil
+}
+
+func (d *DB) ListJobs(ctx context.Context, find *store.FindJob) ([]*store.Job, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.Name != nil {
+		where, args = append(where, "`name` = ?"), append(args, *find.Name)
+	}
+
+	rows, err := d.db.QueryContext(ctx, "SELECT `name`, `updated_ts`, `paused`, `last_run_ts`, `last_error`, `next_run_ts` FROM `job` WHERE "+strings.Join(where, " AND ")+" ORDER BY `name` ASC", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.Job{}
+	for rows.Next() {
+		job := &store.Job{}
+		if err := rows.Scan(
+			&job.Name,
+			&job.UpdatedTs,
+			&job.Paused,
+			&job.LastRunTs,
+			&job.LastError,
+			&job.NextRunTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, job)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
@@ -2,6 +2,7 @@ package sqlite
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"strings"
 
@@ -37,9 +38,20 @@ func (d *DB) CreateMemo(ctx context.Context, create *store.Memo) (*store.Memo, e
 		placeholder = append(placeholder, "?")
 		args = append(args, create.UpdatedTs)
 	}
+	if create.RowStatus != "" {
+		fields = append(fields, "`row_status`")
+		placeholder = append(placeholder, "?")
+		args = append(args, create.RowStatus)
+	}
+
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
 
 	stmt := "INSERT INTO `memo` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ") RETURNING `id`, `created_ts`, `updated_ts`, `row_status`"
-	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+	if err := tx.QueryRowContext(ctx, stmt, args...).Scan(
 		&create.ID,
 		&create.CreatedTs,
 		&create.UpdatedTs,
@@ -47,6 +59,12 @@ func (d *DB) CreateMemo(ctx context.Context, create *store.Memo) (*store.Memo, e
 	); err != nil {
 		return nil, err
 	}
+	if err := upsertMemoSearchIndex(ctx, tx, create.ID, create.Content); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
 
 	return create, nil
 }
@@ -104,6 +122,29 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if find.ExcludeComments {
 		where = append(where, "`parent_uid` IS NULL")
 	}
+	if v := find.RemindBefore; v != nil {
+		where, args = append(where, "CAST(JSON_EXTRACT(`memo`.`payload`, '$.property.remindTs') AS INTEGER) BETWEEN 1 AND ?"), append(args, *v)
+	}
+	if v := find.Cursor; v != nil {
+		if find.OrderByRelevance {
+			return nil, errors.New("cursor cannot be combined with relevance ordering")
+		}
+		// Memos after the cursor in the order of pinned, the timestamp and the id.
+		column, ts, operator := "`memo`.`created_ts`", v.CreatedTs, "<"
+		if find.OrderByUpdatedTs {
+			column, ts = "`memo`.`updated_ts`", v.UpdatedTs
+		}
+		if find.OrderByTimeAsc {
+			operator = ">"
+		}
+		condition := fmt.Sprintf("(%s %s ? OR (%s = ? AND `memo`.`id` < ?))", column, operator, column)
+		conditionArgs := []any{ts, ts, v.ID}
+		if find.OrderByPinned {
+			condition = "(`memo`.`pinned` < ? OR (`memo`.`pinned` = ? AND " + condition + "))"
+			conditionArgs = append([]any{v.Pinned, v.Pinned}, conditionArgs...)
+		}
+		where, args = append(where, condition), append(args, conditionArgs...)
+	}
 
 	order := "DESC"
 	if find.OrderByTimeAsc {
@@ -113,6 +154,11 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if find.OrderByPinned {
 		orderBy = append(orderBy, "`pinned` DESC")
 	}
+	if find.OrderByRelevance {
+		if err := filter.AppendSearchRank(ctx, engine, find.Filters, filter.DialectSQLite, &orderBy, &args); err != nil {
+			return nil, err
+		}
+	}
 	if find.OrderByUpdatedTs {
 		orderBy = append(orderBy, "`updated_ts` "+order)
 	} else {
@@ -126,6 +172,7 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 		"`memo`.`creator_id` AS `creator_id`",
 		"`memo`.`created_ts` AS `created_ts`",
 		"`memo`.`updated_ts` AS `updated_ts`",
+		"`memo`.`version` AS `version`",
 		"`memo`.`row_status` AS `row_status`",
 		"`memo`.`visibility` AS `visibility`",
 		"`memo`.`pinned` AS `pinned`",
@@ -164,6 +211,7 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 			&memo.CreatorID,
 			&memo.CreatedTs,
 			&memo.UpdatedTs,
+			&memo.Version,
 			&memo.RowStatus,
 			&memo.Visibility,
 			&memo.Pinned,
@@ -192,6 +240,25 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 }
 
 func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
+	return d.UpdateMemos(ctx, []*store.UpdateMemo{update})
+}
+
+func (d *DB) UpdateMemos(ctx context.Context, updates []*store.UpdateMemo) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, update := range updates {
+		if err := updateMemo(ctx, tx, update); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func updateMemo(ctx context.Context, tx *sql.Tx, update *store.UpdateMemo) error {
 	set, args := []string{}, []any{}
 	if v := update.UID; v != nil {
 		set, args = append(set, "`uid` = ?"), append(args, *v)
@@ -221,27 +288,66 @@ func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
 		}
 		set, args = append(set, "`payload` = ?"), append(args, string(payloadBytes))
 	}
-	if len(set) == 0 {
+	if len(set) == 0 && update.ExpectedVersion == nil {
 		return nil
 	}
+	set = append(set, "`version` = `version` + 1")
+	where := []string{"`id` = ?"}
 	args = append(args, update.ID)
+	if v := update.ExpectedVersion; v != nil {
+		where, args = append(where, "`version` = ?"), append(args, *v)
+	}
 
-	stmt := "UPDATE `memo` SET " + strings.Join(set, ", ") + " WHERE `id` = ?"
-	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+	stmt := "UPDATE `memo` SET " + strings.Join(set, ", ") + " WHERE " + strings.Join(where, " AND ")
+	result, err := tx.ExecContext(ctx, stmt, args...)
+	if err != nil {
 		return err
 	}
+	if update.ExpectedVersion != nil {
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return store.ErrMemoModified
+		}
+	}
+	if v := update.Content; v != nil {
+		if err := upsertMemoSearchIndex(ctx, tx, update.ID, *v); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 func (d *DB) DeleteMemo(ctx context.Context, delete *store.DeleteMemo) error {
 	where, args := []string{"`id` = ?"}, []any{delete.ID}
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
 	stmt := "DELETE FROM `memo` WHERE " + strings.Join(where, " AND ")
-	result, err := d.db.ExecContext(ctx, stmt, args...)
+	result, err := tx.ExecContext(ctx, stmt, args...)
 	if err != nil {
 		return err
 	}
 	if _, err := result.RowsAffected(); err != nil {
 		return err
 	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM `memo_fts` WHERE `rowid` = ?", delete.ID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// upsertMemoSearchIndex replaces the content of the memo in the FTS5 table backing search().
+// The FTS5 table is not an external content table, so it is kept in sync with the memo table here.
+func upsertMemoSearchIndex(ctx context.Context, tx *sql.Tx, memoID int32, content string) error {
+	stmt := "INSERT OR REPLACE INTO `memo_fts` (`rowid`, `content`) VALUES (?, ?)"
+	if _, err := tx.ExecContext(ctx, stmt, memoID, content); err != nil {
+		return errors.Wrap(err, "failed to update memo search index")
+	}
 	return nil
 }
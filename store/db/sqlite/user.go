@@ -98,6 +98,14 @@ func (d *DB) ListUsers(ctx context.Context, find *store.FindUser) ([]*store.User
 	if v := find.Username; v != nil {
 		where, args = append(where, "username = ?"), append(args, *v)
 	}
+	if len(find.UsernameList) > 0 {
+		placeholders := make([]string, 0, len(find.UsernameList))
+		for _, username := range find.UsernameList {
+			placeholders = append(placeholders, "?")
+			args = append(args, strings.ToLower(username))
+		}
+		where = append(where, "LOWER(username) IN ("+strings.Join(placeholders, ",")+")")
+	}
 	if v := find.Role; v != nil {
 		where, args = append(where, "role = ?"), append(args, *v)
 	}
@@ -27,6 +27,7 @@ type Driver interface {
 	CreateMemo(ctx context.Context, create *Memo) (*Memo, error)
 	ListMemos(ctx context.Context, find *FindMemo) ([]*Memo, error)
 	UpdateMemo(ctx context.Context, update *UpdateMemo) error
+	UpdateMemos(ctx context.Context, updates []*UpdateMemo) error
 	DeleteMemo(ctx context.Context, delete *DeleteMemo) error
 
 	// MemoRelation model related methods.
@@ -67,4 +68,24 @@ type Driver interface {
 	ListReactions(ctx context.Context, find *FindReaction) ([]*Reaction, error)
 	GetReaction(ctx context.Context, find *FindReaction) (*Reaction, error)
 	DeleteReaction(ctx context.Context, delete *DeleteReaction) error
+
+	// WebhookDelivery model related methods.
+	CreateWebhookDelivery(ctx context.Context, create *WebhookDelivery) (*WebhookDelivery, error)
+	ListWebhookDeliveries(ctx context.Context, find *FindWebhookDelivery) ([]*WebhookDelivery, error)
+	UpdateWebhookDelivery(ctx context.Context, update *UpdateWebhookDelivery) error
+	DeleteWebhookDeliveries(ctx context.Context, delete *DeleteWebhookDelivery) error
+
+	// MemoEmbedding model related methods.
+	UpsertMemoEmbedding(ctx context.Context, upsert *MemoEmbedding) (*MemoEmbedding, error)
+	ListMemoEmbeddings(ctx context.Context, find *FindMemoEmbedding) ([]*MemoEmbedding, error)
+	DeleteMemoEmbedding(ctx context.Context, delete *DeleteMemoEmbedding) error
+
+	// MemoRevision model related methods.
+	CreateMemoRevision(ctx context.Context, create *MemoRevision) (*MemoRevision, error)
+	ListMemoRevisions(ctx context.Context, find *FindMemoRevision) ([]*MemoRevision, error)
+	DeleteMemoRevisions(ctx context.Context, delete *DeleteMemoRevision) error
+
+	// Job model related methods.
+	UpsertJob(ctx context.Context, upsert *UpsertJob) (*Job, error)
+	ListJobs(ctx context.Context, find *FindJob) ([]*Job, error)
 }
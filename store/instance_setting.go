@@ -37,6 +37,10 @@ func (s *Store) UpsertInstanceSetting(ctx context.Context, upsert *storepb.Insta
 		valueBytes, err = protojson.Marshal(upsert.GetStorageSetting())
 	} else if upsert.Key == storepb.InstanceSettingKey_MEMO_RELATED {
 		valueBytes, err = protojson.Marshal(upsert.GetMemoRelatedSetting())
+	} else if upsert.Key == storepb.InstanceSettingKey_EMBEDDING {
+		valueBytes, err = protojson.Marshal(upsert.GetEmbeddingSetting())
+	} else if upsert.Key == storepb.InstanceSettingKey_SMTP {
+		valueBytes, err = protojson.Marshal(upsert.GetSmtpSetting())
 	} else {
 		return nil, errors.Errorf("unsupported instance setting key: %v", upsert.Key)
 	}
@@ -202,6 +206,44 @@ func (s *Store) GetInstanceStorageSetting(ctx context.Context) (*storepb.Instanc
 	return instanceStorageSetting, nil
 }
 
+func (s *Store) GetInstanceEmbeddingSetting(ctx context.Context) (*storepb.InstanceEmbeddingSetting, error) {
+	instanceSetting, err := s.GetInstanceSetting(ctx, &FindInstanceSetting{
+		Name: storepb.InstanceSettingKey_EMBEDDING.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get instance embedding setting")
+	}
+
+	instanceEmbeddingSetting := &storepb.InstanceEmbeddingSetting{}
+	if instanceSetting != nil {
+		instanceEmbeddingSetting = instanceSetting.GetEmbeddingSetting()
+	}
+	s.instanceSettingCache.Set(ctx, storepb.InstanceSettingKey_EMBEDDING.String(), &storepb.InstanceSetting{
+		Key:   storepb.InstanceSettingKey_EMBEDDING,
+		Value: &storepb.InstanceSetting_EmbeddingSetting{EmbeddingSetting: instanceEmbeddingSetting},
+	})
+	return instanceEmbeddingSetting, nil
+}
+
+func (s *Store) GetInstanceSMTPSetting(ctx context.Context) (*storepb.InstanceSmtpSetting, error) {
+	instanceSetting, err := s.GetInstanceSetting(ctx, &FindInstanceSetting{
+		Name: storepb.InstanceSettingKey_SMTP.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get instance smtp setting")
+	}
+
+	instanceSMTPSetting := &storepb.InstanceSmtpSetting{}
+	if instanceSetting != nil {
+		instanceSMTPSetting = instanceSetting.GetSmtpSetting()
+	}
+	s.instanceSettingCache.Set(ctx, storepb.InstanceSettingKey_SMTP.String(), &storepb.InstanceSetting{
+		Key:   storepb.InstanceSettingKey_SMTP,
+		Value: &storepb.InstanceSetting_SmtpSetting{SmtpSetting: instanceSMTPSetting},
+	})
+	return instanceSMTPSetting, nil
+}
+
 func convertInstanceSettingFromRaw(instanceSettingRaw *InstanceSetting) (*storepb.InstanceSetting, error) {
 	instanceSetting := &storepb.InstanceSetting{
 		Key: storepb.InstanceSettingKey(storepb.InstanceSettingKey_value[instanceSettingRaw.Name]),
@@ -231,6 +273,18 @@ func convertInstanceSettingFromRaw(instanceSettingRaw *InstanceSetting) (*storep
 			return nil, err
 		}
 		instanceSetting.Value = &storepb.InstanceSetting_MemoRelatedSetting{MemoRelatedSetting: memoRelatedSetting}
+	case storepb.InstanceSettingKey_EMBEDDING.String():
+		embeddingSetting := &storepb.InstanceEmbeddingSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(instanceSettingRaw.Value), embeddingSetting); err != nil {
+			return nil, err
+		}
+		instanceSetting.Value = &storepb.InstanceSetting_EmbeddingSetting{EmbeddingSetting: embeddingSetting}
+	case storepb.InstanceSettingKey_SMTP.String():
+		smtpSetting := &storepb.InstanceSmtpSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(instanceSettingRaw.Value), smtpSetting); err != nil {
+			return nil, err
+		}
+		instanceSetting.Value = &storepb.InstanceSetting_SmtpSetting{SmtpSetting: smtpSetting}
 	default:
 		// Skip unsupported instance setting key.
 		return nil, nil
@@ -0,0 +1,55 @@
+package store
+
+import (
+	"context"
+)
+
+// Job is the persisted state of a scheduled background job.
+type Job struct {
+	Name      string
+	UpdatedTs int64
+
+	// Paused jobs are skipped on schedule, they still run when triggered manually.
+	Paused bool
+	// LastRunTs is the unix timestamp the last run started at, 0 if the job never ran.
+	LastRunTs int64
+	// LastError is the error of the last run, empty if it succeeded.
+	LastError string
+	// NextRunTs is the unix timestamp of the next scheduled run.
+	NextRunTs int64
+}
+
+// FindJob specifies filter criteria for querying jobs.
+type FindJob struct {
+	Name *string
+}
+
+// UpsertJob contains the fields of a job state to set, the job state is created if it does not exist.
+type UpsertJob struct {
+	Name      string
+	Paused    *bool
+	LastRunTs *int64
+	LastError *string
+	NextRunTs *int64
+}
+
+// UpsertJob sets the given fields of the job state, leaving the others unchanged.
+func (s *Store) UpsertJob(ctx context.Context, upsert *UpsertJob) (*Job, error) {
+	return s.driver.UpsertJob(ctx, upsert)
+}
+
+// ListJobs retrieves job states matching the filter criteria, ordered by name.
+func (s *Store) ListJobs(ctx context.Context, find *FindJob) ([]*Job, error) {
+	return s.driver.ListJobs(ctx, find)
+}
+
+func (s *Store) GetJob(ctx context.Context, find *FindJob) (*Job, error) {
+	list, err := s.ListJobs(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
@@ -32,6 +32,9 @@ func (v Visibility) String() string {
 	}
 }
 
+// ErrMemoModified is returned by conditional updates of memos which were updated concurrently.
+var ErrMemoModified = errors.New("memo has been modified")
+
 type Memo struct {
 	// ID is the system generated unique identifier for the memo.
 	ID int32
@@ -43,6 +46,8 @@ type Memo struct {
 	CreatorID int32
 	CreatedTs int64
 	UpdatedTs int64
+	// Version is incremented by every update of the memo, to detect concurrent updates.
+	Version int32
 
 	// Domain specific fields
 	Content    string
@@ -70,15 +75,30 @@ type FindMemo struct {
 	ExcludeContent  bool
 	ExcludeComments bool
 	Filters         []string
+	// RemindBefore lists the memos with incomplete tasks not reminded of yet which came due before the unix timestamp.
+	RemindBefore *int64
 
 	// Pagination
 	Limit  *int
 	Offset *int
+	// Cursor lists the memos ordered after the memo with the sort key, for keyset pagination.
+	// It cannot be combined with OrderByRelevance.
+	Cursor *MemoCursor
 
 	// Ordering
 	OrderByPinned    bool
 	OrderByUpdatedTs bool
 	OrderByTimeAsc   bool
+	// OrderByRelevance orders memos by the relevance of their content to the search() calls of the filters.
+	OrderByRelevance bool
+}
+
+// MemoCursor is the sort key of a memo.
+type MemoCursor struct {
+	Pinned    bool
+	CreatedTs int64
+	UpdatedTs int64
+	ID        int32
 }
 
 type FindMemoPayload struct {
@@ -100,6 +120,9 @@ type UpdateMemo struct {
 	Visibility *Visibility
 	Pinned     *bool
 	Payload    *storepb.MemoPayload
+	// ExpectedVersion makes the update conditional on the version of the memo.
+	// ErrMemoModified is returned if the memo was updated since that version.
+	ExpectedVersion *int32
 }
 
 type DeleteMemo struct {
@@ -137,6 +160,16 @@ func (s *Store) UpdateMemo(ctx context.Context, update *UpdateMemo) error {
 	return s.driver.UpdateMemo(ctx, update)
 }
 
+// UpdateMemos updates the memos in a single transaction, so that either all or none of them are updated.
+func (s *Store) UpdateMemos(ctx context.Context, updates []*UpdateMemo) error {
+	for _, update := range updates {
+		if update.UID != nil && !base.UIDMatcher.MatchString(*update.UID) {
+			return errors.New("invalid uid")
+		}
+	}
+	return s.driver.UpdateMemos(ctx, updates)
+}
+
 func (s *Store) DeleteMemo(ctx context.Context, delete *DeleteMemo) error {
 	// Clean up memo_relation records where this memo is either the source or target.
 	if err := s.driver.DeleteMemoRelation(ctx, &DeleteMemoRelation{MemoID: &delete.ID}); err != nil {
@@ -155,5 +188,13 @@ func (s *Store) DeleteMemo(ctx context.Context, delete *DeleteMemo) error {
 			return err
 		}
 	}
+	// Clean up the embedding of this memo.
+	if err := s.driver.DeleteMemoEmbedding(ctx, &DeleteMemoEmbedding{MemoID: &delete.ID}); err != nil {
+		return err
+	}
+	// Clean up the revisions of this memo.
+	if err := s.driver.DeleteMemoRevisions(ctx, &DeleteMemoRevision{MemoID: &delete.ID}); err != nil {
+		return err
+	}
 	return s.driver.DeleteMemo(ctx, delete)
 }
@@ -0,0 +1,68 @@
+package store
+
+import (
+	"context"
+	"encoding/binary"
+	"math"
+
+	"github.com/pkg/errors"
+)
+
+// MemoEmbedding is the embedding vector of a memo's content used by semantic search.
+type MemoEmbedding struct {
+	MemoID    int32
+	UpdatedTs int64
+
+	// Model is the name of the model that produced the embedding.
+	Model string
+	// ContentHash is the hash of the memo content the embedding was generated from.
+	ContentHash string
+	Embedding   []float32
+}
+
+// FindMemoEmbedding specifies filter criteria for querying memo embeddings.
+type FindMemoEmbedding struct {
+	MemoID *int32
+	Model  *string
+}
+
+// DeleteMemoEmbedding specifies which memo embeddings to delete.
+type DeleteMemoEmbedding struct {
+	MemoID *int32
+}
+
+// UpsertMemoEmbedding creates or replaces the embedding of a memo.
+func (s *Store) UpsertMemoEmbedding(ctx context.Context, upsert *MemoEmbedding) (*MemoEmbedding, error) {
+	return s.driver.UpsertMemoEmbedding(ctx, upsert)
+}
+
+// ListMemoEmbeddings retrieves memo embeddings matching the filter criteria.
+func (s *Store) ListMemoEmbeddings(ctx context.Context, find *FindMemoEmbedding) ([]*MemoEmbedding, error) {
+	return s.driver.ListMemoEmbeddings(ctx, find)
+}
+
+// DeleteMemoEmbedding removes memo embeddings matching the criteria.
+func (s *Store) DeleteMemoEmbedding(ctx context.Context, delete *DeleteMemoEmbedding) error {
+	return s.driver.DeleteMemoEmbedding(ctx, delete)
+}
+
+// EncodeEmbedding serializes an embedding vector as little endian float32 values.
+func EncodeEmbedding(embedding []float32) []byte {
+	data := make([]byte, 4*len(embedding))
+	for i, v := range embedding {
+		binary.LittleEndian.PutUint32(data[4*i:], math.Float32bits(v))
+	}
+	return data
+}
+
+// DecodeEmbedding deserializes an embedding vector encoded by EncodeEmbedding.
+func DecodeEmbedding(data []byte) ([]float32, error) {
+	if len(data)%4 != 0 {
+		return nil, errors.Errorf("invalid embedding length %d", len(data))
+	}
+	embedding := make([]float32, len(data)/4)
+	for i := range embedding {
+		embedding[i] = math.Float32frombits(binary.LittleEndian.Uint32(data[4*i:]))
+	}
+	return embedding, nil
+}
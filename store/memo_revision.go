@@ -0,0 +1,61 @@
+package store
+
+import (
+	"context"
+)
+
+// MemoRevision is a snapshot of a memo's content and visibility, recorded whenever either changes.
+type MemoRevision struct {
+	ID        int32
+	MemoID    int32
+	CreatedTs int64
+
+	// CreatorID is the user who made the change.
+	CreatorID  int32
+	Content    string
+	Visibility Visibility
+}
+
+// FindMemoRevision specifies filter criteria for querying memo revisions.
+type FindMemoRevision struct {
+	ID     *int32
+	MemoID *int32
+	// BeforeID filters revisions older than the given revision.
+	BeforeID *int32
+
+	// Pagination
+	Limit  *int
+	Offset *int
+}
+
+// DeleteMemoRevision specifies which memo revisions to delete.
+type DeleteMemoRevision struct {
+	MemoID *int32
+}
+
+// CreateMemoRevision records a new revision of a memo.
+func (s *Store) CreateMemoRevision(ctx context.Context, create *MemoRevision) (*MemoRevision, error) {
+	return s.driver.CreateMemoRevision(ctx, create)
+}
+
+// ListMemoRevisions retrieves memo revisions matching the filter criteria, newest first.
+func (s *Store) ListMemoRevisions(ctx context.Context, find *FindMemoRevision) ([]*MemoRevision, error) {
+	return s.driver.ListMemoRevisions(ctx, find)
+}
+
+// GetMemoRevision retrieves a single memo revision matching the filter criteria.
+func (s *Store) GetMemoRevision(ctx context.Context, find *FindMemoRevision) (*MemoRevision, error) {
+	list, err := s.ListMemoRevisions(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+// DeleteMemoRevisions removes memo revisions matching the criteria.
+func (s *Store) DeleteMemoRevisions(ctx context.Context, delete *DeleteMemoRevision) error {
+	return s.driver.DeleteMemoRevisions(ctx, delete)
+}
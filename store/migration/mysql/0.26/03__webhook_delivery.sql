@@ -0,0 +1,15 @@
+CREATE TABLE `webhook_delivery` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `creator_id` INT NOT NULL,
+  `webhook_id` VARCHAR(256) NOT NULL,
+  `url` TEXT NOT NULL,
+  `activity_type` VARCHAR(256) NOT NULL,
+  `payload` LONGTEXT NOT NULL,
+  `status` VARCHAR(256) NOT NULL DEFAULT 'PENDING',
+  `attempts` INT NOT NULL DEFAULT 0,
+  `next_attempt_ts` BIGINT NOT NULL DEFAULT 0,
+  `response_code` INT NOT NULL DEFAULT 0,
+  `last_error` TEXT NOT NULL
+);
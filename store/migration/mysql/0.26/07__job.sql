@@ -0,0 +1,8 @@
+CREATE TABLE `job` (
+  `name` VARCHAR(256) NOT NULL PRIMARY KEY,
+  `updated_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `paused` BOOLEAN NOT NULL DEFAULT FALSE,
+  `last_run_ts` BIGINT NOT NULL DEFAULT 0,
+  `last_error` TEXT NOT NULL,
+  `next_run_ts` BIGINT NOT NULL DEFAULT 0
+);
@@ -39,7 +39,8 @@ CREATE TABLE `memo` (
   `content` TEXT NOT NULL,
   `visibility` VARCHAR(256) NOT NULL DEFAULT 'PRIVATE',
   `pinned` BOOLEAN NOT NULL DEFAULT FALSE,
-  `payload` JSON NOT NULL
+  `payload` JSON NOT NULL,
+  `version` INT NOT NULL DEFAULT 0
 );
 
 -- memo_relation
@@ -105,3 +106,52 @@ CREATE TABLE `reaction` (
   `reaction_type` VARCHAR(256) NOT NULL,
   UNIQUE(`creator_id`,`content_id`,`reaction_type`)  
 );
+
+-- webhook_delivery
+CREATE TABLE `webhook_delivery` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `creator_id` INT NOT NULL,
+  `webhook_id` VARCHAR(256) NOT NULL,
+  `url` TEXT NOT NULL,
+  `activity_type` VARCHAR(256) NOT NULL,
+  `payload` LONGTEXT NOT NULL,
+  `status` VARCHAR(256) NOT NULL DEFAULT 'PENDING',
+  `attempts` INT NOT NULL DEFAULT 0,
+  `next_attempt_ts` BIGINT NOT NULL DEFAULT 0,
+  `response_code` INT NOT NULL DEFAULT 0,
+  `last_error` TEXT NOT NULL
+);
+
+-- memo_embedding
+CREATE TABLE `memo_embedding` (
+  `memo_id` INT NOT NULL PRIMARY KEY,
+  `updated_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `model` VARCHAR(256) NOT NULL,
+  `content_hash` VARCHAR(64) NOT NULL,
+  `embedding` LONGBLOB NOT NULL
+);
+
+-- idx_memo_content_search
+ALTER TABLE `memo` ADD FULLTEXT INDEX `idx_memo_content_search` (`content`);
+
+-- memo_revision
+CREATE TABLE `memo_revision` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `memo_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `creator_id` INT NOT NULL,
+  `content` TEXT NOT NULL,
+  `visibility` VARCHAR(256) NOT NULL DEFAULT 'PRIVATE'
+);
+
+-- job
+CREATE TABLE `job` (
+  `name` VARCHAR(256) NOT NULL PRIMARY KEY,
+  `updated_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `paused` BOOLEAN NOT NULL DEFAULT FALSE,
+  `last_run_ts` BIGINT NOT NULL DEFAULT 0,
+  `last_error` TEXT NOT NULL,
+  `next_run_ts` BIGINT NOT NULL DEFAULT 0
+);
@@ -0,0 +1,8 @@
+CREATE TABLE job (
+  name TEXT NOT NULL PRIMARY KEY,
+  updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  paused BOOLEAN NOT NULL DEFAULT FALSE,
+  last_run_ts BIGINT NOT NULL DEFAULT 0,
+  last_error TEXT NOT NULL DEFAULT '',
+  next_run_ts BIGINT NOT NULL DEFAULT 0
+);
@@ -0,0 +1,7 @@
+CREATE TABLE memo_embedding (
+  memo_id INTEGER NOT NULL PRIMARY KEY,
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  model TEXT NOT NULL,
+  content_hash TEXT NOT NULL,
+  embedding BLOB NOT NULL
+);
@@ -0,0 +1,3 @@
+CREATE VIRTUAL TABLE memo_fts USING fts5(content);
+
+INSERT INTO memo_fts (rowid, content) SELECT id, content FROM memo;
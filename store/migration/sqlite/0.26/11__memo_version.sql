@@ -0,0 +1 @@
+ALTER TABLE memo ADD COLUMN version INTEGER NOT NULL DEFAULT 0;
@@ -37,3 +37,6 @@ INSERT INTO reaction (id,creator_id,content_id,reaction_type) VALUES(5,1,'memos/
 
 -- System Settings
 INSERT INTO system_setting VALUES ('MEMO_RELATED', '{"contentLengthLimit":8192,"enableAutoCompact":true,"enableComment":true,"enableLocation":true,"defaultVisibility":"PUBLIC","reactions":["👍","💛","🔥","👏","😂","👌","🚀","👀","🤔","🤡","❓","+1","🎉","💡","✅"]}', '');
+
+-- Memo Search Index
+INSERT INTO memo_fts (rowid, content) SELECT id, content FROM memo;
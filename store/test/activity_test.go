@@ -378,3 +378,107 @@ func TestActivityPayloadComplexMemoComment(t *testing.T) {
 
 	ts.Close()
 }
+
+func TestActivityListByParty(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	commenter, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	owner, err := createTestingUserWithRole(ctx, ts, "owner", store.RoleUser)
+	require.NoError(t, err)
+	other, err := createTestingUserWithRole(ctx, ts, "other", store.RoleUser)
+	require.NoError(t, err)
+
+	comment, err := ts.CreateActivity(ctx, &store.Activity{
+		CreatorID: commenter.ID,
+		Type:      store.ActivityTypeMemoComment,
+		Level:     store.ActivityLevelInfo,
+		Payload:   &storepb.ActivityPayload{},
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateInbox(ctx, &store.Inbox{
+		SenderID:   commenter.ID,
+		ReceiverID: owner.ID,
+		Status:     store.UNREAD,
+		Message:    &storepb.InboxMessage{Type: storepb.InboxMessage_MEMO_COMMENT, ActivityId: &comment.ID},
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateActivity(ctx, &store.Activity{
+		CreatorID: owner.ID,
+		Type:      store.ActivityTypeSignInFailed,
+		Level:     store.ActivityLevelWarn,
+		Payload:   &storepb.ActivityPayload{},
+	})
+	require.NoError(t, err)
+
+	for _, test := range []struct {
+		find  *store.FindActivity
+		count int
+	}{
+		{&store.FindActivity{PartyID: &commenter.ID}, 1},
+		{&store.FindActivity{PartyID: &owner.ID}, 2},
+		{&store.FindActivity{PartyID: &owner.ID, ExcludeTypes: []store.ActivityType{store.ActivityTypeSignInFailed}}, 1},
+		{&store.FindActivity{PartyID: &other.ID}, 0},
+		{&store.FindActivity{Filters: []string{`activity_type == "SIGN_IN_FAILED" && level == "WARN"`}}, 1},
+		{&store.FindActivity{Filters: []string{`activity_type in ["MEMO_COMMENT", "SIGN_IN_FAILED"] && create_time > now() - 3600`}}, 2},
+	} {
+		activities, err := ts.ListActivities(ctx, test.find)
+		require.NoError(t, err)
+		require.Len(t, activities, test.count)
+	}
+
+	// The cursor lists the activities created before it.
+	limit := 1
+	activities, err := ts.ListActivities(ctx, &store.FindActivity{Limit: &limit})
+	require.NoError(t, err)
+	require.Len(t, activities, 1)
+	activities, err = ts.ListActivities(ctx, &store.FindActivity{
+		Cursor: &store.ActivityCursor{CreatedTs: activities[0].CreatedTs, ID: activities[0].ID},
+	})
+	require.NoError(t, err)
+	require.Len(t, activities, 1)
+	require.Equal(t, comment.ID, activities[0].ID)
+
+	ts.Close()
+}
+
+func TestActivityAuditPayload(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	_, err = ts.CreateActivity(ctx, &store.Activity{
+		CreatorID: user.ID,
+		Type:      store.ActivityTypeMemoComment,
+		Level:     store.ActivityLevelInfo,
+		Payload:   &storepb.ActivityPayload{},
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateActivity(ctx, &store.Activity{
+		CreatorID: user.ID,
+		Type:      store.ActivityTypeUserRoleChanged,
+		Level:     store.ActivityLevelInfo,
+		Payload: &storepb.ActivityPayload{
+			Audit: &storepb.ActivityAuditPayload{
+				Resource:  "users/2",
+				IpAddress: "192.0.2.1",
+				OldValue:  "USER",
+				NewValue:  "ADMIN",
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	activities, err := ts.ListActivities(ctx, &store.FindActivity{TypeList: store.AuditActivityTypes})
+	require.NoError(t, err)
+	require.Len(t, activities, 1)
+	require.Equal(t, store.ActivityTypeUserRoleChanged, activities[0].Type)
+	require.Equal(t, "users/2", activities[0].Payload.Audit.Resource)
+	require.Equal(t, "192.0.2.1", activities[0].Payload.Audit.IpAddress)
+	require.Equal(t, "ADMIN", activities[0].Payload.Audit.NewValue)
+
+	ts.Close()
+}
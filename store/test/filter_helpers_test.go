@@ -74,6 +74,14 @@ func (b *MemoBuilder) Tags(tags ...string) *MemoBuilder {
 	return b
 }
 
+func (b *MemoBuilder) Mentions(userIDs ...int32) *MemoBuilder {
+	if b.memo.Payload == nil {
+		b.memo.Payload = &storepb.MemoPayload{}
+	}
+	b.memo.Payload.Mentions = userIDs
+	return b
+}
+
 func (b *MemoBuilder) Property(fn func(*storepb.MemoPayload_Property)) *MemoBuilder {
 	if b.memo.Payload == nil {
 		b.memo.Payload = &storepb.MemoPayload{}
@@ -0,0 +1,59 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestJobStore(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+
+	name := "s3-presign"
+	job, err := ts.GetJob(ctx, &store.FindJob{Name: &name})
+	require.NoError(t, err)
+	require.Nil(t, job)
+
+	lastRunTs, nextRunTs, lastError := int64(1700000000), int64(1700043200), "failed to presign"
+	job, err = ts.UpsertJob(ctx, &store.UpsertJob{
+		Name:      name,
+		LastRunTs: &lastRunTs,
+		LastError: &lastError,
+		NextRunTs: &nextRunTs,
+	})
+	require.NoError(t, err)
+	require.Equal(t, name, job.Name)
+	require.False(t, job.Paused)
+	require.Equal(t, lastRunTs, job.LastRunTs)
+	require.Equal(t, lastError, job.LastError)
+	require.Equal(t, nextRunTs, job.NextRunTs)
+
+	// Only the given fields are updated.
+	paused := true
+	job, err = ts.UpsertJob(ctx, &store.UpsertJob{Name: name, Paused: &paused})
+	require.NoError(t, err)
+	require.True(t, job.Paused)
+	require.Equal(t, lastRunTs, job.LastRunTs)
+	require.Equal(t, lastError, job.LastError)
+
+	lastError = ""
+	job, err = ts.UpsertJob(ctx, &store.UpsertJob{Name: name, LastError: &lastError})
+	require.NoError(t, err)
+	require.True(t, job.Paused)
+	require.Empty(t, job.LastError)
+
+	_, err = ts.UpsertJob(ctx, &store.UpsertJob{Name: "memo-payload", Paused: &paused})
+	require.NoError(t, err)
+	jobs, err := ts.ListJobs(ctx, &store.FindJob{})
+	require.NoError(t, err)
+	require.Len(t, jobs, 2)
+	require.Equal(t, "memo-payload", jobs[0].Name)
+	require.Equal(t, name, jobs[1].Name)
+
+	ts.Close()
+}
@@ -0,0 +1,63 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoEmbeddingStore(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "test-memo",
+		CreatorID:  user.ID,
+		Content:    "test content",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+
+	memoEmbedding, err := ts.UpsertMemoEmbedding(ctx, &store.MemoEmbedding{
+		MemoID:      memo.ID,
+		Model:       "model-a",
+		ContentHash: "hash-a",
+		Embedding:   []float32{0.1, -0.2, 0.3},
+	})
+	require.NoError(t, err)
+	require.NotZero(t, memoEmbedding.UpdatedTs)
+
+	// Upserting again replaces the embedding.
+	_, err = ts.UpsertMemoEmbedding(ctx, &store.MemoEmbedding{
+		MemoID:      memo.ID,
+		Model:       "model-b",
+		ContentHash: "hash-b",
+		Embedding:   []float32{1, 2},
+	})
+	require.NoError(t, err)
+	memoEmbeddings, err := ts.ListMemoEmbeddings(ctx, &store.FindMemoEmbedding{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Len(t, memoEmbeddings, 1)
+	require.Equal(t, "model-b", memoEmbeddings[0].Model)
+	require.Equal(t, "hash-b", memoEmbeddings[0].ContentHash)
+	require.Equal(t, []float32{1, 2}, memoEmbeddings[0].Embedding)
+
+	model := "model-a"
+	memoEmbeddings, err = ts.ListMemoEmbeddings(ctx, &store.FindMemoEmbedding{Model: &model})
+	require.NoError(t, err)
+	require.Empty(t, memoEmbeddings)
+
+	// Deleting the memo deletes its embedding.
+	err = ts.DeleteMemo(ctx, &store.DeleteMemo{ID: memo.ID})
+	require.NoError(t, err)
+	memoEmbeddings, err = ts.ListMemoEmbeddings(ctx, &store.FindMemoEmbedding{})
+	require.NoError(t, err)
+	require.Empty(t, memoEmbeddings)
+
+	ts.Close()
+}
@@ -83,6 +83,79 @@ func TestMemoFilterContentCaseSensitivity(t *testing.T) {
 	}
 }
 
+// =============================================================================
+// Full-Text Search Tests
+// Schema: search(query) matches the full-text index of content
+// =============================================================================
+
+func TestMemoFilterSearch(t *testing.T) {
+	t.Parallel()
+	tc := NewMemoFilterTestContext(t)
+	defer tc.Close()
+
+	tc.CreateMemo(NewMemoBuilder("memo-coffee", tc.User.ID).Content("Brewing coffee with the new grinder"))
+	tc.CreateMemo(NewMemoBuilder("memo-tea", tc.User.ID).Content("Green tea tastes better than coffee"))
+	tc.CreateMemo(NewMemoBuilder("memo-garden", tc.User.ID).Content("Planting tomatoes in the garden"))
+
+	// Test: single term, case-insensitive
+	memos := tc.ListWithFilter(`search("COFFEE")`)
+	require.Len(t, memos, 2)
+
+	// Test: all terms must match
+	memos = tc.ListWithFilter(`search("coffee grinder")`)
+	require.Len(t, memos, 1)
+	require.Equal(t, "memo-coffee", memos[0].UID)
+
+	// Test: whole words are matched rather than substrings
+	memos = tc.ListWithFilter(`search("toma")`)
+	require.Len(t, memos, 0)
+
+	// Test: negation and combination with other fields
+	memos = tc.ListWithFilter(`search("coffee") && !search("grinder")`)
+	require.Len(t, memos, 1)
+	require.Equal(t, "memo-tea", memos[0].UID)
+	memos = tc.ListWithFilter(`search("tomatoes") || content.contains("Green")`)
+	require.Len(t, memos, 2)
+}
+
+func TestMemoFilterSearchIndexSync(t *testing.T) {
+	t.Parallel()
+	tc := NewMemoFilterTestContext(t)
+	defer tc.Close()
+
+	memo := tc.CreateMemo(NewMemoBuilder("memo-sync", tc.User.ID).Content("Original wording about mountains"))
+	require.Len(t, tc.ListWithFilter(`search("mountains")`), 1)
+
+	// Test: updating the content refreshes the index
+	content := "Rewritten wording about rivers"
+	require.NoError(t, tc.Store.UpdateMemo(tc.Ctx, &store.UpdateMemo{ID: memo.ID, Content: &content}))
+	require.Len(t, tc.ListWithFilter(`search("mountains")`), 0)
+	require.Len(t, tc.ListWithFilter(`search("rivers")`), 1)
+
+	// Test: deleting the memo removes it from the index
+	require.NoError(t, tc.Store.DeleteMemo(tc.Ctx, &store.DeleteMemo{ID: memo.ID}))
+	require.Len(t, tc.ListWithFilter(`search("rivers")`), 0)
+}
+
+func TestMemoFilterSearchOrderByRelevance(t *testing.T) {
+	t.Parallel()
+	tc := NewMemoFilterTestContext(t)
+	defer tc.Close()
+
+	tc.CreateMemo(NewMemoBuilder("memo-strong", tc.User.ID).Content("Databases, databases, databases"))
+	tc.CreateMemo(NewMemoBuilder("memo-weak", tc.User.ID).Content("A long memo mentioning databases once among many other unrelated words about cooking and travel"))
+	tc.CreateMemo(NewMemoBuilder("memo-unrelated", tc.User.ID).Content("Nothing to see here"))
+
+	memos, err := tc.Store.ListMemos(tc.Ctx, &store.FindMemo{
+		Filters:          []string{`search("databases")`},
+		OrderByRelevance: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, memos, 2)
+	require.Equal(t, "memo-strong", memos[0].UID)
+	require.Equal(t, "memo-weak", memos[1].UID)
+}
+
 // =============================================================================
 // Visibility Field Tests
 // Schema: visibility (string, ==, !=)
@@ -275,6 +348,37 @@ func TestMemoFilterHierarchicalTags(t *testing.T) {
 	require.Len(t, memos, 2)
 }
 
+func TestMemoFilterTagUnder(t *testing.T) {
+	t.Parallel()
+	tc := NewMemoFilterTestContext(t)
+	defer tc.Close()
+
+	tc.CreateMemo(NewMemoBuilder("memo-work", tc.User.ID).Content("Work memo").Tags("work"))
+	tc.CreateMemo(NewMemoBuilder("memo-work-project", tc.User.ID).Content("Project memo").Tags("work/project_a"))
+	tc.CreateMemo(NewMemoBuilder("memo-workout", tc.User.ID).Content("Workout memo").Tags("workout"))
+	tc.CreateMemo(NewMemoBuilder("memo-project-other", tc.User.ID).Content("Other memo").Tags("work/projectxa"))
+	tc.CreateMemo(NewMemoBuilder("memo-no-tags", tc.User.ID).Content("No tags"))
+
+	// The tag and its descendants match, but not tags sharing a prefix.
+	memos := tc.ListWithFilter(`tag_under("work")`)
+	require.Len(t, memos, 3)
+	for _, memo := range memos {
+		require.NotEqual(t, "memo-workout", memo.UID)
+	}
+
+	// Matching ignores case, and "_" is not a wildcard.
+	memos = tc.ListWithFilter(`tag_under("Work/Project_A")`)
+	require.Len(t, memos, 1)
+	require.Equal(t, "memo-work-project", memos[0].UID)
+
+	// Negation keeps memos without tags.
+	memos = tc.ListWithFilter(`!tag_under("work")`)
+	require.Len(t, memos, 2)
+
+	memos = tc.ListWithFilter(`tag_under("work") && content.contains("Project")`)
+	require.Len(t, memos, 1)
+}
+
 func TestMemoFilterEmptyTags(t *testing.T) {
 	t.Parallel()
 	tc := NewMemoFilterTestContext(t)
@@ -488,6 +592,63 @@ func TestMemoFilterUpdatedTs(t *testing.T) {
 	require.Len(t, memos, 0)
 }
 
+func TestMemoFilterDueTime(t *testing.T) {
+	t.Parallel()
+	tc := NewMemoFilterTestContext(t)
+	defer tc.Close()
+
+	now := time.Now().Unix()
+	tc.CreateMemo(NewMemoBuilder("memo-overdue", tc.User.ID).
+		Content("- [ ] Overdue task").
+		Property(func(p *storepb.MemoPayload_Property) { p.DueTs = now - 3600 }))
+	tc.CreateMemo(NewMemoBuilder("memo-due-tomorrow", tc.User.ID).
+		Content("- [ ] Task due tomorrow").
+		Property(func(p *storepb.MemoPayload_Property) { p.DueTs = now + 86400 }))
+	tc.CreateMemo(NewMemoBuilder("memo-no-due", tc.User.ID).Content("- [ ] Task"))
+
+	// Test: due_time < now() (overdue)
+	memos := tc.ListWithFilter(`due_time < now()`)
+	require.Len(t, memos, 1)
+	require.Equal(t, "memo-overdue", memos[0].UID)
+
+	// Test: due_time <= now() + 2 days
+	memos = tc.ListWithFilter(`due_time <= now() + 172800`)
+	require.Len(t, memos, 2)
+
+	// Test: due_time > past timestamp
+	memos = tc.ListWithFilter(`due_time > ` + formatInt64(now))
+	require.Len(t, memos, 1)
+	require.Equal(t, "memo-due-tomorrow", memos[0].UID)
+}
+
+func TestMemoFilterMentions(t *testing.T) {
+	t.Parallel()
+	tc := NewMemoFilterTestContext(t)
+	defer tc.Close()
+
+	tc.CreateMemo(NewMemoBuilder("memo-mention-2", tc.User.ID).Content("Hi @alice").Mentions(2))
+	tc.CreateMemo(NewMemoBuilder("memo-mention-2-3", tc.User.ID).Content("Hi @alice and @bob").Mentions(2, 3))
+	tc.CreateMemo(NewMemoBuilder("memo-no-mention", tc.User.ID).Content("Hi all"))
+
+	// Test: 3 in mentions
+	memos := tc.ListWithFilter(`3 in mentions`)
+	require.Len(t, memos, 1)
+	require.Equal(t, "memo-mention-2-3", memos[0].UID)
+
+	// Test: 2 in mentions
+	memos = tc.ListWithFilter(`2 in mentions`)
+	require.Len(t, memos, 2)
+
+	// Test: !(2 in mentions)
+	memos = tc.ListWithFilter(`!(2 in mentions)`)
+	require.Len(t, memos, 1)
+	require.Equal(t, "memo-no-mention", memos[0].UID)
+
+	// Test: 4 in mentions
+	memos = tc.ListWithFilter(`4 in mentions`)
+	require.Len(t, memos, 0)
+}
+
 func TestMemoFilterAllComparisonOperators(t *testing.T) {
 	t.Parallel()
 	tc := NewMemoFilterTestContext(t)
@@ -0,0 +1,68 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoRevisionStore(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "test-memo",
+		CreatorID:  user.ID,
+		Content:    "first",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+
+	first, err := ts.CreateMemoRevision(ctx, &store.MemoRevision{
+		MemoID:     memo.ID,
+		CreatedTs:  1700000000,
+		CreatorID:  user.ID,
+		Content:    "first",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1700000000), first.CreatedTs)
+	second, err := ts.CreateMemoRevision(ctx, &store.MemoRevision{
+		MemoID:     memo.ID,
+		CreatorID:  user.ID,
+		Content:    "second",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	require.NotZero(t, second.CreatedTs)
+
+	// Revisions are listed newest first.
+	revisions, err := ts.ListMemoRevisions(ctx, &store.FindMemoRevision{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Len(t, revisions, 2)
+	require.Equal(t, second.ID, revisions[0].ID)
+	require.Equal(t, "second", revisions[0].Content)
+	require.Equal(t, store.Public, revisions[0].Visibility)
+	require.Equal(t, first.ID, revisions[1].ID)
+
+	previous, err := ts.GetMemoRevision(ctx, &store.FindMemoRevision{MemoID: &memo.ID, BeforeID: &second.ID})
+	require.NoError(t, err)
+	require.Equal(t, first.ID, previous.ID)
+	previous, err = ts.GetMemoRevision(ctx, &store.FindMemoRevision{MemoID: &memo.ID, BeforeID: &first.ID})
+	require.NoError(t, err)
+	require.Nil(t, previous)
+
+	// Deleting the memo deletes its revisions.
+	err = ts.DeleteMemo(ctx, &store.DeleteMemo{ID: memo.ID})
+	require.NoError(t, err)
+	revisions, err = ts.ListMemoRevisions(ctx, &store.FindMemoRevision{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Empty(t, revisions)
+
+	ts.Close()
+}
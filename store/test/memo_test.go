@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/usememos/memos/store"
 
@@ -280,6 +282,61 @@ func TestMemoListWithPagination(t *testing.T) {
 	ts.Close()
 }
 
+func TestMemoListWithCursor(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	// Memos share timestamps so that pages are cut between memos of the same time.
+	for i := 0; i < 7; i++ {
+		memo, err := ts.CreateMemo(ctx, &store.Memo{
+			UID:        fmt.Sprintf("memo-%d", i),
+			CreatorID:  user.ID,
+			Content:    fmt.Sprintf("content %d", i),
+			Visibility: store.Public,
+			CreatedTs:  1700000000 + int64(i/3),
+			UpdatedTs:  1700000000 - int64(i/2),
+		})
+		require.NoError(t, err)
+		if i%3 == 1 {
+			pinned := true
+			require.NoError(t, ts.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, Pinned: &pinned}))
+		}
+	}
+
+	for _, order := range []store.FindMemo{
+		{},
+		{OrderByPinned: true},
+		{OrderByTimeAsc: true},
+		{OrderByPinned: true, OrderByUpdatedTs: true},
+		{OrderByUpdatedTs: true, OrderByTimeAsc: true},
+	} {
+		all, err := ts.ListMemos(ctx, &order)
+		require.NoError(t, err)
+		require.Len(t, all, 7)
+
+		paged := []*store.Memo{}
+		limit := 2
+		find := order
+		find.Limit = &limit
+		for {
+			memos, err := ts.ListMemos(ctx, &find)
+			require.NoError(t, err)
+			paged = append(paged, memos...)
+			if len(memos) < limit {
+				break
+			}
+			last := memos[len(memos)-1]
+			find.Cursor = &store.MemoCursor{Pinned: last.Pinned, CreatedTs: last.CreatedTs, UpdatedTs: last.UpdatedTs, ID: last.ID}
+		}
+		require.Equal(t, all, paged)
+	}
+
+	ts.Close()
+}
+
 func TestMemoUpdatePinned(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
@@ -324,6 +381,160 @@ func TestMemoUpdatePinned(t *testing.T) {
 	ts.Close()
 }
 
+func TestMemoUpdateExpectedVersion(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "versioned-memo",
+		CreatorID:  user.ID,
+		Content:    "content",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	found, err := ts.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	require.NoError(t, err)
+	version := found.Version
+
+	// An update with the current version succeeds and increments the version.
+	content := "first update"
+	err = ts.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:              memo.ID,
+		Content:         &content,
+		ExpectedVersion: &version,
+	})
+	require.NoError(t, err)
+	found, err = ts.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, version+1, found.Version)
+
+	// An update with a stale version fails without changing the memo.
+	content = "second update"
+	err = ts.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:              memo.ID,
+		Content:         &content,
+		ExpectedVersion: &version,
+	})
+	require.ErrorIs(t, err, store.ErrMemoModified)
+	found, err = ts.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, "first update", found.Content)
+	require.Equal(t, version+1, found.Version)
+
+	ts.Close()
+}
+
+func TestMemoDraft(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	publishTime := timestamppb.New(time.Now().Add(time.Hour).Truncate(time.Second))
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "scheduled-memo",
+		CreatorID:  user.ID,
+		RowStatus:  store.Draft,
+		Content:    "content",
+		Visibility: store.Public,
+		Payload: &storepb.MemoPayload{
+			Schedule: &storepb.MemoPayload_Schedule{PublishTime: publishTime, Recurrence: "0 9 * * 1-5"},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, store.Draft, memo.RowStatus)
+
+	// Drafts are excluded from the normal memos.
+	normalStatus := store.Normal
+	memos, err := ts.ListMemos(ctx, &store.FindMemo{RowStatus: &normalStatus})
+	require.NoError(t, err)
+	require.Empty(t, memos)
+	draftStatus := store.Draft
+	memos, err = ts.ListMemos(ctx, &store.FindMemo{RowStatus: &draftStatus})
+	require.NoError(t, err)
+	require.Len(t, memos, 1)
+	require.Equal(t, publishTime.AsTime(), memos[0].Payload.Schedule.PublishTime.AsTime())
+	require.Equal(t, "0 9 * * 1-5", memos[0].Payload.Schedule.Recurrence)
+
+	// Publish the draft.
+	err = ts.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:        memo.ID,
+		RowStatus: &normalStatus,
+		Payload:   &storepb.MemoPayload{},
+	})
+	require.NoError(t, err)
+	memos, err = ts.ListMemos(ctx, &store.FindMemo{RowStatus: &normalStatus})
+	require.NoError(t, err)
+	require.Len(t, memos, 1)
+	require.Nil(t, memos[0].Payload.Schedule)
+
+	ts.Close()
+}
+
+func TestMemoUpdateMemos(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	first, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "first-memo",
+		CreatorID:  user.ID,
+		Content:    "first",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	second, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "second-memo",
+		CreatorID:  user.ID,
+		Content:    "second",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+
+	firstContent, secondContent := "first updated", "second updated"
+	err = ts.UpdateMemos(ctx, []*store.UpdateMemo{
+		{ID: first.ID, Content: &firstContent},
+		{ID: second.ID, Content: &secondContent},
+	})
+	require.NoError(t, err)
+	found, err := ts.GetMemo(ctx, &store.FindMemo{ID: &first.ID})
+	require.NoError(t, err)
+	require.Equal(t, firstContent, found.Content)
+	found, err = ts.GetMemo(ctx, &store.FindMemo{ID: &second.ID})
+	require.NoError(t, err)
+	require.Equal(t, secondContent, found.Content)
+
+	// A failing update rolls back the other updates.
+	rolledBack, duplicateUID := "rolled back", "first-memo"
+	err = ts.UpdateMemos(ctx, []*store.UpdateMemo{
+		{ID: first.ID, Content: &rolledBack},
+		{ID: second.ID, UID: &duplicateUID},
+	})
+	require.Error(t, err)
+	found, err = ts.GetMemo(ctx, &store.FindMemo{ID: &first.ID})
+	require.NoError(t, err)
+	require.Equal(t, firstContent, found.Content)
+
+	// An update of a memo modified since its version rolls back the other updates.
+	staleVersion := second.Version
+	err = ts.UpdateMemos(ctx, []*store.UpdateMemo{
+		{ID: first.ID, Content: &rolledBack},
+		{ID: second.ID, Content: &rolledBack, ExpectedVersion: &staleVersion},
+	})
+	require.ErrorIs(t, err, store.ErrMemoModified)
+	found, err = ts.GetMemo(ctx, &store.FindMemo{ID: &first.ID})
+	require.NoError(t, err)
+	require.Equal(t, firstContent, found.Content)
+
+	ts.Close()
+}
+
 func TestMemoUpdateVisibility(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
@@ -95,6 +95,30 @@ func TestUserGetByUsername(t *testing.T) {
 	ts.Close()
 }
 
+func TestUserListByUsernames(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+
+	alice, err := createTestingUserWithRole(ctx, ts, "Alice", store.RoleUser)
+	require.NoError(t, err)
+	bob, err := createTestingUserWithRole(ctx, ts, "bob", store.RoleUser)
+	require.NoError(t, err)
+	_, err = createTestingUserWithRole(ctx, ts, "carol", store.RoleUser)
+	require.NoError(t, err)
+
+	// Usernames are matched case-insensitively.
+	users, err := ts.ListUsers(ctx, &store.FindUser{UsernameList: []string{"alice", "BOB", "nobody"}})
+	require.NoError(t, err)
+	ids := []int32{}
+	for _, user := range users {
+		ids = append(ids, user.ID)
+	}
+	require.ElementsMatch(t, []int32{alice.ID, bob.ID}, ids)
+
+	ts.Close()
+}
+
 func TestUserListByRole(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
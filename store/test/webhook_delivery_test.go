@@ -0,0 +1,155 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestWebhookDeliveryStore(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	create := &store.WebhookDelivery{
+		CreatorID:     user.ID,
+		WebhookID:     "hook",
+		URL:           "https://example.com/webhook",
+		ActivityType:  "memos.memo.created",
+		Payload:       `{"activityType":"memos.memo.created"}`,
+		Status:        store.WebhookDeliveryPending,
+		NextAttemptTs: 100,
+	}
+	delivery, err := ts.CreateWebhookDelivery(ctx, create)
+	require.NoError(t, err)
+	require.NotZero(t, delivery.ID)
+	require.NotZero(t, delivery.CreatedTs)
+
+	deliveries, err := ts.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{
+		CreatorID: &user.ID,
+	})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	require.Equal(t, create.Payload, deliveries[0].Payload)
+	require.Equal(t, store.WebhookDeliveryPending, deliveries[0].Status)
+	require.Equal(t, int32(0), deliveries[0].Attempts)
+
+	attempts := int32(1)
+	responseCode := int32(500)
+	lastError := "internal error"
+	nextAttemptTs := int64(200)
+	err = ts.UpdateWebhookDelivery(ctx, &store.UpdateWebhookDelivery{
+		ID:            delivery.ID,
+		Attempts:      &attempts,
+		ResponseCode:  &responseCode,
+		LastError:     &lastError,
+		NextAttemptTs: &nextAttemptTs,
+	})
+	require.NoError(t, err)
+	updated, err := ts.GetWebhookDelivery(ctx, &store.FindWebhookDelivery{ID: &delivery.ID})
+	require.NoError(t, err)
+	require.Equal(t, attempts, updated.Attempts)
+	require.Equal(t, responseCode, updated.ResponseCode)
+	require.Equal(t, lastError, updated.LastError)
+	require.Equal(t, nextAttemptTs, updated.NextAttemptTs)
+
+	webhookID := "hook"
+	err = ts.DeleteWebhookDeliveries(ctx, &store.DeleteWebhookDelivery{
+		CreatorID: &user.ID,
+		WebhookID: &webhookID,
+	})
+	require.NoError(t, err)
+	deliveries, err = ts.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{
+		CreatorID: &user.ID,
+	})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 0)
+	ts.Close()
+}
+
+func TestWebhookDeliveryCreateFailed(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	// Deliveries which cannot be sent are recorded as failed right away, with their error.
+	delivery, err := ts.CreateWebhookDelivery(ctx, &store.WebhookDelivery{
+		CreatorID:    user.ID,
+		WebhookID:    "hook",
+		URL:          "https://example.com/webhook",
+		ActivityType: "memos.memo.created",
+		Payload:      "{}",
+		Status:       store.WebhookDeliveryFailed,
+		LastError:    "invalid template",
+	})
+	require.NoError(t, err)
+	delivery, err = ts.GetWebhookDelivery(ctx, &store.FindWebhookDelivery{ID: &delivery.ID})
+	require.NoError(t, err)
+	require.Equal(t, store.WebhookDeliveryFailed, delivery.Status)
+	require.Equal(t, "invalid template", delivery.LastError)
+	ts.Close()
+}
+
+func TestWebhookDeliveryListDue(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	for _, nextAttemptTs := range []int64{100, 200, 300} {
+		_, err := ts.CreateWebhookDelivery(ctx, &store.WebhookDelivery{
+			CreatorID:     user.ID,
+			WebhookID:     "hook",
+			URL:           "https://example.com/webhook",
+			ActivityType:  "memos.memo.created",
+			Payload:       "{}",
+			Status:        store.WebhookDeliveryPending,
+			NextAttemptTs: nextAttemptTs,
+		})
+		require.NoError(t, err)
+	}
+
+	pending := store.WebhookDeliveryPending
+	before := int64(200)
+	deliveries, err := ts.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{
+		Status:            &pending,
+		NextAttemptBefore: &before,
+		OrderByIDAsc:      true,
+	})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 2)
+	require.Equal(t, int64(100), deliveries[0].NextAttemptTs)
+	require.Equal(t, int64(200), deliveries[1].NextAttemptTs)
+
+	// Finished deliveries are pruned, pending ones are kept.
+	succeeded := store.WebhookDeliverySucceeded
+	updatedTs := int64(1000)
+	err = ts.UpdateWebhookDelivery(ctx, &store.UpdateWebhookDelivery{
+		ID:        deliveries[0].ID,
+		UpdatedTs: &updatedTs,
+		Status:    &succeeded,
+	})
+	require.NoError(t, err)
+	finishedBefore := int64(2000)
+	err = ts.DeleteWebhookDeliveries(ctx, &store.DeleteWebhookDelivery{
+		FinishedBefore: &finishedBefore,
+	})
+	require.NoError(t, err)
+	deliveries, err = ts.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{
+		CreatorID: &user.ID,
+	})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 2)
+	for _, delivery := range deliveries {
+		require.Equal(t, store.WebhookDeliveryPending, delivery.Status)
+	}
+	ts.Close()
+}
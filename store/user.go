@@ -77,6 +77,8 @@ type FindUser struct {
 	Role      *Role
 	Email     *string
 	Nickname  *string
+	// UsernameList matches the usernames case-insensitively.
+	UsernameList []string
 
 	// Domain specific fields
 	Filters []string
@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"time"
 
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -394,6 +395,62 @@ func (s *Store) UpdateUserWebhook(ctx context.Context, userID int32, webhook *st
 	return err
 }
 
+// GetUserTwoFactorSetting returns the two-factor authentication setting of the user.
+// An empty setting is returned if the user never enrolled.
+func (s *Store) GetUserTwoFactorSetting(ctx context.Context, userID int32) (*storepb.TwoFactorUserSetting, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_TWO_FACTOR,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil || userSetting.GetTwoFactor() == nil {
+		return &storepb.TwoFactorUserSetting{}, nil
+	}
+	return userSetting.GetTwoFactor(), nil
+}
+
+// UpdateUserTwoFactorSetting replaces the two-factor authentication setting of the user.
+func (s *Store) UpdateUserTwoFactorSetting(ctx context.Context, userID int32, setting *storepb.TwoFactorUserSetting) error {
+	_, err := s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_TWO_FACTOR,
+		Value: &storepb.UserSetting_TwoFactor{
+			TwoFactor: setting,
+		},
+	})
+	return err
+}
+
+// GetUserNotificationSetting returns the notification preferences of the user.
+// An empty setting, with every email disabled, is returned if the user never set any.
+func (s *Store) GetUserNotificationSetting(ctx context.Context, userID int32) (*storepb.NotificationUserSetting, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_NOTIFICATION,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil || userSetting.GetNotification() == nil {
+		return &storepb.NotificationUserSetting{}, nil
+	}
+	return userSetting.GetNotification(), nil
+}
+
+// GetUserLocation returns the timezone of the user, UTC if the user has none.
+func (s *Store) GetUserLocation(ctx context.Context, userID int32) (*time.Location, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_GENERAL,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return time.LoadLocation(userSetting.GetGeneral().GetTimezone())
+}
+
 func convertUserSettingFromRaw(raw *UserSetting) (*storepb.UserSetting, error) {
 	userSetting := &storepb.UserSetting{
 		UserId: raw.UserID,
@@ -431,6 +488,18 @@ func convertUserSettingFromRaw(raw *UserSetting) (*storepb.UserSetting, error) {
 			return nil, err
 		}
 		userSetting.Value = &storepb.UserSetting_Webhooks{Webhooks: webhooksUserSetting}
+	case storepb.UserSetting_TWO_FACTOR:
+		twoFactorUserSetting := &storepb.TwoFactorUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), twoFactorUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_TwoFactor{TwoFactor: twoFactorUserSetting}
+	case storepb.UserSetting_NOTIFICATION:
+		notificationUserSetting := &storepb.NotificationUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), notificationUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_Notification{Notification: notificationUserSetting}
 	default:
 		return nil, nil
 	}
@@ -479,6 +548,20 @@ func convertUserSettingToRaw(userSetting *storepb.UserSetting) (*UserSetting, er
 			return nil, err
 		}
 		raw.Value = string(value)
+	case storepb.UserSetting_TWO_FACTOR:
+		twoFactorUserSetting := userSetting.GetTwoFactor()
+		value, err := protojson.Marshal(twoFactorUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_NOTIFICATION:
+		notificationUserSetting := userSetting.GetNotification()
+		value, err := protojson.Marshal(notificationUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
 	default:
 		return nil, errors.Errorf("unsupported user setting key: %v", userSetting.Key)
 	}
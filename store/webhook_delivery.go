@@ -0,0 +1,114 @@
+package store
+
+import (
+	"context"
+)
+
+// WebhookDeliveryStatus represents the status of a webhook delivery.
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliveryPending indicates the delivery is waiting for its next attempt.
+	WebhookDeliveryPending WebhookDeliveryStatus = "PENDING"
+	// WebhookDeliverySucceeded indicates the receiver acknowledged the delivery.
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "SUCCEEDED"
+	// WebhookDeliveryFailed indicates the delivery exhausted its retries, or its request could not be built.
+	WebhookDeliveryFailed WebhookDeliveryStatus = "FAILED"
+)
+
+func (s WebhookDeliveryStatus) String() string {
+	return string(s)
+}
+
+// WebhookDelivery represents a single outgoing webhook request in the delivery outbox.
+type WebhookDelivery struct {
+	ID        int32
+	CreatedTs int64
+	UpdatedTs int64
+
+	// CreatorID is the owner of the webhook.
+	CreatorID int32
+	// WebhookID is the ID of the webhook in the owner's webhooks setting.
+	WebhookID    string
+	URL          string
+	ActivityType string
+	// Payload is the serialized request body.
+	Payload string
+
+	Status WebhookDeliveryStatus
+	// Attempts is the number of delivery attempts made so far.
+	Attempts int32
+	// NextAttemptTs is the unix timestamp after which a pending delivery is due.
+	NextAttemptTs int64
+	// ResponseCode is the HTTP status code of the last attempt, 0 if no response was received.
+	ResponseCode int32
+	LastError    string
+}
+
+// FindWebhookDelivery specifies filter criteria for querying webhook deliveries.
+type FindWebhookDelivery struct {
+	ID        *int32
+	CreatorID *int32
+	WebhookID *string
+	Status    *WebhookDeliveryStatus
+	// NextAttemptBefore filters deliveries which are due at or before the given unix timestamp.
+	NextAttemptBefore *int64
+
+	// OrderByIDAsc returns the oldest deliveries first, used to process the outbox in order.
+	OrderByIDAsc bool
+
+	// Pagination
+	Limit  *int
+	Offset *int
+}
+
+// UpdateWebhookDelivery contains fields that can be updated for a webhook delivery.
+type UpdateWebhookDelivery struct {
+	ID            int32
+	UpdatedTs     *int64
+	Status        *WebhookDeliveryStatus
+	Attempts      *int32
+	NextAttemptTs *int64
+	ResponseCode  *int32
+	LastError     *string
+}
+
+// DeleteWebhookDelivery specifies which webhook deliveries to delete.
+type DeleteWebhookDelivery struct {
+	CreatorID *int32
+	WebhookID *string
+	// FinishedBefore deletes deliveries that are no longer pending and were last updated before the given unix timestamp.
+	FinishedBefore *int64
+}
+
+// CreateWebhookDelivery enqueues a new webhook delivery.
+func (s *Store) CreateWebhookDelivery(ctx context.Context, create *WebhookDelivery) (*WebhookDelivery, error) {
+	return s.driver.CreateWebhookDelivery(ctx, create)
+}
+
+// ListWebhookDeliveries retrieves webhook deliveries matching the filter criteria.
+func (s *Store) ListWebhookDeliveries(ctx context.Context, find *FindWebhookDelivery) ([]*WebhookDelivery, error) {
+	return s.driver.ListWebhookDeliveries(ctx, find)
+}
+
+// GetWebhookDelivery retrieves a single webhook delivery, returning nil if none matches.
+func (s *Store) GetWebhookDelivery(ctx context.Context, find *FindWebhookDelivery) (*WebhookDelivery, error) {
+	list, err := s.ListWebhookDeliveries(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+// UpdateWebhookDelivery records the outcome of a delivery attempt.
+func (s *Store) UpdateWebhookDelivery(ctx context.Context, update *UpdateWebhookDelivery) error {
+	return s.driver.UpdateWebhookDelivery(ctx, update)
+}
+
+// DeleteWebhookDeliveries removes webhook deliveries matching the criteria.
+func (s *Store) DeleteWebhookDeliveries(ctx context.Context, delete *DeleteWebhookDelivery) error {
+	return s.driver.DeleteWebhookDeliveries(ctx, delete)
+}
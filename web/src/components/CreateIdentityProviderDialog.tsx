@@ -7,6 +7,7 @@ import { Dialog, DialogContent, DialogFooter, DialogHeader, DialogTitle } from "
 import { Input } from "@/components/ui/input";
 import { Select, SelectContent, SelectItem, SelectTrigger, SelectValue } from "@/components/ui/select";
 import { Separator } from "@/components/ui/separator";
+import { Switch } from "@/components/ui/switch";
 import { identityProviderServiceClient } from "@/connect";
 import { absolutifyLink } from "@/helpers/utils";
 import { handleError } from "@/lib/error";
@@ -17,8 +18,12 @@ import {
   IdentityProvider_Type,
   IdentityProviderConfigSchema,
   IdentityProviderSchema,
+  LDAPConfig,
+  LDAPConfigSchema,
   OAuth2Config,
   OAuth2ConfigSchema,
+  OIDCConfig,
+  OIDCConfigSchema,
 } from "@/types/proto/api/v1/idp_service_pb";
 import { useTranslate } from "@/utils/i18n";
 
@@ -95,6 +100,53 @@ const templateList: IdentityProvider[] = [
       },
     }),
   }),
+  create(IdentityProviderSchema, {
+    name: "",
+    title: "OpenID Connect",
+    type: IdentityProvider_Type.OIDC,
+    identifierFilter: "",
+    config: create(IdentityProviderConfigSchema, {
+      config: {
+        case: "oidcConfig",
+        value: create(OIDCConfigSchema, {
+          issuerUrl: "",
+          clientId: "",
+          clientSecret: "",
+          scopes: ["openid", "profile", "email"],
+          fieldMapping: create(FieldMappingSchema, {
+            identifier: "preferred_username",
+            displayName: "name",
+            email: "email",
+          }),
+          groupsClaim: "",
+          adminGroups: [],
+        }),
+      },
+    }),
+  }),
+  create(IdentityProviderSchema, {
+    name: "",
+    title: "LDAP",
+    type: IdentityProvider_Type.LDAP,
+    identifierFilter: "",
+    config: create(IdentityProviderConfigSchema, {
+      config: {
+        case: "ldapConfig",
+        value: create(LDAPConfigSchema, {
+          serverUrl: "ldaps://ldap.example.com:636",
+          bindDn: "",
+          bindPassword: "",
+          searchBase: "",
+          userFilter: "(uid={username})",
+          fieldMapping: create(FieldMappingSchema, {
+            identifier: "uid",
+            displayName: "cn",
+            email: "mail",
+          }),
+        }),
+      },
+    }),
+  }),
   create(IdentityProviderSchema, {
     name: "",
     title: "Custom",
@@ -152,6 +204,18 @@ function CreateIdentityProviderDialog({ open, onOpenChange, identityProvider, on
     }),
   );
   const [oauth2Scopes, setOAuth2Scopes] = useState<string>("");
+  const [oidcConfig, setOIDCConfig] = useState<OIDCConfig>(
+    create(OIDCConfigSchema, {
+      fieldMapping: create(FieldMappingSchema, {}),
+    }),
+  );
+  const [oidcScopes, setOIDCScopes] = useState<string>("");
+  const [oidcAdminGroups, setOIDCAdminGroups] = useState<string>("");
+  const [ldapConfig, setLDAPConfig] = useState<LDAPConfig>(
+    create(LDAPConfigSchema, {
+      fieldMapping: create(FieldMappingSchema, {}),
+    }),
+  );
   const [selectedTemplate, setSelectedTemplate] = useState<string>("GitHub");
   const isCreating = identityProvider === undefined;
 
@@ -180,10 +244,24 @@ function CreateIdentityProviderDialog({ open, onOpenChange, identityProvider, on
         }),
       );
       setOAuth2Scopes("");
+      setOIDCConfig(create(OIDCConfigSchema, { fieldMapping: create(FieldMappingSchema, {}) }));
+      setOIDCScopes("");
+      setOIDCAdminGroups("");
+      setLDAPConfig(create(LDAPConfigSchema, { fieldMapping: create(FieldMappingSchema, {}) }));
       setSelectedTemplate("GitHub");
     }
   }, [open]);
 
+  const loadOIDCConfig = (config: OIDCConfig) => {
+    const oidcConfig = create(OIDCConfigSchema, config);
+    if (!oidcConfig.fieldMapping) {
+      oidcConfig.fieldMapping = create(FieldMappingSchema, {});
+    }
+    setOIDCConfig(oidcConfig);
+    setOIDCScopes(oidcConfig.scopes.join(" "));
+    setOIDCAdminGroups(oidcConfig.adminGroups.join(", "));
+  };
+
   // Load existing identity provider data when editing
   useEffect(() => {
     if (open && identityProvider) {
@@ -197,6 +275,12 @@ function CreateIdentityProviderDialog({ open, onOpenChange, identityProvider, on
         setOAuth2Config(oauth2Config);
         setOAuth2Scopes(oauth2Config.scopes.join(" "));
       }
+      if (identityProvider.type === IdentityProvider_Type.OIDC && identityProvider.config?.config?.case === "oidcConfig") {
+        loadOIDCConfig(identityProvider.config.config.value);
+      }
+      if (identityProvider.type === IdentityProvider_Type.LDAP && identityProvider.config?.config?.case === "ldapConfig") {
+        setLDAPConfig(create(LDAPConfigSchema, identityProvider.config.config.value));
+      }
     }
   }, [open, identityProvider]);
 
@@ -218,6 +302,12 @@ function CreateIdentityProviderDialog({ open, onOpenChange, identityProvider, on
         setOAuth2Config(oauth2Config);
         setOAuth2Scopes(oauth2Config.scopes.join(" "));
       }
+      if (template.type === IdentityProvider_Type.OIDC && template.config?.config?.case === "oidcConfig") {
+        loadOIDCConfig(template.config.config.value);
+      }
+      if (template.type === IdentityProvider_Type.LDAP && template.config?.config?.case === "ldapConfig") {
+        setLDAPConfig(create(LDAPConfigSchema, template.config.config.value));
+      }
     }
   }, [selectedTemplate, isCreating, open]);
 
@@ -246,10 +336,55 @@ function CreateIdentityProviderDialog({ open, onOpenChange, identityProvider, on
         }
       }
     }
+    if (type === IdentityProvider_Type.OIDC) {
+      if (oidcConfig.issuerUrl === "" || oidcConfig.clientId === "" || oidcConfig.fieldMapping?.identifier === "") {
+        return false;
+      }
+    }
+    if (type === IdentityProvider_Type.LDAP) {
+      if (ldapConfig.serverUrl === "" || ldapConfig.searchBase === "" || !ldapConfig.fieldMapping?.identifier) {
+        return false;
+      }
+    }
 
     return true;
   };
 
+  const buildConfig = () => {
+    if (type === IdentityProvider_Type.LDAP) {
+      return create(IdentityProviderConfigSchema, {
+        config: {
+          case: "ldapConfig",
+          value: ldapConfig,
+        },
+      });
+    }
+    if (type === IdentityProvider_Type.OIDC) {
+      return create(IdentityProviderConfigSchema, {
+        config: {
+          case: "oidcConfig",
+          value: {
+            ...oidcConfig,
+            scopes: oidcScopes.split(" ").filter(Boolean),
+            adminGroups: oidcAdminGroups
+              .split(",")
+              .map((group) => group.trim())
+              .filter(Boolean),
+          },
+        },
+      });
+    }
+    return create(IdentityProviderConfigSchema, {
+      config: {
+        case: "oauth2Config",
+        value: {
+          ...oauth2Config,
+          scopes: oauth2Scopes.split(" "),
+        },
+      },
+    });
+  };
+
   const handleConfirmBtnClick = async () => {
     try {
       if (isCreating) {
@@ -257,15 +392,7 @@ function CreateIdentityProviderDialog({ open, onOpenChange, identityProvider, on
           identityProvider: create(IdentityProviderSchema, {
             ...basicInfo,
             type: type,
-            config: create(IdentityProviderConfigSchema, {
-              config: {
-                case: "oauth2Config",
-                value: {
-                  ...oauth2Config,
-                  scopes: oauth2Scopes.split(" "),
-                },
-              },
-            }),
+            config: buildConfig(),
           }),
         });
         toast.success(t("setting.sso-section.sso-created", { name: basicInfo.title }));
@@ -275,15 +402,7 @@ function CreateIdentityProviderDialog({ open, onOpenChange, identityProvider, on
             ...basicInfo,
             name: identityProvider!.name,
             type: type,
-            config: create(IdentityProviderConfigSchema, {
-              config: {
-                case: "oauth2Config",
-                value: {
-                  ...oauth2Config,
-                  scopes: oauth2Scopes.split(" "),
-                },
-              },
-            }),
+            config: buildConfig(),
           }),
           updateMask: create(FieldMaskSchema, { paths: ["title", "identifier_filter", "config"] }),
         });
@@ -305,6 +424,20 @@ function CreateIdentityProviderDialog({ open, onOpenChange, identityProvider, on
     });
   };
 
+  const setPartialLDAPConfig = (state: Partial<LDAPConfig>) => {
+    setLDAPConfig({
+      ...ldapConfig,
+      ...state,
+    });
+  };
+
+  const setPartialOIDCConfig = (state: Partial<OIDCConfig>) => {
+    setOIDCConfig({
+      ...oidcConfig,
+      ...state,
+    });
+  };
+
   return (
     <Dialog open={open} onOpenChange={onOpenChange}>
       <DialogContent className="max-w-2xl max-h-[80vh] overflow-y-auto">
@@ -480,6 +613,183 @@ function CreateIdentityProviderDialog({ open, onOpenChange, identityProvider, on
               />
             </>
           )}
+          {type === IdentityProvider_Type.OIDC && (
+            <>
+              {isCreating && (
+                <p className="border border-border rounded-md p-2 text-sm w-full mb-2 break-all">
+                  {t("setting.sso-section.redirect-url")}: {absolutifyLink("/auth/callback")}
+                </p>
+              )}
+              <p className="mb-1 text-sm font-medium">
+                {t("setting.sso-section.issuer-url")}
+                <span className="text-destructive">*</span>
+              </p>
+              <Input
+                className="mb-2 w-full"
+                placeholder="https://keycloak.example.com/realms/memos"
+                value={oidcConfig.issuerUrl}
+                onChange={(e) => setPartialOIDCConfig({ issuerUrl: e.target.value })}
+              />
+              <p className="mb-1 text-sm font-medium">
+                {t("setting.sso-section.client-id")}
+                <span className="text-destructive">*</span>
+              </p>
+              <Input
+                className="mb-2 w-full"
+                placeholder={t("setting.sso-section.client-id")}
+                value={oidcConfig.clientId}
+                onChange={(e) => setPartialOIDCConfig({ clientId: e.target.value })}
+              />
+              <p className="mb-1 text-sm font-medium">{t("setting.sso-section.client-secret")}</p>
+              <Input
+                className="mb-2 w-full"
+                placeholder={t("setting.sso-section.client-secret")}
+                value={oidcConfig.clientSecret}
+                onChange={(e) => setPartialOIDCConfig({ clientSecret: e.target.value })}
+              />
+              <p className="mb-1 text-sm font-medium">{t("setting.sso-section.scopes")}</p>
+              <Input
+                className="mb-2 w-full"
+                placeholder="openid profile email"
+                value={oidcScopes}
+                onChange={(e) => setOIDCScopes(e.target.value)}
+              />
+              <Separator className="my-2" />
+              <p className="mb-1 text-sm font-medium">
+                {t("setting.sso-section.identifier")}
+                <span className="text-destructive">*</span>
+              </p>
+              <Input
+                className="mb-2 w-full"
+                placeholder={t("setting.sso-section.identifier")}
+                value={oidcConfig.fieldMapping!.identifier}
+                onChange={(e) =>
+                  setPartialOIDCConfig({ fieldMapping: { ...oidcConfig.fieldMapping, identifier: e.target.value } as FieldMapping })
+                }
+              />
+              <p className="mb-1 text-sm font-medium">{t("setting.sso-section.display-name")}</p>
+              <Input
+                className="mb-2 w-full"
+                placeholder={t("setting.sso-section.display-name")}
+                value={oidcConfig.fieldMapping!.displayName}
+                onChange={(e) =>
+                  setPartialOIDCConfig({ fieldMapping: { ...oidcConfig.fieldMapping, displayName: e.target.value } as FieldMapping })
+                }
+              />
+              <p className="mb-1 text-sm font-medium">{t("common.email")}</p>
+              <Input
+                className="mb-2 w-full"
+                placeholder={t("common.email")}
+                value={oidcConfig.fieldMapping!.email}
+                onChange={(e) =>
+                  setPartialOIDCConfig({ fieldMapping: { ...oidcConfig.fieldMapping, email: e.target.value } as FieldMapping })
+                }
+              />
+              <Separator className="my-2" />
+              <p className="mb-1 text-sm font-medium">{t("setting.sso-section.groups-claim")}</p>
+              <Input
+                className="mb-2 w-full"
+                placeholder="groups"
+                value={oidcConfig.groupsClaim}
+                onChange={(e) => setPartialOIDCConfig({ groupsClaim: e.target.value })}
+              />
+              <p className="mb-1 text-sm font-medium">{t("setting.sso-section.admin-groups")}</p>
+              <Input
+                className="mb-2 w-full"
+                placeholder="/memos-admins"
+                value={oidcAdminGroups}
+                onChange={(e) => setOIDCAdminGroups(e.target.value)}
+              />
+            </>
+          )}
+          {type === IdentityProvider_Type.LDAP && (
+            <>
+              <p className="mb-1 text-sm font-medium">
+                {t("setting.sso-section.server-url")}
+                <span className="text-destructive">*</span>
+              </p>
+              <Input
+                className="mb-2 w-full"
+                placeholder="ldaps://ldap.example.com:636"
+                value={ldapConfig.serverUrl}
+                onChange={(e) => setPartialLDAPConfig({ serverUrl: e.target.value })}
+              />
+              <div className="w-full flex flex-row justify-between items-center mb-2">
+                <p className="text-sm font-medium">{t("setting.sso-section.start-tls")}</p>
+                <Switch checked={ldapConfig.startTls} onCheckedChange={(checked) => setPartialLDAPConfig({ startTls: checked })} />
+              </div>
+              <div className="w-full flex flex-row justify-between items-center mb-2">
+                <p className="text-sm font-medium">{t("setting.sso-section.insecure-skip-verify")}</p>
+                <Switch
+                  checked={ldapConfig.insecureSkipVerify}
+                  onCheckedChange={(checked) => setPartialLDAPConfig({ insecureSkipVerify: checked })}
+                />
+              </div>
+              <p className="mb-1 text-sm font-medium">{t("setting.sso-section.bind-dn")}</p>
+              <Input
+                className="mb-2 w-full"
+                placeholder="cn=memos,ou=services,dc=example,dc=com"
+                value={ldapConfig.bindDn}
+                onChange={(e) => setPartialLDAPConfig({ bindDn: e.target.value })}
+              />
+              <p className="mb-1 text-sm font-medium">{t("setting.sso-section.bind-password")}</p>
+              <Input
+                className="mb-2 w-full"
+                type="password"
+                placeholder={t("setting.sso-section.bind-password")}
+                value={ldapConfig.bindPassword}
+                onChange={(e) => setPartialLDAPConfig({ bindPassword: e.target.value })}
+              />
+              <p className="mb-1 text-sm font-medium">
+                {t("setting.sso-section.search-base")}
+                <span className="text-destructive">*</span>
+              </p>
+              <Input
+                className="mb-2 w-full"
+                placeholder="ou=people,dc=example,dc=com"
+                value={ldapConfig.searchBase}
+                onChange={(e) => setPartialLDAPConfig({ searchBase: e.target.value })}
+              />
+              <p className="mb-1 text-sm font-medium">{t("setting.sso-section.user-filter")}</p>
+              <Input
+                className="mb-2 w-full"
+                placeholder="(uid={username})"
+                value={ldapConfig.userFilter}
+                onChange={(e) => setPartialLDAPConfig({ userFilter: e.target.value })}
+              />
+              <Separator className="my-2" />
+              <p className="mb-1 text-sm font-medium">
+                {t("setting.sso-section.identifier")}
+                <span className="text-destructive">*</span>
+              </p>
+              <Input
+                className="mb-2 w-full"
+                placeholder={t("setting.sso-section.identifier")}
+                value={ldapConfig.fieldMapping?.identifier}
+                onChange={(e) =>
+                  setPartialLDAPConfig({ fieldMapping: { ...ldapConfig.fieldMapping, identifier: e.target.value } as FieldMapping })
+                }
+              />
+              <p className="mb-1 text-sm font-medium">{t("setting.sso-section.display-name")}</p>
+              <Input
+                className="mb-2 w-full"
+                placeholder={t("setting.sso-section.display-name")}
+                value={ldapConfig.fieldMapping?.displayName}
+                onChange={(e) =>
+                  setPartialLDAPConfig({ fieldMapping: { ...ldapConfig.fieldMapping, displayName: e.target.value } as FieldMapping })
+                }
+              />
+              <p className="mb-1 text-sm font-medium">{t("common.email")}</p>
+              <Input
+                className="mb-2 w-full"
+                placeholder={t("common.email")}
+                value={ldapConfig.fieldMapping?.email}
+                onChange={(e) =>
+                  setPartialLDAPConfig({ fieldMapping: { ...ldapConfig.fieldMapping, email: e.target.value } as FieldMapping })
+                }
+              />
+            </>
+          )}
         </div>
         <DialogFooter>
           <Button variant="ghost" onClick={handleCloseBtnClick}>
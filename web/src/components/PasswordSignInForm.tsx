@@ -21,6 +21,8 @@ function PasswordSignInForm() {
   const actionBtnLoadingState = useLoading(false);
   const [username, setUsername] = useState(profile.demo ? "demo" : "");
   const [password, setPassword] = useState(profile.demo ? "secret" : "");
+  const [twoFactorChallenge, setTwoFactorChallenge] = useState("");
+  const [twoFactorCode, setTwoFactorCode] = useState("");
 
   const handleUsernameInputChanged = (e: React.ChangeEvent<HTMLInputElement>) => {
     const text = e.target.value as string;
@@ -32,13 +34,18 @@ function PasswordSignInForm() {
     setPassword(text);
   };
 
+  const handleTwoFactorCodeInputChanged = (e: React.ChangeEvent<HTMLInputElement>) => {
+    const text = e.target.value as string;
+    setTwoFactorCode(text);
+  };
+
   const handleFormSubmit = (e: React.FormEvent<HTMLFormElement>) => {
     e.preventDefault();
     handleSignInButtonClick();
   };
 
   const handleSignInButtonClick = async () => {
-    if (username === "" || password === "") {
+    if (username === "" || password === "" || (twoFactorChallenge !== "" && twoFactorCode === "")) {
       return;
     }
 
@@ -49,11 +56,23 @@ function PasswordSignInForm() {
     try {
       actionBtnLoadingState.setLoading();
       const response = await authServiceClient.signIn({
-        credentials: {
-          case: "passwordCredentials",
-          value: { username, password },
-        },
+        credentials:
+          twoFactorChallenge !== ""
+            ? {
+                case: "twoFactorCredentials",
+                value: { challenge: twoFactorChallenge, code: twoFactorCode },
+              }
+            : {
+                case: "passwordCredentials",
+                value: { username, password },
+              },
       });
+      // The password is correct, but a code of the authenticator app is required.
+      if (response.twoFactorChallenge) {
+        setTwoFactorChallenge(response.twoFactorChallenge);
+        actionBtnLoadingState.setFinish();
+        return;
+      }
       // Store access token from login response
       if (response.accessToken) {
         setAccessToken(response.accessToken, response.accessTokenExpiresAt ? timestampDate(response.accessTokenExpiresAt) : undefined);
@@ -101,6 +120,24 @@ function PasswordSignInForm() {
             required
           />
         </div>
+        {twoFactorChallenge !== "" && (
+          <div className="w-full flex flex-col justify-start items-start">
+            <span className="leading-8 text-muted-foreground">{t("auth.two-factor-code")}</span>
+            <Input
+              className="w-full bg-background h-10"
+              type="text"
+              readOnly={actionBtnLoadingState.isLoading}
+              placeholder={t("auth.two-factor-code-placeholder")}
+              value={twoFactorCode}
+              autoComplete="one-time-code"
+              autoCapitalize="off"
+              autoFocus
+              spellCheck={false}
+              onChange={handleTwoFactorCodeInputChanged}
+              required
+            />
+          </div>
+        )}
       </div>
       <div className="flex flex-row justify-end items-center w-full mt-6">
         <Button type="submit" className="w-full h-10" disabled={actionBtnLoadingState.isLoading} onClick={handleSignInButtonClick}>
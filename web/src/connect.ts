@@ -9,6 +9,7 @@ import { IdentityProviderService } from "./types/proto/api/v1/idp_service_pb";
 import { InstanceService } from "./types/proto/api/v1/instance_service_pb";
 import { MemoService } from "./types/proto/api/v1/memo_service_pb";
 import { ShortcutService } from "./types/proto/api/v1/shortcut_service_pb";
+import { TagService } from "./types/proto/api/v1/tag_service_pb";
 import { UserService } from "./types/proto/api/v1/user_service_pb";
 import { redirectOnAuthFailure } from "./utils/auth-redirect";
 
@@ -141,6 +142,7 @@ export const userServiceClient = createClient(UserService, transport);
 export const memoServiceClient = createClient(MemoService, transport);
 export const attachmentServiceClient = createClient(AttachmentService, transport);
 export const shortcutServiceClient = createClient(ShortcutService, transport);
+export const tagServiceClient = createClient(TagService, transport);
 export const activityServiceClient = createClient(ActivityService, transport);
 
 // Configuration service clients
@@ -1,4 +1,5 @@
 import OpenAI from 'openai';
+import { getAccessToken } from '@/auth-state';
 import { supabase, type MemoEmbedding } from './supabase';
 
 export type { MemoEmbedding };
@@ -109,10 +110,12 @@ async function generateHuggingFaceEmbedding(text: string): Promise<number[]> {
   const API_URL = '/api/embedding';
 
   try {
+    const token = getAccessToken();
     const response = await fetch(API_URL, {
       method: 'POST',
       headers: {
         'Content-Type': 'application/json',
+        ...(token ? { Authorization: `Bearer ${token}` } : {}),
       },
       body: JSON.stringify({
         inputs: text,
@@ -67,7 +67,7 @@ const AuthCallback = () => {
       return;
     }
 
-    const { identityProviderId, returnUrl, codeVerifier } = validatedState;
+    const { identityProviderId, returnUrl, codeVerifier, nonce } = validatedState;
     const redirectUri = absolutifyLink("/auth/callback");
 
     (async () => {
@@ -80,6 +80,7 @@ const AuthCallback = () => {
               code,
               redirectUri,
               codeVerifier: codeVerifier || "", // Pass PKCE code_verifier for token exchange
+              nonce: nonce || "", // Checked against the ID token of OpenID Connect providers
             },
           },
         });
@@ -4,6 +4,8 @@ import { ArchiveIcon, BellIcon, InboxIcon } from "lucide-react";
 import { useState } from "react";
 import Empty from "@/components/Empty";
 import MemoCommentMessage from "@/components/Inbox/MemoCommentMessage";
+import MemoMentionMessage from "@/components/Inbox/MemoMentionMessage";
+import TaskDueMessage from "@/components/Inbox/TaskDueMessage";
 import MobileHeader from "@/components/MobileHeader";
 import useMediaQuery from "@/hooks/useMediaQuery";
 import { useNotifications } from "@/hooks/useUserQueries";
@@ -108,6 +110,12 @@ const Inboxes = () => {
                   if (notification.type === UserNotification_Type.MEMO_COMMENT) {
                     return <MemoCommentMessage key={notification.name} notification={notification} />;
                   }
+                  if (notification.type === UserNotification_Type.MEMO_MENTION) {
+                    return <MemoMentionMessage key={notification.name} notification={notification} />;
+                  }
+                  if (notification.type === UserNotification_Type.TASK_DUE) {
+                    return <TaskDueMessage key={notification.name} notification={notification} />;
+                  }
                   return null;
                 })}
               </div>
@@ -38,6 +38,10 @@ const SignIn = () => {
     fetchIdentityProviderList();
   }, []);
 
+  // Directory (LDAP) users sign in with the password form, other providers redirect to their sign-in page.
+  const hasDirectory = identityProviderList.some((identityProvider) => identityProvider.type === IdentityProvider_Type.LDAP);
+  const redirectIdentityProviderList = identityProviderList.filter((identityProvider) => identityProvider.type !== IdentityProvider_Type.LDAP);
+
   const handleSignInWithIdentityProvider = async (identityProvider: IdentityProvider) => {
     if (identityProvider.type === IdentityProvider_Type.OAUTH2) {
       const redirectUri = absolutifyLink("/auth/callback");
@@ -68,6 +72,40 @@ const SignIn = () => {
           fallbackMessage: "Failed to initiate sign-in. Please try again.",
         });
       }
+    } else if (identityProvider.type === IdentityProvider_Type.OIDC) {
+      const redirectUri = absolutifyLink("/auth/callback");
+      const oidcConfig = identityProvider.config?.config?.case === "oidcConfig" ? identityProvider.config.config.value : undefined;
+      if (!oidcConfig?.authorizationEndpoint) {
+        toast.error("Identity provider configuration is invalid.");
+        return;
+      }
+
+      try {
+        const identityProviderId = extractIdentityProviderIdFromName(identityProvider.name);
+        const { state, codeChallenge, nonce } = await storeOAuthState(identityProviderId);
+        const scopes = oidcConfig.scopes.length > 0 ? oidcConfig.scopes : ["openid", "profile", "email"];
+        if (!scopes.includes("openid")) {
+          scopes.unshift("openid");
+        }
+
+        // The authorization endpoint is discovered by the server from the issuer.
+        const authUrl = new URL(oidcConfig.authorizationEndpoint);
+        authUrl.searchParams.set("client_id", oidcConfig.clientId);
+        authUrl.searchParams.set("redirect_uri", redirectUri);
+        authUrl.searchParams.set("state", state);
+        authUrl.searchParams.set("nonce", nonce);
+        authUrl.searchParams.set("response_type", "code");
+        authUrl.searchParams.set("scope", scopes.join(" "));
+        authUrl.searchParams.set("code_challenge", codeChallenge);
+        authUrl.searchParams.set("code_challenge_method", "S256");
+
+        window.location.href = authUrl.toString();
+      } catch (error) {
+        handleError(error, toast.error, {
+          context: "Failed to initiate OpenID Connect flow",
+          fallbackMessage: "Failed to initiate sign-in. Please try again.",
+        });
+      }
     }
   };
 
@@ -78,10 +116,12 @@ const SignIn = () => {
           <img className="h-14 w-auto rounded-full shadow" src={instanceGeneralSetting.customProfile?.logoUrl || "/logo.webp"} alt="" />
           <p className="ml-2 text-5xl text-foreground opacity-80">{instanceGeneralSetting.customProfile?.title || "Memos"}</p>
         </div>
-        {!instanceGeneralSetting.disallowPasswordAuth ? (
+        {!instanceGeneralSetting.disallowPasswordAuth || hasDirectory ? (
           <PasswordSignInForm />
         ) : (
-          identityProviderList.length === 0 && <p className="w-full text-2xl mt-2 text-muted-foreground">Password auth is not allowed.</p>
+          redirectIdentityProviderList.length === 0 && (
+            <p className="w-full text-2xl mt-2 text-muted-foreground">Password auth is not allowed.</p>
+          )
         )}
         {!instanceGeneralSetting.disallowUserRegistration && !instanceGeneralSetting.disallowPasswordAuth && (
           <p className="w-full mt-4 text-sm">
@@ -91,9 +131,9 @@ const SignIn = () => {
             </Link>
           </p>
         )}
-        {identityProviderList.length > 0 && (
+        {redirectIdentityProviderList.length > 0 && (
           <>
-            {!instanceGeneralSetting.disallowPasswordAuth && (
+            {(!instanceGeneralSetting.disallowPasswordAuth || hasDirectory) && (
               <div className="relative my-4 w-full">
                 <Separator />
                 <div className="absolute inset-0 flex items-center justify-center">
@@ -102,7 +142,7 @@ const SignIn = () => {
               </div>
             )}
             <div className="w-full flex flex-col space-y-2">
-              {identityProviderList.map((identityProvider) => (
+              {redirectIdentityProviderList.map((identityProvider) => (
                 <Button
                   className="bg-background w-full"
                   key={identityProvider.name}
@@ -16,7 +16,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file api/v1/activity_service.proto.
  */
 export const file_api_v1_activity_service: GenFile = /*@__PURE__*/
-  fileDesc("Ch1hcGkvdjEvYWN0aXZpdHlfc2VydmljZS5wcm90bxIMbWVtb3MuYXBpLnYxIsEDCghBY3Rpdml0eRIUCgRuYW1lGAEgASgJQgbgQQPgQQgSFAoHY3JlYXRvchgCIAEoCUID4EEDEi4KBHR5cGUYAyABKA4yGy5tZW1vcy5hcGkudjEuQWN0aXZpdHkuVHlwZUID4EEDEjAKBWxldmVsGAQgASgOMhwubWVtb3MuYXBpLnYxLkFjdGl2aXR5LkxldmVsQgPgQQMSNAoLY3JlYXRlX3RpbWUYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSMwoHcGF5bG9hZBgGIAEoCzIdLm1lbW9zLmFwaS52MS5BY3Rpdml0eVBheWxvYWRCA+BBAyIuCgRUeXBlEhQKEFRZUEVfVU5TUEVDSUZJRUQQABIQCgxNRU1PX0NPTU1FTlQQASI9CgVMZXZlbBIVChFMRVZFTF9VTlNQRUNJRklFRBAAEggKBElORk8QARIICgRXQVJOEAISCQoFRVJST1IQAzpN6kFKChVtZW1vcy5hcGkudjEvQWN0aXZpdHkSFWFjdGl2aXRpZXMve2FjdGl2aXR5fRoEbmFtZSoKYWN0aXZpdGllczIIYWN0aXZpdHkiXgoPQWN0aXZpdHlQYXlsb2FkEkAKDG1lbW9fY29tbWVudBgBIAEoCzIoLm1lbW9zLmFwaS52MS5BY3Rpdml0eU1lbW9Db21tZW50UGF5bG9hZEgAQgkKB3BheWxvYWQiQAoaQWN0aXZpdHlNZW1vQ29tbWVudFBheWxvYWQSDAoEbWVtbxgBIAEoCRIUCgxyZWxhdGVkX21lbW8YAiABKAkiPgoVTGlzdEFjdGl2aXRpZXNSZXF1ZXN0EhEKCXBhZ2Vfc2l6ZRgBIAEoBRISCgpwYWdlX3Rva2VuGAIgASgJIl0KFkxpc3RBY3Rpdml0aWVzUmVzcG9uc2USKgoKYWN0aXZpdGllcxgBIAMoCzIWLm1lbW9zLmFwaS52MS5BY3Rpdml0eRIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkiQQoSR2V0QWN0aXZpdHlSZXF1ZXN0EisKBG5hbWUYASABKAlCHeBBAvpBFwoVbWVtb3MuYXBpLnYxL0FjdGl2aXR5Mv8BCg9BY3Rpdml0eVNlcnZpY2USdwoOTGlzdEFjdGl2aXRpZXMSIy5tZW1vcy5hcGkudjEuTGlzdEFjdGl2aXRpZXNSZXF1ZXN0GiQubWVtb3MuYXBpLnYxLkxpc3RBY3Rpdml0aWVzUmVzcG9uc2UiGoLT5JMCFBISL2FwaS92MS9hY3Rpdml0aWVzEnMKC0dldEFjdGl2aXR5EiAubWVtb3MuYXBpLnYxLkdldEFjdGl2aXR5UmVxdWVzdBoWLm1lbW9zLmFwaS52MS5BY3Rpdml0eSIq2kEEbmFtZYLT5JMCHRIbL2FwaS92MS97bmFtZT1hY3Rpdml0aWVzLyp9QqwBChBjb20ubWVtb3MuYXBpLnYxQhRBY3Rpdml0eVNlcnZpY2VQcm90b1ABWjBnaXRodWIuY29tL3VzZW1lbW9zL21lbW9zL3Byb3RvL2dlbi9hcGkvdjE7YXBpdjGiAgNNQViqAgxNZW1vcy5BcGkuVjHKAgxNZW1vc1xBcGlcVjHiAhhNZW1vc1xBcGlcVjFcR1BCTWV0YWRhdGHqAg5NZW1vczo6QXBpOjpWMWIGcHJvdG8z", [file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_timestamp]);
+  fileDesc("Ch1hcGkvdjEvYWN0aXZpdHlfc2VydmljZS5wcm90bxIMbWVtb3MuYXBpLnYxIqUGCghBY3Rpdml0eRIUCgRuYW1lGAEgASgJQgbgQQPgQQgSFAoHY3JlYXRvchgCIAEoCUID4EEDEi4KBHR5cGUYAyABKA4yGy5tZW1vcy5hcGkudjEuQWN0aXZpdHkuVHlwZUID4EEDEjAKBWxldmVsGAQgASgOMhwubWVtb3MuYXBpLnYxLkFjdGl2aXR5LkxldmVsQgPgQQMSNAoLY3JlYXRlX3RpbWUYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSMwoHcGF5bG9hZBgGIAEoCzIdLm1lbW9zLmFwaS52MS5BY3Rpdml0eVBheWxvYWRCA+BBAyKRAwoEVHlwZRIUChBUWVBFX1VOU1BFQ0lGSUVEEAASEAoMTUVNT19DT01NRU5UEAESEgoOU0lHTl9JTl9GQUlMRUQQAhILCgdTSUdOX0lOEAMSEAoMVVNFUl9DUkVBVEVEEAQSFQoRVVNFUl9ST0xFX0NIQU5HRUQQBRIRCg1VU0VSX0FSQ0hJVkVEEAYSEQoNVVNFUl9SRVNUT1JFRBAHEhAKDFVTRVJfREVMRVRFRBAIEiEKHVBFUlNPTkFMX0FDQ0VTU19UT0tFTl9DUkVBVEVEEAkSIQodUEVSU09OQUxfQUNDRVNTX1RPS0VOX0RFTEVURUQQChIcChhJTlNUQU5DRV9TRVRUSU5HX1VQREFURUQQCxIdChlJREVOVElUWV9QUk9WSURFUl9DUkVBVEVEEAwSHQoZSURFTlRJVFlfUFJPVklERVJfVVBEQVRFRBANEh0KGUlERU5USVRZX1BST1ZJREVSX0RFTEVURUQQDhIMCghUQVNLX0RVRRAPEhAKDE1FTU9fTUVOVElPThAQIj0KBUxldmVsEhUKEUxFVkVMX1VOU1BFQ0lGSUVEEAASCAoESU5GTxABEggKBFdBUk4QAhIJCgVFUlJPUhADOk3qQUoKFW1lbW9zLmFwaS52MS9BY3Rpdml0eRIVYWN0aXZpdGllcy97YWN0aXZpdHl9GgRuYW1lKgphY3Rpdml0aWVzMghhY3Rpdml0eSLUAgoPQWN0aXZpdHlQYXlsb2FkEkAKDG1lbW9fY29tbWVudBgBIAEoCzIoLm1lbW9zLmFwaS52MS5BY3Rpdml0eU1lbW9Db21tZW50UGF5bG9hZEgAEkMKDnNpZ25faW5fZmFpbGVkGAIgASgLMikubWVtb3MuYXBpLnYxLkFjdGl2aXR5U2lnbkluRmFpbGVkUGF5bG9hZEgAEjMKBWF1ZGl0GAMgASgLMiIubWVtb3MuYXBpLnYxLkFjdGl2aXR5QXVkaXRQYXlsb2FkSAASOAoIdGFza19kdWUYBCABKAsyJC5tZW1vcy5hcGkudjEuQWN0aXZpdHlUYXNrRHVlUGF5bG9hZEgAEkAKDG1lbW9fbWVudGlvbhgFIAEoCzIoLm1lbW9zLmFwaS52MS5BY3Rpdml0eU1lbW9NZW50aW9uUGF5bG9hZEgAQgkKB3BheWxvYWQidgoUQWN0aXZpdHlBdWRpdFBheWxvYWQSEAoIcmVzb3VyY2UYASABKAkSEgoKaXBfYWRkcmVzcxgCIAEoCRISCgp1c2VyX2FnZW50GAMgASgJEhEKCW9sZF92YWx1ZRgEIAEoCRIRCgluZXdfdmFsdWUYBSABKAkiZwobQWN0aXZpdHlTaWduSW5GYWlsZWRQYXlsb2FkEhAKCHVzZXJuYW1lGAEgASgJEhIKCmlwX2FkZHJlc3MYAiABKAkSEgoKdXNlcl9hZ2VudBgDIAEoCRIOCgZyZWFzb24YBCABKAkiQAoaQWN0aXZpdHlNZW1vQ29tbWVudFBheWxvYWQSDAoEbWVtbxgBIAEoCRIUCgxyZWxhdGVkX21lbW8YAiABKAkiKgoaQWN0aXZpdHlNZW1vTWVudGlvblBheWxvYWQSDAoEbWVtbxgBIAEoCSJlChZBY3Rpdml0eVRhc2tEdWVQYXlsb2FkEgwKBG1lbW8YASABKAkSDwoHY29udGVudBgCIAEoCRIsCghkdWVfdGltZRgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiUwoVTGlzdEFjdGl2aXRpZXNSZXF1ZXN0EhEKCXBhZ2Vfc2l6ZRgBIAEoBRISCgpwYWdlX3Rva2VuGAIgASgJEhMKBmZpbHRlchgDIAEoCUID4EEBIl0KFkxpc3RBY3Rpdml0aWVzUmVzcG9uc2USKgoKYWN0aXZpdGllcxgBIAMoCzIWLm1lbW9zLmFwaS52MS5BY3Rpdml0eRIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkiQQoSR2V0QWN0aXZpdHlSZXF1ZXN0EisKBG5hbWUYASABKAlCHeBBAvpBFwoVbWVtb3MuYXBpLnYxL0FjdGl2aXR5Il4KFkxpc3RBdWRpdEV2ZW50c1JlcXVlc3QSFgoJcGFnZV9zaXplGAEgASgFQgPgQQESFwoKcGFnZV90b2tlbhgCIAEoCUID4EEBEhMKBmZpbHRlchgDIAEoCUID4EEBImAKF0xpc3RBdWRpdEV2ZW50c1Jlc3BvbnNlEiwKDGF1ZGl0X2V2ZW50cxgBIAMoCzIWLm1lbW9zLmFwaS52MS5BY3Rpdml0eRIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkiSAoYRXhwb3J0QXVkaXRFdmVudHNSZXF1ZXN0EhMKBmZpbHRlchgBIAEoCUID4EEBEhcKCnBhZ2VfdG9rZW4YAiABKAlCA+BBASJFChlFeHBvcnRBdWRpdEV2ZW50c1Jlc3BvbnNlEg8KB2NvbnRlbnQYASABKAwSFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJMocECg9BY3Rpdml0eVNlcnZpY2USdwoOTGlzdEFjdGl2aXRpZXMSIy5tZW1vcy5hcGkudjEuTGlzdEFjdGl2aXRpZXNSZXF1ZXN0GiQubWVtb3MuYXBpLnYxLkxpc3RBY3Rpdml0aWVzUmVzcG9uc2UiGoLT5JMCFBISL2FwaS92MS9hY3Rpdml0aWVzEnMKC0dldEFjdGl2aXR5EiAubWVtb3MuYXBpLnYxLkdldEFjdGl2aXR5UmVxdWVzdBoWLm1lbW9zLmFwaS52MS5BY3Rpdml0eSIq2kEEbmFtZYLT5JMCHRIbL2FwaS92MS97bmFtZT1hY3Rpdml0aWVzLyp9EnsKD0xpc3RBdWRpdEV2ZW50cxIkLm1lbW9zLmFwaS52MS5MaXN0QXVkaXRFdmVudHNSZXF1ZXN0GiUubWVtb3MuYXBpLnYxLkxpc3RBdWRpdEV2ZW50c1Jlc3BvbnNlIhuC0+STAhUSEy9hcGkvdjEvYXVkaXRFdmVudHMSiAEKEUV4cG9ydEF1ZGl0RXZlbnRzEiYubWVtb3MuYXBpLnYxLkV4cG9ydEF1ZGl0RXZlbnRzUmVxdWVzdBonLm1lbW9zLmFwaS52MS5FeHBvcnRBdWRpdEV2ZW50c1Jlc3BvbnNlIiKC0+STAhwSGi9hcGkvdjEvYXVkaXRFdmVudHM6ZXhwb3J0QqwBChBjb20ubWVtb3MuYXBpLnYxQhRBY3Rpdml0eVNlcnZpY2VQcm90b1ABWjBnaXRodWIuY29tL3VzZW1lbW9zL21lbW9zL3Byb3RvL2dlbi9hcGkvdjE7YXBpdjGiAgNNQViqAgxNZW1vcy5BcGkuVjHKAgxNZW1vc1xBcGlcVjHiAhhNZW1vc1xBcGlcVjFcR1BCTWV0YWRhdGHqAg5NZW1vczo6QXBpOjpWMWIGcHJvdG8z", [file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_timestamp]);
 
 /**
  * @generated from message memos.api.v1.Activity
@@ -93,6 +93,112 @@ export enum Activity_Type {
    * @generated from enum value: MEMO_COMMENT = 1;
    */
   MEMO_COMMENT = 1,
+
+  /**
+   * Failed sign-in attempt, only visible to admins.
+   *
+   * @generated from enum value: SIGN_IN_FAILED = 2;
+   */
+  SIGN_IN_FAILED = 2,
+
+  /**
+   * Audit events, only visible to admins.
+   * Successful sign-in.
+   *
+   * @generated from enum value: SIGN_IN = 3;
+   */
+  SIGN_IN = 3,
+
+  /**
+   * User created.
+   *
+   * @generated from enum value: USER_CREATED = 4;
+   */
+  USER_CREATED = 4,
+
+  /**
+   * User role changed.
+   *
+   * @generated from enum value: USER_ROLE_CHANGED = 5;
+   */
+  USER_ROLE_CHANGED = 5,
+
+  /**
+   * User archived.
+   *
+   * @generated from enum value: USER_ARCHIVED = 6;
+   */
+  USER_ARCHIVED = 6,
+
+  /**
+   * Archived user restored.
+   *
+   * @generated from enum value: USER_RESTORED = 7;
+   */
+  USER_RESTORED = 7,
+
+  /**
+   * User deleted.
+   *
+   * @generated from enum value: USER_DELETED = 8;
+   */
+  USER_DELETED = 8,
+
+  /**
+   * Personal access token created.
+   *
+   * @generated from enum value: PERSONAL_ACCESS_TOKEN_CREATED = 9;
+   */
+  PERSONAL_ACCESS_TOKEN_CREATED = 9,
+
+  /**
+   * Personal access token deleted.
+   *
+   * @generated from enum value: PERSONAL_ACCESS_TOKEN_DELETED = 10;
+   */
+  PERSONAL_ACCESS_TOKEN_DELETED = 10,
+
+  /**
+   * Instance setting updated.
+   *
+   * @generated from enum value: INSTANCE_SETTING_UPDATED = 11;
+   */
+  INSTANCE_SETTING_UPDATED = 11,
+
+  /**
+   * Identity provider created.
+   *
+   * @generated from enum value: IDENTITY_PROVIDER_CREATED = 12;
+   */
+  IDENTITY_PROVIDER_CREATED = 12,
+
+  /**
+   * Identity provider updated.
+   *
+   * @generated from enum value: IDENTITY_PROVIDER_UPDATED = 13;
+   */
+  IDENTITY_PROVIDER_UPDATED = 13,
+
+  /**
+   * Identity provider deleted.
+   *
+   * @generated from enum value: IDENTITY_PROVIDER_DELETED = 14;
+   */
+  IDENTITY_PROVIDER_DELETED = 14,
+
+  /**
+   * Task of a memo came due.
+   *
+   * @generated from enum value: TASK_DUE = 15;
+   */
+  TASK_DUE = 15,
+
+  /**
+   * User was mentioned in a memo.
+   *
+   * @generated from enum value: MEMO_MENTION = 16;
+   */
+  MEMO_MENTION = 16,
 }
 
 /**
@@ -157,6 +263,38 @@ export type ActivityPayload = Message<"memos.api.v1.ActivityPayload"> & {
      */
     value: ActivityMemoCommentPayload;
     case: "memoComment";
+  } | {
+    /**
+     * Failed sign-in activity payload.
+     *
+     * @generated from field: memos.api.v1.ActivitySignInFailedPayload sign_in_failed = 2;
+     */
+    value: ActivitySignInFailedPayload;
+    case: "signInFailed";
+  } | {
+    /**
+     * Audit event payload.
+     *
+     * @generated from field: memos.api.v1.ActivityAuditPayload audit = 3;
+     */
+    value: ActivityAuditPayload;
+    case: "audit";
+  } | {
+    /**
+     * Task due activity payload.
+     *
+     * @generated from field: memos.api.v1.ActivityTaskDuePayload task_due = 4;
+     */
+    value: ActivityTaskDuePayload;
+    case: "taskDue";
+  } | {
+    /**
+     * Memo mention activity payload.
+     *
+     * @generated from field: memos.api.v1.ActivityMemoMentionPayload memo_mention = 5;
+     */
+    value: ActivityMemoMentionPayload;
+    case: "memoMention";
   } | { case: undefined; value?: undefined };
 };
 
@@ -167,6 +305,99 @@ export type ActivityPayload = Message<"memos.api.v1.ActivityPayload"> & {
 export const ActivityPayloadSchema: GenMessage<ActivityPayload> = /*@__PURE__*/
   messageDesc(file_api_v1_activity_service, 1);
 
+/**
+ * ActivityAuditPayload represents the payload of an audit event.
+ * The creator of the activity is the user who caused the event.
+ *
+ * @generated from message memos.api.v1.ActivityAuditPayload
+ */
+export type ActivityAuditPayload = Message<"memos.api.v1.ActivityAuditPayload"> & {
+  /**
+   * The name of the resource the event is about.
+   * e.g. users/1, users/1/personalAccessTokens/{id}, instance/settings/GENERAL or identity-providers/1.
+   *
+   * @generated from field: string resource = 1;
+   */
+  resource: string;
+
+  /**
+   * The IP address of the client.
+   *
+   * @generated from field: string ip_address = 2;
+   */
+  ipAddress: string;
+
+  /**
+   * The user agent of the client.
+   *
+   * @generated from field: string user_agent = 3;
+   */
+  userAgent: string;
+
+  /**
+   * The value before the change, e.g. the previous role of a role change.
+   *
+   * @generated from field: string old_value = 4;
+   */
+  oldValue: string;
+
+  /**
+   * The value after the change, e.g. the new role of a role change.
+   *
+   * @generated from field: string new_value = 5;
+   */
+  newValue: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ActivityAuditPayload.
+ * Use `create(ActivityAuditPayloadSchema)` to create a new message.
+ */
+export const ActivityAuditPayloadSchema: GenMessage<ActivityAuditPayload> = /*@__PURE__*/
+  messageDesc(file_api_v1_activity_service, 2);
+
+/**
+ * ActivitySignInFailedPayload represents the payload of a failed sign-in activity.
+ *
+ * @generated from message memos.api.v1.ActivitySignInFailedPayload
+ */
+export type ActivitySignInFailedPayload = Message<"memos.api.v1.ActivitySignInFailedPayload"> & {
+  /**
+   * The username the sign-in was attempted with.
+   *
+   * @generated from field: string username = 1;
+   */
+  username: string;
+
+  /**
+   * The IP address of the client.
+   *
+   * @generated from field: string ip_address = 2;
+   */
+  ipAddress: string;
+
+  /**
+   * The user agent of the client.
+   *
+   * @generated from field: string user_agent = 3;
+   */
+  userAgent: string;
+
+  /**
+   * Why the sign-in failed.
+   *
+   * @generated from field: string reason = 4;
+   */
+  reason: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ActivitySignInFailedPayload.
+ * Use `create(ActivitySignInFailedPayloadSchema)` to create a new message.
+ */
+export const ActivitySignInFailedPayloadSchema: GenMessage<ActivitySignInFailedPayload> = /*@__PURE__*/
+  messageDesc(file_api_v1_activity_service, 3);
+
 /**
  * ActivityMemoCommentPayload represents the payload of a memo comment activity.
  *
@@ -195,7 +426,65 @@ export type ActivityMemoCommentPayload = Message<"memos.api.v1.ActivityMemoComme
  * Use `create(ActivityMemoCommentPayloadSchema)` to create a new message.
  */
 export const ActivityMemoCommentPayloadSchema: GenMessage<ActivityMemoCommentPayload> = /*@__PURE__*/
-  messageDesc(file_api_v1_activity_service, 2);
+  messageDesc(file_api_v1_activity_service, 4);
+
+/**
+ * ActivityMemoMentionPayload represents the payload of a memo mention activity.
+ *
+ * @generated from message memos.api.v1.ActivityMemoMentionPayload
+ */
+export type ActivityMemoMentionPayload = Message<"memos.api.v1.ActivityMemoMentionPayload"> & {
+  /**
+   * The name of the memo mentioning the user, empty if the memo was deleted.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string memo = 1;
+   */
+  memo: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ActivityMemoMentionPayload.
+ * Use `create(ActivityMemoMentionPayloadSchema)` to create a new message.
+ */
+export const ActivityMemoMentionPayloadSchema: GenMessage<ActivityMemoMentionPayload> = /*@__PURE__*/
+  messageDesc(file_api_v1_activity_service, 5);
+
+/**
+ * ActivityTaskDuePayload represents the payload of a task due activity.
+ *
+ * @generated from message memos.api.v1.ActivityTaskDuePayload
+ */
+export type ActivityTaskDuePayload = Message<"memos.api.v1.ActivityTaskDuePayload"> & {
+  /**
+   * The name of the memo of the task, empty if the memo was deleted.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string memo = 1;
+   */
+  memo: string;
+
+  /**
+   * The text of the task.
+   *
+   * @generated from field: string content = 2;
+   */
+  content: string;
+
+  /**
+   * The due time of the task.
+   *
+   * @generated from field: google.protobuf.Timestamp due_time = 3;
+   */
+  dueTime?: Timestamp;
+};
+
+/**
+ * Describes the message memos.api.v1.ActivityTaskDuePayload.
+ * Use `create(ActivityTaskDuePayloadSchema)` to create a new message.
+ */
+export const ActivityTaskDuePayloadSchema: GenMessage<ActivityTaskDuePayload> = /*@__PURE__*/
+  messageDesc(file_api_v1_activity_service, 6);
 
 /**
  * @generated from message memos.api.v1.ListActivitiesRequest
@@ -218,6 +507,17 @@ export type ListActivitiesRequest = Message<"memos.api.v1.ListActivitiesRequest"
    * @generated from field: string page_token = 2;
    */
   pageToken: string;
+
+  /**
+   * Optional. Filter to apply to the list results.
+   * Example: "activity_type == \"MEMO_COMMENT\" && create_time > now() - 86400"
+   * Supported fields and their operators:
+   *   - activity_type, level, creator_id: ==, !=, in
+   *   - create_time: ==, !=, <, <=, >, >=
+   *
+   * @generated from field: string filter = 3;
+   */
+  filter: string;
 };
 
 /**
@@ -225,7 +525,7 @@ export type ListActivitiesRequest = Message<"memos.api.v1.ListActivitiesRequest"
  * Use `create(ListActivitiesRequestSchema)` to create a new message.
  */
 export const ListActivitiesRequestSchema: GenMessage<ListActivitiesRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_activity_service, 3);
+  messageDesc(file_api_v1_activity_service, 7);
 
 /**
  * @generated from message memos.api.v1.ListActivitiesResponse
@@ -253,7 +553,7 @@ export type ListActivitiesResponse = Message<"memos.api.v1.ListActivitiesRespons
  * Use `create(ListActivitiesResponseSchema)` to create a new message.
  */
 export const ListActivitiesResponseSchema: GenMessage<ListActivitiesResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_activity_service, 4);
+  messageDesc(file_api_v1_activity_service, 8);
 
 /**
  * @generated from message memos.api.v1.GetActivityRequest
@@ -273,7 +573,126 @@ export type GetActivityRequest = Message<"memos.api.v1.GetActivityRequest"> & {
  * Use `create(GetActivityRequestSchema)` to create a new message.
  */
 export const GetActivityRequestSchema: GenMessage<GetActivityRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_activity_service, 5);
+  messageDesc(file_api_v1_activity_service, 9);
+
+/**
+ * @generated from message memos.api.v1.ListAuditEventsRequest
+ */
+export type ListAuditEventsRequest = Message<"memos.api.v1.ListAuditEventsRequest"> & {
+  /**
+   * The maximum number of audit events to return.
+   * The service may return fewer than this value.
+   * If unspecified, at most 100 audit events will be returned.
+   * The maximum value is 1000; values above 1000 will be coerced to 1000.
+   *
+   * @generated from field: int32 page_size = 1;
+   */
+  pageSize: number;
+
+  /**
+   * A page token, received from a previous `ListAuditEvents` call.
+   * Provide this to retrieve the subsequent page.
+   *
+   * @generated from field: string page_token = 2;
+   */
+  pageToken: string;
+
+  /**
+   * Optional. Filter to apply to the list results, with the fields of the `ListActivities` filter.
+   * Example: "activity_type == \"USER_ROLE_CHANGED\" && create_time > now() - 86400"
+   *
+   * @generated from field: string filter = 3;
+   */
+  filter: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListAuditEventsRequest.
+ * Use `create(ListAuditEventsRequestSchema)` to create a new message.
+ */
+export const ListAuditEventsRequestSchema: GenMessage<ListAuditEventsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_activity_service, 10);
+
+/**
+ * @generated from message memos.api.v1.ListAuditEventsResponse
+ */
+export type ListAuditEventsResponse = Message<"memos.api.v1.ListAuditEventsResponse"> & {
+  /**
+   * The audit events, from the newest.
+   *
+   * @generated from field: repeated memos.api.v1.Activity audit_events = 1;
+   */
+  auditEvents: Activity[];
+
+  /**
+   * A token to retrieve the next page of results.
+   * Pass this value in the page_token field in the subsequent call to `ListAuditEvents`
+   * method to retrieve the next page of results.
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListAuditEventsResponse.
+ * Use `create(ListAuditEventsResponseSchema)` to create a new message.
+ */
+export const ListAuditEventsResponseSchema: GenMessage<ListAuditEventsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_activity_service, 11);
+
+/**
+ * @generated from message memos.api.v1.ExportAuditEventsRequest
+ */
+export type ExportAuditEventsRequest = Message<"memos.api.v1.ExportAuditEventsRequest"> & {
+  /**
+   * Optional. Filter to apply to the exported audit events, see `ListAuditEventsRequest.filter`.
+   *
+   * @generated from field: string filter = 1;
+   */
+  filter: string;
+
+  /**
+   * Optional. The next page token of a previous export, to export the following audit events.
+   *
+   * @generated from field: string page_token = 2;
+   */
+  pageToken: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ExportAuditEventsRequest.
+ * Use `create(ExportAuditEventsRequestSchema)` to create a new message.
+ */
+export const ExportAuditEventsRequestSchema: GenMessage<ExportAuditEventsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_activity_service, 12);
+
+/**
+ * @generated from message memos.api.v1.ExportAuditEventsResponse
+ */
+export type ExportAuditEventsResponse = Message<"memos.api.v1.ExportAuditEventsResponse"> & {
+  /**
+   * The audit events as JSON lines, one JSON encoded activity per line from the newest.
+   *
+   * @generated from field: bytes content = 1;
+   */
+  content: Uint8Array;
+
+  /**
+   * A token to export the following audit events.
+   * If this field is omitted, there are no more audit events.
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ExportAuditEventsResponse.
+ * Use `create(ExportAuditEventsResponseSchema)` to create a new message.
+ */
+export const ExportAuditEventsResponseSchema: GenMessage<ExportAuditEventsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_activity_service, 13);
 
 /**
  * @generated from service memos.api.v1.ActivityService
@@ -299,6 +718,29 @@ export const ActivityService: GenService<{
     input: typeof GetActivityRequestSchema;
     output: typeof ActivitySchema;
   },
+  /**
+   * ListAuditEvents returns the audit events, which record administrative and security events.
+   * Only admins can list audit events.
+   *
+   * @generated from rpc memos.api.v1.ActivityService.ListAuditEvents
+   */
+  listAuditEvents: {
+    methodKind: "unary";
+    input: typeof ListAuditEventsRequestSchema;
+    output: typeof ListAuditEventsResponseSchema;
+  },
+  /**
+   * ExportAuditEvents exports the audit events matching the filter as JSON lines.
+   * An export holds at most 10000 audit events, the following ones are exported with the next page token.
+   * Only admins can export audit events.
+   *
+   * @generated from rpc memos.api.v1.ActivityService.ExportAuditEvents
+   */
+  exportAuditEvents: {
+    methodKind: "unary";
+    input: typeof ExportAuditEventsRequestSchema;
+    output: typeof ExportAuditEventsResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_api_v1_activity_service, 0);
 
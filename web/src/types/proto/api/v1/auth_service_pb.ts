@@ -16,7 +16,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file api/v1/auth_service.proto.
  */
 export const file_api_v1_auth_service: GenFile = /*@__PURE__*/
-  fileDesc("ChlhcGkvdjEvYXV0aF9zZXJ2aWNlLnByb3RvEgxtZW1vcy5hcGkudjEiFwoVR2V0Q3VycmVudFVzZXJSZXF1ZXN0IjoKFkdldEN1cnJlbnRVc2VyUmVzcG9uc2USIAoEdXNlchgBIAEoCzISLm1lbW9zLmFwaS52MS5Vc2VyIuwCCg1TaWduSW5SZXF1ZXN0Ek8KFHBhc3N3b3JkX2NyZWRlbnRpYWxzGAEgASgLMi8ubWVtb3MuYXBpLnYxLlNpZ25JblJlcXVlc3QuUGFzc3dvcmRDcmVkZW50aWFsc0gAEkUKD3Nzb19jcmVkZW50aWFscxgCIAEoCzIqLm1lbW9zLmFwaS52MS5TaWduSW5SZXF1ZXN0LlNTT0NyZWRlbnRpYWxzSAAaQwoTUGFzc3dvcmRDcmVkZW50aWFscxIVCgh1c2VybmFtZRgBIAEoCUID4EECEhUKCHBhc3N3b3JkGAIgASgJQgPgQQIabwoOU1NPQ3JlZGVudGlhbHMSEwoGaWRwX2lkGAEgASgFQgPgQQISEQoEY29kZRgCIAEoCUID4EECEhkKDHJlZGlyZWN0X3VyaRgDIAEoCUID4EECEhoKDWNvZGVfdmVyaWZpZXIYBCABKAlCA+BBAUINCgtjcmVkZW50aWFscyKFAQoOU2lnbkluUmVzcG9uc2USIAoEdXNlchgBIAEoCzISLm1lbW9zLmFwaS52MS5Vc2VyEhQKDGFjY2Vzc190b2tlbhgCIAEoCRI7ChdhY2Nlc3NfdG9rZW5fZXhwaXJlc19hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiEAoOU2lnbk91dFJlcXVlc3QiFQoTUmVmcmVzaFRva2VuUmVxdWVzdCJcChRSZWZyZXNoVG9rZW5SZXNwb25zZRIUCgxhY2Nlc3NfdG9rZW4YASABKAkSLgoKZXhwaXJlc19hdBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAyvwMKC0F1dGhTZXJ2aWNlEnQKDkdldEN1cnJlbnRVc2VyEiMubWVtb3MuYXBpLnYxLkdldEN1cnJlbnRVc2VyUmVxdWVzdBokLm1lbW9zLmFwaS52MS5HZXRDdXJyZW50VXNlclJlc3BvbnNlIheC0+STAhESDy9hcGkvdjEvYXV0aC9tZRJjCgZTaWduSW4SGy5tZW1vcy5hcGkudjEuU2lnbkluUmVxdWVzdBocLm1lbW9zLmFwaS52MS5TaWduSW5SZXNwb25zZSIegtPkkwIYOgEqIhMvYXBpL3YxL2F1dGgvc2lnbmluEl0KB1NpZ25PdXQSHC5tZW1vcy5hcGkudjEuU2lnbk91dFJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiHILT5JMCFiIUL2FwaS92MS9hdXRoL3NpZ25vdXQSdgoMUmVmcmVzaFRva2VuEiEubWVtb3MuYXBpLnYxLlJlZnJlc2hUb2tlblJlcXVlc3QaIi5tZW1vcy5hcGkudjEuUmVmcmVzaFRva2VuUmVzcG9uc2UiH4LT5JMCGToBKiIUL2FwaS92MS9hdXRoL3JlZnJlc2hCqAEKEGNvbS5tZW1vcy5hcGkudjFCEEF1dGhTZXJ2aWNlUHJvdG9QAVowZ2l0aHViLmNvbS91c2VtZW1vcy9tZW1vcy9wcm90by9nZW4vYXBpL3YxO2FwaXYxogIDTUFYqgIMTWVtb3MuQXBpLlYxygIMTWVtb3NcQXBpXFYx4gIYTWVtb3NcQXBpXFYxXEdQQk1ldGFkYXRh6gIOTWVtb3M6OkFwaTo6VjFiBnByb3RvMw", [file_api_v1_user_service, file_google_api_annotations, file_google_api_field_behavior, file_google_protobuf_empty, file_google_protobuf_timestamp]);
+  fileDesc("ChlhcGkvdjEvYXV0aF9zZXJ2aWNlLnByb3RvEgxtZW1vcy5hcGkudjEiFwoVR2V0Q3VycmVudFVzZXJSZXF1ZXN0IjoKFkdldEN1cnJlbnRVc2VyUmVzcG9uc2USIAoEdXNlchgBIAEoCzISLm1lbW9zLmFwaS52MS5Vc2VyIpgECg1TaWduSW5SZXF1ZXN0Ek8KFHBhc3N3b3JkX2NyZWRlbnRpYWxzGAEgASgLMi8ubWVtb3MuYXBpLnYxLlNpZ25JblJlcXVlc3QuUGFzc3dvcmRDcmVkZW50aWFsc0gAEkUKD3Nzb19jcmVkZW50aWFscxgCIAEoCzIqLm1lbW9zLmFwaS52MS5TaWduSW5SZXF1ZXN0LlNTT0NyZWRlbnRpYWxzSAASUgoWdHdvX2ZhY3Rvcl9jcmVkZW50aWFscxgDIAEoCzIwLm1lbW9zLmFwaS52MS5TaWduSW5SZXF1ZXN0LlR3b0ZhY3RvckNyZWRlbnRpYWxzSAAaQwoTUGFzc3dvcmRDcmVkZW50aWFscxIVCgh1c2VybmFtZRgBIAEoCUID4EECEhUKCHBhc3N3b3JkGAIgASgJQgPgQQIagwEKDlNTT0NyZWRlbnRpYWxzEhMKBmlkcF9pZBgBIAEoBUID4EECEhEKBGNvZGUYAiABKAlCA+BBAhIZCgxyZWRpcmVjdF91cmkYAyABKAlCA+BBAhIaCg1jb2RlX3ZlcmlmaWVyGAQgASgJQgPgQQESEgoFbm9uY2UYBSABKAlCA+BBARpBChRUd29GYWN0b3JDcmVkZW50aWFscxIWCgljaGFsbGVuZ2UYASABKAlCA+BBAhIRCgRjb2RlGAIgASgJQgPgQQJCDQoLY3JlZGVudGlhbHMi6AEKDlNpZ25JblJlc3BvbnNlEiAKBHVzZXIYASABKAsyEi5tZW1vcy5hcGkudjEuVXNlchIUCgxhY2Nlc3NfdG9rZW4YAiABKAkSOwoXYWNjZXNzX3Rva2VuX2V4cGlyZXNfYXQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhwKFHR3b19mYWN0b3JfY2hhbGxlbmdlGAQgASgJEkMKH3R3b19mYWN0b3JfY2hhbGxlbmdlX2V4cGlyZXNfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIhAKDlNpZ25PdXRSZXF1ZXN0IhUKE1JlZnJlc2hUb2tlblJlcXVlc3QiXAoUUmVmcmVzaFRva2VuUmVzcG9uc2USFAoMYWNjZXNzX3Rva2VuGAEgASgJEi4KCmV4cGlyZXNfYXQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wMr8DCgtBdXRoU2VydmljZRJ0Cg5HZXRDdXJyZW50VXNlchIjLm1lbW9zLmFwaS52MS5HZXRDdXJyZW50VXNlclJlcXVlc3QaJC5tZW1vcy5hcGkudjEuR2V0Q3VycmVudFVzZXJSZXNwb25zZSIXgtPkkwIREg8vYXBpL3YxL2F1dGgvbWUSYwoGU2lnbkluEhsubWVtb3MuYXBpLnYxLlNpZ25JblJlcXVlc3QaHC5tZW1vcy5hcGkudjEuU2lnbkluUmVzcG9uc2UiHoLT5JMCGDoBKiITL2FwaS92MS9hdXRoL3NpZ25pbhJdCgdTaWduT3V0EhwubWVtb3MuYXBpLnYxLlNpZ25PdXRSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IhyC0+STAhYiFC9hcGkvdjEvYXV0aC9zaWdub3V0EnYKDFJlZnJlc2hUb2tlbhIhLm1lbW9zLmFwaS52MS5SZWZyZXNoVG9rZW5SZXF1ZXN0GiIubWVtb3MuYXBpLnYxLlJlZnJlc2hUb2tlblJlc3BvbnNlIh+C0+STAhk6ASoiFC9hcGkvdjEvYXV0aC9yZWZyZXNoQqgBChBjb20ubWVtb3MuYXBpLnYxQhBBdXRoU2VydmljZVByb3RvUAFaMGdpdGh1Yi5jb20vdXNlbWVtb3MvbWVtb3MvcHJvdG8vZ2VuL2FwaS92MTthcGl2MaICA01BWKoCDE1lbW9zLkFwaS5WMcoCDE1lbW9zXEFwaVxWMeICGE1lbW9zXEFwaVxWMVxHUEJNZXRhZGF0YeoCDk1lbW9zOjpBcGk6OlYxYgZwcm90bzM", [file_api_v1_user_service, file_google_api_annotations, file_google_api_field_behavior, file_google_protobuf_empty, file_google_protobuf_timestamp]);
 
 /**
  * @generated from message memos.api.v1.GetCurrentUserRequest
@@ -75,6 +75,14 @@ export type SignInRequest = Message<"memos.api.v1.SignInRequest"> & {
      */
     value: SignInRequest_SSOCredentials;
     case: "ssoCredentials";
+  } | {
+    /**
+     * Second factor of a password sign-in.
+     *
+     * @generated from field: memos.api.v1.SignInRequest.TwoFactorCredentials two_factor_credentials = 3;
+     */
+    value: SignInRequest_TwoFactorCredentials;
+    case: "twoFactorCredentials";
   } | { case: undefined; value?: undefined };
 };
 
@@ -147,6 +155,14 @@ export type SignInRequest_SSOCredentials = Message<"memos.api.v1.SignInRequest.S
    * @generated from field: string code_verifier = 4;
    */
   codeVerifier: string;
+
+  /**
+   * The nonce sent in the authorization request.
+   * Required for OpenID Connect providers, it must match the nonce of the ID token.
+   *
+   * @generated from field: string nonce = 5;
+   */
+  nonce: string;
 };
 
 /**
@@ -156,6 +172,34 @@ export type SignInRequest_SSOCredentials = Message<"memos.api.v1.SignInRequest.S
 export const SignInRequest_SSOCredentialsSchema: GenMessage<SignInRequest_SSOCredentials> = /*@__PURE__*/
   messageDesc(file_api_v1_auth_service, 2, 1);
 
+/**
+ * Nested message for the second step of a two-factor sign-in.
+ *
+ * @generated from message memos.api.v1.SignInRequest.TwoFactorCredentials
+ */
+export type SignInRequest_TwoFactorCredentials = Message<"memos.api.v1.SignInRequest.TwoFactorCredentials"> & {
+  /**
+   * The challenge returned by the password sign-in.
+   *
+   * @generated from field: string challenge = 1;
+   */
+  challenge: string;
+
+  /**
+   * The code of the authenticator app, or an unused recovery code.
+   *
+   * @generated from field: string code = 2;
+   */
+  code: string;
+};
+
+/**
+ * Describes the message memos.api.v1.SignInRequest.TwoFactorCredentials.
+ * Use `create(SignInRequest_TwoFactorCredentialsSchema)` to create a new message.
+ */
+export const SignInRequest_TwoFactorCredentialsSchema: GenMessage<SignInRequest_TwoFactorCredentials> = /*@__PURE__*/
+  messageDesc(file_api_v1_auth_service, 2, 2);
+
 /**
  * @generated from message memos.api.v1.SignInResponse
  */
@@ -182,6 +226,21 @@ export type SignInResponse = Message<"memos.api.v1.SignInResponse"> & {
    * @generated from field: google.protobuf.Timestamp access_token_expires_at = 3;
    */
   accessTokenExpiresAt?: Timestamp;
+
+  /**
+   * Set instead of the user and tokens when the password is correct but a second factor is required.
+   * Pass it back with TwoFactorCredentials before it expires.
+   *
+   * @generated from field: string two_factor_challenge = 4;
+   */
+  twoFactorChallenge: string;
+
+  /**
+   * When the two-factor challenge expires.
+   *
+   * @generated from field: google.protobuf.Timestamp two_factor_challenge_expires_at = 5;
+   */
+  twoFactorChallengeExpiresAt?: Timestamp;
 };
 
 /**
@@ -263,6 +322,8 @@ export const AuthService: GenService<{
    * SignIn authenticates a user with credentials and returns tokens.
    * On success, returns an access token and sets a refresh token cookie.
    * Supports password-based and SSO authentication methods.
+   * If the user enabled two-factor authentication, a successful password sign-in returns
+   * a challenge instead, which is exchanged for tokens with TwoFactorCredentials.
    *
    * @generated from rpc memos.api.v1.AuthService.SignIn
    */
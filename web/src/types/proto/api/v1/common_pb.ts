@@ -10,7 +10,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file api/v1/common.proto.
  */
 export const file_api_v1_common: GenFile = /*@__PURE__*/
-  fileDesc("ChNhcGkvdjEvY29tbW9uLnByb3RvEgxtZW1vcy5hcGkudjEiKgoJUGFnZVRva2VuEg0KBWxpbWl0GAEgASgFEg4KBm9mZnNldBgCIAEoBSo4CgVTdGF0ZRIVChFTVEFURV9VTlNQRUNJRklFRBAAEgoKBk5PUk1BTBABEgwKCEFSQ0hJVkVEEAIqOQoJRGlyZWN0aW9uEhkKFURJUkVDVElPTl9VTlNQRUNJRklFRBAAEgcKA0FTQxABEggKBERFU0MQAkKjAQoQY29tLm1lbW9zLmFwaS52MUILQ29tbW9uUHJvdG9QAVowZ2l0aHViLmNvbS91c2VtZW1vcy9tZW1vcy9wcm90by9nZW4vYXBpL3YxO2FwaXYxogIDTUFYqgIMTWVtb3MuQXBpLlYxygIMTWVtb3NcQXBpXFYx4gIYTWVtb3NcQXBpXFYxXEdQQk1ldGFkYXRh6gIOTWVtb3M6OkFwaTo6VjFiBnByb3RvMw");
+  fileDesc("ChNhcGkvdjEvY29tbW9uLnByb3RvEgxtZW1vcy5hcGkudjEiqAEKCVBhZ2VUb2tlbhINCgVsaW1pdBgBIAEoBRIOCgZvZmZzZXQYAiABKAUSLgoGY3Vyc29yGAMgASgLMh4ubWVtb3MuYXBpLnYxLlBhZ2VUb2tlbi5DdXJzb3IaTAoGQ3Vyc29yEg4KBnBpbm5lZBgBIAEoCBISCgpjcmVhdGVkX3RzGAIgASgDEhIKCnVwZGF0ZWRfdHMYAyABKAMSCgoCaWQYBCABKAUqQwoFU3RhdGUSFQoRU1RBVEVfVU5TUEVDSUZJRUQQABIKCgZOT1JNQUwQARIMCghBUkNISVZFRBACEgkKBURSQUZUEAMqOQoJRGlyZWN0aW9uEhkKFURJUkVDVElPTl9VTlNQRUNJRklFRBAAEgcKA0FTQxABEggKBERFU0MQAkKjAQoQY29tLm1lbW9zLmFwaS52MUILQ29tbW9uUHJvdG9QAVowZ2l0aHViLmNvbS91c2VtZW1vcy9tZW1vcy9wcm90by9nZW4vYXBpL3YxO2FwaXYxogIDTUFYqgIMTWVtb3MuQXBpLlYxygIMTWVtb3NcQXBpXFYx4gIYTWVtb3NcQXBpXFYxXEdQQk1ldGFkYXRh6gIOTWVtb3M6OkFwaTo6VjFiBnByb3RvMw");
 
 /**
  * Used internally for obfuscating the page token.
@@ -27,6 +27,14 @@ export type PageToken = Message<"memos.api.v1.PageToken"> & {
    * @generated from field: int32 offset = 2;
    */
   offset: number;
+
+  /**
+   * The sort key of the last item of the previous page for keyset pagination.
+   * The offset is used if it is not set.
+   *
+   * @generated from field: memos.api.v1.PageToken.Cursor cursor = 3;
+   */
+  cursor?: PageToken_Cursor;
 };
 
 /**
@@ -36,6 +44,38 @@ export type PageToken = Message<"memos.api.v1.PageToken"> & {
 export const PageTokenSchema: GenMessage<PageToken> = /*@__PURE__*/
   messageDesc(file_api_v1_common, 0);
 
+/**
+ * @generated from message memos.api.v1.PageToken.Cursor
+ */
+export type PageToken_Cursor = Message<"memos.api.v1.PageToken.Cursor"> & {
+  /**
+   * @generated from field: bool pinned = 1;
+   */
+  pinned: boolean;
+
+  /**
+   * @generated from field: int64 created_ts = 2;
+   */
+  createdTs: bigint;
+
+  /**
+   * @generated from field: int64 updated_ts = 3;
+   */
+  updatedTs: bigint;
+
+  /**
+   * @generated from field: int32 id = 4;
+   */
+  id: number;
+};
+
+/**
+ * Describes the message memos.api.v1.PageToken.Cursor.
+ * Use `create(PageToken_CursorSchema)` to create a new message.
+ */
+export const PageToken_CursorSchema: GenMessage<PageToken_Cursor> = /*@__PURE__*/
+  messageDesc(file_api_v1_common, 0, 0);
+
 /**
  * @generated from enum memos.api.v1.State
  */
@@ -54,6 +94,13 @@ export enum State {
    * @generated from enum value: ARCHIVED = 2;
    */
   ARCHIVED = 2,
+
+  /**
+   * Not published yet, such as scheduled memos.
+   *
+   * @generated from enum value: DRAFT = 3;
+   */
+  DRAFT = 3,
 }
 
 /**
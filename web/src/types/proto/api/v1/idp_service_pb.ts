@@ -16,7 +16,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file api/v1/idp_service.proto.
  */
 export const file_api_v1_idp_service: GenFile = /*@__PURE__*/
-  fileDesc("ChhhcGkvdjEvaWRwX3NlcnZpY2UucHJvdG8SDG1lbW9zLmFwaS52MSLfAgoQSWRlbnRpdHlQcm92aWRlchIRCgRuYW1lGAEgASgJQgPgQQgSNgoEdHlwZRgCIAEoDjIjLm1lbW9zLmFwaS52MS5JZGVudGl0eVByb3ZpZGVyLlR5cGVCA+BBAhISCgV0aXRsZRgDIAEoCUID4EECEh4KEWlkZW50aWZpZXJfZmlsdGVyGAQgASgJQgPgQQESOQoGY29uZmlnGAUgASgLMiQubWVtb3MuYXBpLnYxLklkZW50aXR5UHJvdmlkZXJDb25maWdCA+BBAiIoCgRUeXBlEhQKEFRZUEVfVU5TUEVDSUZJRUQQABIKCgZPQVVUSDIQATpn6kFkCh1tZW1vcy5hcGkudjEvSWRlbnRpdHlQcm92aWRlchIYaWRlbnRpdHktcHJvdmlkZXJzL3tpZHB9GgRuYW1lKhFpZGVudGl0eVByb3ZpZGVyczIQaWRlbnRpdHlQcm92aWRlciJXChZJZGVudGl0eVByb3ZpZGVyQ29uZmlnEjMKDW9hdXRoMl9jb25maWcYASABKAsyGi5tZW1vcy5hcGkudjEuT0F1dGgyQ29uZmlnSABCCAoGY29uZmlnIlsKDEZpZWxkTWFwcGluZxISCgppZGVudGlmaWVyGAEgASgJEhQKDGRpc3BsYXlfbmFtZRgCIAEoCRINCgVlbWFpbBgDIAEoCRISCgphdmF0YXJfdXJsGAQgASgJIrcBCgxPQXV0aDJDb25maWcSEQoJY2xpZW50X2lkGAEgASgJEhUKDWNsaWVudF9zZWNyZXQYAiABKAkSEAoIYXV0aF91cmwYAyABKAkSEQoJdG9rZW5fdXJsGAQgASgJEhUKDXVzZXJfaW5mb191cmwYBSABKAkSDgoGc2NvcGVzGAYgAygJEjEKDWZpZWxkX21hcHBpbmcYByABKAsyGi5tZW1vcy5hcGkudjEuRmllbGRNYXBwaW5nIh4KHExpc3RJZGVudGl0eVByb3ZpZGVyc1JlcXVlc3QiWwodTGlzdElkZW50aXR5UHJvdmlkZXJzUmVzcG9uc2USOgoSaWRlbnRpdHlfcHJvdmlkZXJzGAEgAygLMh4ubWVtb3MuYXBpLnYxLklkZW50aXR5UHJvdmlkZXIiUQoaR2V0SWRlbnRpdHlQcm92aWRlclJlcXVlc3QSMwoEbmFtZRgBIAEoCUIl4EEC+kEfCh1tZW1vcy5hcGkudjEvSWRlbnRpdHlQcm92aWRlciKCAQodQ3JlYXRlSWRlbnRpdHlQcm92aWRlclJlcXVlc3QSPgoRaWRlbnRpdHlfcHJvdmlkZXIYASABKAsyHi5tZW1vcy5hcGkudjEuSWRlbnRpdHlQcm92aWRlckID4EECEiEKFGlkZW50aXR5X3Byb3ZpZGVyX2lkGAIgASgJQgPgQQEilQEKHVVwZGF0ZUlkZW50aXR5UHJvdmlkZXJSZXF1ZXN0Ej4KEWlkZW50aXR5X3Byb3ZpZGVyGAEgASgLMh4ubWVtb3MuYXBpLnYxLklkZW50aXR5UHJvdmlkZXJCA+BBAhI0Cgt1cGRhdGVfbWFzaxgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2tCA+BBAiJUCh1EZWxldGVJZGVudGl0eVByb3ZpZGVyUmVxdWVzdBIzCgRuYW1lGAEgASgJQiXgQQL6QR8KHW1lbW9zLmFwaS52MS9JZGVudGl0eVByb3ZpZGVyMucGChdJZGVudGl0eVByb3ZpZGVyU2VydmljZRKUAQoVTGlzdElkZW50aXR5UHJvdmlkZXJzEioubWVtb3MuYXBpLnYxLkxpc3RJZGVudGl0eVByb3ZpZGVyc1JlcXVlc3QaKy5tZW1vcy5hcGkudjEuTGlzdElkZW50aXR5UHJvdmlkZXJzUmVzcG9uc2UiIoLT5JMCHBIaL2FwaS92MS9pZGVudGl0eS1wcm92aWRlcnMSkwEKE0dldElkZW50aXR5UHJvdmlkZXISKC5tZW1vcy5hcGkudjEuR2V0SWRlbnRpdHlQcm92aWRlclJlcXVlc3QaHi5tZW1vcy5hcGkudjEuSWRlbnRpdHlQcm92aWRlciIy2kEEbmFtZYLT5JMCJRIjL2FwaS92MS97bmFtZT1pZGVudGl0eS1wcm92aWRlcnMvKn0SsAEKFkNyZWF0ZUlkZW50aXR5UHJvdmlkZXISKy5tZW1vcy5hcGkudjEuQ3JlYXRlSWRlbnRpdHlQcm92aWRlclJlcXVlc3QaHi5tZW1vcy5hcGkudjEuSWRlbnRpdHlQcm92aWRlciJJ2kERaWRlbnRpdHlfcHJvdmlkZXKC0+STAi86EWlkZW50aXR5X3Byb3ZpZGVyIhovYXBpL3YxL2lkZW50aXR5LXByb3ZpZGVycxLXAQoWVXBkYXRlSWRlbnRpdHlQcm92aWRlchIrLm1lbW9zLmFwaS52MS5VcGRhdGVJZGVudGl0eVByb3ZpZGVyUmVxdWVzdBoeLm1lbW9zLmFwaS52MS5JZGVudGl0eVByb3ZpZGVyInDaQR1pZGVudGl0eV9wcm92aWRlcix1cGRhdGVfbWFza4LT5JMCSjoRaWRlbnRpdHlfcHJvdmlkZXIyNS9hcGkvdjEve2lkZW50aXR5X3Byb3ZpZGVyLm5hbWU9aWRlbnRpdHktcHJvdmlkZXJzLyp9EpEBChZEZWxldGVJZGVudGl0eVByb3ZpZGVyEisubWVtb3MuYXBpLnYxLkRlbGV0ZUlkZW50aXR5UHJvdmlkZXJSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IjLaQQRuYW1lgtPkkwIlKiMvYXBpL3YxL3tuYW1lPWlkZW50aXR5LXByb3ZpZGVycy8qfUKnAQoQY29tLm1lbW9zLmFwaS52MUIPSWRwU2VydmljZVByb3RvUAFaMGdpdGh1Yi5jb20vdXNlbWVtb3MvbWVtb3MvcHJvdG8vZ2VuL2FwaS92MTthcGl2MaICA01BWKoCDE1lbW9zLkFwaS5WMcoCDE1lbW9zXEFwaVxWMeICGE1lbW9zXEFwaVxWMVxHUEJNZXRhZGF0YeoCDk1lbW9zOjpBcGk6OlYxYgZwcm90bzM", [file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_empty, file_google_protobuf_field_mask]);
+  fileDesc("ChhhcGkvdjEvaWRwX3NlcnZpY2UucHJvdG8SDG1lbW9zLmFwaS52MSLzAgoQSWRlbnRpdHlQcm92aWRlchIRCgRuYW1lGAEgASgJQgPgQQgSNgoEdHlwZRgCIAEoDjIjLm1lbW9zLmFwaS52MS5JZGVudGl0eVByb3ZpZGVyLlR5cGVCA+BBAhISCgV0aXRsZRgDIAEoCUID4EECEh4KEWlkZW50aWZpZXJfZmlsdGVyGAQgASgJQgPgQQESOQoGY29uZmlnGAUgASgLMiQubWVtb3MuYXBpLnYxLklkZW50aXR5UHJvdmlkZXJDb25maWdCA+BBAiI8CgRUeXBlEhQKEFRZUEVfVU5TUEVDSUZJRUQQABIKCgZPQVVUSDIQARIICgRPSURDEAISCAoETERBUBADOmfqQWQKHW1lbW9zLmFwaS52MS9JZGVudGl0eVByb3ZpZGVyEhhpZGVudGl0eS1wcm92aWRlcnMve2lkcH0aBG5hbWUqEWlkZW50aXR5UHJvdmlkZXJzMhBpZGVudGl0eVByb3ZpZGVyIrkBChZJZGVudGl0eVByb3ZpZGVyQ29uZmlnEjMKDW9hdXRoMl9jb25maWcYASABKAsyGi5tZW1vcy5hcGkudjEuT0F1dGgyQ29uZmlnSAASLwoLb2lkY19jb25maWcYAiABKAsyGC5tZW1vcy5hcGkudjEuT0lEQ0NvbmZpZ0gAEi8KC2xkYXBfY29uZmlnGAMgASgLMhgubWVtb3MuYXBpLnYxLkxEQVBDb25maWdIAEIICgZjb25maWciWwoMRmllbGRNYXBwaW5nEhIKCmlkZW50aWZpZXIYASABKAkSFAoMZGlzcGxheV9uYW1lGAIgASgJEg0KBWVtYWlsGAMgASgJEhIKCmF2YXRhcl91cmwYBCABKAkitwEKDE9BdXRoMkNvbmZpZxIRCgljbGllbnRfaWQYASABKAkSFQoNY2xpZW50X3NlY3JldBgCIAEoCRIQCghhdXRoX3VybBgDIAEoCRIRCgl0b2tlbl91cmwYBCABKAkSFQoNdXNlcl9pbmZvX3VybBgFIAEoCRIOCgZzY29wZXMYBiADKAkSMQoNZmllbGRfbWFwcGluZxgHIAEoCzIaLm1lbW9zLmFwaS52MS5GaWVsZE1hcHBpbmcigQIKCk9JRENDb25maWcSFwoKaXNzdWVyX3VybBgBIAEoCUID4EECEhYKCWNsaWVudF9pZBgCIAEoCUID4EECEhoKDWNsaWVudF9zZWNyZXQYAyABKAlCA+BBARITCgZzY29wZXMYBCADKAlCA+BBARI2Cg1maWVsZF9tYXBwaW5nGAUgASgLMhoubWVtb3MuYXBpLnYxLkZpZWxkTWFwcGluZ0ID4EEBEiMKFmF1dGhvcml6YXRpb25fZW5kcG9pbnQYBiABKAlCA+BBAxIZCgxncm91cHNfY2xhaW0YByABKAlCA+BBARIZCgxhZG1pbl9ncm91cHMYCCADKAlCA+BBASL+AQoKTERBUENvbmZpZxIXCgpzZXJ2ZXJfdXJsGAEgASgJQgPgQQISFgoJc3RhcnRfdGxzGAIgASgIQgPgQQESIQoUaW5zZWN1cmVfc2tpcF92ZXJpZnkYAyABKAhCA+BBARIUCgdiaW5kX2RuGAQgASgJQgPgQQESGgoNYmluZF9wYXNzd29yZBgFIAEoCUID4EEBEhgKC3NlYXJjaF9iYXNlGAYgASgJQgPgQQISGAoLdXNlcl9maWx0ZXIYByABKAlCA+BBARI2Cg1maWVsZF9tYXBwaW5nGAggASgLMhoubWVtb3MuYXBpLnYxLkZpZWxkTWFwcGluZ0ID4EECIh4KHExpc3RJZGVudGl0eVByb3ZpZGVyc1JlcXVlc3QiWwodTGlzdElkZW50aXR5UHJvdmlkZXJzUmVzcG9uc2USOgoSaWRlbnRpdHlfcHJvdmlkZXJzGAEgAygLMh4ubWVtb3MuYXBpLnYxLklkZW50aXR5UHJvdmlkZXIiUQoaR2V0SWRlbnRpdHlQcm92aWRlclJlcXVlc3QSMwoEbmFtZRgBIAEoCUIl4EEC+kEfCh1tZW1vcy5hcGkudjEvSWRlbnRpdHlQcm92aWRlciKCAQodQ3JlYXRlSWRlbnRpdHlQcm92aWRlclJlcXVlc3QSPgoRaWRlbnRpdHlfcHJvdmlkZXIYASABKAsyHi5tZW1vcy5hcGkudjEuSWRlbnRpdHlQcm92aWRlckID4EECEiEKFGlkZW50aXR5X3Byb3ZpZGVyX2lkGAIgASgJQgPgQQEilQEKHVVwZGF0ZUlkZW50aXR5UHJvdmlkZXJSZXF1ZXN0Ej4KEWlkZW50aXR5X3Byb3ZpZGVyGAEgASgLMh4ubWVtb3MuYXBpLnYxLklkZW50aXR5UHJvdmlkZXJCA+BBAhI0Cgt1cGRhdGVfbWFzaxgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2tCA+BBAiJUCh1EZWxldGVJZGVudGl0eVByb3ZpZGVyUmVxdWVzdBIzCgRuYW1lGAEgASgJQiXgQQL6QR8KHW1lbW9zLmFwaS52MS9JZGVudGl0eVByb3ZpZGVyMucGChdJZGVudGl0eVByb3ZpZGVyU2VydmljZRKUAQoVTGlzdElkZW50aXR5UHJvdmlkZXJzEioubWVtb3MuYXBpLnYxLkxpc3RJZGVudGl0eVByb3ZpZGVyc1JlcXVlc3QaKy5tZW1vcy5hcGkudjEuTGlzdElkZW50aXR5UHJvdmlkZXJzUmVzcG9uc2UiIoLT5JMCHBIaL2FwaS92MS9pZGVudGl0eS1wcm92aWRlcnMSkwEKE0dldElkZW50aXR5UHJvdmlkZXISKC5tZW1vcy5hcGkudjEuR2V0SWRlbnRpdHlQcm92aWRlclJlcXVlc3QaHi5tZW1vcy5hcGkudjEuSWRlbnRpdHlQcm92aWRlciIy2kEEbmFtZYLT5JMCJRIjL2FwaS92MS97bmFtZT1pZGVudGl0eS1wcm92aWRlcnMvKn0SsAEKFkNyZWF0ZUlkZW50aXR5UHJvdmlkZXISKy5tZW1vcy5hcGkudjEuQ3JlYXRlSWRlbnRpdHlQcm92aWRlclJlcXVlc3QaHi5tZW1vcy5hcGkudjEuSWRlbnRpdHlQcm92aWRlciJJ2kERaWRlbnRpdHlfcHJvdmlkZXKC0+STAi86EWlkZW50aXR5X3Byb3ZpZGVyIhovYXBpL3YxL2lkZW50aXR5LXByb3ZpZGVycxLXAQoWVXBkYXRlSWRlbnRpdHlQcm92aWRlchIrLm1lbW9zLmFwaS52MS5VcGRhdGVJZGVudGl0eVByb3ZpZGVyUmVxdWVzdBoeLm1lbW9zLmFwaS52MS5JZGVudGl0eVByb3ZpZGVyInDaQR1pZGVudGl0eV9wcm92aWRlcix1cGRhdGVfbWFza4LT5JMCSjoRaWRlbnRpdHlfcHJvdmlkZXIyNS9hcGkvdjEve2lkZW50aXR5X3Byb3ZpZGVyLm5hbWU9aWRlbnRpdHktcHJvdmlkZXJzLyp9EpEBChZEZWxldGVJZGVudGl0eVByb3ZpZGVyEisubWVtb3MuYXBpLnYxLkRlbGV0ZUlkZW50aXR5UHJvdmlkZXJSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IjLaQQRuYW1lgtPkkwIlKiMvYXBpL3YxL3tuYW1lPWlkZW50aXR5LXByb3ZpZGVycy8qfUKnAQoQY29tLm1lbW9zLmFwaS52MUIPSWRwU2VydmljZVByb3RvUAFaMGdpdGh1Yi5jb20vdXNlbWVtb3MvbWVtb3MvcHJvdG8vZ2VuL2FwaS92MTthcGl2MaICA01BWKoCDE1lbW9zLkFwaS5WMcoCDE1lbW9zXEFwaVxWMeICGE1lbW9zXEFwaVxWMVxHUEJNZXRhZGF0YeoCDk1lbW9zOjpBcGk6OlYxYgZwcm90bzM", [file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_empty, file_google_protobuf_field_mask]);
 
 /**
  * @generated from message memos.api.v1.IdentityProvider
@@ -81,6 +81,20 @@ export enum IdentityProvider_Type {
    * @generated from enum value: OAUTH2 = 1;
    */
   OAUTH2 = 1,
+
+  /**
+   * OpenID Connect identity provider.
+   *
+   * @generated from enum value: OIDC = 2;
+   */
+  OIDC = 2,
+
+  /**
+   * LDAP directory, used by password sign-in.
+   *
+   * @generated from enum value: LDAP = 3;
+   */
+  LDAP = 3,
 }
 
 /**
@@ -102,6 +116,18 @@ export type IdentityProviderConfig = Message<"memos.api.v1.IdentityProviderConfi
      */
     value: OAuth2Config;
     case: "oauth2Config";
+  } | {
+    /**
+     * @generated from field: memos.api.v1.OIDCConfig oidc_config = 2;
+     */
+    value: OIDCConfig;
+    case: "oidcConfig";
+  } | {
+    /**
+     * @generated from field: memos.api.v1.LDAPConfig ldap_config = 3;
+     */
+    value: LDAPConfig;
+    case: "ldapConfig";
   } | { case: undefined; value?: undefined };
 };
 
@@ -191,6 +217,152 @@ export type OAuth2Config = Message<"memos.api.v1.OAuth2Config"> & {
 export const OAuth2ConfigSchema: GenMessage<OAuth2Config> = /*@__PURE__*/
   messageDesc(file_api_v1_idp_service, 3);
 
+/**
+ * OpenID Connect configuration. The endpoints and signing keys are discovered
+ * from {issuer_url}/.well-known/openid-configuration.
+ *
+ * @generated from message memos.api.v1.OIDCConfig
+ */
+export type OIDCConfig = Message<"memos.api.v1.OIDCConfig"> & {
+  /**
+   * Required. The issuer URL, e.g. https://keycloak.example.com/realms/memos.
+   *
+   * @generated from field: string issuer_url = 1;
+   */
+  issuerUrl: string;
+
+  /**
+   * Required. The client ID registered with the issuer.
+   *
+   * @generated from field: string client_id = 2;
+   */
+  clientId: string;
+
+  /**
+   * Optional. The client secret, empty for public clients.
+   *
+   * @generated from field: string client_secret = 3;
+   */
+  clientSecret: string;
+
+  /**
+   * Optional. The requested scopes, "openid" is always requested.
+   * Defaults to "openid", "profile" and "email".
+   *
+   * @generated from field: repeated string scopes = 4;
+   */
+  scopes: string[];
+
+  /**
+   * Optional. Maps ID token claims to user fields.
+   * Defaults to the standard "sub", "name", "email" and "picture" claims.
+   *
+   * @generated from field: memos.api.v1.FieldMapping field_mapping = 5;
+   */
+  fieldMapping?: FieldMapping;
+
+  /**
+   * Output only. The authorization endpoint discovered from the issuer.
+   *
+   * @generated from field: string authorization_endpoint = 6;
+   */
+  authorizationEndpoint: string;
+
+  /**
+   * Optional. The ID token claim listing the groups of the user, e.g. "groups".
+   * If set, the role of the user is synchronized with the groups on every sign-in.
+   *
+   * @generated from field: string groups_claim = 7;
+   */
+  groupsClaim: string;
+
+  /**
+   * Optional. Members of any of these groups are admins, other users are regular users.
+   *
+   * @generated from field: repeated string admin_groups = 8;
+   */
+  adminGroups: string[];
+};
+
+/**
+ * Describes the message memos.api.v1.OIDCConfig.
+ * Use `create(OIDCConfigSchema)` to create a new message.
+ */
+export const OIDCConfigSchema: GenMessage<OIDCConfig> = /*@__PURE__*/
+  messageDesc(file_api_v1_idp_service, 4);
+
+/**
+ * LDAP configuration. Users sign in with their directory username and password:
+ * the user is searched with the service account, then bound with the password.
+ *
+ * @generated from message memos.api.v1.LDAPConfig
+ */
+export type LDAPConfig = Message<"memos.api.v1.LDAPConfig"> & {
+  /**
+   * Required. The URL of the directory, e.g. ldaps://ldap.example.com:636.
+   *
+   * @generated from field: string server_url = 1;
+   */
+  serverUrl: string;
+
+  /**
+   * Optional. Upgrades an ldap:// connection with StartTLS.
+   *
+   * @generated from field: bool start_tls = 2;
+   */
+  startTls: boolean;
+
+  /**
+   * Optional. Skips the verification of the directory certificate.
+   *
+   * @generated from field: bool insecure_skip_verify = 3;
+   */
+  insecureSkipVerify: boolean;
+
+  /**
+   * Optional. The DN of the service account searching for users, anonymous if empty.
+   *
+   * @generated from field: string bind_dn = 4;
+   */
+  bindDn: string;
+
+  /**
+   * Optional. The password of the service account.
+   *
+   * @generated from field: string bind_password = 5;
+   */
+  bindPassword: string;
+
+  /**
+   * Required. The DN under which users are searched, e.g. ou=people,dc=example,dc=com.
+   *
+   * @generated from field: string search_base = 6;
+   */
+  searchBase: string;
+
+  /**
+   * Optional. The filter finding the user, "{username}" is replaced with the escaped username.
+   * Defaults to "(uid={username})", use "(sAMAccountName={username})" for Active Directory.
+   *
+   * @generated from field: string user_filter = 7;
+   */
+  userFilter: string;
+
+  /**
+   * Required. Maps entry attributes to user fields, e.g. "uid", "cn" and "mail".
+   *
+   * @generated from field: memos.api.v1.FieldMapping field_mapping = 8;
+   */
+  fieldMapping?: FieldMapping;
+};
+
+/**
+ * Describes the message memos.api.v1.LDAPConfig.
+ * Use `create(LDAPConfigSchema)` to create a new message.
+ */
+export const LDAPConfigSchema: GenMessage<LDAPConfig> = /*@__PURE__*/
+  messageDesc(file_api_v1_idp_service, 5);
+
 /**
  * @generated from message memos.api.v1.ListIdentityProvidersRequest
  */
@@ -202,7 +374,7 @@ export type ListIdentityProvidersRequest = Message<"memos.api.v1.ListIdentityPro
  * Use `create(ListIdentityProvidersRequestSchema)` to create a new message.
  */
 export const ListIdentityProvidersRequestSchema: GenMessage<ListIdentityProvidersRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_idp_service, 4);
+  messageDesc(file_api_v1_idp_service, 6);
 
 /**
  * @generated from message memos.api.v1.ListIdentityProvidersResponse
@@ -221,7 +393,7 @@ export type ListIdentityProvidersResponse = Message<"memos.api.v1.ListIdentityPr
  * Use `create(ListIdentityProvidersResponseSchema)` to create a new message.
  */
 export const ListIdentityProvidersResponseSchema: GenMessage<ListIdentityProvidersResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_idp_service, 5);
+  messageDesc(file_api_v1_idp_service, 7);
 
 /**
  * @generated from message memos.api.v1.GetIdentityProviderRequest
@@ -241,7 +413,7 @@ export type GetIdentityProviderRequest = Message<"memos.api.v1.GetIdentityProvid
  * Use `create(GetIdentityProviderRequestSchema)` to create a new message.
  */
 export const GetIdentityProviderRequestSchema: GenMessage<GetIdentityProviderRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_idp_service, 6);
+  messageDesc(file_api_v1_idp_service, 8);
 
 /**
  * @generated from message memos.api.v1.CreateIdentityProviderRequest
@@ -268,7 +440,7 @@ export type CreateIdentityProviderRequest = Message<"memos.api.v1.CreateIdentity
  * Use `create(CreateIdentityProviderRequestSchema)` to create a new message.
  */
 export const CreateIdentityProviderRequestSchema: GenMessage<CreateIdentityProviderRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_idp_service, 7);
+  messageDesc(file_api_v1_idp_service, 9);
 
 /**
  * @generated from message memos.api.v1.UpdateIdentityProviderRequest
@@ -295,7 +467,7 @@ export type UpdateIdentityProviderRequest = Message<"memos.api.v1.UpdateIdentity
  * Use `create(UpdateIdentityProviderRequestSchema)` to create a new message.
  */
 export const UpdateIdentityProviderRequestSchema: GenMessage<UpdateIdentityProviderRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_idp_service, 8);
+  messageDesc(file_api_v1_idp_service, 10);
 
 /**
  * @generated from message memos.api.v1.DeleteIdentityProviderRequest
@@ -315,7 +487,7 @@ export type DeleteIdentityProviderRequest = Message<"memos.api.v1.DeleteIdentity
  * Use `create(DeleteIdentityProviderRequestSchema)` to create a new message.
  */
 export const DeleteIdentityProviderRequestSchema: GenMessage<DeleteIdentityProviderRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_idp_service, 9);
+  messageDesc(file_api_v1_idp_service, 11);
 
 /**
  * @generated from service memos.api.v1.IdentityProviderService
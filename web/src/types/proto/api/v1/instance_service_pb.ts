@@ -8,15 +8,15 @@ import { file_google_api_annotations } from "../../google/api/annotations_pb";
 import { file_google_api_client } from "../../google/api/client_pb";
 import { file_google_api_field_behavior } from "../../google/api/field_behavior_pb";
 import { file_google_api_resource } from "../../google/api/resource_pb";
-import type { FieldMask } from "@bufbuild/protobuf/wkt";
-import { file_google_protobuf_field_mask } from "@bufbuild/protobuf/wkt";
+import type { EmptySchema, FieldMask, Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file api/v1/instance_service.proto.
  */
 export const file_api_v1_instance_service: GenFile = /*@__PURE__*/
-  fileDesc("Ch1hcGkvdjEvaW5zdGFuY2Vfc2VydmljZS5wcm90bxIMbWVtb3MuYXBpLnYxIlsKD0luc3RhbmNlUHJvZmlsZRIPCgd2ZXJzaW9uGAIgASgJEgwKBGRlbW8YAyABKAgSFAoMaW5zdGFuY2VfdXJsGAYgASgJEhMKC2luaXRpYWxpemVkGAcgASgIIhsKGUdldEluc3RhbmNlUHJvZmlsZVJlcXVlc3QiswsKD0luc3RhbmNlU2V0dGluZxIRCgRuYW1lGAEgASgJQgPgQQgSRwoPZ2VuZXJhbF9zZXR0aW5nGAIgASgLMiwubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZy5HZW5lcmFsU2V0dGluZ0gAEkcKD3N0b3JhZ2Vfc2V0dGluZxgDIAEoCzIsLm1lbW9zLmFwaS52MS5JbnN0YW5jZVNldHRpbmcuU3RvcmFnZVNldHRpbmdIABJQChRtZW1vX3JlbGF0ZWRfc2V0dGluZxgEIAEoCzIwLm1lbW9zLmFwaS52MS5JbnN0YW5jZVNldHRpbmcuTWVtb1JlbGF0ZWRTZXR0aW5nSAAahwMKDkdlbmVyYWxTZXR0aW5nEiIKGmRpc2FsbG93X3VzZXJfcmVnaXN0cmF0aW9uGAIgASgIEh4KFmRpc2FsbG93X3Bhc3N3b3JkX2F1dGgYAyABKAgSGQoRYWRkaXRpb25hbF9zY3JpcHQYBCABKAkSGAoQYWRkaXRpb25hbF9zdHlsZRgFIAEoCRJSCg5jdXN0b21fcHJvZmlsZRgGIAEoCzI6Lm1lbW9zLmFwaS52MS5JbnN0YW5jZVNldHRpbmcuR2VuZXJhbFNldHRpbmcuQ3VzdG9tUHJvZmlsZRIdChV3ZWVrX3N0YXJ0X2RheV9vZmZzZXQYByABKAUSIAoYZGlzYWxsb3dfY2hhbmdlX3VzZXJuYW1lGAggASgIEiAKGGRpc2FsbG93X2NoYW5nZV9uaWNrbmFtZRgJIAEoCBpFCg1DdXN0b21Qcm9maWxlEg0KBXRpdGxlGAEgASgJEhMKC2Rlc2NyaXB0aW9uGAIgASgJEhAKCGxvZ29fdXJsGAMgASgJGroDCg5TdG9yYWdlU2V0dGluZxJOCgxzdG9yYWdlX3R5cGUYASABKA4yOC5tZW1vcy5hcGkudjEuSW5zdGFuY2VTZXR0aW5nLlN0b3JhZ2VTZXR0aW5nLlN0b3JhZ2VUeXBlEhkKEWZpbGVwYXRoX3RlbXBsYXRlGAIgASgJEhwKFHVwbG9hZF9zaXplX2xpbWl0X21iGAMgASgDEkgKCXMzX2NvbmZpZxgEIAEoCzI1Lm1lbW9zLmFwaS52MS5JbnN0YW5jZVNldHRpbmcuU3RvcmFnZVNldHRpbmcuUzNDb25maWcahgEKCFMzQ29uZmlnEhUKDWFjY2Vzc19rZXlfaWQYASABKAkSGQoRYWNjZXNzX2tleV9zZWNyZXQYAiABKAkSEAoIZW5kcG9pbnQYAyABKAkSDgoGcmVnaW9uGAQgASgJEg4KBmJ1Y2tldBgFIAEoCRIWCg51c2VfcGF0aF9zdHlsZRgGIAEoCCJMCgtTdG9yYWdlVHlwZRIcChhTVE9SQUdFX1RZUEVfVU5TUEVDSUZJRUQQABIMCghEQVRBQkFTRRABEgkKBUxPQ0FMEAISBgoCUzMQAxqtAQoSTWVtb1JlbGF0ZWRTZXR0aW5nEiIKGmRpc2FsbG93X3B1YmxpY192aXNpYmlsaXR5GAEgASgIEiAKGGRpc3BsYXlfd2l0aF91cGRhdGVfdGltZRgCIAEoCBIcChRjb250ZW50X2xlbmd0aF9saW1pdBgDIAEoBRIgChhlbmFibGVfZG91YmxlX2NsaWNrX2VkaXQYBCABKAgSEQoJcmVhY3Rpb25zGAcgAygJIkYKA0tleRITCg9LRVlfVU5TUEVDSUZJRUQQABILCgdHRU5FUkFMEAESCwoHU1RPUkFHRRACEhAKDE1FTU9fUkVMQVRFRBADOmHqQV4KHG1lbW9zLmFwaS52MS9JbnN0YW5jZVNldHRpbmcSG2luc3RhbmNlL3NldHRpbmdzL3tzZXR0aW5nfSoQaW5zdGFuY2VTZXR0aW5nczIPaW5zdGFuY2VTZXR0aW5nQgcKBXZhbHVlIk8KGUdldEluc3RhbmNlU2V0dGluZ1JlcXVlc3QSMgoEbmFtZRgBIAEoCUIk4EEC+kEeChxtZW1vcy5hcGkudjEvSW5zdGFuY2VTZXR0aW5nIokBChxVcGRhdGVJbnN0YW5jZVNldHRpbmdSZXF1ZXN0EjMKB3NldHRpbmcYASABKAsyHS5tZW1vcy5hcGkudjEuSW5zdGFuY2VTZXR0aW5nQgPgQQISNAoLdXBkYXRlX21hc2sYAiABKAsyGi5nb29nbGUucHJvdG9idWYuRmllbGRNYXNrQgPgQQEy2wMKD0luc3RhbmNlU2VydmljZRJ+ChJHZXRJbnN0YW5jZVByb2ZpbGUSJy5tZW1vcy5hcGkudjEuR2V0SW5zdGFuY2VQcm9maWxlUmVxdWVzdBodLm1lbW9zLmFwaS52MS5JbnN0YW5jZVByb2ZpbGUiIILT5JMCGhIYL2FwaS92MS9pbnN0YW5jZS9wcm9maWxlEo8BChJHZXRJbnN0YW5jZVNldHRpbmcSJy5tZW1vcy5hcGkudjEuR2V0SW5zdGFuY2VTZXR0aW5nUmVxdWVzdBodLm1lbW9zLmFwaS52MS5JbnN0YW5jZVNldHRpbmciMdpBBG5hbWWC0+STAiQSIi9hcGkvdjEve25hbWU9aW5zdGFuY2Uvc2V0dGluZ3MvKn0StQEKFVVwZGF0ZUluc3RhbmNlU2V0dGluZxIqLm1lbW9zLmFwaS52MS5VcGRhdGVJbnN0YW5jZVNldHRpbmdSZXF1ZXN0Gh0ubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZyJR2kETc2V0dGluZyx1cGRhdGVfbWFza4LT5JMCNToHc2V0dGluZzIqL2FwaS92MS97c2V0dGluZy5uYW1lPWluc3RhbmNlL3NldHRpbmdzLyp9QqwBChBjb20ubWVtb3MuYXBpLnYxQhRJbnN0YW5jZVNlcnZpY2VQcm90b1ABWjBnaXRodWIuY29tL3VzZW1lbW9zL21lbW9zL3Byb3RvL2dlbi9hcGkvdjE7YXBpdjGiAgNNQViqAgxNZW1vcy5BcGkuVjHKAgxNZW1vc1xBcGlcVjHiAhhNZW1vc1xBcGlcVjFcR1BCTWV0YWRhdGHqAg5NZW1vczo6QXBpOjpWMWIGcHJvdG8z", [file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_field_mask]);
+  fileDesc("Ch1hcGkvdjEvaW5zdGFuY2Vfc2VydmljZS5wcm90bxIMbWVtb3MuYXBpLnYxIlsKD0luc3RhbmNlUHJvZmlsZRIPCgd2ZXJzaW9uGAIgASgJEgwKBGRlbW8YAyABKAgSFAoMaW5zdGFuY2VfdXJsGAYgASgJEhMKC2luaXRpYWxpemVkGAcgASgIIhsKGUdldEluc3RhbmNlUHJvZmlsZVJlcXVlc3Qi3REKD0luc3RhbmNlU2V0dGluZxIRCgRuYW1lGAEgASgJQgPgQQgSRwoPZ2VuZXJhbF9zZXR0aW5nGAIgASgLMiwubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZy5HZW5lcmFsU2V0dGluZ0gAEkcKD3N0b3JhZ2Vfc2V0dGluZxgDIAEoCzIsLm1lbW9zLmFwaS52MS5JbnN0YW5jZVNldHRpbmcuU3RvcmFnZVNldHRpbmdIABJQChRtZW1vX3JlbGF0ZWRfc2V0dGluZxgEIAEoCzIwLm1lbW9zLmFwaS52MS5JbnN0YW5jZVNldHRpbmcuTWVtb1JlbGF0ZWRTZXR0aW5nSAASSwoRZW1iZWRkaW5nX3NldHRpbmcYBSABKAsyLi5tZW1vcy5hcGkudjEuSW5zdGFuY2VTZXR0aW5nLkVtYmVkZGluZ1NldHRpbmdIABJBCgxzbXRwX3NldHRpbmcYBiABKAsyKS5tZW1vcy5hcGkudjEuSW5zdGFuY2VTZXR0aW5nLlNtdHBTZXR0aW5nSAAa+gQKDkdlbmVyYWxTZXR0aW5nEiIKGmRpc2FsbG93X3VzZXJfcmVnaXN0cmF0aW9uGAIgASgIEh4KFmRpc2FsbG93X3Bhc3N3b3JkX2F1dGgYAyABKAgSGQoRYWRkaXRpb25hbF9zY3JpcHQYBCABKAkSGAoQYWRkaXRpb25hbF9zdHlsZRgFIAEoCRJSCg5jdXN0b21fcHJvZmlsZRgGIAEoCzI6Lm1lbW9zLmFwaS52MS5JbnN0YW5jZVNldHRpbmcuR2VuZXJhbFNldHRpbmcuQ3VzdG9tUHJvZmlsZRIdChV3ZWVrX3N0YXJ0X2RheV9vZmZzZXQYByABKAUSIAoYZGlzYWxsb3dfY2hhbmdlX3VzZXJuYW1lGAggASgIEiAKGGRpc2FsbG93X2NoYW5nZV9uaWNrbmFtZRgJIAEoCBIgChhyZXF1aXJlX2FkbWluX3R3b19mYWN0b3IYCiABKAgSTwoNc2lnbl9pbl9saW1pdBgLIAEoCzI4Lm1lbW9zLmFwaS52MS5JbnN0YW5jZVNldHRpbmcuR2VuZXJhbFNldHRpbmcuU2lnbkluTGltaXQaRQoNQ3VzdG9tUHJvZmlsZRINCgV0aXRsZRgBIAEoCRITCgtkZXNjcmlwdGlvbhgCIAEoCRIQCghsb2dvX3VybBgDIAEoCRp+CgtTaWduSW5MaW1pdBIhChltYXhfYXR0ZW1wdHNfcGVyX3VzZXJuYW1lGAEgASgFEhsKE21heF9hdHRlbXB0c19wZXJfaXAYAiABKAUSFgoOd2luZG93X3NlY29uZHMYAyABKAUSFwoPbG9ja291dF9zZWNvbmRzGAQgASgFGroDCg5TdG9yYWdlU2V0dGluZxJOCgxzdG9yYWdlX3R5cGUYASABKA4yOC5tZW1vcy5hcGkudjEuSW5zdGFuY2VTZXR0aW5nLlN0b3JhZ2VTZXR0aW5nLlN0b3JhZ2VUeXBlEhkKEWZpbGVwYXRoX3RlbXBsYXRlGAIgASgJEhwKFHVwbG9hZF9zaXplX2xpbWl0X21iGAMgASgDEkgKCXMzX2NvbmZpZxgEIAEoCzI1Lm1lbW9zLmFwaS52MS5JbnN0YW5jZVNldHRpbmcuU3RvcmFnZVNldHRpbmcuUzNDb25maWcahgEKCFMzQ29uZmlnEhUKDWFjY2Vzc19rZXlfaWQYASABKAkSGQoRYWNjZXNzX2tleV9zZWNyZXQYAiABKAkSEAoIZW5kcG9pbnQYAyABKAkSDgoGcmVnaW9uGAQgASgJEg4KBmJ1Y2tldBgFIAEoCRIWCg51c2VfcGF0aF9zdHlsZRgGIAEoCCJMCgtTdG9yYWdlVHlwZRIcChhTVE9SQUdFX1RZUEVfVU5TUEVDSUZJRUQQABIMCghEQVRBQkFTRRABEgkKBUxPQ0FMEAISBgoCUzMQAxqtAQoSTWVtb1JlbGF0ZWRTZXR0aW5nEiIKGmRpc2FsbG93X3B1YmxpY192aXNpYmlsaXR5GAEgASgIEiAKGGRpc3BsYXlfd2l0aF91cGRhdGVfdGltZRgCIAEoCBIcChRjb250ZW50X2xlbmd0aF9saW1pdBgDIAEoBRIgChhlbmFibGVfZG91YmxlX2NsaWNrX2VkaXQYBCABKAgSEQoJcmVhY3Rpb25zGAcgAygJGvIBChBFbWJlZGRpbmdTZXR0aW5nEkkKCHByb3ZpZGVyGAEgASgOMjcubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZy5FbWJlZGRpbmdTZXR0aW5nLlByb3ZpZGVyEhAKCGVuZHBvaW50GAIgASgJEg8KB2FwaV9rZXkYAyABKAkSDQoFbW9kZWwYBCABKAkSEQoJZGltZW5zaW9uGAUgASgFIk4KCFByb3ZpZGVyEhgKFFBST1ZJREVSX1VOU1BFQ0lGSUVEEAASCgoGT1BFTkFJEAESDwoLSFVHR0lOR0ZBQ0UQAhILCgdIQVNISU5HEAMalgEKC1NtdHBTZXR0aW5nEgwKBGhvc3QYASABKAkSDAoEcG9ydBgCIAEoBRIQCgh1c2VybmFtZRgDIAEoCRIQCghwYXNzd29yZBgEIAEoCRISCgpmcm9tX2VtYWlsGAUgASgJEhEKCWZyb21fbmFtZRgGIAEoCRIPCgd1c2VfdGxzGAcgASgIEg8KB3VzZV9zc2wYCCABKAgiXwoDS2V5EhMKD0tFWV9VTlNQRUNJRklFRBAAEgsKB0dFTkVSQUwQARILCgdTVE9SQUdFEAISEAoMTUVNT19SRUxBVEVEEAMSDQoJRU1CRURESU5HEAQSCAoEU01UUBAFOmHqQV4KHG1lbW9zLmFwaS52MS9JbnN0YW5jZVNldHRpbmcSG2luc3RhbmNlL3NldHRpbmdzL3tzZXR0aW5nfSoQaW5zdGFuY2VTZXR0aW5nczIPaW5zdGFuY2VTZXR0aW5nQgcKBXZhbHVlIk8KGUdldEluc3RhbmNlU2V0dGluZ1JlcXVlc3QSMgoEbmFtZRgBIAEoCUIk4EEC+kEeChxtZW1vcy5hcGkudjEvSW5zdGFuY2VTZXR0aW5nIokBChxVcGRhdGVJbnN0YW5jZVNldHRpbmdSZXF1ZXN0EjMKB3NldHRpbmcYASABKAsyHS5tZW1vcy5hcGkudjEuSW5zdGFuY2VTZXR0aW5nQgPgQQISNAoLdXBkYXRlX21hc2sYAiABKAsyGi5nb29nbGUucHJvdG9idWYuRmllbGRNYXNrQgPgQQEiuQIKC0luc3RhbmNlSm9iEhEKBG5hbWUYASABKAlCA+BBCBIYCgtkZXNjcmlwdGlvbhgCIAEoCUID4EEDEhUKCHNjaGVkdWxlGAMgASgJQgPgQQMSDgoGcGF1c2VkGAQgASgIEjYKDWxhc3RfcnVuX3RpbWUYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSFwoKbGFzdF9lcnJvchgGIAEoCUID4EEDEjYKDW5leHRfcnVuX3RpbWUYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQM6TepBSgoYbWVtb3MuYXBpLnYxL0luc3RhbmNlSm9iEhNpbnN0YW5jZS9qb2JzL3tqb2J9KgxpbnN0YW5jZUpvYnMyC2luc3RhbmNlSm9iIhkKF0xpc3RJbnN0YW5jZUpvYnNSZXF1ZXN0IkMKGExpc3RJbnN0YW5jZUpvYnNSZXNwb25zZRInCgRqb2JzGAEgAygLMhkubWVtb3MuYXBpLnYxLkluc3RhbmNlSm9iIkcKFVJ1bkluc3RhbmNlSm9iUmVxdWVzdBIuCgRuYW1lGAEgASgJQiDgQQL6QRoKGG1lbW9zLmFwaS52MS9JbnN0YW5jZUpvYiJ9ChhVcGRhdGVJbnN0YW5jZUpvYlJlcXVlc3QSKwoDam9iGAEgASgLMhkubWVtb3MuYXBpLnYxLkluc3RhbmNlSm9iQgPgQQISNAoLdXBkYXRlX21hc2sYAiABKAsyGi5nb29nbGUucHJvdG9idWYuRmllbGRNYXNrQgPgQQIiKgoUU2VuZFRlc3RFbWFpbFJlcXVlc3QSEgoFZW1haWwYASABKAlCA+BBATL7BwoPSW5zdGFuY2VTZXJ2aWNlEn4KEkdldEluc3RhbmNlUHJvZmlsZRInLm1lbW9zLmFwaS52MS5HZXRJbnN0YW5jZVByb2ZpbGVSZXF1ZXN0Gh0ubWVtb3MuYXBpLnYxLkluc3RhbmNlUHJvZmlsZSIggtPkkwIaEhgvYXBpL3YxL2luc3RhbmNlL3Byb2ZpbGUSjwEKEkdldEluc3RhbmNlU2V0dGluZxInLm1lbW9zLmFwaS52MS5HZXRJbnN0YW5jZVNldHRpbmdSZXF1ZXN0Gh0ubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZyIx2kEEbmFtZYLT5JMCJBIiL2FwaS92MS97bmFtZT1pbnN0YW5jZS9zZXR0aW5ncy8qfRK1AQoVVXBkYXRlSW5zdGFuY2VTZXR0aW5nEioubWVtb3MuYXBpLnYxLlVwZGF0ZUluc3RhbmNlU2V0dGluZ1JlcXVlc3QaHS5tZW1vcy5hcGkudjEuSW5zdGFuY2VTZXR0aW5nIlHaQRNzZXR0aW5nLHVwZGF0ZV9tYXNrgtPkkwI1OgdzZXR0aW5nMiovYXBpL3YxL3tzZXR0aW5nLm5hbWU9aW5zdGFuY2Uvc2V0dGluZ3MvKn0SgAEKEExpc3RJbnN0YW5jZUpvYnMSJS5tZW1vcy5hcGkudjEuTGlzdEluc3RhbmNlSm9ic1JlcXVlc3QaJi5tZW1vcy5hcGkudjEuTGlzdEluc3RhbmNlSm9ic1Jlc3BvbnNlIh2C0+STAhcSFS9hcGkvdjEvaW5zdGFuY2Uvam9icxKGAQoOUnVuSW5zdGFuY2VKb2ISIy5tZW1vcy5hcGkudjEuUnVuSW5zdGFuY2VKb2JSZXF1ZXN0GhkubWVtb3MuYXBpLnYxLkluc3RhbmNlSm9iIjTaQQRuYW1lgtPkkwInOgEqIiIvYXBpL3YxL3tuYW1lPWluc3RhbmNlL2pvYnMvKn06cnVuEpkBChFVcGRhdGVJbnN0YW5jZUpvYhImLm1lbW9zLmFwaS52MS5VcGRhdGVJbnN0YW5jZUpvYlJlcXVlc3QaGS5tZW1vcy5hcGkudjEuSW5zdGFuY2VKb2IiQdpBD2pvYix1cGRhdGVfbWFza4LT5JMCKToDam9iMiIvYXBpL3YxL3tqb2IubmFtZT1pbnN0YW5jZS9qb2JzLyp9EnYKDVNlbmRUZXN0RW1haWwSIi5tZW1vcy5hcGkudjEuU2VuZFRlc3RFbWFpbFJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiKYLT5JMCIzoBKiIeL2FwaS92MS9pbnN0YW5jZTpzZW5kVGVzdEVtYWlsQqwBChBjb20ubWVtb3MuYXBpLnYxQhRJbnN0YW5jZVNlcnZpY2VQcm90b1ABWjBnaXRodWIuY29tL3VzZW1lbW9zL21lbW9zL3Byb3RvL2dlbi9hcGkvdjE7YXBpdjGiAgNNQViqAgxNZW1vcy5BcGkuVjHKAgxNZW1vc1xBcGlcVjHiAhhNZW1vc1xBcGlcVjFcR1BCTWV0YWRhdGHqAg5NZW1vczo6QXBpOjpWMWIGcHJvdG8z", [file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp]);
 
 /**
  * Instance profile message containing basic instance information.
@@ -112,6 +112,18 @@ export type InstanceSetting = Message<"memos.api.v1.InstanceSetting"> & {
      */
     value: InstanceSetting_MemoRelatedSetting;
     case: "memoRelatedSetting";
+  } | {
+    /**
+     * @generated from field: memos.api.v1.InstanceSetting.EmbeddingSetting embedding_setting = 5;
+     */
+    value: InstanceSetting_EmbeddingSetting;
+    case: "embeddingSetting";
+  } | {
+    /**
+     * @generated from field: memos.api.v1.InstanceSetting.SmtpSetting smtp_setting = 6;
+     */
+    value: InstanceSetting_SmtpSetting;
+    case: "smtpSetting";
   } | { case: undefined; value?: undefined };
 };
 
@@ -185,6 +197,22 @@ export type InstanceSetting_GeneralSetting = Message<"memos.api.v1.InstanceSetti
    * @generated from field: bool disallow_change_nickname = 9;
    */
   disallowChangeNickname: boolean;
+
+  /**
+   * require_admin_two_factor requires admins to sign in with two-factor authentication.
+   * Admins without two-factor authentication cannot sign in with a password,
+   * so it can only be turned on once all the active admins enabled two-factor authentication.
+   *
+   * @generated from field: bool require_admin_two_factor = 10;
+   */
+  requireAdminTwoFactor: boolean;
+
+  /**
+   * sign_in_limit throttles failed password sign-in attempts.
+   *
+   * @generated from field: memos.api.v1.InstanceSetting.GeneralSetting.SignInLimit sign_in_limit = 11;
+   */
+  signInLimit?: InstanceSetting_GeneralSetting_SignInLimit;
 };
 
 /**
@@ -223,6 +251,52 @@ export type InstanceSetting_GeneralSetting_CustomProfile = Message<"memos.api.v1
 export const InstanceSetting_GeneralSetting_CustomProfileSchema: GenMessage<InstanceSetting_GeneralSetting_CustomProfile> = /*@__PURE__*/
   messageDesc(file_api_v1_instance_service, 2, 0, 0);
 
+/**
+ * Lockout thresholds of failed password sign-in attempts.
+ *
+ * @generated from message memos.api.v1.InstanceSetting.GeneralSetting.SignInLimit
+ */
+export type InstanceSetting_GeneralSetting_SignInLimit = Message<"memos.api.v1.InstanceSetting.GeneralSetting.SignInLimit"> & {
+  /**
+   * max_attempts_per_username is the number of failed attempts for a username which locks it out.
+   * 0 uses the default of 5, a negative value disables the limit.
+   *
+   * @generated from field: int32 max_attempts_per_username = 1;
+   */
+  maxAttemptsPerUsername: number;
+
+  /**
+   * max_attempts_per_ip is the number of failed attempts from a client IP which locks it out.
+   * 0 uses the default of 20, a negative value disables the limit.
+   *
+   * @generated from field: int32 max_attempts_per_ip = 2;
+   */
+  maxAttemptsPerIp: number;
+
+  /**
+   * window_seconds is the period over which failed attempts are counted.
+   * 0 uses the default of 15 minutes.
+   *
+   * @generated from field: int32 window_seconds = 3;
+   */
+  windowSeconds: number;
+
+  /**
+   * lockout_seconds is how long a username or client IP is locked out.
+   * 0 uses the default of 15 minutes.
+   *
+   * @generated from field: int32 lockout_seconds = 4;
+   */
+  lockoutSeconds: number;
+};
+
+/**
+ * Describes the message memos.api.v1.InstanceSetting.GeneralSetting.SignInLimit.
+ * Use `create(InstanceSetting_GeneralSetting_SignInLimitSchema)` to create a new message.
+ */
+export const InstanceSetting_GeneralSetting_SignInLimitSchema: GenMessage<InstanceSetting_GeneralSetting_SignInLimit> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 2, 0, 1);
+
 /**
  * Storage configuration settings for instance attachments.
  *
@@ -399,6 +473,165 @@ export type InstanceSetting_MemoRelatedSetting = Message<"memos.api.v1.InstanceS
 export const InstanceSetting_MemoRelatedSettingSchema: GenMessage<InstanceSetting_MemoRelatedSetting> = /*@__PURE__*/
   messageDesc(file_api_v1_instance_service, 2, 2);
 
+/**
+ * Embedding provider settings used by semantic search.
+ *
+ * @generated from message memos.api.v1.InstanceSetting.EmbeddingSetting
+ */
+export type InstanceSetting_EmbeddingSetting = Message<"memos.api.v1.InstanceSetting.EmbeddingSetting"> & {
+  /**
+   * provider is the embedding provider.
+   *
+   * @generated from field: memos.api.v1.InstanceSetting.EmbeddingSetting.Provider provider = 1;
+   */
+  provider: InstanceSetting_EmbeddingSetting_Provider;
+
+  /**
+   * endpoint is the base URL of the provider API, e.g. http://localhost:11434/v1.
+   * Empty uses the default endpoint of the provider.
+   *
+   * @generated from field: string endpoint = 2;
+   */
+  endpoint: string;
+
+  /**
+   * api_key is the key used to authenticate with the provider.
+   *
+   * @generated from field: string api_key = 3;
+   */
+  apiKey: string;
+
+  /**
+   * model is the embedding model, empty uses the default model of the provider.
+   *
+   * @generated from field: string model = 4;
+   */
+  model: string;
+
+  /**
+   * dimension is the dimension of the embedding vectors, 0 uses the model default.
+   *
+   * @generated from field: int32 dimension = 5;
+   */
+  dimension: number;
+};
+
+/**
+ * Describes the message memos.api.v1.InstanceSetting.EmbeddingSetting.
+ * Use `create(InstanceSetting_EmbeddingSettingSchema)` to create a new message.
+ */
+export const InstanceSetting_EmbeddingSettingSchema: GenMessage<InstanceSetting_EmbeddingSetting> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 2, 3);
+
+/**
+ * Embedding provider enumeration.
+ *
+ * @generated from enum memos.api.v1.InstanceSetting.EmbeddingSetting.Provider
+ */
+export enum InstanceSetting_EmbeddingSetting_Provider {
+  /**
+   * @generated from enum value: PROVIDER_UNSPECIFIED = 0;
+   */
+  PROVIDER_UNSPECIFIED = 0,
+
+  /**
+   * OPENAI is an OpenAI compatible /v1/embeddings endpoint, e.g. a local Ollama or llama.cpp server.
+   *
+   * @generated from enum value: OPENAI = 1;
+   */
+  OPENAI = 1,
+
+  /**
+   * HUGGINGFACE is the HuggingFace inference API.
+   *
+   * @generated from enum value: HUGGINGFACE = 2;
+   */
+  HUGGINGFACE = 2,
+
+  /**
+   * HASHING is a deterministic local provider hashing words into vectors, mainly for testing.
+   *
+   * @generated from enum value: HASHING = 3;
+   */
+  HASHING = 3,
+}
+
+/**
+ * Describes the enum memos.api.v1.InstanceSetting.EmbeddingSetting.Provider.
+ */
+export const InstanceSetting_EmbeddingSetting_ProviderSchema: GenEnum<InstanceSetting_EmbeddingSetting_Provider> = /*@__PURE__*/
+  enumDesc(file_api_v1_instance_service, 2, 3, 0);
+
+/**
+ * SMTP server settings used to send email notifications.
+ *
+ * @generated from message memos.api.v1.InstanceSetting.SmtpSetting
+ */
+export type InstanceSetting_SmtpSetting = Message<"memos.api.v1.InstanceSetting.SmtpSetting"> & {
+  /**
+   * host is the host of the SMTP server, empty disables emails.
+   *
+   * @generated from field: string host = 1;
+   */
+  host: string;
+
+  /**
+   * port is the port of the SMTP server, e.g. 587 for STARTTLS or 465 for SSL.
+   *
+   * @generated from field: int32 port = 2;
+   */
+  port: number;
+
+  /**
+   * username is the username used to authenticate with the SMTP server.
+   *
+   * @generated from field: string username = 3;
+   */
+  username: string;
+
+  /**
+   * password is the password used to authenticate with the SMTP server.
+   *
+   * @generated from field: string password = 4;
+   */
+  password: string;
+
+  /**
+   * from_email is the address emails are sent from.
+   *
+   * @generated from field: string from_email = 5;
+   */
+  fromEmail: string;
+
+  /**
+   * from_name is the display name emails are sent from.
+   *
+   * @generated from field: string from_name = 6;
+   */
+  fromName: string;
+
+  /**
+   * use_tls enables STARTTLS.
+   *
+   * @generated from field: bool use_tls = 7;
+   */
+  useTls: boolean;
+
+  /**
+   * use_ssl enables implicit SSL/TLS.
+   *
+   * @generated from field: bool use_ssl = 8;
+   */
+  useSsl: boolean;
+};
+
+/**
+ * Describes the message memos.api.v1.InstanceSetting.SmtpSetting.
+ * Use `create(InstanceSetting_SmtpSettingSchema)` to create a new message.
+ */
+export const InstanceSetting_SmtpSettingSchema: GenMessage<InstanceSetting_SmtpSetting> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 2, 4);
+
 /**
  * Enumeration of instance setting keys.
  *
@@ -430,6 +663,20 @@ export enum InstanceSetting_Key {
    * @generated from enum value: MEMO_RELATED = 3;
    */
   MEMO_RELATED = 3,
+
+  /**
+   * EMBEDDING is the key for embedding provider settings.
+   *
+   * @generated from enum value: EMBEDDING = 4;
+   */
+  EMBEDDING = 4,
+
+  /**
+   * SMTP is the key for the SMTP server used to send emails.
+   *
+   * @generated from enum value: SMTP = 5;
+   */
+  SMTP = 5,
 }
 
 /**
@@ -488,6 +735,180 @@ export type UpdateInstanceSettingRequest = Message<"memos.api.v1.UpdateInstanceS
 export const UpdateInstanceSettingRequestSchema: GenMessage<UpdateInstanceSettingRequest> = /*@__PURE__*/
   messageDesc(file_api_v1_instance_service, 4);
 
+/**
+ * A background job run by the instance on a schedule.
+ *
+ * @generated from message memos.api.v1.InstanceJob
+ */
+export type InstanceJob = Message<"memos.api.v1.InstanceJob"> & {
+  /**
+   * The resource name of the job.
+   * Format: instance/jobs/{job}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The description of what the job does.
+   *
+   * @generated from field: string description = 2;
+   */
+  description: string;
+
+  /**
+   * The cron expression of the job schedule.
+   *
+   * @generated from field: string schedule = 3;
+   */
+  schedule: string;
+
+  /**
+   * Whether the scheduled runs of the job are paused.
+   *
+   * @generated from field: bool paused = 4;
+   */
+  paused: boolean;
+
+  /**
+   * The time of the last run, unset if the job never ran.
+   * Scheduled runs which end like the previous one are recorded at most every
+   * ten minutes, so it may lag behind for jobs running more often.
+   *
+   * @generated from field: google.protobuf.Timestamp last_run_time = 5;
+   */
+  lastRunTime?: Timestamp;
+
+  /**
+   * The error of the last run, empty if it succeeded.
+   *
+   * @generated from field: string last_error = 6;
+   */
+  lastError: string;
+
+  /**
+   * The time of the next scheduled run.
+   *
+   * @generated from field: google.protobuf.Timestamp next_run_time = 7;
+   */
+  nextRunTime?: Timestamp;
+};
+
+/**
+ * Describes the message memos.api.v1.InstanceJob.
+ * Use `create(InstanceJobSchema)` to create a new message.
+ */
+export const InstanceJobSchema: GenMessage<InstanceJob> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 5);
+
+/**
+ * Request message for ListInstanceJobs method.
+ *
+ * @generated from message memos.api.v1.ListInstanceJobsRequest
+ */
+export type ListInstanceJobsRequest = Message<"memos.api.v1.ListInstanceJobsRequest"> & {
+};
+
+/**
+ * Describes the message memos.api.v1.ListInstanceJobsRequest.
+ * Use `create(ListInstanceJobsRequestSchema)` to create a new message.
+ */
+export const ListInstanceJobsRequestSchema: GenMessage<ListInstanceJobsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 6);
+
+/**
+ * Response message for ListInstanceJobs method.
+ *
+ * @generated from message memos.api.v1.ListInstanceJobsResponse
+ */
+export type ListInstanceJobsResponse = Message<"memos.api.v1.ListInstanceJobsResponse"> & {
+  /**
+   * The jobs ordered by name.
+   *
+   * @generated from field: repeated memos.api.v1.InstanceJob jobs = 1;
+   */
+  jobs: InstanceJob[];
+};
+
+/**
+ * Describes the message memos.api.v1.ListInstanceJobsResponse.
+ * Use `create(ListInstanceJobsResponseSchema)` to create a new message.
+ */
+export const ListInstanceJobsResponseSchema: GenMessage<ListInstanceJobsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 7);
+
+/**
+ * Request message for RunInstanceJob method.
+ *
+ * @generated from message memos.api.v1.RunInstanceJobRequest
+ */
+export type RunInstanceJobRequest = Message<"memos.api.v1.RunInstanceJobRequest"> & {
+  /**
+   * The resource name of the job.
+   * Format: instance/jobs/{job}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.RunInstanceJobRequest.
+ * Use `create(RunInstanceJobRequestSchema)` to create a new message.
+ */
+export const RunInstanceJobRequestSchema: GenMessage<RunInstanceJobRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 8);
+
+/**
+ * Request message for UpdateInstanceJob method.
+ *
+ * @generated from message memos.api.v1.UpdateInstanceJobRequest
+ */
+export type UpdateInstanceJobRequest = Message<"memos.api.v1.UpdateInstanceJobRequest"> & {
+  /**
+   * The job to update, only paused can be updated.
+   *
+   * @generated from field: memos.api.v1.InstanceJob job = 1;
+   */
+  job?: InstanceJob;
+
+  /**
+   * The list of fields to update.
+   *
+   * @generated from field: google.protobuf.FieldMask update_mask = 2;
+   */
+  updateMask?: FieldMask;
+};
+
+/**
+ * Describes the message memos.api.v1.UpdateInstanceJobRequest.
+ * Use `create(UpdateInstanceJobRequestSchema)` to create a new message.
+ */
+export const UpdateInstanceJobRequestSchema: GenMessage<UpdateInstanceJobRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 9);
+
+/**
+ * Request message for SendTestEmail method.
+ *
+ * @generated from message memos.api.v1.SendTestEmailRequest
+ */
+export type SendTestEmailRequest = Message<"memos.api.v1.SendTestEmailRequest"> & {
+  /**
+   * The address to send the test email to.
+   * If empty, the email of the current user is used.
+   *
+   * @generated from field: string email = 1;
+   */
+  email: string;
+};
+
+/**
+ * Describes the message memos.api.v1.SendTestEmailRequest.
+ * Use `create(SendTestEmailRequestSchema)` to create a new message.
+ */
+export const SendTestEmailRequestSchema: GenMessage<SendTestEmailRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 10);
+
 /**
  * @generated from service memos.api.v1.InstanceService
  */
@@ -522,6 +943,46 @@ export const InstanceService: GenService<{
     input: typeof UpdateInstanceSettingRequestSchema;
     output: typeof InstanceSettingSchema;
   },
+  /**
+   * Lists the background jobs of the instance.
+   *
+   * @generated from rpc memos.api.v1.InstanceService.ListInstanceJobs
+   */
+  listInstanceJobs: {
+    methodKind: "unary";
+    input: typeof ListInstanceJobsRequestSchema;
+    output: typeof ListInstanceJobsResponseSchema;
+  },
+  /**
+   * Runs a background job now, even if it is paused.
+   *
+   * @generated from rpc memos.api.v1.InstanceService.RunInstanceJob
+   */
+  runInstanceJob: {
+    methodKind: "unary";
+    input: typeof RunInstanceJobRequestSchema;
+    output: typeof InstanceJobSchema;
+  },
+  /**
+   * Updates a background job, pausing or resuming its scheduled runs.
+   *
+   * @generated from rpc memos.api.v1.InstanceService.UpdateInstanceJob
+   */
+  updateInstanceJob: {
+    methodKind: "unary";
+    input: typeof UpdateInstanceJobRequestSchema;
+    output: typeof InstanceJobSchema;
+  },
+  /**
+   * Sends a test email with the SMTP setting of the instance.
+   *
+   * @generated from rpc memos.api.v1.InstanceService.SendTestEmail
+   */
+  sendTestEmail: {
+    methodKind: "unary";
+    input: typeof SendTestEmailRequestSchema;
+    output: typeof EmptySchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_api_v1_instance_service, 0);
 
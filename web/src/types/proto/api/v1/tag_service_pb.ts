@@ -0,0 +1,308 @@
+// @generated by protoc-gen-es v2.11.0 with parameter "target=ts"
+// @generated from file api/v1/tag_service.proto (package memos.api.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_google_api_annotations } from "../../google/api/annotations_pb";
+import { file_google_api_client } from "../../google/api/client_pb";
+import { file_google_api_field_behavior } from "../../google/api/field_behavior_pb";
+import { file_google_api_resource } from "../../google/api/resource_pb";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file api/v1/tag_service.proto.
+ */
+export const file_api_v1_tag_service: GenFile = /*@__PURE__*/
+  fileDesc("ChhhcGkvdjEvdGFnX3NlcnZpY2UucHJvdG8SDG1lbW9zLmFwaS52MSImCgNUYWcSCwoDdGFnGAEgASgJEhIKCm1lbW9fY291bnQYAiABKAUiPAoPTGlzdFRhZ3NSZXF1ZXN0EikKBnBhcmVudBgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvVXNlciIzChBMaXN0VGFnc1Jlc3BvbnNlEh8KBHRhZ3MYASADKAsyES5tZW1vcy5hcGkudjEuVGFnIoUBChBSZW5hbWVUYWdSZXF1ZXN0EikKBnBhcmVudBgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvVXNlchIUCgdvbGRfdGFnGAIgASgJQgPgQQISFAoHbmV3X3RhZxgDIAEoCUID4EECEhoKDXZhbGlkYXRlX29ubHkYBCABKAhCA+BBASIiChFSZW5hbWVUYWdSZXNwb25zZRINCgVtZW1vcxgBIAMoCSKMAQoQTWVyZ2VUYWdzUmVxdWVzdBIpCgZwYXJlbnQYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL1VzZXISGAoLc291cmNlX3RhZ3MYAiADKAlCA+BBAhIXCgp0YXJnZXRfdGFnGAMgASgJQgPgQQISGgoNdmFsaWRhdGVfb25seRgEIAEoCEID4EEBIiIKEU1lcmdlVGFnc1Jlc3BvbnNlEg0KBW1lbW9zGAEgAygJImsKEERlbGV0ZVRhZ1JlcXVlc3QSKQoGcGFyZW50GAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9Vc2VyEhAKA3RhZxgCIAEoCUID4EECEhoKDXZhbGlkYXRlX29ubHkYAyABKAhCA+BBASIiChFEZWxldGVUYWdSZXNwb25zZRINCgVtZW1vcxgBIAMoCTLMBAoKVGFnU2VydmljZRJ5CghMaXN0VGFncxIdLm1lbW9zLmFwaS52MS5MaXN0VGFnc1JlcXVlc3QaHi5tZW1vcy5hcGkudjEuTGlzdFRhZ3NSZXNwb25zZSIu2kEGcGFyZW50gtPkkwIfEh0vYXBpL3YxL3twYXJlbnQ9dXNlcnMvKn0vdGFncxKWAQoJUmVuYW1lVGFnEh4ubWVtb3MuYXBpLnYxLlJlbmFtZVRhZ1JlcXVlc3QaHy5tZW1vcy5hcGkudjEuUmVuYW1lVGFnUmVzcG9uc2UiSNpBFnBhcmVudCxvbGRfdGFnLG5ld190YWeC0+STAik6ASoiJC9hcGkvdjEve3BhcmVudD11c2Vycy8qfS90YWdzOnJlbmFtZRKcAQoJTWVyZ2VUYWdzEh4ubWVtb3MuYXBpLnYxLk1lcmdlVGFnc1JlcXVlc3QaHy5tZW1vcy5hcGkudjEuTWVyZ2VUYWdzUmVzcG9uc2UiTtpBHXBhcmVudCxzb3VyY2VfdGFncyx0YXJnZXRfdGFngtPkkwIoOgEqIiMvYXBpL3YxL3twYXJlbnQ9dXNlcnMvKn0vdGFnczptZXJnZRKKAQoJRGVsZXRlVGFnEh4ubWVtb3MuYXBpLnYxLkRlbGV0ZVRhZ1JlcXVlc3QaHy5tZW1vcy5hcGkudjEuRGVsZXRlVGFnUmVzcG9uc2UiPNpBCnBhcmVudCx0YWeC0+STAik6ASoiJC9hcGkvdjEve3BhcmVudD11c2Vycy8qfS90YWdzOmRlbGV0ZUKnAQoQY29tLm1lbW9zLmFwaS52MUIPVGFnU2VydmljZVByb3RvUAFaMGdpdGh1Yi5jb20vdXNlbWVtb3MvbWVtb3MvcHJvdG8vZ2VuL2FwaS92MTthcGl2MaICA01BWKoCDE1lbW9zLkFwaS5WMcoCDE1lbW9zXEFwaVxWMeICGE1lbW9zXEFwaVxWMVxHUEJNZXRhZGF0YeoCDk1lbW9zOjpBcGk6OlYxYgZwcm90bzM", [file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource]);
+
+/**
+ * @generated from message memos.api.v1.Tag
+ */
+export type Tag = Message<"memos.api.v1.Tag"> & {
+  /**
+   * The tag, without the # prefix.
+   * Nested tags are separated by "/", e.g. "work/project".
+   *
+   * @generated from field: string tag = 1;
+   */
+  tag: string;
+
+  /**
+   * The number of memos using the tag, archived memos included.
+   *
+   * @generated from field: int32 memo_count = 2;
+   */
+  memoCount: number;
+};
+
+/**
+ * Describes the message memos.api.v1.Tag.
+ * Use `create(TagSchema)` to create a new message.
+ */
+export const TagSchema: GenMessage<Tag> = /*@__PURE__*/
+  messageDesc(file_api_v1_tag_service, 0);
+
+/**
+ * @generated from message memos.api.v1.ListTagsRequest
+ */
+export type ListTagsRequest = Message<"memos.api.v1.ListTagsRequest"> & {
+  /**
+   * Required. The user whose tags are listed.
+   * Format: users/{user}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListTagsRequest.
+ * Use `create(ListTagsRequestSchema)` to create a new message.
+ */
+export const ListTagsRequestSchema: GenMessage<ListTagsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_tag_service, 1);
+
+/**
+ * @generated from message memos.api.v1.ListTagsResponse
+ */
+export type ListTagsResponse = Message<"memos.api.v1.ListTagsResponse"> & {
+  /**
+   * The tags, sorted by name.
+   *
+   * @generated from field: repeated memos.api.v1.Tag tags = 1;
+   */
+  tags: Tag[];
+};
+
+/**
+ * Describes the message memos.api.v1.ListTagsResponse.
+ * Use `create(ListTagsResponseSchema)` to create a new message.
+ */
+export const ListTagsResponseSchema: GenMessage<ListTagsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_tag_service, 2);
+
+/**
+ * @generated from message memos.api.v1.RenameTagRequest
+ */
+export type RenameTagRequest = Message<"memos.api.v1.RenameTagRequest"> & {
+  /**
+   * Required. The user whose memos are changed.
+   * Format: users/{user}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+
+  /**
+   * Required. The tag to rename, without the # prefix.
+   *
+   * @generated from field: string old_tag = 2;
+   */
+  oldTag: string;
+
+  /**
+   * Required. The new name of the tag, without the # prefix.
+   * It must not be in use already; use MergeTags to combine tags.
+   *
+   * @generated from field: string new_tag = 3;
+   */
+  newTag: string;
+
+  /**
+   * Optional. If set, report the memos which would be changed, but do not change them.
+   *
+   * @generated from field: bool validate_only = 4;
+   */
+  validateOnly: boolean;
+};
+
+/**
+ * Describes the message memos.api.v1.RenameTagRequest.
+ * Use `create(RenameTagRequestSchema)` to create a new message.
+ */
+export const RenameTagRequestSchema: GenMessage<RenameTagRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_tag_service, 3);
+
+/**
+ * @generated from message memos.api.v1.RenameTagResponse
+ */
+export type RenameTagResponse = Message<"memos.api.v1.RenameTagResponse"> & {
+  /**
+   * The memos which are changed.
+   * Format: memos/{memo}
+   *
+   * @generated from field: repeated string memos = 1;
+   */
+  memos: string[];
+};
+
+/**
+ * Describes the message memos.api.v1.RenameTagResponse.
+ * Use `create(RenameTagResponseSchema)` to create a new message.
+ */
+export const RenameTagResponseSchema: GenMessage<RenameTagResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_tag_service, 4);
+
+/**
+ * @generated from message memos.api.v1.MergeTagsRequest
+ */
+export type MergeTagsRequest = Message<"memos.api.v1.MergeTagsRequest"> & {
+  /**
+   * Required. The user whose memos are changed.
+   * Format: users/{user}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+
+  /**
+   * Required. The tags to merge into the target tag, without the # prefix.
+   *
+   * @generated from field: repeated string source_tags = 2;
+   */
+  sourceTags: string[];
+
+  /**
+   * Required. The tag replacing the source tags, without the # prefix.
+   *
+   * @generated from field: string target_tag = 3;
+   */
+  targetTag: string;
+
+  /**
+   * Optional. If set, report the memos which would be changed, but do not change them.
+   *
+   * @generated from field: bool validate_only = 4;
+   */
+  validateOnly: boolean;
+};
+
+/**
+ * Describes the message memos.api.v1.MergeTagsRequest.
+ * Use `create(MergeTagsRequestSchema)` to create a new message.
+ */
+export const MergeTagsRequestSchema: GenMessage<MergeTagsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_tag_service, 5);
+
+/**
+ * @generated from message memos.api.v1.MergeTagsResponse
+ */
+export type MergeTagsResponse = Message<"memos.api.v1.MergeTagsResponse"> & {
+  /**
+   * The memos which are changed.
+   * Format: memos/{memo}
+   *
+   * @generated from field: repeated string memos = 1;
+   */
+  memos: string[];
+};
+
+/**
+ * Describes the message memos.api.v1.MergeTagsResponse.
+ * Use `create(MergeTagsResponseSchema)` to create a new message.
+ */
+export const MergeTagsResponseSchema: GenMessage<MergeTagsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_tag_service, 6);
+
+/**
+ * @generated from message memos.api.v1.DeleteTagRequest
+ */
+export type DeleteTagRequest = Message<"memos.api.v1.DeleteTagRequest"> & {
+  /**
+   * Required. The user whose memos are changed.
+   * Format: users/{user}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+
+  /**
+   * Required. The tag to remove, without the # prefix.
+   *
+   * @generated from field: string tag = 2;
+   */
+  tag: string;
+
+  /**
+   * Optional. If set, report the memos which would be changed, but do not change them.
+   *
+   * @generated from field: bool validate_only = 3;
+   */
+  validateOnly: boolean;
+};
+
+/**
+ * Describes the message memos.api.v1.DeleteTagRequest.
+ * Use `create(DeleteTagRequestSchema)` to create a new message.
+ */
+export const DeleteTagRequestSchema: GenMessage<DeleteTagRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_tag_service, 7);
+
+/**
+ * @generated from message memos.api.v1.DeleteTagResponse
+ */
+export type DeleteTagResponse = Message<"memos.api.v1.DeleteTagResponse"> & {
+  /**
+   * The memos which are changed.
+   * Format: memos/{memo}
+   *
+   * @generated from field: repeated string memos = 1;
+   */
+  memos: string[];
+};
+
+/**
+ * Describes the message memos.api.v1.DeleteTagResponse.
+ * Use `create(DeleteTagResponseSchema)` to create a new message.
+ */
+export const DeleteTagResponseSchema: GenMessage<DeleteTagResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_tag_service, 8);
+
+/**
+ * @generated from service memos.api.v1.TagService
+ */
+export const TagService: GenService<{
+  /**
+   * ListTags returns the tags used in the memos of a user.
+   *
+   * @generated from rpc memos.api.v1.TagService.ListTags
+   */
+  listTags: {
+    methodKind: "unary";
+    input: typeof ListTagsRequestSchema;
+    output: typeof ListTagsResponseSchema;
+  },
+  /**
+   * RenameTag renames a tag, and the tags nested under it, in all memos of a user.
+   *
+   * @generated from rpc memos.api.v1.TagService.RenameTag
+   */
+  renameTag: {
+    methodKind: "unary";
+    input: typeof RenameTagRequestSchema;
+    output: typeof RenameTagResponseSchema;
+  },
+  /**
+   * MergeTags replaces tags, and the tags nested under them, with a target tag in all memos of a user.
+   *
+   * @generated from rpc memos.api.v1.TagService.MergeTags
+   */
+  mergeTags: {
+    methodKind: "unary";
+    input: typeof MergeTagsRequestSchema;
+    output: typeof MergeTagsResponseSchema;
+  },
+  /**
+   * DeleteTag removes a tag, and the tags nested under it, from all memos of a user.
+   *
+   * @generated from rpc memos.api.v1.TagService.DeleteTag
+   */
+  deleteTag: {
+    methodKind: "unary";
+    input: typeof DeleteTagRequestSchema;
+    output: typeof DeleteTagResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_api_v1_tag_service, 0);
+